@@ -0,0 +1,89 @@
+// Command authctl manages the auth service from the command line.
+//
+//	authctl [-addr localhost:50051] [-ca cert/ca.cert] [-token TOKEN] policy apply -f policy.yaml [-dry-run]
+//	authctl [-addr localhost:50051] [-ca cert/ca.cert] [-token TOKEN] policy export [-o policy.yaml]
+//
+// The access token of an admin is taken from -token or the AUTHCTL_TOKEN environment variable.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+const requestTimeout = 30 * time.Second
+
+func main() {
+	addr := flag.String("addr", envOrDefault("AUTHCTL_ADDR", "localhost:50051"), "gRPC address of the auth service")
+	caFile := flag.String("ca", envOrDefault("AUTHCTL_CA", "cert/ca.cert"), "CA certificate verifying the service")
+	token := flag.String("token", os.Getenv("AUTHCTL_TOKEN"), "access token of an admin")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() < 2 || flag.Arg(0) != "policy" {
+		usage()
+		os.Exit(2)
+	}
+
+	creds, err := credentials.NewClientTLSFromFile(*caFile, "")
+	if err != nil {
+		fatalf("failed to load CA certificate: %v", err)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		fatalf("failed to connect to %s: %v", *addr, err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*token)
+
+	client := pb.NewAccessV1Client(conn)
+	args := flag.Args()[2:]
+	switch flag.Arg(1) {
+	case "apply":
+		err = applyPolicy(ctx, client, args)
+	case "export":
+		err = exportPolicy(ctx, client, args)
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fatalf("%v", err)
+	}
+}
+
+func usage() {
+	out := flag.CommandLine.Output()
+	_, _ = fmt.Fprintln(out, "Usage:")
+	_, _ = fmt.Fprintln(out, "  authctl [flags] policy apply -f policy.yaml [-dry-run]")
+	_, _ = fmt.Fprintln(out, "  authctl [flags] policy export [-o policy.yaml]")
+	_, _ = fmt.Fprintln(out, "Flags:")
+	flag.PrintDefaults()
+}
+
+func envOrDefault(key, value string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return value
+}
+
+func fatalf(format string, args ...any) {
+	_, _ = fmt.Fprintf(os.Stderr, "authctl: "+format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// applyPolicy sends the policy document to the service and prints the plan, the plan is applied unless -dry-run is set.
+func applyPolicy(ctx context.Context, client pb.AccessV1Client, args []string) error {
+	flags := flag.NewFlagSet("policy apply", flag.ExitOnError)
+	file := flags.String("f", "", "YAML or JSON policy document, - reads standard input")
+	dryRun := flags.Bool("dry-run", false, "print the plan without applying it")
+	_ = flags.Parse(args)
+
+	if *file == "" {
+		return errors.New("policy document required: -f policy.yaml")
+	}
+
+	document, err := readDocument(*file)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ApplyPolicy(ctx, &pb.ApplyPolicyRequest{Document: string(document), DryRun: *dryRun})
+	if err != nil {
+		return err
+	}
+
+	printPlan(os.Stdout, resp.GetRoleChanges(), resp.GetChanges(), resp.GetApplied())
+
+	return nil
+}
+
+// exportPolicy writes the policy document of the stored roles and permissions to the file or standard output.
+func exportPolicy(ctx context.Context, client pb.AccessV1Client, args []string) error {
+	flags := flag.NewFlagSet("policy export", flag.ExitOnError)
+	file := flags.String("o", "", "output file, standard output if not set")
+	_ = flags.Parse(args)
+
+	resp, err := client.ExportPolicy(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+
+	if *file == "" {
+		_, err = io.WriteString(os.Stdout, resp.GetDocument())
+		return err
+	}
+
+	return os.WriteFile(*file, []byte(resp.GetDocument()), 0o600)
+}
+
+func readDocument(file string) ([]byte, error) {
+	if file == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(file)
+}
+
+// printPlan prints one change per line marked with + for creations, ~ for updates and - for deletions,
+// role changes first, followed by a summary such as "Plan: 1 to create, 1 to update, 1 to delete.".
+func printPlan(w io.Writer, roleChanges []*pb.RoleChange, changes []*pb.PolicyChange, applied bool) {
+	counts := make(map[string]int, 3)
+	for _, change := range roleChanges {
+		counts[change.GetAction()]++
+		role := change.GetRole()
+
+		switch change.GetAction() {
+		case "create":
+			_, _ = fmt.Fprintf(w, "+ role %s%s\n", role.GetName(), describeRole(role))
+		case "update":
+			_, _ = fmt.Fprintf(w, "~ role %s: %s\n", role.GetName(), diffRole(change.GetPrevious(), role))
+		}
+	}
+
+	for _, change := range changes {
+		counts[change.GetAction()]++
+		permission := change.GetPermission()
+		target := fmt.Sprintf("%s for %s", permission.GetEndpoint(), permission.GetRole())
+
+		switch change.GetAction() {
+		case "create":
+			_, _ = fmt.Fprintf(w, "+ %s%s\n", target, describe(permission))
+		case "update":
+			_, _ = fmt.Fprintf(w, "~ %s: %s\n", target, diff(change.GetPrevious(), permission))
+		case "delete":
+			_, _ = fmt.Fprintf(w, "- %s\n", target)
+		}
+	}
+
+	if len(roleChanges) == 0 && len(changes) == 0 {
+		_, _ = fmt.Fprintln(w, "No changes, the roles and permissions match the policy document.")
+		return
+	}
+
+	verb := "Plan"
+	if applied {
+		verb = "Applied"
+	}
+	_, _ = fmt.Fprintf(w, "%s: %d to create, %d to update, %d to delete.\n",
+		verb, counts["create"], counts["update"], counts["delete"])
+}
+
+func describe(permission *pb.Permission) string {
+	var attrs []string
+	if permission.GetEffect() != "allow" {
+		attrs = append(attrs, permission.GetEffect())
+	}
+	if permission.GetMaxAuthAgeMin() > 0 {
+		attrs = append(attrs, fmt.Sprintf("max auth age %dm", permission.GetMaxAuthAgeMin()))
+	}
+	if permission.GetCondition() != "" {
+		attrs = append(attrs, "if "+permission.GetCondition())
+	}
+	if len(attrs) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(attrs, ", ") + ")"
+}
+
+func diff(previous, permission *pb.Permission) string {
+	var changes []string
+	if previous.GetEffect() != permission.GetEffect() {
+		changes = append(changes, fmt.Sprintf("effect %s -> %s", previous.GetEffect(), permission.GetEffect()))
+	}
+	if previous.GetMaxAuthAgeMin() != permission.GetMaxAuthAgeMin() {
+		changes = append(changes, fmt.Sprintf("max auth age %dm -> %dm", previous.GetMaxAuthAgeMin(), permission.GetMaxAuthAgeMin()))
+	}
+	if previous.GetCondition() != permission.GetCondition() {
+		changes = append(changes, fmt.Sprintf("condition %s -> %s",
+			strconv.Quote(previous.GetCondition()), strconv.Quote(permission.GetCondition())))
+	}
+	return strings.Join(changes, ", ")
+}
+
+func describeRole(role *pb.Role) string {
+	var attrs []string
+	if role.GetParent() != "" {
+		attrs = append(attrs, "parent "+role.GetParent())
+	}
+	if role.GetDescription() != "" {
+		attrs = append(attrs, strconv.Quote(role.GetDescription()))
+	}
+	if len(attrs) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(attrs, ", ") + ")"
+}
+
+func diffRole(previous, role *pb.Role) string {
+	var changes []string
+	if previous.GetParent() != role.GetParent() {
+		changes = append(changes, fmt.Sprintf("parent %s -> %s",
+			strconv.Quote(previous.GetParent()), strconv.Quote(role.GetParent())))
+	}
+	if previous.GetDescription() != role.GetDescription() {
+		changes = append(changes, fmt.Sprintf("description %s -> %s",
+			strconv.Quote(previous.GetDescription()), strconv.Quote(role.GetDescription())))
+	}
+	return strings.Join(changes, ", ")
+}
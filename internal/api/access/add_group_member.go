@@ -0,0 +1,20 @@
+package access
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// AddGroupMember adds a user to a group.
+func (i *Implementation) AddGroupMember(ctx context.Context, req *pb.GroupMemberRequest) (*emptypb.Empty, error) {
+	err := i.accessService.AddGroupMember(ctx, req.GetGroupId(), req.GetUserId())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
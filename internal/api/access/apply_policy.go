@@ -0,0 +1,29 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/policy"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ApplyPolicy brings roles and endpoint permissions to the state declared by a policy document and returns the plan.
+func (i *Implementation) ApplyPolicy(ctx context.Context, req *pb.ApplyPolicyRequest) (*pb.ApplyPolicyResponse, error) {
+	declared, err := policy.ParseDocument([]byte(req.GetDocument()))
+	if err != nil {
+		return nil, customerrors.ConvertError(customerrors.NewErrInvalidArgument(err.Error()))
+	}
+
+	plan, err := i.accessService.ApplyPolicy(ctx, declared, req.GetDryRun())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ApplyPolicyResponse{
+		Changes:     converter.FromServiceToProtobufPolicyChangeList(plan.Permissions),
+		Applied:     !req.GetDryRun(),
+		RoleChanges: converter.FromServiceToProtobufRoleChangeList(plan.Roles),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package access
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ApproveElevation approves an elevation request and grants the requested role for the requested duration.
+func (i *Implementation) ApproveElevation(ctx context.Context, req *pb.ApproveElevationRequest) (*pb.ApproveElevationResponse, error) {
+	grant, err := i.accessService.ApproveElevation(ctx, req.GetId())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ApproveElevationResponse{
+		UserId:     grant.UserID,
+		Role:       grant.Role,
+		ValidUntil: timestamppb.New(grant.ValidUntil),
+	}, nil
+}
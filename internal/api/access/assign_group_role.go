@@ -0,0 +1,20 @@
+package access
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// AssignGroupRole assigns a role to all members of a group.
+func (i *Implementation) AssignGroupRole(ctx context.Context, req *pb.GroupRoleRequest) (*emptypb.Empty, error) {
+	err := i.accessService.AssignGroupRole(ctx, req.GetGroupId(), req.GetRole())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,29 @@
+package access
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// AssignUserRole assigns an additional role to a user, permanently unless valid_until is set.
+func (i *Implementation) AssignUserRole(ctx context.Context, req *pb.UserRoleRequest) (*emptypb.Empty, error) {
+	grant := &model.RoleGrant{UserID: req.GetUserId(), Role: req.GetRole()}
+	if req.GetValidFrom() != nil {
+		grant.ValidFrom = req.GetValidFrom().AsTime()
+	}
+	if req.GetValidUntil() != nil {
+		grant.ValidUntil = req.GetValidUntil().AsTime()
+	}
+
+	err := i.accessService.AssignUserRole(ctx, grant)
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
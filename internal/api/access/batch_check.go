@@ -0,0 +1,19 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// BatchCheck verifies access to each of the requested endpoints and returns a per-item result.
+func (i *Implementation) BatchCheck(ctx context.Context, req *pb.BatchCheckRequest) (*pb.BatchCheckResponse, error) {
+	decisions, err := i.accessService.BatchCheck(ctx, converter.FromProtobufToServiceAccessRequests(req.GetItems()))
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.BatchCheckResponse{Results: converter.FromServiceToProtobufCheckResults(decisions)}, nil
+}
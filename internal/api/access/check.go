@@ -3,16 +3,31 @@ package access
 import (
 	"context"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
 	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
 	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-// Check verifies access to the specified endpoint.
-func (i *Implementation) Check(ctx context.Context, req *pb.CheckRequest) (*emptypb.Empty, error) {
-	err := i.accessService.Check(ctx, req.GetEndpoint())
+// headerActor is the response header carrying the admin acting on behalf of the user.
+const headerActor = "x-actor"
+
+// Check verifies access to the specified endpoint, optionally narrowed to a resource, and returns the authenticated principal with the matched permission.
+// For impersonation tokens the admin username is also returned in the x-actor response header.
+func (i *Implementation) Check(ctx context.Context, req *pb.CheckRequest) (*pb.CheckResponse, error) {
+	grant, err := i.accessService.CheckResource(ctx, req.GetEndpoint(), req.GetResource())
 	if err != nil {
 		return nil, customerrors.ConvertError(err)
 	}
-	return &emptypb.Empty{}, nil
+
+	if grant.Claims.IsImpersonated() {
+		err = grpc.SetHeader(ctx, metadata.Pairs(headerActor, grant.Claims.Act.Username))
+		if err != nil {
+			return nil, customerrors.ConvertError(err)
+		}
+	}
+
+	return converter.FromServiceToProtobufCheckResponse(grant), nil
 }
@@ -0,0 +1,18 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// CheckRelation verifies whether a user has a relation to an object.
+func (i *Implementation) CheckRelation(ctx context.Context, req *pb.CheckRelationRequest) (*pb.CheckRelationResponse, error) {
+	allowed, err := i.accessService.CheckRelation(ctx, req.GetObject(), req.GetRelation(), req.GetUserId())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.CheckRelationResponse{Allowed: allowed}, nil
+}
@@ -0,0 +1,19 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// CreateGroup adds a new user group.
+func (i *Implementation) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*pb.CreateGroupResponse, error) {
+	id, err := i.accessService.CreateGroup(ctx, converter.FromProtobufToServiceGroup(req))
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.CreateGroupResponse{Id: id}, nil
+}
@@ -0,0 +1,19 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// CreatePermission grants a role access to an endpoint.
+func (i *Implementation) CreatePermission(ctx context.Context, req *pb.CreatePermissionRequest) (*pb.CreatePermissionResponse, error) {
+	id, err := i.accessService.CreatePermission(ctx, converter.FromProtobufToServicePermission(req))
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.CreatePermissionResponse{Id: id}, nil
+}
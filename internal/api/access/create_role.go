@@ -0,0 +1,21 @@
+package access
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// CreateRole adds a new role.
+func (i *Implementation) CreateRole(ctx context.Context, req *pb.CreateRoleRequest) (*emptypb.Empty, error) {
+	err := i.accessService.CreateRole(ctx, converter.FromProtobufToServiceRole(req))
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,20 @@
+package access
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// DeleteGroup removes a user group by its ID.
+func (i *Implementation) DeleteGroup(ctx context.Context, req *pb.DeleteGroupRequest) (*emptypb.Empty, error) {
+	err := i.accessService.DeleteGroup(ctx, req.GetId())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
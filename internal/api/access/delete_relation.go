@@ -0,0 +1,21 @@
+package access
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// DeleteRelation removes a relation tuple.
+func (i *Implementation) DeleteRelation(ctx context.Context, req *pb.RelationTupleRequest) (*emptypb.Empty, error) {
+	err := i.accessService.DeleteRelation(ctx, converter.FromProtobufToServiceRelationTuple(req))
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,19 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// Explain returns the evaluation trace of the access decision of a user for an endpoint.
+func (i *Implementation) Explain(ctx context.Context, req *pb.ExplainRequest) (*pb.ExplainResponse, error) {
+	explanation, err := i.accessService.Explain(ctx, req.GetUserId(), req.GetEndpoint())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return converter.FromServiceToProtobufExplainResponse(explanation), nil
+}
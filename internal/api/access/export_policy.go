@@ -0,0 +1,26 @@
+package access
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/policy"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ExportPolicy renders all roles and endpoint permissions as a YAML policy document.
+func (i *Implementation) ExportPolicy(ctx context.Context, _ *emptypb.Empty) (*pb.ExportPolicyResponse, error) {
+	stored, err := i.accessService.ExportPolicy(ctx)
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	document, err := policy.MarshalDocument(stored)
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ExportPolicyResponse{Document: string(document)}, nil
+}
@@ -0,0 +1,19 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// GetRole retrieves a role by its name.
+func (i *Implementation) GetRole(ctx context.Context, req *pb.GetRoleRequest) (*pb.GetRoleResponse, error) {
+	role, err := i.accessService.GetRole(ctx, req.GetName())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.GetRoleResponse{Role: converter.FromServiceToProtobufRole(role)}, nil
+}
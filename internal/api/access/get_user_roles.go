@@ -0,0 +1,18 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// GetUserRoles retrieves effective roles of a user.
+func (i *Implementation) GetUserRoles(ctx context.Context, req *pb.GetUserRolesRequest) (*pb.GetUserRolesResponse, error) {
+	roles, err := i.accessService.GetUserRoles(ctx, req.GetUserId())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.GetUserRolesResponse{Roles: roles}, nil
+}
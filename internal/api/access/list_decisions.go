@@ -0,0 +1,19 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ListDecisions retrieves recorded access decisions optionally filtered by user, endpoint and time range.
+func (i *Implementation) ListDecisions(ctx context.Context, req *pb.ListDecisionsRequest) (*pb.ListDecisionsResponse, error) {
+	decisions, err := i.accessService.ListDecisions(ctx, converter.FromProtobufToServiceDecisionFilter(req))
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ListDecisionsResponse{Decisions: converter.FromServiceToProtobufDecisionList(decisions)}, nil
+}
@@ -0,0 +1,21 @@
+package access
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ListGroups retrieves all user groups.
+func (i *Implementation) ListGroups(ctx context.Context, _ *emptypb.Empty) (*pb.ListGroupsResponse, error) {
+	groups, err := i.accessService.ListGroups(ctx)
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ListGroupsResponse{Groups: converter.FromServiceToProtobufGroupList(groups)}, nil
+}
@@ -0,0 +1,23 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository/permission/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ListPermissions retrieves endpoint permissions optionally filtered by endpoint and role.
+func (i *Implementation) ListPermissions(ctx context.Context, req *pb.ListPermissionsRequest) (*pb.ListPermissionsResponse, error) {
+	permissions, err := i.accessService.ListPermissions(ctx, filter.PermissionFilter{
+		Endpoint: req.GetEndpoint(),
+		Role:     req.GetRole(),
+	})
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ListPermissionsResponse{Permissions: converter.FromServiceToProtobufPermissionList(permissions)}, nil
+}
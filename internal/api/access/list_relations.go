@@ -0,0 +1,19 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ListRelations retrieves relation tuples of an object or a subject.
+func (i *Implementation) ListRelations(ctx context.Context, req *pb.ListRelationsRequest) (*pb.ListRelationsResponse, error) {
+	tuples, err := i.accessService.ListRelations(ctx, req.GetObject(), req.GetRelation(), req.GetSubject())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ListRelationsResponse{Tuples: converter.FromServiceToProtobufRelationTupleList(tuples)}, nil
+}
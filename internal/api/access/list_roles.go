@@ -0,0 +1,21 @@
+package access
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ListRoles retrieves all roles.
+func (i *Implementation) ListRoles(ctx context.Context, _ *emptypb.Empty) (*pb.ListRolesResponse, error) {
+	roles, err := i.accessService.ListRoles(ctx)
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ListRolesResponse{Roles: converter.FromServiceToProtobufRoleList(roles)}, nil
+}
@@ -0,0 +1,20 @@
+package access
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// RemoveGroupMember removes a user from a group.
+func (i *Implementation) RemoveGroupMember(ctx context.Context, req *pb.GroupMemberRequest) (*emptypb.Empty, error) {
+	err := i.accessService.RemoveGroupMember(ctx, req.GetGroupId(), req.GetUserId())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
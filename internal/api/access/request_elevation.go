@@ -0,0 +1,23 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// RequestElevation asks for a temporary role for the caller.
+func (i *Implementation) RequestElevation(ctx context.Context, req *pb.RequestElevationRequest) (*pb.RequestElevationResponse, error) {
+	id, err := i.accessService.RequestElevation(ctx, &model.ElevationRequest{
+		Role:     req.GetRole(),
+		Reason:   req.GetReason(),
+		Duration: req.GetDuration().AsDuration(),
+	})
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.RequestElevationResponse{Id: id}, nil
+}
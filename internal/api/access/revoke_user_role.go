@@ -0,0 +1,20 @@
+package access
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// RevokeUserRole removes an additional role of a user.
+func (i *Implementation) RevokeUserRole(ctx context.Context, req *pb.UserRoleRequest) (*emptypb.Empty, error) {
+	err := i.accessService.RevokeUserRole(ctx, req.GetUserId(), req.GetRole())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,19 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// Simulate reports who would gain or lose access to each affected endpoint if the proposed changes were applied.
+func (i *Implementation) Simulate(ctx context.Context, req *pb.SimulateRequest) (*pb.SimulateResponse, error) {
+	results, err := i.accessService.Simulate(ctx, converter.FromProtobufToServiceProposedChanges(req.GetChanges()))
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.SimulateResponse{Results: converter.FromServiceToProtobufSimulationResultList(results)}, nil
+}
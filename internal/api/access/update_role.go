@@ -0,0 +1,21 @@
+package access
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/access/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// UpdateRole changes the description or the parent of a role.
+func (i *Implementation) UpdateRole(ctx context.Context, req *pb.UpdateRoleRequest) (*emptypb.Empty, error) {
+	err := i.accessService.UpdateRole(ctx, converter.FromProtobufToServiceRoleUpdate(req))
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,18 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ValidatePolicy type-checks a permission condition before it is saved.
+func (i *Implementation) ValidatePolicy(ctx context.Context, req *pb.ValidatePolicyRequest) (*pb.ValidatePolicyResponse, error) {
+	issues, err := i.accessService.ValidatePolicy(ctx, req.GetCondition())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ValidatePolicyResponse{Valid: len(issues) == 0, Issues: issues}, nil
+}
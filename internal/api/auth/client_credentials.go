@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/auth/pkg/auth_v1"
+)
+
+// ClientCredentials authenticates a service account with its client ID and secret and returns an access token.
+func (i *Implementation) ClientCredentials(ctx context.Context, req *pb.ClientCredentialsRequest) (*pb.ClientCredentialsResponse, error) {
+	accessToken, err := i.authService.ClientCredentials(ctx, req.GetClientId(), req.GetClientSecret())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ClientCredentialsResponse{AccessToken: accessToken}, nil
+}
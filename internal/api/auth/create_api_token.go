@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/auth/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/auth_v1"
+)
+
+// CreateAPIToken issues a new personal access token for the authenticated user.
+// The plain token is returned only in this response.
+func (i *Implementation) CreateAPIToken(ctx context.Context, req *pb.CreateAPITokenRequest) (*pb.CreateAPITokenResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token name is required")
+	}
+	if req.GetExpiresAt() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "token expiration time is required")
+	}
+
+	token, apiToken, err := i.authService.CreateAPIToken(ctx, req.GetName(), req.GetExpiresAt().AsTime(), req.GetScopes())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.CreateAPITokenResponse{
+		Token:    token,
+		ApiToken: converter.FromServiceToProtobufAPIToken(apiToken),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/auth/pkg/auth_v1"
+)
+
+// Impersonate issues a short-lived token allowing an admin to act as the target user.
+func (i *Implementation) Impersonate(ctx context.Context, req *pb.ImpersonateRequest) (*pb.ImpersonateResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user id must be positive")
+	}
+
+	accessToken, expiresAt, err := i.authService.Impersonate(ctx, req.GetUserId(), req.GetReason())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ImpersonateResponse{
+		AccessToken: accessToken,
+		ExpiresAt:   timestamppb.New(expiresAt),
+	}, nil
+}
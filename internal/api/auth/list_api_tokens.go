@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/auth/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/auth_v1"
+)
+
+// ListAPITokens lists personal access tokens of the authenticated user without their secrets.
+func (i *Implementation) ListAPITokens(ctx context.Context, _ *emptypb.Empty) (*pb.ListAPITokensResponse, error) {
+	tokens, err := i.authService.ListAPITokens(ctx)
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ListAPITokensResponse{ApiTokens: converter.FromServiceToProtobufAPITokenList(tokens)}, nil
+}
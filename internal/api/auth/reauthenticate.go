@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/auth/pkg/auth_v1"
+)
+
+// Reauthenticate confirms the password of the current user and returns a step-up access token.
+func (i *Implementation) Reauthenticate(ctx context.Context, req *pb.ReauthenticateRequest) (*pb.ReauthenticateResponse, error) {
+	accessToken, expiresAt, err := i.authService.Reauthenticate(ctx, req.GetPassword())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ReauthenticateResponse{
+		AccessToken: accessToken,
+		ExpiresAt:   timestamppb.New(expiresAt),
+	}, nil
+}
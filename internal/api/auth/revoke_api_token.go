@@ -0,0 +1,26 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/auth/pkg/auth_v1"
+)
+
+// RevokeAPIToken revokes a personal access token of the authenticated user.
+func (i *Implementation) RevokeAPIToken(ctx context.Context, req *pb.RevokeAPITokenRequest) (*emptypb.Empty, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "token id must be positive")
+	}
+
+	err := i.authService.RevokeAPIToken(ctx, req.GetId())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,19 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+)
+
+// StopImpersonation records the end of the impersonation session started with the provided token.
+func (i *Implementation) StopImpersonation(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	err := i.authService.StopImpersonation(ctx)
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
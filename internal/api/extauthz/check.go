@@ -0,0 +1,98 @@
+package extauthz
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+)
+
+// Headers injected into allowed requests, values sent by the client are overwritten.
+const (
+	headerUserID   = "x-user-id"
+	headerUserRole = "x-user-role"
+)
+
+// Check decides whether Envoy lets the request through by checking the endpoint permission of its gRPC method,
+// allowed requests are forwarded with the x-user-id and x-user-role headers.
+func (i *Implementation) Check(ctx context.Context, req *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+	request := req.GetAttributes().GetRequest().GetHttp()
+	headers := request.GetHeaders()
+
+	isGRPC := strings.HasPrefix(headers["content-type"], "application/grpc")
+	endpoint, ok := i.resolveEndpoint(request.GetMethod(), request.GetPath(), isGRPC)
+	if !ok {
+		return deniedResponse(codes.PermissionDenied, "unknown route"), nil
+	}
+
+	header := make(http.Header, len(headers))
+	for key, value := range headers {
+		header.Set(key, value)
+	}
+
+	grant, err := i.accessService.Check(i.requestContext(ctx, header, sourceAddr(req)), endpoint)
+	if err != nil {
+		st := status.Convert(customerrors.ConvertError(err))
+		if st.Code() == codes.Internal {
+			return nil, st.Err()
+		}
+		return deniedResponse(st.Code(), st.Message()), nil
+	}
+
+	return &authv3.CheckResponse{
+		Status: &rpcstatus.Status{Code: int32(codes.OK)},
+		HttpResponse: &authv3.CheckResponse_OkResponse{
+			OkResponse: &authv3.OkHttpResponse{
+				Headers: []*corev3.HeaderValueOption{
+					overwriteHeader(headerUserID, strconv.FormatInt(grant.Claims.UserID, 10)),
+					overwriteHeader(headerUserRole, grant.Claims.Role),
+				},
+			},
+		},
+	}, nil
+}
+
+// sourceAddr returns the address of the downstream client, nil if Envoy didn't report it.
+func sourceAddr(req *authv3.CheckRequest) net.Addr {
+	socket := req.GetAttributes().GetSource().GetAddress().GetSocketAddress()
+	ip := net.ParseIP(socket.GetAddress())
+	if ip == nil {
+		return nil
+	}
+	return &net.TCPAddr{IP: ip, Port: int(socket.GetPortValue())}
+}
+
+func deniedResponse(code codes.Code, message string) *authv3.CheckResponse {
+	var headers []*corev3.HeaderValueOption
+	if httpStatus(code) == http.StatusUnauthorized {
+		headers = append(headers, overwriteHeader("www-authenticate", "Bearer"))
+	}
+
+	return &authv3.CheckResponse{
+		Status: &rpcstatus.Status{Code: int32(code), Message: message},
+		HttpResponse: &authv3.CheckResponse_DeniedResponse{
+			DeniedResponse: &authv3.DeniedHttpResponse{
+				Status:  &typev3.HttpStatus{Code: typev3.StatusCode(httpStatus(code))},
+				Headers: headers,
+				Body:    message,
+			},
+		},
+	}
+}
+
+func overwriteHeader(key, value string) *corev3.HeaderValueOption {
+	return &corev3.HeaderValueOption{
+		Header:       &corev3.HeaderValue{Key: key, Value: value},
+		AppendAction: corev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+	}
+}
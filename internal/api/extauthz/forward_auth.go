@@ -0,0 +1,77 @@
+package extauthz
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+)
+
+// Headers of the original request set by nginx auth_request (proxy_set_header) and Traefik ForwardAuth.
+const (
+	headerForwardedMethod = "X-Forwarded-Method"
+	headerForwardedURI    = "X-Forwarded-Uri"
+	headerForwardedFor    = "X-Forwarded-For"
+)
+
+// ForwardAuth decides whether nginx or Traefik lets the original request through by checking the endpoint
+// permission of its route, the client address is the last X-Forwarded-For entry, the one appended by the proxy.
+func (i *Implementation) ForwardAuth(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	method := r.Header.Get(headerForwardedMethod)
+	if method == "" {
+		method = r.Method
+	}
+
+	uri := r.Header.Get(headerForwardedURI)
+	if uri == "" {
+		http.Error(w, headerForwardedURI+" header required", http.StatusBadRequest)
+		return
+	}
+
+	endpoint, ok := i.resolveEndpoint(strings.ToUpper(method), uri, false)
+	if !ok {
+		http.Error(w, "unknown route", http.StatusForbidden)
+		return
+	}
+
+	grant, err := i.accessService.Check(i.requestContext(r.Context(), r.Header, forwardedAddr(r)), endpoint)
+	if err != nil {
+		st := status.Convert(customerrors.ConvertError(err))
+		if st.Code() == codes.Internal {
+			http.Error(w, st.Message(), http.StatusInternalServerError)
+			return
+		}
+
+		code := httpStatus(st.Code())
+		if code == http.StatusUnauthorized {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+		}
+		http.Error(w, st.Message(), code)
+		return
+	}
+
+	w.Header().Set(headerUserID, strconv.FormatInt(grant.Claims.UserID, 10))
+	w.Header().Set(headerUserRole, grant.Claims.Role)
+	w.WriteHeader(http.StatusOK)
+}
+
+func forwardedAddr(r *http.Request) net.Addr {
+	addrs := strings.Split(r.Header.Get(headerForwardedFor), ",")
+	ip := net.ParseIP(strings.TrimSpace(addrs[len(addrs)-1]))
+	if ip == nil {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			return nil
+		}
+		ip = net.ParseIP(host)
+	}
+	if ip == nil {
+		return nil
+	}
+	return &net.TCPAddr{IP: ip}
+}
@@ -0,0 +1,47 @@
+package extauthz
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	headerAuthorization = "authorization"
+	headerCookie        = "cookie"
+)
+
+// requestContext builds the context the original request is checked in, the connection of the proxy is dropped
+// so that the client certificate of the proxy isn't taken for the caller's one.
+func (i *Implementation) requestContext(ctx context.Context, header http.Header, addr net.Addr) context.Context {
+	md := metadata.MD{}
+	for key, values := range header {
+		key = strings.ToLower(key)
+		if strings.HasPrefix(key, ":") || key == headerCookie {
+			continue
+		}
+		md.Append(key, values...)
+	}
+
+	if len(md.Get(headerAuthorization)) == 0 && i.config.SessionCookie != "" {
+		cookie, err := (&http.Request{Header: header}).Cookie(i.config.SessionCookie)
+		if err == nil && cookie.Value != "" {
+			md.Set(headerAuthorization, "Bearer "+cookie.Value)
+		}
+	}
+
+	return peer.NewContext(metadata.NewIncomingContext(ctx, md), &peer.Peer{Addr: addr})
+}
+
+// httpStatus returns the HTTP status of the denial: 401 for missing, invalid or stale credentials and 403 otherwise.
+func httpStatus(code codes.Code) int {
+	if code == codes.Unauthenticated || code == codes.FailedPrecondition {
+		return http.StatusUnauthorized
+	}
+	return http.StatusForbidden
+}
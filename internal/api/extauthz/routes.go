@@ -0,0 +1,149 @@
+package extauthz
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// route maps an HTTP method and path template of grpc-gateway to the full gRPC method name,
+// a template segment in braces such as {id} matches any single path segment.
+type route struct {
+	method   string
+	segments []string
+	endpoint string
+}
+
+// loadRoutes collects HTTP bindings of all methods of the registered gRPC services.
+func loadRoutes() []route {
+	var routes []route
+	protoregistry.GlobalFiles.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				routes = append(routes, methodRoutes(methods.Get(j))...)
+			}
+		}
+		return true
+	})
+
+	return routes
+}
+
+// configRoutes parses routes given as "METHOD /path/{var}" mapped to endpoints, malformed routes are skipped.
+func configRoutes(routes map[string]string) []route {
+	parsed := make([]route, 0, len(routes))
+	for binding, endpoint := range routes {
+		method, template, ok := strings.Cut(strings.TrimSpace(binding), " ")
+		if !ok {
+			log.Printf("skipping malformed route %q", binding)
+			continue
+		}
+		parsed = append(parsed, route{
+			method:   strings.ToUpper(method),
+			segments: splitPath(strings.TrimSpace(template)),
+			endpoint: strings.TrimSpace(endpoint),
+		})
+	}
+
+	return parsed
+}
+
+func methodRoutes(method protoreflect.MethodDescriptor) []route {
+	options := method.Options()
+	if options == nil || !proto.HasExtension(options, annotations.E_Http) {
+		return nil
+	}
+
+	rule, ok := proto.GetExtension(options, annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return nil
+	}
+
+	endpoint := "/" + string(method.Parent().FullName()) + "/" + string(method.Name())
+
+	var routes []route
+	for _, binding := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+		httpMethod, template := bindingPattern(binding)
+		if template == "" {
+			continue
+		}
+		routes = append(routes, route{method: httpMethod, segments: splitPath(template), endpoint: endpoint})
+	}
+
+	return routes
+}
+
+func bindingPattern(rule *annotations.HttpRule) (string, string) {
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Custom:
+		return pattern.Custom.GetKind(), pattern.Custom.GetPath()
+	default:
+		return "", ""
+	}
+}
+
+// resolveEndpoint returns the gRPC method name the HTTP request is routed to. gRPC requests carry it as the path,
+// among matching HTTP routes the one with the most literal segments wins.
+func (i *Implementation) resolveEndpoint(method, path string, isGRPC bool) (string, bool) {
+	path, _, _ = strings.Cut(path, "?")
+	if isGRPC {
+		return path, strings.Count(path, "/") == 2
+	}
+
+	segments := splitPath(path)
+	var (
+		best     string
+		literals = -1
+	)
+	for _, r := range i.routes {
+		if r.method != method {
+			continue
+		}
+		if n, ok := matchSegments(r.segments, segments); ok && n > literals {
+			best, literals = r.endpoint, n
+		}
+	}
+
+	return best, literals >= 0
+}
+
+// matchSegments reports whether the path matches the template and returns the number of literal segments.
+func matchSegments(template, path []string) (int, bool) {
+	if len(template) != len(path) {
+		return 0, false
+	}
+
+	literals := 0
+	for i, segment := range template {
+		if strings.HasPrefix(segment, "{") {
+			continue
+		}
+		if segment != path[i] {
+			return 0, false
+		}
+		literals++
+	}
+
+	return literals, true
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
@@ -0,0 +1,28 @@
+package extauthz
+
+import (
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/service"
+)
+
+// Implementation provides external authorization for proxies on top of the access service:
+// the Envoy ext_authz API and the HTTP forward-auth endpoint.
+type Implementation struct {
+	authv3.UnimplementedAuthorizationServer
+	accessService service.AccessService
+	config        config.ExtAuthz
+	routes        []route
+}
+
+// NewImplementation creates a new instance of Implementation with the given access service.
+// HTTP routes are resolved to gRPC methods by google.api.http annotations of the services registered in this binary
+// and by the routes of the config.
+func NewImplementation(accessService service.AccessService, config config.ExtAuthz) *Implementation {
+	return &Implementation{
+		accessService: accessService,
+		config:        config,
+		routes:        append(loadRoutes(), configRoutes(config.Routes)...),
+	}
+}
@@ -0,0 +1,165 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/mikhailsoldatkin/auth/internal/api/extauthz"
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service"
+	serviceMocks "github.com/mikhailsoldatkin/auth/internal/service/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pbAccess "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+	pbUser "github.com/mikhailsoldatkin/auth/pkg/user_v1"
+)
+
+func checkRequest(method, path string, headers map[string]string) *authv3.CheckRequest {
+	return &authv3.CheckRequest{
+		Attributes: &authv3.AttributeContext{
+			Source: &authv3.AttributeContext_Peer{
+				Address: &corev3.Address{Address: &corev3.Address_SocketAddress{
+					SocketAddress: &corev3.SocketAddress{Address: "203.0.113.7", PortSpecifier: &corev3.SocketAddress_PortValue{PortValue: 51234}},
+				}},
+			},
+			Request: &authv3.AttributeContext_Request{
+				Http: &authv3.AttributeContext_HttpRequest{Method: method, Path: path, Headers: headers},
+			},
+		},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+	type accessServiceMockFunc func(mc *minimock.Controller) service.AccessService
+
+	var (
+		ctx    = context.Background()
+		mc     = minimock.NewController(t)
+		cfg    = config.ExtAuthz{SessionCookie: "access_token", Routes: map[string]string{"GET /reports/{id}": "/legacy.Reports/Get"}}
+		bearer = map[string]string{"authorization": "Bearer token", ":authority": "api.example.com"}
+		grant  = &model.AccessGrant{Claims: &model.UserClaims{UserID: 42, Username: "alice", Role: "USER"}}
+
+		expectEndpoint = func(endpoint string, grant *model.AccessGrant, err error) accessServiceMockFunc {
+			return func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.Set(func(ctx context.Context, e string) (*model.AccessGrant, error) {
+					require.Equal(t, endpoint, e)
+
+					md, _ := metadata.FromIncomingContext(ctx)
+					require.Equal(t, []string{"Bearer token"}, md.Get("authorization"))
+					require.Empty(t, md.Get(":authority"))
+					require.Empty(t, md.Get("cookie"))
+
+					p, ok := peer.FromContext(ctx)
+					require.True(t, ok)
+					require.Equal(t, "203.0.113.7:51234", p.Addr.String())
+					require.Nil(t, p.AuthInfo)
+
+					return grant, err
+				})
+				return mock
+			}
+		}
+	)
+
+	tests := []struct {
+		name              string
+		req               *authv3.CheckRequest
+		code              codes.Code
+		httpStatus        typev3.StatusCode
+		err               bool
+		accessServiceMock accessServiceMockFunc
+	}{
+		{
+			name:              "gRPC request allowed",
+			req:               checkRequest("POST", pbUser.UserV1_Get_FullMethodName, map[string]string{"authorization": "Bearer token", "content-type": "application/grpc"}),
+			code:              codes.OK,
+			accessServiceMock: expectEndpoint(pbUser.UserV1_Get_FullMethodName, grant, nil),
+		},
+		{
+			name:              "HTTP route with a path variable",
+			req:               checkRequest("DELETE", "/access/v1/permissions/17?force=true", bearer),
+			code:              codes.OK,
+			accessServiceMock: expectEndpoint(pbAccess.AccessV1_DeletePermission_FullMethodName, grant, nil),
+		},
+		{
+			name:              "literal segment wins over a variable",
+			req:               checkRequest("POST", "/access/v1/relations/check", bearer),
+			code:              codes.OK,
+			accessServiceMock: expectEndpoint(pbAccess.AccessV1_CheckRelation_FullMethodName, grant, nil),
+		},
+		{
+			name:              "configured route with the session cookie",
+			req:               checkRequest("GET", "/reports/7", map[string]string{"cookie": "theme=dark; access_token=token"}),
+			code:              codes.OK,
+			accessServiceMock: expectEndpoint("/legacy.Reports/Get", grant, nil),
+		},
+		{
+			name:       "unknown route",
+			req:        checkRequest("GET", "/unknown", bearer),
+			code:       codes.PermissionDenied,
+			httpStatus: typev3.StatusCode_Forbidden,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				return serviceMocks.NewAccessServiceMock(mc)
+			},
+		},
+		{
+			name:              "invalid token",
+			req:               checkRequest("GET", "/access/v1/permissions", bearer),
+			code:              codes.Unauthenticated,
+			httpStatus:        typev3.StatusCode_Unauthorized,
+			accessServiceMock: expectEndpoint(pbAccess.AccessV1_ListPermissions_FullMethodName, nil, customerrors.NewErrInvalidToken()),
+		},
+		{
+			name:              "forbidden",
+			req:               checkRequest("GET", "/access/v1/permissions", bearer),
+			code:              codes.PermissionDenied,
+			httpStatus:        typev3.StatusCode_Forbidden,
+			accessServiceMock: expectEndpoint(pbAccess.AccessV1_ListPermissions_FullMethodName, nil, customerrors.NewErrForbiddenReason("no permission for roles USER")),
+		},
+		{
+			name:              "internal failure",
+			req:               checkRequest("GET", "/access/v1/permissions", bearer),
+			err:               true,
+			accessServiceMock: expectEndpoint(pbAccess.AccessV1_ListPermissions_FullMethodName, nil, errors.New("connection refused")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := extauthz.NewImplementation(tt.accessServiceMock(mc), cfg)
+			resp, err := api.Check(ctx, tt.req)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, int32(tt.code), resp.GetStatus().GetCode())
+
+			if tt.code != codes.OK {
+				require.Equal(t, tt.httpStatus, resp.GetDeniedResponse().GetStatus().GetCode())
+				return
+			}
+
+			headers := make(map[string]string)
+			for _, header := range resp.GetOkResponse().GetHeaders() {
+				require.Equal(t, corev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD, header.GetAppendAction())
+				headers[header.GetHeader().GetKey()] = header.GetHeader().GetValue()
+			}
+			require.Equal(t, map[string]string{"x-user-id": "42", "x-user-role": "USER"}, headers)
+		})
+	}
+}
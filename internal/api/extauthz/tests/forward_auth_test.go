@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/mikhailsoldatkin/auth/internal/api/extauthz"
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service"
+	serviceMocks "github.com/mikhailsoldatkin/auth/internal/service/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pbAccess "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+func TestForwardAuth(t *testing.T) {
+	t.Parallel()
+	type accessServiceMockFunc func(mc *minimock.Controller) service.AccessService
+
+	var (
+		mc    = minimock.NewController(t)
+		cfg   = config.ExtAuthz{SessionCookie: "access_token", Routes: map[string]string{"get /reports/{id}": "/legacy.Reports/Get"}}
+		grant = &model.AccessGrant{Claims: &model.UserClaims{UserID: 42, Username: "alice", Role: "USER"}}
+
+		expectEndpoint = func(endpoint string, grant *model.AccessGrant, err error) accessServiceMockFunc {
+			return func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.Set(func(ctx context.Context, e string) (*model.AccessGrant, error) {
+					require.Equal(t, endpoint, e)
+
+					md, _ := metadata.FromIncomingContext(ctx)
+					require.Equal(t, []string{"Bearer token"}, md.Get("authorization"))
+
+					p, ok := peer.FromContext(ctx)
+					require.True(t, ok)
+					require.Equal(t, "198.51.100.4:0", p.Addr.String())
+
+					return grant, err
+				})
+				return mock
+			}
+		}
+		request = func(method, uri string, header map[string]string) *http.Request {
+			r := httptest.NewRequest(http.MethodGet, "/forward-auth", nil)
+			r.Header.Set("X-Forwarded-Method", method)
+			r.Header.Set("X-Forwarded-Uri", uri)
+			r.Header.Set("X-Forwarded-For", "203.0.113.9, 198.51.100.4")
+			for key, value := range header {
+				r.Header.Set(key, value)
+			}
+			return r
+		}
+		bearer = map[string]string{"Authorization": "Bearer token"}
+	)
+
+	tests := []struct {
+		name              string
+		req               *http.Request
+		code              int
+		accessServiceMock accessServiceMockFunc
+	}{
+		{
+			name:              "allowed",
+			req:               request("GET", "/access/v1/permissions?role=USER", bearer),
+			code:              http.StatusOK,
+			accessServiceMock: expectEndpoint(pbAccess.AccessV1_ListPermissions_FullMethodName, grant, nil),
+		},
+		{
+			name:              "configured route with the session cookie",
+			req:               request("GET", "/reports/7", map[string]string{"Cookie": "access_token=token"}),
+			code:              http.StatusOK,
+			accessServiceMock: expectEndpoint("/legacy.Reports/Get", grant, nil),
+		},
+		{
+			name: "missing original URI",
+			req:  httptest.NewRequest(http.MethodGet, "/forward-auth", nil),
+			code: http.StatusBadRequest,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				return serviceMocks.NewAccessServiceMock(mc)
+			},
+		},
+		{
+			name: "unknown route",
+			req:  request("GET", "/unknown", bearer),
+			code: http.StatusForbidden,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				return serviceMocks.NewAccessServiceMock(mc)
+			},
+		},
+		{
+			name:              "step-up required",
+			req:               request("DELETE", "/access/v1/permissions/3", bearer),
+			code:              http.StatusUnauthorized,
+			accessServiceMock: expectEndpoint(pbAccess.AccessV1_DeletePermission_FullMethodName, nil, customerrors.NewErrStepUpRequired(5)),
+		},
+		{
+			name:              "forbidden",
+			req:               request("DELETE", "/access/v1/permissions/3", bearer),
+			code:              http.StatusForbidden,
+			accessServiceMock: expectEndpoint(pbAccess.AccessV1_DeletePermission_FullMethodName, nil, customerrors.NewErrForbidden()),
+		},
+		{
+			name:              "internal failure",
+			req:               request("DELETE", "/access/v1/permissions/3", bearer),
+			code:              http.StatusInternalServerError,
+			accessServiceMock: expectEndpoint(pbAccess.AccessV1_DeletePermission_FullMethodName, nil, errors.New("connection refused")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := extauthz.NewImplementation(tt.accessServiceMock(mc), cfg)
+			w := httptest.NewRecorder()
+			api.ForwardAuth(w, tt.req, nil)
+
+			require.Equal(t, tt.code, w.Code)
+			switch tt.code {
+			case http.StatusOK:
+				require.Equal(t, "42", w.Header().Get("X-User-Id"))
+				require.Equal(t, "USER", w.Header().Get("X-User-Role"))
+			case http.StatusUnauthorized:
+				require.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package federation
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+)
+
+// Callback completes the federated login and responds with a token in the same shape as AuthV1.Login.
+func (i *Implementation) Callback(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	query := r.URL.Query()
+	if query.Get(errorParam) != "" {
+		http.Error(w, "federated login failed: "+query.Get(errorParam), http.StatusUnauthorized)
+		return
+	}
+
+	cookie, err := r.Cookie(stateCookie)
+	if err != nil || cookie.Value != query.Get(stateParam) {
+		http.Error(w, "state mismatch", http.StatusBadRequest)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{Name: stateCookie, Path: "/", MaxAge: -1})
+
+	accessToken, err := i.federationService.Callback(r.Context(), query.Get(codeParam), cookie.Value)
+	if err != nil {
+		writeError(w, customerrors.ConvertError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{"access_token": accessToken})
+}
+
+// writeError writes the gRPC status error with the matching HTTP status code.
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+}
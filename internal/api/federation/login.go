@@ -0,0 +1,28 @@
+package federation
+
+import (
+	"net/http"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+)
+
+// Login redirects the user to the upstream identity provider.
+// The signed state is also stored in a cookie to bind the callback to the browser which started the login.
+func (i *Implementation) Login(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	authURL, state, err := i.federationService.AuthCodeURL(r.Context())
+	if err != nil {
+		writeError(w, customerrors.ConvertError(err))
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookie,
+		Value:    state,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
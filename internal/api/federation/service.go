@@ -0,0 +1,24 @@
+package federation
+
+import (
+	"github.com/mikhailsoldatkin/auth/internal/service"
+)
+
+const (
+	stateCookie = "oidc_state"
+	stateParam  = "state"
+	codeParam   = "code"
+	errorParam  = "error"
+)
+
+// Implementation serves the HTTP redirect and callback endpoints of the federated login on the gateway.
+type Implementation struct {
+	federationService service.FederationService
+}
+
+// NewImplementation creates a new instance of Implementation with the given federation service.
+func NewImplementation(federationService service.FederationService) *Implementation {
+	return &Implementation{
+		federationService: federationService,
+	}
+}
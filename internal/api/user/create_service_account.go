@@ -0,0 +1,19 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/converter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/user_v1"
+)
+
+// CreateServiceAccount handles the creation of a new service account owned by a human user.
+func (i *Implementation) CreateServiceAccount(ctx context.Context, req *pb.CreateServiceAccountRequest) (*pb.CreateServiceAccountResponse, error) {
+	id, secret, err := i.userService.CreateServiceAccount(ctx, converter.FromProtobufToServiceCreateServiceAccount(req))
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.CreateServiceAccountResponse{Id: id, ClientSecret: secret}, nil
+}
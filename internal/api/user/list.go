@@ -8,12 +8,17 @@ import (
 	pb "github.com/mikhailsoldatkin/auth/pkg/user_v1"
 )
 
-// List lists users with pagination support using limit and offset.
+// List lists users with pagination support using limit and either offset or page token, optionally filtered
+// and sorted, along with the total number of matching users.
 func (i *Implementation) List(ctx context.Context, req *pb.ListRequest) (*pb.ListResponse, error) {
-	users, err := i.userService.List(ctx, req.Limit, req.Offset)
+	page, err := i.userService.List(ctx, converter.FromProtobufToServiceListFilter(req))
 	if err != nil {
 		return nil, customerrors.ConvertError(err)
 	}
 
-	return &pb.ListResponse{Users: converter.FromServiceToProtobufList(users)}, nil
+	return &pb.ListResponse{
+		Users:         converter.FromServiceToProtobufList(page.Users),
+		TotalCount:    page.TotalCount,
+		NextPageToken: page.NextPageToken,
+	}, nil
 }
@@ -53,6 +53,7 @@ func TestGet(t *testing.T) {
 				Username:  name,
 				Email:     email,
 				Role:      pb.Role(pb.Role_value[role]),
+				RoleName:  role,
 				CreatedAt: timestamppb.New(now),
 				UpdatedAt: timestamppb.New(now),
 			},
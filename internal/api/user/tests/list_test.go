@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/gojuno/minimock/v3"
@@ -12,6 +13,7 @@ import (
 
 	userAPI "github.com/mikhailsoldatkin/auth/internal/api/user"
 	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
 	"github.com/mikhailsoldatkin/auth/internal/service"
 	serviceMocks "github.com/mikhailsoldatkin/auth/internal/service/mocks"
 	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
@@ -61,6 +63,25 @@ func TestList(t *testing.T) {
 		req = &pb.ListRequest{
 			Limit: int64(limit),
 		}
+		serviceReq = &pb.ListRequest{
+			Limit:         int64(limit),
+			PrincipalType: pb.PrincipalType_SERVICE,
+		}
+		createdFrom = now.Add(-time.Hour)
+		createdTo   = now.Add(time.Hour)
+		filteredReq = &pb.ListRequest{
+			Limit:       int64(limit),
+			Username:    "jo",
+			Email:       "example",
+			Match:       pb.MatchMode_MATCH_SUBSTRING,
+			Role:        "ADMIN",
+			CreatedFrom: timestamppb.New(createdFrom),
+			CreatedTo:   timestamppb.New(createdTo),
+			SortBy:      pb.SortField_SORT_BY_CREATED_AT,
+			Direction:   pb.SortDirection_DESC,
+			PageToken:   "token",
+		}
+		page     = &model.UserPage{Users: wantUsers, TotalCount: int64(len(wantUsers)), NextPageToken: "next"}
 		wantResp = &pb.ListResponse{
 			Users: []*pb.User{
 				{
@@ -68,6 +89,7 @@ func TestList(t *testing.T) {
 					Username:  name1,
 					Email:     email1,
 					Role:      pb.Role(pb.Role_value[role1]),
+					RoleName:  role1,
 					CreatedAt: timestamppb.New(now),
 					UpdatedAt: timestamppb.New(now),
 				},
@@ -76,10 +98,13 @@ func TestList(t *testing.T) {
 					Username:  name2,
 					Email:     email2,
 					Role:      pb.Role(pb.Role_value[role2]),
+					RoleName:  role2,
 					CreatedAt: timestamppb.New(now),
 					UpdatedAt: timestamppb.New(now),
 				},
 			},
+			TotalCount:    page.TotalCount,
+			NextPageToken: page.NextPageToken,
 		}
 		wantErr = fmt.Errorf("service error")
 	)
@@ -101,7 +126,54 @@ func TestList(t *testing.T) {
 			err:  nil,
 			userServiceMock: func(mc *minimock.Controller) service.UserService {
 				mock := serviceMocks.NewUserServiceMock(mc)
-				mock.ListMock.Expect(ctx, req.Limit, req.Offset).Return(wantUsers, nil)
+				mock.ListMock.Expect(ctx, filter.UserListFilter{
+					Limit:  req.Limit,
+					Offset: req.Offset,
+					SortBy: filter.SortByID,
+				}).Return(page, nil)
+				return mock
+			},
+		},
+		{
+			name: "principal type filter case",
+			args: args{
+				ctx: ctx,
+				req: serviceReq,
+			},
+			want: wantResp,
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.ListMock.Expect(ctx, filter.UserListFilter{
+					Limit:         serviceReq.Limit,
+					PrincipalType: model.PrincipalService,
+					SortBy:        filter.SortByID,
+				}).Return(page, nil)
+				return mock
+			},
+		},
+		{
+			name: "filters and sorting case",
+			args: args{
+				ctx: ctx,
+				req: filteredReq,
+			},
+			want: wantResp,
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.ListMock.Expect(ctx, filter.UserListFilter{
+					Limit:       filteredReq.Limit,
+					Username:    filteredReq.Username,
+					Email:       filteredReq.Email,
+					Substring:   true,
+					Role:        filteredReq.Role,
+					CreatedFrom: filteredReq.CreatedFrom.AsTime(),
+					CreatedTo:   filteredReq.CreatedTo.AsTime(),
+					SortBy:      filter.SortByCreatedAt,
+					Descending:  true,
+					PageToken:   filteredReq.PageToken,
+				}).Return(page, nil)
 				return mock
 			},
 		},
@@ -115,7 +187,11 @@ func TestList(t *testing.T) {
 			err:  customerrors.ConvertError(wantErr),
 			userServiceMock: func(mc *minimock.Controller) service.UserService {
 				mock := serviceMocks.NewUserServiceMock(mc)
-				mock.ListMock.Expect(ctx, req.Limit, req.Offset).Return(nil, wantErr)
+				mock.ListMock.Expect(ctx, filter.UserListFilter{
+					Limit:  req.Limit,
+					Offset: req.Offset,
+					SortBy: filter.SortByID,
+				}).Return(nil, wantErr)
 				return mock
 			},
 		},
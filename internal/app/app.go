@@ -2,6 +2,9 @@ package app
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"io"
 	"log"
 	"net"
@@ -12,6 +15,7 @@ import (
 	"syscall"
 	"time"
 
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/mikhailsoldatkin/auth/internal/logger"
 	"github.com/mikhailsoldatkin/auth/internal/metric"
@@ -58,7 +62,7 @@ func NewApp(ctx context.Context) (*App, error) {
 	return a, nil
 }
 
-// Run starts the GRPC server, HTTP server, Swagger server, and Kafka consumer.
+// Run starts the GRPC server, HTTP server, Swagger server, Kafka consumer and the role grant sweeper.
 // It handles graceful shutdown by waiting for context cancellation or termination signals.
 func (a *App) Run(ctx context.Context) error {
 	defer func() {
@@ -69,7 +73,7 @@ func (a *App) Run(ctx context.Context) error {
 	ctx, cancel := context.WithCancel(ctx)
 
 	wg := &sync.WaitGroup{}
-	wg.Add(5)
+	wg.Add(8)
 
 	go func() {
 		defer wg.Done()
@@ -107,6 +111,33 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	go func() {
+		defer wg.Done()
+
+		err := a.serviceProvider.PermissionCache(ctx).Listen(ctx)
+		if err != nil {
+			logger.Fatalf("failed to listen for permission cache invalidations: %v", err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		err := a.serviceProvider.RoleGrantSweeper(ctx).RunSweeper(ctx)
+		if err != nil {
+			logger.Fatalf("failed to run role grant sweeper: %v", err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		err := a.serviceProvider.DecisionRecorder(ctx).RunRecorder(ctx)
+		if err != nil {
+			logger.Fatalf("failed to run decision recorder: %v", err)
+		}
+	}()
+
 	go func() {
 		defer wg.Done()
 
@@ -188,10 +219,11 @@ func (a *App) initServiceProvider(_ context.Context) error {
 
 // initGRPCServer initializes the gRPC server.
 func (a *App) initGRPCServer(ctx context.Context) error {
-	creds, err := credentials.NewServerTLSFromFile("cert/service.pem", "cert/service.key")
+	tlsConfig, err := serverTLSConfig(a.serviceProvider.config.TLS)
 	if err != nil {
 		logger.Fatal("failed to load TLS credentials from files:", zap.Error(err))
 	}
+	creds := credentials.NewTLS(tlsConfig)
 
 	a.grpcServer = grpc.NewServer(
 		grpc.Creds(creds),
@@ -200,6 +232,7 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 				interceptor.MetricsInterceptor,
 				interceptor.LoggingInterceptor,
 				interceptor.ValidateInterceptor,
+				interceptor.NewAuthInterceptor(a.serviceProvider.AccessService(ctx)),
 			),
 		),
 	)
@@ -209,10 +242,45 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 	pbUser.RegisterUserV1Server(a.grpcServer, a.serviceProvider.UserImplementation(ctx))
 	pbAuth.RegisterAuthV1Server(a.grpcServer, a.serviceProvider.AuthImplementation(ctx))
 	pbAccess.RegisterAccessV1Server(a.grpcServer, a.serviceProvider.AccessImplementation(ctx))
+	authv3.RegisterAuthorizationServer(a.grpcServer, a.serviceProvider.ExtAuthzImplementation(ctx))
 
 	return nil
 }
 
+// serverTLSConfig loads the server certificate and, when a client CA bundle is configured,
+// verifies client certificates presented by internal services. Clients without a certificate
+// (the gateway, users with bearer tokens) are still accepted.
+func serverTLSConfig(cfg config.TLS) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if cfg.ClientCAFile == "" {
+		return tlsConfig, nil
+	}
+
+	caPEM, err := os.ReadFile(cfg.ClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates found in %s", cfg.ClientCAFile)
+	}
+
+	tlsConfig.ClientCAs = clientCAs
+	tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+
+	return tlsConfig, nil
+}
+
 // runGRPCServer starts the GRPC server and listens for incoming GRPC requests.
 func (a *App) runGRPCServer() error {
 	lis, err := net.Listen("tcp", a.serviceProvider.config.GRPC.Address)
@@ -234,7 +302,7 @@ func (a *App) runGRPCServer() error {
 func (a *App) initHTTPServer(ctx context.Context) error {
 	mux := runtime.NewServeMux()
 
-	creds, err := credentials.NewClientTLSFromFile("cert/service.pem", "")
+	creds, err := credentials.NewClientTLSFromFile(a.serviceProvider.config.TLS.CertFile, "")
 	if err != nil {
 		logger.Fatal("failed to load TLS credentials for gRPC gateway:", zap.Error(err))
 	}
@@ -248,6 +316,34 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 		return err
 	}
 
+	err = pbAccess.RegisterAccessV1HandlerFromEndpoint(ctx, mux, a.serviceProvider.config.GRPC.Address, opts)
+	if err != nil {
+		return err
+	}
+
+	// nginx auth_request keeps the method of the original request, Traefik ForwardAuth sends GET
+	extAuthzImpl := a.serviceProvider.ExtAuthzImplementation(ctx)
+	for _, method := range []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		err = mux.HandlePath(method, "/forward-auth", extAuthzImpl.ForwardAuth)
+		if err != nil {
+			return err
+		}
+	}
+
+	if a.serviceProvider.config.OIDC.Enabled {
+		federationImpl := a.serviceProvider.FederationImplementation(ctx)
+
+		err = mux.HandlePath(http.MethodGet, "/auth/v1/oidc/login", federationImpl.Login)
+		if err != nil {
+			return err
+		}
+
+		err = mux.HandlePath(http.MethodGet, "/auth/v1/oidc/callback", federationImpl.Callback)
+		if err != nil {
+			return err
+		}
+	}
+
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PATCH", "DELETE", "OPTIONS"},
@@ -312,6 +408,7 @@ func (a *App) initSwaggerServer(_ context.Context) error {
 	mux := http.NewServeMux()
 	mux.Handle("/", http.StripPrefix("/", http.FileServer(statikFs)))
 	mux.HandleFunc("/api.swagger.json", serveSwaggerFile("/api.swagger.json"))
+	mux.HandleFunc("/access.swagger.json", serveSwaggerFile("/access.swagger.json"))
 
 	a.swaggerServer = &http.Server{
 		Addr:              a.serviceProvider.config.Swagger.Address,
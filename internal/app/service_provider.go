@@ -16,18 +16,38 @@ import (
 
 	"github.com/mikhailsoldatkin/auth/internal/api/access"
 	"github.com/mikhailsoldatkin/auth/internal/api/auth"
+	"github.com/mikhailsoldatkin/auth/internal/api/extauthz"
+	"github.com/mikhailsoldatkin/auth/internal/api/federation"
 	"github.com/mikhailsoldatkin/auth/internal/api/user"
 	"github.com/mikhailsoldatkin/auth/internal/client/kafka"
 	kafkaConsumer "github.com/mikhailsoldatkin/auth/internal/client/kafka/consumer"
+	kafkaProducer "github.com/mikhailsoldatkin/auth/internal/client/kafka/producer"
+	"github.com/mikhailsoldatkin/auth/internal/client/oidc"
+	"github.com/mikhailsoldatkin/auth/internal/client/oidc/upstream"
+	"github.com/mikhailsoldatkin/auth/internal/client/pubsub"
+	redisPubSub "github.com/mikhailsoldatkin/auth/internal/client/pubsub/redis"
 	"github.com/mikhailsoldatkin/auth/internal/config"
 	"github.com/mikhailsoldatkin/auth/internal/repository"
+	decisionRepository "github.com/mikhailsoldatkin/auth/internal/repository/decision"
+	elevationRepository "github.com/mikhailsoldatkin/auth/internal/repository/elevation"
+	groupRepository "github.com/mikhailsoldatkin/auth/internal/repository/group"
+	identityRepository "github.com/mikhailsoldatkin/auth/internal/repository/identity"
 	logRepository "github.com/mikhailsoldatkin/auth/internal/repository/log"
+	permissionRepository "github.com/mikhailsoldatkin/auth/internal/repository/permission"
+	permissionCache "github.com/mikhailsoldatkin/auth/internal/repository/permission/cache"
+	relationRepository "github.com/mikhailsoldatkin/auth/internal/repository/relation"
+	roleRepository "github.com/mikhailsoldatkin/auth/internal/repository/role"
+	tokenRepository "github.com/mikhailsoldatkin/auth/internal/repository/token"
 	pgRepository "github.com/mikhailsoldatkin/auth/internal/repository/user/pg"
 	redisRepository "github.com/mikhailsoldatkin/auth/internal/repository/user/redis"
 	"github.com/mikhailsoldatkin/auth/internal/service"
 	accessService "github.com/mikhailsoldatkin/auth/internal/service/access"
 	authService "github.com/mikhailsoldatkin/auth/internal/service/auth"
+	authProvider "github.com/mikhailsoldatkin/auth/internal/service/auth/provider"
 	userSaverConsumer "github.com/mikhailsoldatkin/auth/internal/service/consumer/user_create"
+	decisionService "github.com/mikhailsoldatkin/auth/internal/service/decision"
+	federationService "github.com/mikhailsoldatkin/auth/internal/service/federation"
+	sweeperService "github.com/mikhailsoldatkin/auth/internal/service/sweeper"
 	userService "github.com/mikhailsoldatkin/auth/internal/service/user"
 )
 
@@ -43,20 +63,40 @@ type serviceProvider struct {
 	pgRepository    repository.UserRepository
 	redisRepository repository.UserRepository
 	logRepository   repository.LogRepository
+	apiTokenRepo    repository.APITokenRepository
+	identityRepo    repository.IdentityRepository
+	permissionRepo  repository.PermissionRepository
+	roleRepo        repository.RoleRepository
+	groupRepo       repository.GroupRepository
+	relationRepo    repository.RelationRepository
+	elevationRepo   repository.ElevationRepository
+	decisionRepo    repository.DecisionRepository
+	permissionCache repository.PermissionCache
+
+	pubSubClient pubsub.Client
 
 	userSaverConsumer service.ConsumerService
+	roleGrantSweeper  service.SweeperService
+	decisionRecorder  service.DecisionRecorder
 
 	consumer             kafka.Consumer
+	producer             kafka.Producer
 	consumerGroup        sarama.ConsumerGroup
 	consumerGroupHandler *kafkaConsumer.GroupHandler
 
-	userService   service.UserService
-	authService   service.AuthService
-	accessService service.AccessService
+	authProviders []service.AuthProvider
+	oidcClient    oidc.Client
 
-	userImplementation   *user.Implementation
-	authImplementation   *auth.Implementation
-	accessImplementation *access.Implementation
+	userService       service.UserService
+	authService       service.AuthService
+	accessService     service.AccessService
+	federationService service.FederationService
+
+	userImplementation       *user.Implementation
+	authImplementation       *auth.Implementation
+	accessImplementation     *access.Implementation
+	federationImplementation *federation.Implementation
+	extAuthzImplementation   *extauthz.Implementation
 }
 
 func newServiceProvider() *serviceProvider {
@@ -156,6 +196,91 @@ func (s *serviceProvider) LogRepository(ctx context.Context) repository.LogRepos
 	return s.logRepository
 }
 
+func (s *serviceProvider) APITokenRepository(ctx context.Context) repository.APITokenRepository {
+	if s.apiTokenRepo == nil {
+		s.apiTokenRepo = tokenRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.apiTokenRepo
+}
+
+func (s *serviceProvider) IdentityRepository(ctx context.Context) repository.IdentityRepository {
+	if s.identityRepo == nil {
+		s.identityRepo = identityRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.identityRepo
+}
+
+func (s *serviceProvider) PermissionRepository(ctx context.Context) repository.PermissionRepository {
+	if s.permissionRepo == nil {
+		s.permissionRepo = permissionRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.permissionRepo
+}
+
+func (s *serviceProvider) RoleRepository(ctx context.Context) repository.RoleRepository {
+	if s.roleRepo == nil {
+		s.roleRepo = roleRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.roleRepo
+}
+
+func (s *serviceProvider) GroupRepository(ctx context.Context) repository.GroupRepository {
+	if s.groupRepo == nil {
+		s.groupRepo = groupRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.groupRepo
+}
+
+func (s *serviceProvider) RelationRepository(ctx context.Context) repository.RelationRepository {
+	if s.relationRepo == nil {
+		s.relationRepo = relationRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.relationRepo
+}
+
+func (s *serviceProvider) ElevationRepository(ctx context.Context) repository.ElevationRepository {
+	if s.elevationRepo == nil {
+		s.elevationRepo = elevationRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.elevationRepo
+}
+
+func (s *serviceProvider) DecisionRepository(ctx context.Context) repository.DecisionRepository {
+	if s.decisionRepo == nil {
+		s.decisionRepo = decisionRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.decisionRepo
+}
+
+func (s *serviceProvider) PubSubClient() pubsub.Client {
+	if s.pubSubClient == nil {
+		s.pubSubClient = redisPubSub.NewClient(s.RedisPool())
+	}
+
+	return s.pubSubClient
+}
+
+func (s *serviceProvider) PermissionCache(ctx context.Context) repository.PermissionCache {
+	if s.permissionCache == nil {
+		s.permissionCache = permissionCache.NewPermissionCache(
+			s.PermissionRepository(ctx),
+			s.RedisClient(ctx),
+			s.PubSubClient(),
+			s.config.PermissionCache,
+		)
+	}
+
+	return s.permissionCache
+}
+
 func (s *serviceProvider) UserSaverConsumer(ctx context.Context) service.ConsumerService {
 	if s.userSaverConsumer == nil {
 		s.userSaverConsumer = userSaverConsumer.NewConsumerService(
@@ -169,6 +294,27 @@ func (s *serviceProvider) UserSaverConsumer(ctx context.Context) service.Consume
 	return s.userSaverConsumer
 }
 
+func (s *serviceProvider) RoleGrantSweeper(ctx context.Context) service.SweeperService {
+	if s.roleGrantSweeper == nil {
+		s.roleGrantSweeper = sweeperService.NewSweeperService(
+			s.RoleRepository(ctx),
+			s.LogRepository(ctx),
+			s.TxManager(ctx),
+			s.config.RoleGrants,
+		)
+	}
+
+	return s.roleGrantSweeper
+}
+
+func (s *serviceProvider) DecisionRecorder(ctx context.Context) service.DecisionRecorder {
+	if s.decisionRecorder == nil {
+		s.decisionRecorder = decisionService.NewRecorderService(s.DecisionRepository(ctx), s.config.DecisionLog)
+	}
+
+	return s.decisionRecorder
+}
+
 func (s *serviceProvider) Consumer() kafka.Consumer {
 	if s.consumer == nil {
 		s.consumer = kafkaConsumer.NewConsumer(
@@ -181,6 +327,20 @@ func (s *serviceProvider) Consumer() kafka.Consumer {
 	return s.consumer
 }
 
+func (s *serviceProvider) Producer() kafka.Producer {
+	if s.producer == nil {
+		syncProducer, err := sarama.NewSyncProducer(s.config.KafkaProducer.Brokers, s.config.KafkaProducer.Config)
+		if err != nil {
+			log.Fatalf("failed to create producer: %v", err)
+		}
+
+		s.producer = kafkaProducer.NewProducer(syncProducer)
+		closer.Add(s.producer.Close)
+	}
+
+	return s.producer
+}
+
 func (s *serviceProvider) ConsumerGroup() sarama.ConsumerGroup {
 	if s.consumerGroup == nil {
 		consumerGroup, err := sarama.NewConsumerGroup(
@@ -214,16 +374,33 @@ func (s *serviceProvider) UserService(ctx context.Context) service.UserService {
 			s.RedisRepository(ctx),
 			s.LogRepository(ctx),
 			s.TxManager(ctx),
+			s.config.Pagination,
 		)
 	}
 
 	return s.userService
 }
 
+func (s *serviceProvider) AuthProviders(ctx context.Context) []service.AuthProvider {
+	if s.authProviders == nil {
+		s.authProviders = []service.AuthProvider{authProvider.NewLocalProvider(s.PGRepository(ctx))}
+		if s.config.LDAP.Enabled {
+			s.authProviders = append(s.authProviders, authProvider.NewLDAPProvider(s.config.LDAP, nil))
+		}
+	}
+
+	return s.authProviders
+}
+
 func (s *serviceProvider) AuthService(ctx context.Context) service.AuthService {
 	if s.authService == nil {
 		s.authService = authService.NewAuthService(
 			s.PGRepository(ctx),
+			s.APITokenRepository(ctx),
+			s.LogRepository(ctx),
+			s.PermissionCache(ctx),
+			s.UserService(ctx),
+			s.AuthProviders(ctx),
 			s.config.Auth,
 		)
 	}
@@ -233,13 +410,51 @@ func (s *serviceProvider) AuthService(ctx context.Context) service.AuthService {
 
 func (s *serviceProvider) AccessService(ctx context.Context) service.AccessService {
 	if s.accessService == nil {
-		s.accessService = accessService.NewAccessService(
+		s.accessService = accessService.NewAccessService(accessService.Deps{
+			UserRepo:        s.PGRepository(ctx),
+			APITokenRepo:    s.APITokenRepository(ctx),
+			PermissionRepo:  s.PermissionRepository(ctx),
+			RoleRepo:        s.RoleRepository(ctx),
+			GroupRepo:       s.GroupRepository(ctx),
+			RelationRepo:    s.RelationRepository(ctx),
+			ElevationRepo:   s.ElevationRepository(ctx),
+			DecisionRepo:    s.DecisionRepository(ctx),
+			PermissionCache: s.PermissionCache(ctx),
+			Decisions:       s.DecisionRecorder(ctx),
+			Producer:        s.Producer(),
+			TxManager:       s.TxManager(ctx),
+			AuthConfig:      s.config.Auth,
+			TLSConfig:       s.config.TLS,
+			ProducerConfig:  s.config.KafkaProducer,
+			GrantsConfig:    s.config.RoleGrants,
+		})
+	}
+
+	return s.accessService
+}
+
+func (s *serviceProvider) OIDCClient() oidc.Client {
+	if s.oidcClient == nil {
+		s.oidcClient = upstream.NewClient(s.config.OIDC)
+	}
+
+	return s.oidcClient
+}
+
+func (s *serviceProvider) FederationService(ctx context.Context) service.FederationService {
+	if s.federationService == nil {
+		s.federationService = federationService.NewFederationService(
+			s.OIDCClient(),
+			s.IdentityRepository(ctx),
 			s.PGRepository(ctx),
+			s.UserService(ctx),
+			s.TxManager(ctx),
+			s.config.OIDC,
 			s.config.Auth,
 		)
 	}
 
-	return s.accessService
+	return s.federationService
 }
 
 func (s *serviceProvider) UserImplementation(ctx context.Context) *user.Implementation {
@@ -265,3 +480,19 @@ func (s *serviceProvider) AccessImplementation(ctx context.Context) *access.Impl
 
 	return s.accessImplementation
 }
+
+func (s *serviceProvider) FederationImplementation(ctx context.Context) *federation.Implementation {
+	if s.federationImplementation == nil {
+		s.federationImplementation = federation.NewImplementation(s.FederationService(ctx))
+	}
+
+	return s.federationImplementation
+}
+
+func (s *serviceProvider) ExtAuthzImplementation(ctx context.Context) *extauthz.Implementation {
+	if s.extAuthzImplementation == nil {
+		s.extAuthzImplementation = extauthz.NewImplementation(s.AccessService(ctx), s.Config().ExtAuthz)
+	}
+
+	return s.extAuthzImplementation
+}
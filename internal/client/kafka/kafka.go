@@ -11,3 +11,9 @@ type Consumer interface {
 	Consume(ctx context.Context, topicName string, handler consumer.Handler) error
 	Close() error
 }
+
+// Producer defines the interface for a Kafka producer.
+type Producer interface {
+	Produce(ctx context.Context, topicName string, key, value []byte) error
+	Close() error
+}
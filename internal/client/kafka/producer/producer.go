@@ -0,0 +1,33 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+)
+
+// Producer wraps a Sarama synchronous producer.
+type Producer struct {
+	syncProducer sarama.SyncProducer
+}
+
+// NewProducer creates a new Kafka producer instance.
+func NewProducer(syncProducer sarama.SyncProducer) *Producer {
+	return &Producer{syncProducer: syncProducer}
+}
+
+// Produce sends the message to the topic and waits for the brokers to acknowledge it.
+// Messages with the same key are delivered to the same partition and keep their order.
+func (p *Producer) Produce(_ context.Context, topicName string, key, value []byte) error {
+	_, _, err := p.syncProducer.SendMessage(&sarama.ProducerMessage{
+		Topic: topicName,
+		Key:   sarama.ByteEncoder(key),
+		Value: sarama.ByteEncoder(value),
+	})
+	return err
+}
+
+// Close shuts down the producer and flushes buffered messages.
+func (p *Producer) Close() error {
+	return p.syncProducer.Close()
+}
@@ -0,0 +1,14 @@
+package oidc
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/client/oidc/upstream"
+)
+
+// Client defines the interface for an upstream OpenID Connect identity provider.
+type Client interface {
+	AuthCodeURL(ctx context.Context, state, nonce string) (string, error)
+	Exchange(ctx context.Context, code string) (string, error)
+	VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*upstream.IDToken, error)
+}
@@ -0,0 +1,281 @@
+package upstream
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+)
+
+const discoveryPath = "/.well-known/openid-configuration"
+
+// IDToken holds the verified subject and claims of an upstream ID token.
+type IDToken struct {
+	Subject string
+	Claims  map[string]any
+}
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// Client is an OpenID Connect relying party of the upstream identity provider.
+// Provider metadata and signing keys are fetched lazily and cached, keys are refetched on an unknown key ID.
+type Client struct {
+	config     config.OIDC
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	discovery *discovery
+	keys      map[string]*rsa.PublicKey
+}
+
+// NewClient creates a new upstream OpenID Connect client.
+func NewClient(config config.OIDC) *Client {
+	return &Client{
+		config:     config,
+		httpClient: &http.Client{Timeout: time.Duration(config.TimeoutSec) * time.Second},
+	}
+}
+
+// AuthCodeURL returns the upstream authorization endpoint URL the user is redirected to.
+func (c *Client) AuthCodeURL(ctx context.Context, state, nonce string) (string, error) {
+	d, err := c.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{
+		"response_type": {"code"},
+		"client_id":     {c.config.ClientID},
+		"redirect_uri":  {c.config.RedirectURL},
+		"scope":         {strings.Join(c.config.Scopes, " ")},
+		"state":         {state},
+		"nonce":         {nonce},
+	}
+
+	return d.AuthorizationEndpoint + "?" + params.Encode(), nil
+}
+
+// Exchange exchanges the authorization code for tokens and returns the raw ID token.
+func (c *Client) Exchange(ctx context.Context, code string) (string, error) {
+	d, err := c.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {c.config.RedirectURL},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(c.config.ClientID), url.QueryEscape(c.config.ClientSecret))
+
+	var tokens struct {
+		IDToken string `json:"id_token"`
+	}
+	err = c.doJSON(req, &tokens)
+	if err != nil {
+		return "", fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+
+	if tokens.IDToken == "" {
+		return "", errors.New("token response has no id_token")
+	}
+
+	return tokens.IDToken, nil
+}
+
+// VerifyIDToken verifies the signature of the ID token against the upstream JWKS
+// and checks its issuer, audience, expiry and nonce.
+func (c *Client) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*IDToken, error) {
+	d, err := c.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keyFunc := func(token *jwt.Token) (interface{}, error) {
+		_, ok := token.Method.(*jwt.SigningMethodRSA)
+		if !ok {
+			return nil, errors.Errorf("unexpected token signing method")
+		}
+
+		kid, _ := token.Header["kid"].(string)
+
+		return c.getKey(ctx, d.JWKSURI, kid)
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, keyFunc)
+	if err != nil {
+		return nil, errors.Errorf("invalid id token: %s", err.Error())
+	}
+
+	if !claims.VerifyIssuer(d.Issuer, true) {
+		return nil, errors.New("invalid id token issuer")
+	}
+
+	if !hasAudience(claims["aud"], c.config.ClientID) {
+		return nil, errors.New("invalid id token audience")
+	}
+
+	if claims["nonce"] != nonce {
+		return nil, errors.New("invalid id token nonce")
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, errors.New("id token has no subject")
+	}
+
+	return &IDToken{Subject: subject, Claims: claims}, nil
+}
+
+func (c *Client) getDiscovery(ctx context.Context) (*discovery, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.discovery != nil {
+		return c.discovery, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(c.config.IssuerURL, "/")+discoveryPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var d discovery
+	err = c.doJSON(req, &d)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover OIDC provider: %w", err)
+	}
+
+	if d.Issuer != strings.TrimSuffix(c.config.IssuerURL, "/") && d.Issuer != c.config.IssuerURL {
+		return nil, errors.Errorf("issuer %q doesn't match configured %q", d.Issuer, c.config.IssuerURL)
+	}
+
+	c.discovery = &d
+
+	return c.discovery, nil
+}
+
+func (c *Client) getKey(ctx context.Context, jwksURI, kid string) (*rsa.PublicKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, ok := c.keys[kid]
+	if ok {
+		return key, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+	err = c.doJSON(req, &jwks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(jwks.Keys))
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+
+		publicKey, errKey := k.rsaPublicKey()
+		if errKey != nil {
+			return nil, errKey
+		}
+		keys[k.Kid] = publicKey
+	}
+	c.keys = keys
+
+	key, ok = c.keys[kid]
+	if !ok {
+		return nil, errors.Errorf("unknown signing key %q", kid)
+	}
+
+	return key, nil
+}
+
+func (c *Client) doJSON(req *http.Request, v any) error {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %d from %s", resp.StatusCode, req.URL.Path)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func (k jwk) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWK modulus: %w", err)
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWK exponent: %w", err)
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
+
+// hasAudience reports whether the aud claim, a string or an array of strings, contains the client ID.
+func hasAudience(aud any, clientID string) bool {
+	switch v := aud.(type) {
+	case string:
+		return v == clientID
+	case []any:
+		for _, a := range v {
+			if a == clientID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,18 @@
+package pubsub
+
+import (
+	"context"
+)
+
+// Handler processes a message received from a channel.
+type Handler func(ctx context.Context, message string)
+
+// SubscribedHandler is called each time the subscription to a channel is established, including resubscriptions
+// after connection failures, so that subscribers can catch up on messages published meanwhile.
+type SubscribedHandler func(ctx context.Context)
+
+// Client defines the interface for publishing messages to and subscribing to broadcast channels.
+type Client interface {
+	Publish(ctx context.Context, channel, message string) error
+	Subscribe(ctx context.Context, channel string, handler Handler, subscribed SubscribedHandler) error
+}
@@ -0,0 +1,101 @@
+package redis
+
+import (
+	"context"
+	"log"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+
+	"github.com/mikhailsoldatkin/auth/internal/client/pubsub"
+)
+
+const resubscribeDelay = time.Second
+
+var _ pubsub.Client = (*client)(nil)
+
+type client struct {
+	pool *redigo.Pool
+}
+
+// NewClient creates a new pub/sub client on top of the Redis connection pool.
+func NewClient(pool *redigo.Pool) pubsub.Client {
+	return &client{pool: pool}
+}
+
+// Publish sends the message to all subscribers of the channel.
+func (c *client) Publish(ctx context.Context, channel, message string) error {
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	_, err = conn.Do("PUBLISH", channel, message)
+	return err
+}
+
+// Subscribe passes messages published to the channel to the handler until the context is canceled.
+// The subscription is restored after connection failures, messages published meanwhile are lost,
+// subscribed is called after each (re)subscription.
+func (c *client) Subscribe(ctx context.Context, channel string, handler pubsub.Handler, subscribed pubsub.SubscribedHandler) error {
+	for {
+		err := c.receive(ctx, channel, handler, subscribed)
+		if ctx.Err() != nil {
+			return nil
+		}
+		log.Printf("subscription to channel %s failed: %v", channel, err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(resubscribeDelay):
+		}
+	}
+}
+
+func (c *client) receive(ctx context.Context, channel string, handler pubsub.Handler, subscribed pubsub.SubscribedHandler) error {
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	psc := redigo.PubSubConn{Conn: conn}
+	defer func() {
+		_ = psc.Close()
+	}()
+
+	err = psc.Subscribe(channel)
+	if err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			// unblocks Receive below with the confirmation of the unsubscription
+			_ = psc.Unsubscribe()
+		case <-done:
+		}
+	}()
+
+	for {
+		switch v := psc.Receive().(type) {
+		case redigo.Message:
+			handler(ctx, string(v.Data))
+		case redigo.Subscription:
+			if v.Kind == "subscribe" {
+				subscribed(ctx)
+			}
+			if v.Count == 0 {
+				return ctx.Err()
+			}
+		case error:
+			return v
+		}
+	}
+}
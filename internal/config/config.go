@@ -28,6 +28,16 @@ type GRPC struct {
 	Address string `env:"-"`
 }
 
+// TLS represents the configuration for gRPC server TLS and optional client certificate authentication.
+// Client certificates are verified only when ClientCAFile is set. ClientPrincipals maps service account
+// usernames to certificate identities (URI SAN, DNS SAN or subject CN), e.g. "chat:spiffe://corp/chat;billing:billing.internal".
+type TLS struct {
+	CertFile         string            `env:"TLS_CERT_FILE" env-default:"cert/service.pem"`
+	KeyFile          string            `env:"TLS_KEY_FILE" env-default:"cert/service.key"`
+	ClientCAFile     string            `env:"TLS_CLIENT_CA_FILE"`
+	ClientPrincipals map[string]string `env:"TLS_CLIENT_PRINCIPALS" env-separator:";"`
+}
+
 // HTTP represents the configuration for the http server.
 type HTTP struct {
 	Port    int    `env:"HTTP_PORT" env-required:"true"`
@@ -53,6 +63,13 @@ type Redis struct {
 	Address     string `env:"-"`
 }
 
+// PermissionCache represents configuration for the permission cache, Key prefixes its Redis keys.
+type PermissionCache struct {
+	TTLSec  int    `env:"PERMISSION_CACHE_TTL_SEC" env-default:"300"`
+	Key     string `env:"PERMISSION_CACHE_KEY" env-default:"permissions:granted"`
+	Channel string `env:"PERMISSION_CACHE_CHANNEL" env-default:"permissions:invalidate"`
+}
+
 // KafkaConsumer represents configuration for KafkaConsumer.
 type KafkaConsumer struct {
 	Brokers []string `env:"KAFKA_BROKERS" env-required:"true"`
@@ -61,11 +78,84 @@ type KafkaConsumer struct {
 	Config  *sarama.Config
 }
 
+// KafkaProducer represents configuration for KafkaProducer.
+type KafkaProducer struct {
+	Brokers        []string `env:"KAFKA_BROKERS" env-required:"true"`
+	RelationsTopic string   `env:"KAFKA_RELATIONS_TOPIC" env-default:"relations"`
+	Config         *sarama.Config
+}
+
 // Auth represents configuration for authentication.
 type Auth struct {
-	TokenSecretKey            string `env:"TOKEN_SECRET_KEY" env-required:"true"`
-	RefreshTokenExpirationMin int    `env:"REFRESH_TOKEN_EXPIRATION_MIN" env-required:"true"`
-	AccessTokenExpirationMin  int    `env:"ACCESS_TOKEN_EXPIRATION_MIN" env-required:"true"`
+	TokenSecretKey                  string `env:"TOKEN_SECRET_KEY" env-required:"true"`
+	RefreshTokenExpirationMin       int    `env:"REFRESH_TOKEN_EXPIRATION_MIN" env-required:"true"`
+	AccessTokenExpirationMin        int    `env:"ACCESS_TOKEN_EXPIRATION_MIN" env-required:"true"`
+	ImpersonationTokenExpirationMin int    `env:"IMPERSONATION_TOKEN_EXPIRATION_MIN" env-default:"15"`
+	StepUpTokenExpirationMin        int    `env:"STEP_UP_TOKEN_EXPIRATION_MIN" env-default:"5"`
+}
+
+// RoleGrants represents configuration of temporary role grants. Expired grants are removed every
+// SweepIntervalSec seconds, elevation requests may ask for a role for at most MaxElevationMin minutes.
+type RoleGrants struct {
+	SweepIntervalSec int `env:"ROLE_GRANTS_SWEEP_INTERVAL_SEC" env-default:"60"`
+	MaxElevationMin  int `env:"ROLE_GRANTS_MAX_ELEVATION_MIN" env-default:"480"`
+}
+
+// DecisionLog represents configuration of the access decision log, allowed decisions are sampled with AllowSampleRate.
+type DecisionLog struct {
+	Enabled         bool    `env:"DECISION_LOG_ENABLED" env-default:"true"`
+	AllowSampleRate float64 `env:"DECISION_LOG_ALLOW_SAMPLE_RATE" env-default:"0.1"`
+	BufferSize      int     `env:"DECISION_LOG_BUFFER_SIZE" env-default:"10000"`
+	BatchSize       int     `env:"DECISION_LOG_BATCH_SIZE" env-default:"500"`
+	FlushIntervalMs int     `env:"DECISION_LOG_FLUSH_INTERVAL_MS" env-default:"1000"`
+}
+
+// LDAP represents configuration for the LDAP bind authentication provider.
+// GroupRoles maps group DNs to roles, e.g. "cn=admins,ou=groups,dc=example,dc=com:ADMIN;cn=staff,ou=groups,dc=example,dc=com:USER".
+type LDAP struct {
+	Enabled        bool              `env:"LDAP_ENABLED" env-default:"false"`
+	URL            string            `env:"LDAP_URL"`
+	BindDN         string            `env:"LDAP_BIND_DN"`
+	BindPassword   string            `env:"LDAP_BIND_PASSWORD"`
+	BaseDN         string            `env:"LDAP_BASE_DN"`
+	UserFilter     string            `env:"LDAP_USER_FILTER" env-default:"(uid=%s)"`
+	EmailAttribute string            `env:"LDAP_EMAIL_ATTRIBUTE" env-default:"mail"`
+	GroupAttribute string            `env:"LDAP_GROUP_ATTRIBUTE" env-default:"memberOf"`
+	GroupRoles     map[string]string `env:"LDAP_GROUP_ROLES" env-separator:";"`
+	DefaultRole    string            `env:"LDAP_DEFAULT_ROLE" env-default:"USER"`
+	TimeoutSec     int               `env:"LDAP_TIMEOUT_SEC" env-default:"5"`
+}
+
+// OIDC represents configuration for federated login via an upstream OpenID Connect identity provider.
+// RoleMapping maps values of the role claim to roles, e.g. "auth-admins:ADMIN;staff:USER".
+type OIDC struct {
+	Enabled       bool              `env:"OIDC_ENABLED" env-default:"false"`
+	Provider      string            `env:"OIDC_PROVIDER" env-default:"sso"`
+	IssuerURL     string            `env:"OIDC_ISSUER_URL"`
+	ClientID      string            `env:"OIDC_CLIENT_ID"`
+	ClientSecret  string            `env:"OIDC_CLIENT_SECRET"`
+	RedirectURL   string            `env:"OIDC_REDIRECT_URL"`
+	Scopes        []string          `env:"OIDC_SCOPES" env-default:"openid,email,profile"`
+	UsernameClaim string            `env:"OIDC_USERNAME_CLAIM" env-default:"preferred_username"`
+	EmailClaim    string            `env:"OIDC_EMAIL_CLAIM" env-default:"email"`
+	RoleClaim     string            `env:"OIDC_ROLE_CLAIM" env-default:"groups"`
+	RoleMapping   map[string]string `env:"OIDC_ROLE_MAPPING" env-separator:";"`
+	DefaultRole   string            `env:"OIDC_DEFAULT_ROLE" env-default:"USER"`
+	StateTTLMin   int               `env:"OIDC_STATE_TTL_MIN" env-default:"10"`
+	TimeoutSec    int               `env:"OIDC_TIMEOUT_SEC" env-default:"5"`
+}
+
+// ExtAuthz represents configuration of external authorization for proxies. Routes maps HTTP routes to endpoint
+// permissions, e.g. "GET /reports/{id}:/legacy.Reports/Get;POST /reports:/legacy.Reports/Create".
+type ExtAuthz struct {
+	SessionCookie string            `env:"EXT_AUTHZ_SESSION_COOKIE" env-default:"access_token"`
+	Routes        map[string]string `env:"EXT_AUTHZ_ROUTES" env-separator:";"`
+}
+
+// Pagination represents configuration of list pagination, PageTokenSecretKey defaults to the TOKEN_SECRET_KEY
+// (see signPageToken in internal/utils).
+type Pagination struct {
+	PageTokenSecretKey string `env:"PAGE_TOKEN_SECRET_KEY"`
 }
 
 // Logger represents configuration for logger.
@@ -86,15 +176,24 @@ type Prometheus struct {
 
 // Config represents the overall application configuration.
 type Config struct {
-	DB            DB
-	GRPC          GRPC
-	Redis         Redis
-	HTTP          HTTP
-	Swagger       Swagger
-	KafkaConsumer KafkaConsumer
-	Auth          Auth
-	Logger        Logger
-	Prometheus    Prometheus
+	DB              DB
+	GRPC            GRPC
+	TLS             TLS
+	Redis           Redis
+	PermissionCache PermissionCache
+	HTTP            HTTP
+	Swagger         Swagger
+	KafkaConsumer   KafkaConsumer
+	KafkaProducer   KafkaProducer
+	Auth            Auth
+	RoleGrants      RoleGrants
+	DecisionLog     DecisionLog
+	LDAP            LDAP
+	OIDC            OIDC
+	ExtAuthz        ExtAuthz
+	Pagination      Pagination
+	Logger          Logger
+	Prometheus      Prometheus
 }
 
 // Load reads configuration from .env file.
@@ -121,6 +220,10 @@ func Load() (*Config, error) {
 		cfg.DB.PostgresPassword,
 	)
 
+	if cfg.Pagination.PageTokenSecretKey == "" {
+		cfg.Pagination.PageTokenSecretKey = cfg.Auth.TokenSecretKey
+	}
+
 	cfg.Redis.Address = fmt.Sprintf("%s:%d", cfg.Redis.Host, cfg.Redis.Port)
 	cfg.GRPC.Address = fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port)
 	cfg.HTTP.Address = fmt.Sprintf("%s:%d", cfg.HTTP.Host, cfg.HTTP.Port)
@@ -133,5 +236,12 @@ func Load() (*Config, error) {
 	kafkaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
 	cfg.KafkaConsumer.Config = kafkaConfig
 
+	producerConfig := sarama.NewConfig()
+	producerConfig.Version = sarama.V3_6_0_0
+	producerConfig.Producer.RequiredAcks = sarama.WaitForAll
+	producerConfig.Producer.Retry.Max = 5
+	producerConfig.Producer.Return.Successes = true
+	cfg.KafkaProducer.Config = producerConfig
+
 	return &cfg, nil
 }
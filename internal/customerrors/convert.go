@@ -18,6 +18,8 @@ func ConvertError(err error) error {
 	var errInvalidPassword *ErrInvalidPassword
 	var errInvalidToken *ErrInvalidToken
 	var errForbidden *ErrForbidden
+	var errInvalidArgument *ErrInvalidArgument
+	var errStepUpRequired *ErrStepUpRequired
 
 	switch {
 	case errors.As(err, &errNotFound):
@@ -28,6 +30,10 @@ func ConvertError(err error) error {
 		return status.Errorf(codes.Unauthenticated, errInvalidToken.Error())
 	case errors.As(err, &errForbidden):
 		return status.Errorf(codes.PermissionDenied, errForbidden.Error())
+	case errors.As(err, &errInvalidArgument):
+		return status.Errorf(codes.InvalidArgument, errInvalidArgument.Error())
+	case errors.As(err, &errStepUpRequired):
+		return status.Errorf(codes.FailedPrecondition, errStepUpRequired.Error())
 	default:
 		return status.Errorf(codes.Internal, err.Error())
 	}
@@ -56,15 +56,55 @@ func NewErrInvalidToken() error {
 	return &ErrInvalidToken{}
 }
 
-// ErrForbidden represents an error for a forbidden action.
-type ErrForbidden struct{}
+// ErrForbidden represents an error for a forbidden action, Reason optionally explains the denial.
+type ErrForbidden struct {
+	Reason string
+}
 
 // Error implements the error interface for ErrForbidden.
 func (e *ErrForbidden) Error() string {
-	return "access denied"
+	if e.Reason == "" {
+		return "access denied"
+	}
+	return fmt.Sprintf("access denied: %s", e.Reason)
 }
 
 // NewErrForbidden creates a new ErrForbidden error.
 func NewErrForbidden() error {
 	return &ErrForbidden{}
 }
+
+// NewErrForbiddenReason creates a new ErrForbidden error explaining why access was denied.
+func NewErrForbiddenReason(reason string) error {
+	return &ErrForbidden{Reason: reason}
+}
+
+// ErrInvalidArgument represents an error when the provided request data is invalid.
+type ErrInvalidArgument struct {
+	Reason string
+}
+
+// Error implements the error interface for ErrInvalidArgument.
+func (e *ErrInvalidArgument) Error() string {
+	return fmt.Sprintf("invalid argument: %s", e.Reason)
+}
+
+// NewErrInvalidArgument creates a new ErrInvalidArgument with the given reason.
+func NewErrInvalidArgument(reason string) error {
+	return &ErrInvalidArgument{Reason: reason}
+}
+
+// ErrStepUpRequired represents an error when the endpoint requires a more recent authentication.
+type ErrStepUpRequired struct {
+	MaxAgeMin int
+}
+
+// Error implements the error interface for ErrStepUpRequired.
+func (e *ErrStepUpRequired) Error() string {
+	return fmt.Sprintf("fresh authentication within %d minutes required", e.MaxAgeMin)
+}
+
+// NewErrStepUpRequired creates a new ErrStepUpRequired for the given maximum authentication age.
+func NewErrStepUpRequired(maxAgeMin int) error {
+	return &ErrStepUpRequired{MaxAgeMin: maxAgeMin}
+}
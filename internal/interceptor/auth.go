@@ -0,0 +1,116 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pbUser "github.com/mikhailsoldatkin/auth/pkg/user_v1"
+)
+
+// updateRoleEndpoint is the permission endpoint authorizing role changes made with UserV1.Update in addition to
+// the Update endpoint, so that role changes can be restricted further, e.g. require a fresh authentication.
+const updateRoleEndpoint = pbUser.UserV1_Update_FullMethodName + "/Role"
+
+// publicMethods are the UserV1 methods called without credentials. AuthV1, including Login,
+// is not protected by the interceptor as its methods authenticate callers themselves.
+var publicMethods = map[string]struct{}{
+	pbUser.UserV1_Create_FullMethodName: {},
+}
+
+// NewAuthInterceptor returns a gRPC unary server interceptor authorizing calls of the UserV1 service
+// by AccessService.Check and authorizeAccount, sign-ups with the USER role are public.
+func NewAuthInterceptor(accessService service.AccessService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !strings.HasPrefix(info.FullMethod, "/"+pbUser.UserV1_ServiceDesc.ServiceName+"/") {
+			return handler(ctx, req)
+		}
+
+		if isPublicCall(info.FullMethod, req) {
+			return handler(ctx, req)
+		}
+
+		grant, err := accessService.Check(ctx, info.FullMethod)
+		if err != nil {
+			return nil, customerrors.ConvertError(err)
+		}
+
+		err = authorizeAccount(grant.Claims, req)
+		if err != nil {
+			return nil, customerrors.ConvertError(err)
+		}
+
+		if update, ok := req.(*pbUser.UpdateRequest); ok && changesRole(update) {
+			_, err = accessService.Check(ctx, updateRoleEndpoint)
+			if err != nil {
+				return nil, customerrors.ConvertError(err)
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func isPublicCall(method string, req any) bool {
+	if _, ok := publicMethods[method]; !ok {
+		return false
+	}
+
+	if create, ok := req.(*pbUser.CreateRequest); ok {
+		return isUserRole(create.GetRoleName(), create.GetRole())
+	}
+
+	return true
+}
+
+// authorizeAccount checks that a non-admin caller, a service account included, only reads, updates or checks
+// the existence of their own account and only creates service accounts they own with the USER role.
+func authorizeAccount(claims *model.UserClaims, req any) error {
+	if claims.HasRole(pbUser.Role_ADMIN.String()) {
+		return nil
+	}
+
+	switch r := req.(type) {
+	case *pbUser.GetRequest:
+		return ownAccount(claims, r.GetId())
+	case *pbUser.UpdateRequest:
+		if changesRole(r) {
+			return customerrors.NewErrForbiddenReason("only admins can change roles")
+		}
+		return ownAccount(claims, r.GetId())
+	case *pbUser.CheckUsersExistRequest:
+		for _, id := range r.GetIds() {
+			err := ownAccount(claims, id)
+			if err != nil {
+				return err
+			}
+		}
+	case *pbUser.CreateServiceAccountRequest:
+		if !isUserRole(r.GetRoleName(), r.GetRole()) {
+			return customerrors.NewErrForbiddenReason("only admins can create service accounts with roles other than USER")
+		}
+		return ownAccount(claims, r.GetOwnerId())
+	}
+
+	return nil
+}
+
+// isUserRole reports whether the role referenced by name, or by the built-in role enum without a name, is USER.
+func isUserRole(name string, role pbUser.Role) bool {
+	return name == "" && role == pbUser.Role_USER || name == pbUser.Role_USER.String()
+}
+
+func changesRole(req *pbUser.UpdateRequest) bool {
+	return req.GetRole() != pbUser.Role_UNKNOWN || req.GetRoleName() != ""
+}
+
+func ownAccount(claims *model.UserClaims, userID int64) error {
+	if claims.UserID != userID {
+		return customerrors.NewErrForbiddenReason("users can only access their own accounts")
+	}
+	return nil
+}
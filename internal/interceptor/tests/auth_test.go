@@ -0,0 +1,236 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/interceptor"
+	"github.com/mikhailsoldatkin/auth/internal/service"
+	serviceMocks "github.com/mikhailsoldatkin/auth/internal/service/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pbAuth "github.com/mikhailsoldatkin/auth/pkg/auth_v1"
+	pbUser "github.com/mikhailsoldatkin/auth/pkg/user_v1"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	t.Parallel()
+	type accessServiceMockFunc func(mc *minimock.Controller) service.AccessService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		granted = func(method string, claims *model.UserClaims) accessServiceMockFunc {
+			return func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.Expect(ctx, method).Return(&model.AccessGrant{Claims: claims}, nil)
+				return mock
+			}
+		}
+		notChecked = func(mc *minimock.Controller) service.AccessService {
+			return serviceMocks.NewAccessServiceMock(mc)
+		}
+		user  = &model.UserClaims{UserID: 7, Username: "oncall", Role: "USER"}
+		admin = &model.UserClaims{UserID: 1, Username: "root", Role: "ADMIN"}
+
+		serviceAccount = &model.UserClaims{UserID: 9, Username: "oncall-bot", Role: "USER"}
+	)
+
+	tests := []struct {
+		name              string
+		method            string
+		req               any
+		code              codes.Code
+		accessServiceMock accessServiceMockFunc
+	}{
+		{
+			name:              "public sign-up",
+			method:            pbUser.UserV1_Create_FullMethodName,
+			req:               &pbUser.CreateRequest{Username: "new", Role: pbUser.Role_USER},
+			accessServiceMock: notChecked,
+		},
+		{
+			name:   "sign-up as admin without credentials",
+			method: pbUser.UserV1_Create_FullMethodName,
+			req:    &pbUser.CreateRequest{Username: "new", Role: pbUser.Role_ADMIN},
+			code:   codes.Unauthenticated,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.Expect(ctx, pbUser.UserV1_Create_FullMethodName).Return(nil, customerrors.NewErrInvalidToken())
+				return mock
+			},
+		},
+		{
+			name:              "other services passed through",
+			method:            pbAuth.AuthV1_Login_FullMethodName,
+			req:               &pbAuth.LoginRequest{},
+			accessServiceMock: notChecked,
+		},
+		{
+			name:              "user reads own account",
+			method:            pbUser.UserV1_Get_FullMethodName,
+			req:               &pbUser.GetRequest{Id: user.UserID},
+			accessServiceMock: granted(pbUser.UserV1_Get_FullMethodName, user),
+		},
+		{
+			name:              "user reads another account",
+			method:            pbUser.UserV1_Get_FullMethodName,
+			req:               &pbUser.GetRequest{Id: 8},
+			code:              codes.PermissionDenied,
+			accessServiceMock: granted(pbUser.UserV1_Get_FullMethodName, user),
+		},
+		{
+			name:              "admin reads another account",
+			method:            pbUser.UserV1_Get_FullMethodName,
+			req:               &pbUser.GetRequest{Id: 8},
+			accessServiceMock: granted(pbUser.UserV1_Get_FullMethodName, admin),
+		},
+		{
+			name:              "service account reads itself",
+			method:            pbUser.UserV1_Get_FullMethodName,
+			req:               &pbUser.GetRequest{Id: serviceAccount.UserID},
+			accessServiceMock: granted(pbUser.UserV1_Get_FullMethodName, serviceAccount),
+		},
+		{
+			name:              "service account reads its owner",
+			method:            pbUser.UserV1_Get_FullMethodName,
+			req:               &pbUser.GetRequest{Id: user.UserID},
+			code:              codes.PermissionDenied,
+			accessServiceMock: granted(pbUser.UserV1_Get_FullMethodName, serviceAccount),
+		},
+		{
+			name:              "user checks other users exist",
+			method:            pbUser.UserV1_CheckUsersExist_FullMethodName,
+			req:               &pbUser.CheckUsersExistRequest{Ids: []int64{user.UserID, 8}},
+			code:              codes.PermissionDenied,
+			accessServiceMock: granted(pbUser.UserV1_CheckUsersExist_FullMethodName, user),
+		},
+		{
+			name:              "admin checks users exist",
+			method:            pbUser.UserV1_CheckUsersExist_FullMethodName,
+			req:               &pbUser.CheckUsersExistRequest{Ids: []int64{user.UserID, 8}},
+			accessServiceMock: granted(pbUser.UserV1_CheckUsersExist_FullMethodName, admin),
+		},
+		{
+			name:              "user changes own role",
+			method:            pbUser.UserV1_Update_FullMethodName,
+			req:               &pbUser.UpdateRequest{Id: user.UserID, RoleName: "ADMIN"},
+			code:              codes.PermissionDenied,
+			accessServiceMock: granted(pbUser.UserV1_Update_FullMethodName, user),
+		},
+		{
+			name:   "admin changes role",
+			method: pbUser.UserV1_Update_FullMethodName,
+			req:    &pbUser.UpdateRequest{Id: user.UserID, RoleName: "ADMIN"},
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.When(ctx, pbUser.UserV1_Update_FullMethodName).Then(&model.AccessGrant{Claims: admin}, nil)
+				mock.CheckMock.When(ctx, pbUser.UserV1_Update_FullMethodName+"/Role").Then(&model.AccessGrant{Claims: admin}, nil)
+				return mock
+			},
+		},
+		{
+			name:   "admin changes role without fresh authentication",
+			method: pbUser.UserV1_Update_FullMethodName,
+			req:    &pbUser.UpdateRequest{Id: user.UserID, RoleName: "ADMIN"},
+			code:   codes.FailedPrecondition,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.When(ctx, pbUser.UserV1_Update_FullMethodName).Then(&model.AccessGrant{Claims: admin}, nil)
+				mock.CheckMock.When(ctx, pbUser.UserV1_Update_FullMethodName+"/Role").Then(nil, customerrors.NewErrStepUpRequired(5))
+				return mock
+			},
+		},
+		{
+			name:              "admin updates email without role check",
+			method:            pbUser.UserV1_Update_FullMethodName,
+			req:               &pbUser.UpdateRequest{Id: user.UserID, Email: wrapperspb.String("oncall@example.com")},
+			accessServiceMock: granted(pbUser.UserV1_Update_FullMethodName, admin),
+		},
+		{
+			name:              "user updates own email",
+			method:            pbUser.UserV1_Update_FullMethodName,
+			req:               &pbUser.UpdateRequest{Id: user.UserID, Email: wrapperspb.String("oncall@example.com")},
+			accessServiceMock: granted(pbUser.UserV1_Update_FullMethodName, user),
+		},
+		{
+			name:              "user creates own service account",
+			method:            pbUser.UserV1_CreateServiceAccount_FullMethodName,
+			req:               &pbUser.CreateServiceAccountRequest{Username: "bot", OwnerId: user.UserID, Role: pbUser.Role_USER},
+			accessServiceMock: granted(pbUser.UserV1_CreateServiceAccount_FullMethodName, user),
+		},
+		{
+			name:              "user creates own service account with the USER role name",
+			method:            pbUser.UserV1_CreateServiceAccount_FullMethodName,
+			req:               &pbUser.CreateServiceAccountRequest{Username: "bot", OwnerId: user.UserID, RoleName: "USER"},
+			accessServiceMock: granted(pbUser.UserV1_CreateServiceAccount_FullMethodName, user),
+		},
+		{
+			name:              "user creates an admin service account",
+			method:            pbUser.UserV1_CreateServiceAccount_FullMethodName,
+			req:               &pbUser.CreateServiceAccountRequest{Username: "bot", OwnerId: user.UserID, Role: pbUser.Role_ADMIN},
+			code:              codes.PermissionDenied,
+			accessServiceMock: granted(pbUser.UserV1_CreateServiceAccount_FullMethodName, user),
+		},
+		{
+			name:              "user creates a service account with a custom role name",
+			method:            pbUser.UserV1_CreateServiceAccount_FullMethodName,
+			req:               &pbUser.CreateServiceAccountRequest{Username: "bot", OwnerId: user.UserID, Role: pbUser.Role_USER, RoleName: "AUDITOR"},
+			code:              codes.PermissionDenied,
+			accessServiceMock: granted(pbUser.UserV1_CreateServiceAccount_FullMethodName, user),
+		},
+		{
+			name:              "admin creates an admin service account",
+			method:            pbUser.UserV1_CreateServiceAccount_FullMethodName,
+			req:               &pbUser.CreateServiceAccountRequest{Username: "bot", OwnerId: 8, Role: pbUser.Role_ADMIN},
+			accessServiceMock: granted(pbUser.UserV1_CreateServiceAccount_FullMethodName, admin),
+		},
+		{
+			name:              "user creates a service account for another owner",
+			method:            pbUser.UserV1_CreateServiceAccount_FullMethodName,
+			req:               &pbUser.CreateServiceAccountRequest{Username: "bot", OwnerId: 8, Role: pbUser.Role_USER},
+			code:              codes.PermissionDenied,
+			accessServiceMock: granted(pbUser.UserV1_CreateServiceAccount_FullMethodName, user),
+		},
+		{
+			name:   "list without permission",
+			method: pbUser.UserV1_List_FullMethodName,
+			req:    &pbUser.ListRequest{},
+			code:   codes.PermissionDenied,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.Expect(ctx, pbUser.UserV1_List_FullMethodName).Return(nil, customerrors.NewErrForbiddenReason("no permission for roles USER"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			authInterceptor := interceptor.NewAuthInterceptor(tt.accessServiceMock(mc))
+
+			called := false
+			handler := func(_ context.Context, _ any) (any, error) {
+				called = true
+				return "ok", nil
+			}
+
+			res, err := authInterceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			require.Equal(t, tt.code, status.Code(err))
+			require.Equal(t, tt.code == codes.OK, called)
+			if tt.code == codes.OK {
+				require.Equal(t, "ok", res)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ type Metrics struct {
 	requestCounter        prometheus.Counter
 	responseCounter       *prometheus.CounterVec
 	histogramResponseTime *prometheus.HistogramVec
+	permissionCache       *prometheus.CounterVec
 }
 
 var metrics *Metrics
@@ -51,6 +52,15 @@ func Init(_ context.Context) error {
 			},
 			[]string{"status"},
 		),
+		permissionCache: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "permission_cache",
+				Name:      appName + "_lookups_total",
+				Help:      "Total number of permission cache lookups by cache level and result",
+			},
+			[]string{"level", "result"},
+		),
 	}
 
 	return nil
@@ -70,3 +80,12 @@ func IncResponseCounter(status string, method string) {
 func HistogramResponseTimeObserve(status string, time float64) {
 	metrics.histogramResponseTime.WithLabelValues(status).Observe(time)
 }
+
+// IncPermissionCacheCounter increments the permission cache lookup counter for the given level and result.
+// It is a no-op until metrics are initialized, the cache is also used outside the gRPC server.
+func IncPermissionCacheCounter(level string, result string) {
+	if metrics == nil {
+		return
+	}
+	metrics.permissionCache.WithLabelValues(level, result).Inc()
+}
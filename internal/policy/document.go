@@ -0,0 +1,163 @@
+package policy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// Document is the declarative form of the roles and permissions tables kept under version control, e.g.
+//
+//	roles:
+//	  - name: MODERATOR
+//	    description: Chat moderators
+//	    parent: USER
+//	permissions:
+//	  - endpoint: /chat_v1.ChatV1/*
+//	    role: USER
+//	  - endpoint: /chat_v1.ChatV1/Delete
+//	    role: USER
+//	    effect: deny
+//	    condition: '!request.ip.startsWith("10.")'
+//
+// JSON documents are accepted as well. A role is identified by its name, a permission by its endpoint and role.
+type Document struct {
+	Roles       []Role `yaml:"roles,omitempty" json:"roles,omitempty"`
+	Permissions []Rule `yaml:"permissions" json:"permissions"`
+}
+
+// Role declares a role inheriting permissions of the optional parent role.
+type Role struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	Parent      string `yaml:"parent,omitempty" json:"parent,omitempty"`
+}
+
+// Rule declares an endpoint permission of a role, the effect defaults to allow.
+type Rule struct {
+	Endpoint      string `yaml:"endpoint" json:"endpoint"`
+	Role          string `yaml:"role" json:"role"`
+	Effect        string `yaml:"effect,omitempty" json:"effect,omitempty"`
+	MaxAuthAgeMin int    `yaml:"max_auth_age_min,omitempty" json:"max_auth_age_min,omitempty"`
+	Condition     string `yaml:"condition,omitempty" json:"condition,omitempty"`
+}
+
+// ParseDocument parses a YAML or JSON policy document into roles and permissions.
+// Unknown fields, unknown effects, duplicate roles and duplicate permissions of the same endpoint and role are rejected,
+// parents of roles are checked when the policy is applied.
+func ParseDocument(data []byte) (*model.Policy, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var document Document
+	err := decoder.Decode(&document)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid policy document: %w", err)
+	}
+
+	roles := make([]*model.Role, 0, len(document.Roles))
+	names := make(map[string]struct{}, len(document.Roles))
+	for i, role := range document.Roles {
+		if role.Name == "" {
+			return nil, fmt.Errorf("role %d: name is required", i+1)
+		}
+		if role.Parent == role.Name {
+			return nil, fmt.Errorf("role %d: %s can't be its own parent", i+1, role.Name)
+		}
+		if _, ok := names[role.Name]; ok {
+			return nil, fmt.Errorf("role %d: duplicate role %s", i+1, role.Name)
+		}
+		names[role.Name] = struct{}{}
+
+		roles = append(roles, &model.Role{Name: role.Name, Description: role.Description, Parent: role.Parent})
+	}
+
+	permissions := make([]*model.Permission, 0, len(document.Permissions))
+	seen := make(map[string]struct{}, len(document.Permissions))
+	for i, rule := range document.Permissions {
+		if rule.Endpoint == "" || rule.Role == "" {
+			return nil, fmt.Errorf("permission %d: endpoint and role are required", i+1)
+		}
+
+		effect := rule.Effect
+		if effect == "" {
+			effect = model.EffectAllow
+		}
+		if effect != model.EffectAllow && effect != model.EffectDeny {
+			return nil, fmt.Errorf("permission %d: unknown effect %q", i+1, rule.Effect)
+		}
+
+		key := rule.Endpoint + " " + rule.Role
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("permission %d: duplicate permission of %s for role %s", i+1, rule.Endpoint, rule.Role)
+		}
+		seen[key] = struct{}{}
+
+		permissions = append(permissions, &model.Permission{
+			Endpoint:      rule.Endpoint,
+			Role:          rule.Role,
+			Effect:        effect,
+			MaxAuthAgeMin: rule.MaxAuthAgeMin,
+			Condition:     rule.Condition,
+		})
+	}
+
+	return &model.Policy{Roles: roles, Permissions: permissions}, nil
+}
+
+// MarshalDocument renders the policy as a YAML document with roles ordered by name
+// and permissions ordered by endpoint and role, the allow effect is omitted.
+func MarshalDocument(policy *model.Policy) ([]byte, error) {
+	document := Document{
+		Roles:       make([]Role, 0, len(policy.Roles)),
+		Permissions: make([]Rule, 0, len(policy.Permissions)),
+	}
+	for _, role := range policy.Roles {
+		document.Roles = append(document.Roles, Role{Name: role.Name, Description: role.Description, Parent: role.Parent})
+	}
+	sort.Slice(document.Roles, func(i, j int) bool {
+		return document.Roles[i].Name < document.Roles[j].Name
+	})
+
+	for _, permission := range policy.Permissions {
+		rule := Rule{
+			Endpoint:      permission.Endpoint,
+			Role:          permission.Role,
+			MaxAuthAgeMin: permission.MaxAuthAgeMin,
+			Condition:     permission.Condition,
+		}
+		if permission.IsDeny() {
+			rule.Effect = model.EffectDeny
+		}
+		document.Permissions = append(document.Permissions, rule)
+	}
+
+	sort.SliceStable(document.Permissions, func(i, j int) bool {
+		a, b := document.Permissions[i], document.Permissions[j]
+		if a.Endpoint != b.Endpoint {
+			return a.Endpoint < b.Endpoint
+		}
+		return a.Role < b.Role
+	})
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	err := encoder.Encode(document)
+	if err != nil {
+		return nil, err
+	}
+
+	err = encoder.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,129 @@
+package policy
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+)
+
+// Variables available to conditions.
+const (
+	VarPrincipal = "principal"
+	VarUser      = "user"
+	VarRequest   = "request"
+	VarNow       = "now"
+)
+
+// Vars holds values of the variables a condition is evaluated against.
+// User is called at most once and only by conditions referring to the user record.
+type Vars struct {
+	Principal map[string]any
+	User      func() map[string]any
+	Request   map[string]any
+	Now       time.Time
+}
+
+// Evaluator compiles CEL conditions of permissions and evaluates them, compiled programs are cached by expression.
+type Evaluator struct {
+	env *cel.Env
+
+	mu       sync.RWMutex
+	programs map[string]cel.Program
+}
+
+// NewEvaluator creates a new condition evaluator, conditions refer to principal, user, request and now,
+// e.g. user.email.endsWith("@example.com").
+func NewEvaluator() *Evaluator {
+	env, err := cel.NewEnv(
+		cel.Variable(VarPrincipal, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(VarUser, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(VarRequest, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(VarNow, cel.TimestampType),
+	)
+	if err != nil {
+		// declarations are static, so failing to create the environment is a programming error
+		panic(fmt.Sprintf("failed to create CEL environment: %v", err))
+	}
+
+	return &Evaluator{
+		env:      env,
+		programs: make(map[string]cel.Program),
+	}
+}
+
+// Validate parses and type-checks the condition, it returns the issues found, nothing for a valid condition.
+// A condition must evaluate to a bool.
+func (e *Evaluator) Validate(expression string) []string {
+	_, issues := e.compile(expression)
+	return issues
+}
+
+// Evaluate reports whether the condition holds for the variables.
+func (e *Evaluator) Evaluate(expression string, vars *Vars) (bool, error) {
+	program, err := e.program(expression)
+	if err != nil {
+		return false, err
+	}
+
+	out, _, err := program.Eval(map[string]any{
+		VarPrincipal: vars.Principal,
+		VarUser:      func() any { return vars.User() },
+		VarRequest:   vars.Request,
+		VarNow:       vars.Now,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate condition %q: %w", expression, err)
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("condition %q evaluated to %v instead of bool", expression, out.Value())
+	}
+
+	return result, nil
+}
+
+func (e *Evaluator) program(expression string) (cel.Program, error) {
+	e.mu.RLock()
+	program, ok := e.programs[expression]
+	e.mu.RUnlock()
+	if ok {
+		return program, nil
+	}
+
+	program, issues := e.compile(expression)
+	if len(issues) > 0 {
+		return nil, fmt.Errorf("invalid condition %q: %s", expression, issues[0])
+	}
+
+	e.mu.Lock()
+	e.programs[expression] = program
+	e.mu.Unlock()
+
+	return program, nil
+}
+
+func (e *Evaluator) compile(expression string) (cel.Program, []string) {
+	ast, iss := e.env.Compile(expression)
+	if iss.Err() != nil {
+		issues := make([]string, 0, len(iss.Errors()))
+		for _, issue := range iss.Errors() {
+			issues = append(issues, fmt.Sprintf("%d:%d: %s", issue.Location.Line(), issue.Location.Column()+1, issue.Message))
+		}
+		return nil, issues
+	}
+
+	// values of the map variables are dyn, their type is checked on evaluation
+	if out := ast.OutputType(); !out.IsExactType(cel.BoolType) && !out.IsExactType(cel.DynType) {
+		return nil, []string{fmt.Sprintf("condition must evaluate to bool, not %s", out)}
+	}
+
+	program, err := e.env.Program(ast)
+	if err != nil {
+		return nil, []string{err.Error()}
+	}
+
+	return program, nil
+}
@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikhailsoldatkin/auth/internal/policy"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+func TestParseDocument(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		document    string
+		roles       []*model.Role
+		permissions []*model.Permission
+		err         bool
+	}{
+		{
+			name: "yaml",
+			document: `
+roles:
+  - name: MODERATOR
+    description: Chat moderators
+    parent: USER
+  - name: AUDITOR
+permissions:
+  - endpoint: /chat_v1.ChatV1/*
+    role: USER
+  - endpoint: /chat_v1.ChatV1/Delete
+    role: USER
+    effect: deny
+    condition: '!request.ip.startsWith("10.")'
+  - endpoint: /access_v1.AccessV1/DeleteRole
+    role: ADMIN
+    max_auth_age_min: 5
+`,
+			permissions: []*model.Permission{
+				{Endpoint: "/chat_v1.ChatV1/*", Role: "USER", Effect: model.EffectAllow},
+				{Endpoint: "/chat_v1.ChatV1/Delete", Role: "USER", Effect: model.EffectDeny, Condition: `!request.ip.startsWith("10.")`},
+				{Endpoint: "/access_v1.AccessV1/DeleteRole", Role: "ADMIN", Effect: model.EffectAllow, MaxAuthAgeMin: 5},
+			},
+			roles: []*model.Role{
+				{Name: "MODERATOR", Description: "Chat moderators", Parent: "USER"},
+				{Name: "AUDITOR"},
+			},
+		},
+		{
+			name:        "json",
+			document:    `{"roles": [{"name": "AUDITOR"}], "permissions": [{"endpoint": "/chat_v1.ChatV1/Create", "role": "USER"}]}`,
+			roles:       []*model.Role{{Name: "AUDITOR"}},
+			permissions: []*model.Permission{{Endpoint: "/chat_v1.ChatV1/Create", Role: "USER", Effect: model.EffectAllow}},
+		},
+		{
+			name:        "empty document",
+			document:    "",
+			roles:       []*model.Role{},
+			permissions: []*model.Permission{},
+		},
+		{
+			name:     "duplicate role",
+			document: "roles:\n  - name: AUDITOR\n  - name: AUDITOR\n    parent: USER\n",
+			err:      true,
+		},
+		{
+			name:     "missing role name",
+			document: "roles:\n  - parent: USER\n",
+			err:      true,
+		},
+		{
+			name:     "role is its own parent",
+			document: "roles:\n  - name: AUDITOR\n    parent: AUDITOR\n",
+			err:      true,
+		},
+		{
+			name:     "unknown field",
+			document: "permissions:\n  - endpoint: /chat_v1.ChatV1/Create\n    role: USER\n    scope: all\n",
+			err:      true,
+		},
+		{
+			name:     "unknown effect",
+			document: "permissions:\n  - endpoint: /chat_v1.ChatV1/Create\n    role: USER\n    effect: maybe\n",
+			err:      true,
+		},
+		{
+			name:     "duplicate permission",
+			document: "permissions:\n  - {endpoint: /chat_v1.ChatV1/Create, role: USER}\n  - {endpoint: /chat_v1.ChatV1/Create, role: USER, effect: deny}\n",
+			err:      true,
+		},
+		{
+			name:     "missing role",
+			document: "permissions:\n  - endpoint: /chat_v1.ChatV1/Create\n",
+			err:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := policy.ParseDocument([]byte(tt.document))
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.roles == nil {
+				tt.roles = []*model.Role{}
+			}
+			require.Equal(t, tt.roles, parsed.Roles)
+			require.Equal(t, tt.permissions, parsed.Permissions)
+		})
+	}
+}
+
+func TestMarshalDocument(t *testing.T) {
+	t.Parallel()
+
+	roles := []*model.Role{
+		{Name: "USER", Description: "Regular users"},
+		{Name: "MODERATOR", Description: "Chat moderators", Parent: "USER", CreatedAt: time.Now()},
+	}
+	permissions := []*model.Permission{
+		{ID: 2, Endpoint: "/chat_v1.ChatV1/Delete", Role: "USER", Effect: model.EffectDeny, Condition: `request.ip == "10.0.0.1"`},
+		{ID: 1, Endpoint: "/access_v1.AccessV1/DeleteRole", Role: "ADMIN", Effect: model.EffectAllow, MaxAuthAgeMin: 5},
+	}
+
+	document, err := policy.MarshalDocument(&model.Policy{Roles: roles, Permissions: permissions})
+	require.NoError(t, err)
+	require.Equal(t, `roles:
+  - name: MODERATOR
+    description: Chat moderators
+    parent: USER
+  - name: USER
+    description: Regular users
+permissions:
+  - endpoint: /access_v1.AccessV1/DeleteRole
+    role: ADMIN
+    max_auth_age_min: 5
+  - endpoint: /chat_v1.ChatV1/Delete
+    role: USER
+    effect: deny
+    condition: request.ip == "10.0.0.1"
+`, string(document))
+
+	// an exported document applies without changes
+	parsed, err := policy.ParseDocument(document)
+	require.NoError(t, err)
+	for _, permission := range permissions {
+		permission.ID = 0
+	}
+	for _, role := range roles {
+		role.CreatedAt = time.Time{}
+	}
+	require.ElementsMatch(t, roles, parsed.Roles)
+	require.ElementsMatch(t, permissions, parsed.Permissions)
+}
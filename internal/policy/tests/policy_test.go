@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikhailsoldatkin/auth/internal/policy"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	evaluator := policy.NewEvaluator()
+
+	tests := []struct {
+		name       string
+		expression string
+		valid      bool
+	}{
+		{
+			name:       "email domain",
+			expression: `user.email.endsWith("@example.com")`,
+			valid:      true,
+		},
+		{
+			name:       "business hours",
+			expression: `now.getHours("Europe/Moscow") >= 9 && now.getHours("Europe/Moscow") < 18`,
+			valid:      true,
+		},
+		{
+			name:       "role and metadata",
+			expression: `"MODERATOR" in principal.roles && request.metadata["x-tenant"] == "acme"`,
+			valid:      true,
+		},
+		{
+			name:       "syntax error",
+			expression: `user.email.endsWith(`,
+		},
+		{
+			name:       "unknown variable",
+			expression: `account.email == "admin@example.com"`,
+		},
+		{
+			name:       "not a bool",
+			expression: `now.getHours()`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			issues := evaluator.Validate(tt.expression)
+			if tt.valid {
+				require.Empty(t, issues)
+			} else {
+				require.NotEmpty(t, issues)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		evaluator = policy.NewEvaluator()
+		noon      = time.Date(2024, 11, 11, 12, 0, 0, 0, time.UTC)
+		loads     int
+		vars      = &policy.Vars{
+			Principal: map[string]any{"username": "alice", "roles": []string{"USER", "MODERATOR"}},
+			User: func() map[string]any {
+				loads++
+				return map[string]any{"email": "alice@example.com"}
+			},
+			Request: map[string]any{"endpoint": "/chat_v1.ChatV1/Create", "ip": "10.0.0.7", "metadata": map[string]string{}},
+			Now:     noon,
+		}
+	)
+
+	tests := []struct {
+		name       string
+		expression string
+		holds      bool
+		err        bool
+	}{
+		{
+			name:       "principal",
+			expression: `"MODERATOR" in principal.roles`,
+			holds:      true,
+		},
+		{
+			name:       "user record",
+			expression: `user.email.endsWith("@example.com")`,
+			holds:      true,
+		},
+		{
+			name:       "time",
+			expression: `now.getHours() >= 18`,
+			holds:      false,
+		},
+		{
+			name:       "request",
+			expression: `request.ip.startsWith("10.") && request.endpoint.startsWith("/chat_v1.")`,
+			holds:      true,
+		},
+		{
+			name:       "missing attribute",
+			expression: `request.metadata["x-tenant"] == "acme"`,
+			err:        true,
+		},
+		{
+			name:       "invalid condition",
+			expression: `principal.username ==`,
+			err:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		holds, err := evaluator.Evaluate(tt.expression, vars)
+		if tt.err {
+			require.Error(t, err, tt.name)
+			continue
+		}
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.holds, holds, tt.name)
+	}
+
+	// the user record is loaded only by the condition referring to it
+	require.Equal(t, 1, loads)
+}
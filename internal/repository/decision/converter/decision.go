@@ -0,0 +1,35 @@
+package converter
+
+import (
+	"time"
+
+	modelRepo "github.com/mikhailsoldatkin/auth/internal/repository/decision/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// FromRepoToService converter from Postgres repository DecisionEvent model to service DecisionEvent model.
+func FromRepoToService(event *modelRepo.DecisionEvent) *model.DecisionEvent {
+	return &model.DecisionEvent{
+		ID:           event.ID,
+		UserID:       event.UserID.Int64,
+		Username:     event.Username,
+		Actor:        event.Actor,
+		Endpoint:     event.Endpoint,
+		Decision:     event.Decision,
+		PermissionID: event.PermissionID.Int64,
+		MatchedRule:  event.MatchedRule,
+		Reason:       event.Reason,
+		Latency:      time.Duration(event.LatencyUs) * time.Microsecond,
+		RequestID:    event.RequestID,
+		CreatedAt:    event.CreatedAt,
+	}
+}
+
+// FromRepoToServiceList converts list of Postgres repository DecisionEvent models to list of service DecisionEvent models.
+func FromRepoToServiceList(events []*modelRepo.DecisionEvent) []*model.DecisionEvent {
+	serviceEvents := make([]*model.DecisionEvent, len(events))
+	for i, event := range events {
+		serviceEvents[i] = FromRepoToService(event)
+	}
+	return serviceEvents
+}
@@ -0,0 +1,13 @@
+package filter
+
+import "time"
+
+// DecisionFilter is used to narrow down the list of access decisions, empty fields are ignored.
+// Decisions are matched by the [From, To) range of their creation time, at most Limit are returned.
+type DecisionFilter struct {
+	UserID   int64
+	Endpoint string
+	From     time.Time
+	To       time.Time
+	Limit    uint64
+}
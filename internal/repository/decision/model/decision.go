@@ -0,0 +1,22 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// DecisionEvent represents an access decision entity in the Postgres database.
+type DecisionEvent struct {
+	ID           int64         `db:"id"`
+	UserID       sql.NullInt64 `db:"user_id"`
+	Username     string        `db:"username"`
+	Actor        string        `db:"actor"`
+	Endpoint     string        `db:"endpoint"`
+	Decision     string        `db:"decision"`
+	PermissionID sql.NullInt64 `db:"permission_id"`
+	MatchedRule  string        `db:"matched_rule"`
+	Reason       string        `db:"reason"`
+	LatencyUs    int64         `db:"latency_us"`
+	RequestID    string        `db:"request_id"`
+	CreatedAt    time.Time     `db:"created_at"`
+}
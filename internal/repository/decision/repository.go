@@ -0,0 +1,147 @@
+package decision
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	"github.com/mikhailsoldatkin/auth/internal/repository/decision/converter"
+	"github.com/mikhailsoldatkin/auth/internal/repository/decision/filter"
+	repoModel "github.com/mikhailsoldatkin/auth/internal/repository/decision/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+const (
+	tableAccessDecisions = "access_decisions"
+	columnID             = "id"
+	columnUserID         = "user_id"
+	columnUsername       = "username"
+	columnActor          = "actor"
+	columnEndpoint       = "endpoint"
+	columnDecision       = "decision"
+	columnPermissionID   = "permission_id"
+	columnMatchedRule    = "matched_rule"
+	columnReason         = "reason"
+	columnLatencyUs      = "latency_us"
+	columnRequestID      = "request_id"
+	columnCreatedAt      = "created_at"
+)
+
+var _ repository.DecisionRepository = (*repo)(nil)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of the access decision repository.
+func NewRepository(db db.Client) repository.DecisionRepository {
+	return &repo{db: db}
+}
+
+// CreateBatch inserts access decisions into the database with a single statement.
+func (r *repo) CreateBatch(ctx context.Context, events []*model.DecisionEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	builder := sq.Insert(tableAccessDecisions).
+		PlaceholderFormat(sq.Dollar).
+		Columns(
+			columnUserID,
+			columnUsername,
+			columnActor,
+			columnEndpoint,
+			columnDecision,
+			columnPermissionID,
+			columnMatchedRule,
+			columnReason,
+			columnLatencyUs,
+			columnRequestID,
+			columnCreatedAt,
+		)
+
+	for _, event := range events {
+		builder = builder.Values(
+			sql.NullInt64{Int64: event.UserID, Valid: event.UserID != 0},
+			event.Username,
+			event.Actor,
+			event.Endpoint,
+			event.Decision,
+			sql.NullInt64{Int64: event.PermissionID, Valid: event.PermissionID != 0},
+			event.MatchedRule,
+			event.Reason,
+			event.Latency.Microseconds(),
+			event.RequestID,
+			event.CreatedAt,
+		)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "decision_repository.CreateBatch",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+// List retrieves access decisions matching the filter, the most recent first.
+func (r *repo) List(ctx context.Context, filter filter.DecisionFilter) ([]*model.DecisionEvent, error) {
+	builder := sq.Select(
+		columnID,
+		columnUserID,
+		columnUsername,
+		columnActor,
+		columnEndpoint,
+		columnDecision,
+		columnPermissionID,
+		columnMatchedRule,
+		columnReason,
+		columnLatencyUs,
+		columnRequestID,
+		columnCreatedAt,
+	).
+		From(tableAccessDecisions).
+		OrderBy(columnCreatedAt+" DESC", columnID+" DESC").
+		Limit(filter.Limit).
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.UserID != 0 {
+		builder = builder.Where(sq.Eq{columnUserID: filter.UserID})
+	}
+	if filter.Endpoint != "" {
+		builder = builder.Where(sq.Eq{columnEndpoint: filter.Endpoint})
+	}
+	if !filter.From.IsZero() {
+		builder = builder.Where(sq.GtOrEq{columnCreatedAt: filter.From})
+	}
+	if !filter.To.IsZero() {
+		builder = builder.Where(sq.Lt{columnCreatedAt: filter.To})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "decision_repository.List",
+		QueryRaw: query,
+	}
+
+	var events []*repoModel.DecisionEvent
+	err = r.db.DB().ScanAllContext(ctx, &events, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepoToServiceList(events), nil
+}
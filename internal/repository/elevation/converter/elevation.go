@@ -0,0 +1,23 @@
+package converter
+
+import (
+	"time"
+
+	modelRepo "github.com/mikhailsoldatkin/auth/internal/repository/elevation/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// FromRepoToService converter from Postgres repository ElevationRequest model to service ElevationRequest model.
+func FromRepoToService(request *modelRepo.ElevationRequest) *model.ElevationRequest {
+	return &model.ElevationRequest{
+		ID:         request.ID,
+		UserID:     request.UserID,
+		Role:       request.Role,
+		Reason:     request.Reason,
+		Duration:   time.Duration(request.DurationMin) * time.Minute,
+		Status:     request.Status,
+		ApprovedBy: request.ApprovedBy.Int64,
+		CreatedAt:  request.CreatedAt,
+		ApprovedAt: request.ApprovedAt.Time,
+	}
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// ElevationRequest represents an elevation request entity in the Postgres database.
+type ElevationRequest struct {
+	ID          int64         `db:"id"`
+	UserID      int64         `db:"user_id"`
+	Role        string        `db:"role"`
+	Reason      string        `db:"reason"`
+	DurationMin int           `db:"duration_min"`
+	Status      string        `db:"status"`
+	ApprovedBy  sql.NullInt64 `db:"approved_by"`
+	CreatedAt   time.Time     `db:"created_at"`
+	ApprovedAt  sql.NullTime  `db:"approved_at"`
+}
@@ -0,0 +1,147 @@
+package elevation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	"github.com/mikhailsoldatkin/auth/internal/repository/elevation/converter"
+	repoModel "github.com/mikhailsoldatkin/auth/internal/repository/elevation/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+const (
+	tableElevationRequests = "elevation_requests"
+	columnID               = "id"
+	columnUserID           = "user_id"
+	columnRole             = "role"
+	columnReason           = "reason"
+	columnDurationMin      = "duration_min"
+	columnStatus           = "status"
+	columnApprovedBy       = "approved_by"
+	columnCreatedAt        = "created_at"
+	columnApprovedAt       = "approved_at"
+	elevationEntity        = "elevation request"
+)
+
+var _ repository.ElevationRepository = (*repo)(nil)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of the elevation request repository.
+func NewRepository(db db.Client) repository.ElevationRepository {
+	return &repo{db: db}
+}
+
+// Create inserts a new pending elevation request into the database.
+func (r *repo) Create(ctx context.Context, request *model.ElevationRequest) (int64, error) {
+	builder := sq.Insert(tableElevationRequests).
+		PlaceholderFormat(sq.Dollar).
+		Columns(columnUserID, columnRole, columnReason, columnDurationMin, columnStatus, columnCreatedAt).
+		Values(
+			request.UserID,
+			request.Role,
+			request.Reason,
+			int(request.Duration/time.Minute),
+			model.ElevationPending,
+			time.Now(),
+		).
+		Suffix("RETURNING id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "elevation_repository.Create",
+		QueryRaw: query,
+	}
+
+	var id int64
+	err = r.db.DB().ScanOneContext(ctx, &id, q, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Get retrieves an elevation request by its ID.
+func (r *repo) Get(ctx context.Context, id int64) (*model.ElevationRequest, error) {
+	builder := sq.Select(
+		columnID,
+		columnUserID,
+		columnRole,
+		columnReason,
+		columnDurationMin,
+		columnStatus,
+		columnApprovedBy,
+		columnCreatedAt,
+		columnApprovedAt,
+	).
+		From(tableElevationRequests).
+		Where(sq.Eq{columnID: id}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "elevation_repository.Get",
+		QueryRaw: query,
+	}
+
+	var request repoModel.ElevationRequest
+	err = r.db.DB().ScanOneContext(ctx, &request, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, customerrors.NewErrNotFound(elevationEntity, id)
+		}
+		return nil, err
+	}
+
+	return converter.FromRepoToService(&request), nil
+}
+
+// Approve marks the pending elevation request as approved by the approver.
+// It returns ErrInvalidArgument if the request has already been approved.
+func (r *repo) Approve(ctx context.Context, id, approverID int64, approvedAt time.Time) error {
+	builder := sq.Update(tableElevationRequests).
+		Set(columnStatus, model.ElevationApproved).
+		Set(columnApprovedBy, approverID).
+		Set(columnApprovedAt, approvedAt).
+		Where(sq.Eq{columnID: id, columnStatus: model.ElevationPending}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "elevation_repository.Approve",
+		QueryRaw: query,
+	}
+
+	result, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return customerrors.NewErrInvalidArgument("elevation request is not pending")
+	}
+
+	return nil
+}
@@ -2,3 +2,13 @@ package repository
 
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate minimock -i UserRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i APITokenRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i LogRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i IdentityRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PermissionRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RoleRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i GroupRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PermissionCache -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RelationRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i ElevationRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i DecisionRepository -o ./mocks/ -s "_minimock.go"
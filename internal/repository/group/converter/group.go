@@ -0,0 +1,25 @@
+package converter
+
+import (
+	modelRepo "github.com/mikhailsoldatkin/auth/internal/repository/group/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// FromRepoToService converter from Postgres repository Group model to service Group model.
+func FromRepoToService(group *modelRepo.Group) *model.Group {
+	return &model.Group{
+		ID:          group.ID,
+		Name:        group.Name,
+		Description: group.Description,
+		CreatedAt:   group.CreatedAt,
+	}
+}
+
+// FromRepoToServiceList converts list of Postgres repository Group models to list of service Group models.
+func FromRepoToServiceList(groups []*modelRepo.Group) []*model.Group {
+	serviceGroups := make([]*model.Group, len(groups))
+	for i, group := range groups {
+		serviceGroups[i] = FromRepoToService(group)
+	}
+	return serviceGroups
+}
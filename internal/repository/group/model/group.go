@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// Group represents a user group entity in the Postgres database.
+type Group struct {
+	ID          int64     `db:"id"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	CreatedAt   time.Time `db:"created_at"`
+}
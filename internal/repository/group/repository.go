@@ -0,0 +1,294 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	"github.com/mikhailsoldatkin/auth/internal/repository/group/converter"
+	repoModel "github.com/mikhailsoldatkin/auth/internal/repository/group/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+const (
+	tableGroups       = "groups"
+	tableGroupMembers = "group_members"
+	tableGroupRoles   = "group_roles"
+	columnID          = "id"
+	columnName        = "name"
+	columnDescription = "description"
+	columnCreatedAt   = "created_at"
+	columnGroupID     = "group_id"
+	columnUserID      = "user_id"
+	columnRole        = "role"
+	groupEntity       = "group"
+	memberEntity      = "group member"
+	groupRoleEntity   = "group role"
+)
+
+var _ repository.GroupRepository = (*repo)(nil)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of the user group repository.
+func NewRepository(db db.Client) repository.GroupRepository {
+	return &repo{db: db}
+}
+
+// Create inserts a new user group into the database.
+// It returns ErrInvalidArgument if a group with the same name already exists.
+func (r *repo) Create(ctx context.Context, group *model.Group) (int64, error) {
+	builder := sq.Insert(tableGroups).
+		PlaceholderFormat(sq.Dollar).
+		Columns(columnName, columnDescription, columnCreatedAt).
+		Values(group.Name, group.Description, time.Now()).
+		Suffix("ON CONFLICT (name) DO NOTHING RETURNING id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "group_repository.Create",
+		QueryRaw: query,
+	}
+
+	var id int64
+	err = r.db.DB().ScanOneContext(ctx, &id, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, customerrors.NewErrInvalidArgument("group already exists")
+		}
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Get retrieves a user group by its ID.
+func (r *repo) Get(ctx context.Context, id int64) (*model.Group, error) {
+	builder := sq.Select(columnID, columnName, columnDescription, columnCreatedAt).
+		From(tableGroups).
+		Where(sq.Eq{columnID: id}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "group_repository.Get",
+		QueryRaw: query,
+	}
+
+	var group repoModel.Group
+	err = r.db.DB().ScanOneContext(ctx, &group, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, customerrors.NewErrNotFound(groupEntity, id)
+		}
+		return nil, err
+	}
+
+	return converter.FromRepoToService(&group), nil
+}
+
+// List retrieves all user groups ordered by name.
+func (r *repo) List(ctx context.Context) ([]*model.Group, error) {
+	builder := sq.Select(columnID, columnName, columnDescription, columnCreatedAt).
+		From(tableGroups).
+		OrderBy(columnName).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "group_repository.List",
+		QueryRaw: query,
+	}
+
+	var groups []*repoModel.Group
+	err = r.db.DB().ScanAllContext(ctx, &groups, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepoToServiceList(groups), nil
+}
+
+// Delete removes a user group together with its memberships and role assignments.
+func (r *repo) Delete(ctx context.Context, id int64) error {
+	builder := sq.Delete(tableGroups).
+		Where(sq.Eq{columnID: id}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "group_repository.Delete",
+		QueryRaw: query,
+	}
+
+	result, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return customerrors.NewErrNotFound(groupEntity, id)
+	}
+
+	return nil
+}
+
+// AddMember adds the user to the group, adding a member twice is a no-op.
+func (r *repo) AddMember(ctx context.Context, groupID, userID int64) error {
+	builder := sq.Insert(tableGroupMembers).
+		PlaceholderFormat(sq.Dollar).
+		Columns(columnGroupID, columnUserID).
+		Values(groupID, userID).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "group_repository.AddMember",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RemoveMember removes the user from the group.
+func (r *repo) RemoveMember(ctx context.Context, groupID, userID int64) error {
+	builder := sq.Delete(tableGroupMembers).
+		Where(sq.Eq{columnGroupID: groupID, columnUserID: userID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "group_repository.RemoveMember",
+		QueryRaw: query,
+	}
+
+	result, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return customerrors.NewErrNotFound(memberEntity, userID)
+	}
+
+	return nil
+}
+
+// AssignRole assigns the role to all members of the group, assigning it twice is a no-op.
+func (r *repo) AssignRole(ctx context.Context, groupID int64, role string) error {
+	builder := sq.Insert(tableGroupRoles).
+		PlaceholderFormat(sq.Dollar).
+		Columns(columnGroupID, columnRole).
+		Values(groupID, role).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "group_repository.AssignRole",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RevokeRole removes the role assigned to the group.
+func (r *repo) RevokeRole(ctx context.Context, groupID int64, role string) error {
+	builder := sq.Delete(tableGroupRoles).
+		Where(sq.Eq{columnGroupID: groupID, columnRole: role}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "group_repository.RevokeRole",
+		QueryRaw: query,
+	}
+
+	result, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return customerrors.NewErrNotFound(groupRoleEntity, role)
+	}
+
+	return nil
+}
+
+// HasMember reports whether the user is a member of the group.
+func (r *repo) HasMember(ctx context.Context, groupID, userID int64) (bool, error) {
+	builder := sq.Select("1").
+		Prefix("SELECT EXISTS (").
+		From(tableGroupMembers).
+		Where(sq.Eq{columnGroupID: groupID, columnUserID: userID}).
+		Suffix(")").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "group_repository.HasMember",
+		QueryRaw: query,
+	}
+
+	var exists bool
+	err = r.db.DB().ScanOneContext(ctx, &exists, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,16 @@
+package converter
+
+import (
+	modelRepo "github.com/mikhailsoldatkin/auth/internal/repository/identity/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// FromRepoToService converter from Postgres repository FederatedIdentity model to service FederatedIdentity model.
+func FromRepoToService(identity *modelRepo.FederatedIdentity) *model.FederatedIdentity {
+	return &model.FederatedIdentity{
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		UserID:    identity.UserID,
+		CreatedAt: identity.CreatedAt,
+	}
+}
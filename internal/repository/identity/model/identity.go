@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// FederatedIdentity represents an account link entity in the Postgres database.
+type FederatedIdentity struct {
+	Provider  string    `db:"provider"`
+	Subject   string    `db:"subject"`
+	UserID    int64     `db:"user_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,90 @@
+package identity
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	"github.com/mikhailsoldatkin/auth/internal/repository/identity/converter"
+	repoModel "github.com/mikhailsoldatkin/auth/internal/repository/identity/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+const (
+	tableFederatedIdentities = "federated_identities"
+	columnProvider           = "provider"
+	columnSubject            = "subject"
+	columnUserID             = "user_id"
+	columnCreatedAt          = "created_at"
+	identityEntity           = "federated identity"
+)
+
+var _ repository.IdentityRepository = (*repo)(nil)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of the federated identity repository.
+func NewRepository(db db.Client) repository.IdentityRepository {
+	return &repo{db: db}
+}
+
+// Create links the upstream provider subject to the user.
+func (r *repo) Create(ctx context.Context, identity *model.FederatedIdentity) error {
+	builder := sq.Insert(tableFederatedIdentities).
+		PlaceholderFormat(sq.Dollar).
+		Columns(columnProvider, columnSubject, columnUserID).
+		Values(identity.Provider, identity.Subject, identity.UserID)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "identity_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Get retrieves the account link of the upstream provider subject.
+func (r *repo) Get(ctx context.Context, provider, subject string) (*model.FederatedIdentity, error) {
+	builder := sq.Select(columnProvider, columnSubject, columnUserID, columnCreatedAt).
+		From(tableFederatedIdentities).
+		Where(sq.Eq{columnProvider: provider, columnSubject: subject}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "identity_repository.Get",
+		QueryRaw: query,
+	}
+
+	var identity repoModel.FederatedIdentity
+	err = r.db.DB().ScanOneContext(ctx, &identity, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, customerrors.NewErrNotFound(identityEntity, subject)
+		}
+		return nil, err
+	}
+
+	return converter.FromRepoToService(&identity), nil
+}
@@ -0,0 +1,1782 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/auth/internal/repository.APITokenRepository -o api_token_repository_minimock.go -n APITokenRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// APITokenRepositoryMock implements repository.APITokenRepository
+type APITokenRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, token *model.APIToken) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, token *model.APIToken)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mAPITokenRepositoryMockCreate
+
+	funcGetByPrefix          func(ctx context.Context, prefix string) (ap1 *model.APIToken, err error)
+	inspectFuncGetByPrefix   func(ctx context.Context, prefix string)
+	afterGetByPrefixCounter  uint64
+	beforeGetByPrefixCounter uint64
+	GetByPrefixMock          mAPITokenRepositoryMockGetByPrefix
+
+	funcList          func(ctx context.Context, userID int64) (apa1 []*model.APIToken, err error)
+	inspectFuncList   func(ctx context.Context, userID int64)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mAPITokenRepositoryMockList
+
+	funcRevoke          func(ctx context.Context, id int64, userID int64) (err error)
+	inspectFuncRevoke   func(ctx context.Context, id int64, userID int64)
+	afterRevokeCounter  uint64
+	beforeRevokeCounter uint64
+	RevokeMock          mAPITokenRepositoryMockRevoke
+
+	funcUpdateLastUsed          func(ctx context.Context, id int64, usedAt time.Time) (err error)
+	inspectFuncUpdateLastUsed   func(ctx context.Context, id int64, usedAt time.Time)
+	afterUpdateLastUsedCounter  uint64
+	beforeUpdateLastUsedCounter uint64
+	UpdateLastUsedMock          mAPITokenRepositoryMockUpdateLastUsed
+}
+
+// NewAPITokenRepositoryMock returns a mock for repository.APITokenRepository
+func NewAPITokenRepositoryMock(t minimock.Tester) *APITokenRepositoryMock {
+	m := &APITokenRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mAPITokenRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*APITokenRepositoryMockCreateParams{}
+
+	m.GetByPrefixMock = mAPITokenRepositoryMockGetByPrefix{mock: m}
+	m.GetByPrefixMock.callArgs = []*APITokenRepositoryMockGetByPrefixParams{}
+
+	m.ListMock = mAPITokenRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*APITokenRepositoryMockListParams{}
+
+	m.RevokeMock = mAPITokenRepositoryMockRevoke{mock: m}
+	m.RevokeMock.callArgs = []*APITokenRepositoryMockRevokeParams{}
+
+	m.UpdateLastUsedMock = mAPITokenRepositoryMockUpdateLastUsed{mock: m}
+	m.UpdateLastUsedMock.callArgs = []*APITokenRepositoryMockUpdateLastUsedParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAPITokenRepositoryMockCreate struct {
+	optional           bool
+	mock               *APITokenRepositoryMock
+	defaultExpectation *APITokenRepositoryMockCreateExpectation
+	expectations       []*APITokenRepositoryMockCreateExpectation
+
+	callArgs []*APITokenRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APITokenRepositoryMockCreateExpectation specifies expectation struct of the APITokenRepository.Create
+type APITokenRepositoryMockCreateExpectation struct {
+	mock      *APITokenRepositoryMock
+	params    *APITokenRepositoryMockCreateParams
+	paramPtrs *APITokenRepositoryMockCreateParamPtrs
+	results   *APITokenRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// APITokenRepositoryMockCreateParams contains parameters of the APITokenRepository.Create
+type APITokenRepositoryMockCreateParams struct {
+	ctx   context.Context
+	token *model.APIToken
+}
+
+// APITokenRepositoryMockCreateParamPtrs contains pointers to parameters of the APITokenRepository.Create
+type APITokenRepositoryMockCreateParamPtrs struct {
+	ctx   *context.Context
+	token **model.APIToken
+}
+
+// APITokenRepositoryMockCreateResults contains results of the APITokenRepository.Create
+type APITokenRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mAPITokenRepositoryMockCreate) Optional() *mAPITokenRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for APITokenRepository.Create
+func (mmCreate *mAPITokenRepositoryMockCreate) Expect(ctx context.Context, token *model.APIToken) *mAPITokenRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("APITokenRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &APITokenRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("APITokenRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &APITokenRepositoryMockCreateParams{ctx, token}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APITokenRepository.Create
+func (mmCreate *mAPITokenRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mAPITokenRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("APITokenRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &APITokenRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("APITokenRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &APITokenRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectTokenParam2 sets up expected param token for APITokenRepository.Create
+func (mmCreate *mAPITokenRepositoryMockCreate) ExpectTokenParam2(token *model.APIToken) *mAPITokenRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("APITokenRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &APITokenRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("APITokenRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &APITokenRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.token = &token
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the APITokenRepository.Create
+func (mmCreate *mAPITokenRepositoryMockCreate) Inspect(f func(ctx context.Context, token *model.APIToken)) *mAPITokenRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for APITokenRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by APITokenRepository.Create
+func (mmCreate *mAPITokenRepositoryMockCreate) Return(i1 int64, err error) *APITokenRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("APITokenRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &APITokenRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &APITokenRepositoryMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the APITokenRepository.Create method
+func (mmCreate *mAPITokenRepositoryMockCreate) Set(f func(ctx context.Context, token *model.APIToken) (i1 int64, err error)) *APITokenRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the APITokenRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the APITokenRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the APITokenRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mAPITokenRepositoryMockCreate) When(ctx context.Context, token *model.APIToken) *APITokenRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("APITokenRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &APITokenRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &APITokenRepositoryMockCreateParams{ctx, token},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APITokenRepository.Create return parameters for the expectation previously defined by the When method
+func (e *APITokenRepositoryMockCreateExpectation) Then(i1 int64, err error) *APITokenRepositoryMock {
+	e.results = &APITokenRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times APITokenRepository.Create should be invoked
+func (mmCreate *mAPITokenRepositoryMockCreate) Times(n uint64) *mAPITokenRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of APITokenRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	return mmCreate
+}
+
+func (mmCreate *mAPITokenRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements repository.APITokenRepository
+func (mmCreate *APITokenRepositoryMock) Create(ctx context.Context, token *model.APIToken) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, token)
+	}
+
+	mm_params := APITokenRepositoryMockCreateParams{ctx, token}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := APITokenRepositoryMockCreateParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("APITokenRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmCreate.t.Errorf("APITokenRepositoryMock.Create got unexpected parameter token, want: %#v, got: %#v%s\n", *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("APITokenRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the APITokenRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, token)
+	}
+	mmCreate.t.Fatalf("Unexpected call to APITokenRepositoryMock.Create. %v %v", ctx, token)
+	return
+}
+
+// CreateAfterCounter returns a count of finished APITokenRepositoryMock.Create invocations
+func (mmCreate *APITokenRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of APITokenRepositoryMock.Create invocations
+func (mmCreate *APITokenRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to APITokenRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mAPITokenRepositoryMockCreate) Calls() []*APITokenRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*APITokenRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *APITokenRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *APITokenRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APITokenRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APITokenRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to APITokenRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Error("Expected call to APITokenRepositoryMock.Create")
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to APITokenRepositoryMock.Create but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), afterCreateCounter)
+	}
+}
+
+type mAPITokenRepositoryMockGetByPrefix struct {
+	optional           bool
+	mock               *APITokenRepositoryMock
+	defaultExpectation *APITokenRepositoryMockGetByPrefixExpectation
+	expectations       []*APITokenRepositoryMockGetByPrefixExpectation
+
+	callArgs []*APITokenRepositoryMockGetByPrefixParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APITokenRepositoryMockGetByPrefixExpectation specifies expectation struct of the APITokenRepository.GetByPrefix
+type APITokenRepositoryMockGetByPrefixExpectation struct {
+	mock      *APITokenRepositoryMock
+	params    *APITokenRepositoryMockGetByPrefixParams
+	paramPtrs *APITokenRepositoryMockGetByPrefixParamPtrs
+	results   *APITokenRepositoryMockGetByPrefixResults
+	Counter   uint64
+}
+
+// APITokenRepositoryMockGetByPrefixParams contains parameters of the APITokenRepository.GetByPrefix
+type APITokenRepositoryMockGetByPrefixParams struct {
+	ctx    context.Context
+	prefix string
+}
+
+// APITokenRepositoryMockGetByPrefixParamPtrs contains pointers to parameters of the APITokenRepository.GetByPrefix
+type APITokenRepositoryMockGetByPrefixParamPtrs struct {
+	ctx    *context.Context
+	prefix *string
+}
+
+// APITokenRepositoryMockGetByPrefixResults contains results of the APITokenRepository.GetByPrefix
+type APITokenRepositoryMockGetByPrefixResults struct {
+	ap1 *model.APIToken
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetByPrefix *mAPITokenRepositoryMockGetByPrefix) Optional() *mAPITokenRepositoryMockGetByPrefix {
+	mmGetByPrefix.optional = true
+	return mmGetByPrefix
+}
+
+// Expect sets up expected params for APITokenRepository.GetByPrefix
+func (mmGetByPrefix *mAPITokenRepositoryMockGetByPrefix) Expect(ctx context.Context, prefix string) *mAPITokenRepositoryMockGetByPrefix {
+	if mmGetByPrefix.mock.funcGetByPrefix != nil {
+		mmGetByPrefix.mock.t.Fatalf("APITokenRepositoryMock.GetByPrefix mock is already set by Set")
+	}
+
+	if mmGetByPrefix.defaultExpectation == nil {
+		mmGetByPrefix.defaultExpectation = &APITokenRepositoryMockGetByPrefixExpectation{}
+	}
+
+	if mmGetByPrefix.defaultExpectation.paramPtrs != nil {
+		mmGetByPrefix.mock.t.Fatalf("APITokenRepositoryMock.GetByPrefix mock is already set by ExpectParams functions")
+	}
+
+	mmGetByPrefix.defaultExpectation.params = &APITokenRepositoryMockGetByPrefixParams{ctx, prefix}
+	for _, e := range mmGetByPrefix.expectations {
+		if minimock.Equal(e.params, mmGetByPrefix.defaultExpectation.params) {
+			mmGetByPrefix.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetByPrefix.defaultExpectation.params)
+		}
+	}
+
+	return mmGetByPrefix
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APITokenRepository.GetByPrefix
+func (mmGetByPrefix *mAPITokenRepositoryMockGetByPrefix) ExpectCtxParam1(ctx context.Context) *mAPITokenRepositoryMockGetByPrefix {
+	if mmGetByPrefix.mock.funcGetByPrefix != nil {
+		mmGetByPrefix.mock.t.Fatalf("APITokenRepositoryMock.GetByPrefix mock is already set by Set")
+	}
+
+	if mmGetByPrefix.defaultExpectation == nil {
+		mmGetByPrefix.defaultExpectation = &APITokenRepositoryMockGetByPrefixExpectation{}
+	}
+
+	if mmGetByPrefix.defaultExpectation.params != nil {
+		mmGetByPrefix.mock.t.Fatalf("APITokenRepositoryMock.GetByPrefix mock is already set by Expect")
+	}
+
+	if mmGetByPrefix.defaultExpectation.paramPtrs == nil {
+		mmGetByPrefix.defaultExpectation.paramPtrs = &APITokenRepositoryMockGetByPrefixParamPtrs{}
+	}
+	mmGetByPrefix.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetByPrefix
+}
+
+// ExpectPrefixParam2 sets up expected param prefix for APITokenRepository.GetByPrefix
+func (mmGetByPrefix *mAPITokenRepositoryMockGetByPrefix) ExpectPrefixParam2(prefix string) *mAPITokenRepositoryMockGetByPrefix {
+	if mmGetByPrefix.mock.funcGetByPrefix != nil {
+		mmGetByPrefix.mock.t.Fatalf("APITokenRepositoryMock.GetByPrefix mock is already set by Set")
+	}
+
+	if mmGetByPrefix.defaultExpectation == nil {
+		mmGetByPrefix.defaultExpectation = &APITokenRepositoryMockGetByPrefixExpectation{}
+	}
+
+	if mmGetByPrefix.defaultExpectation.params != nil {
+		mmGetByPrefix.mock.t.Fatalf("APITokenRepositoryMock.GetByPrefix mock is already set by Expect")
+	}
+
+	if mmGetByPrefix.defaultExpectation.paramPtrs == nil {
+		mmGetByPrefix.defaultExpectation.paramPtrs = &APITokenRepositoryMockGetByPrefixParamPtrs{}
+	}
+	mmGetByPrefix.defaultExpectation.paramPtrs.prefix = &prefix
+
+	return mmGetByPrefix
+}
+
+// Inspect accepts an inspector function that has same arguments as the APITokenRepository.GetByPrefix
+func (mmGetByPrefix *mAPITokenRepositoryMockGetByPrefix) Inspect(f func(ctx context.Context, prefix string)) *mAPITokenRepositoryMockGetByPrefix {
+	if mmGetByPrefix.mock.inspectFuncGetByPrefix != nil {
+		mmGetByPrefix.mock.t.Fatalf("Inspect function is already set for APITokenRepositoryMock.GetByPrefix")
+	}
+
+	mmGetByPrefix.mock.inspectFuncGetByPrefix = f
+
+	return mmGetByPrefix
+}
+
+// Return sets up results that will be returned by APITokenRepository.GetByPrefix
+func (mmGetByPrefix *mAPITokenRepositoryMockGetByPrefix) Return(ap1 *model.APIToken, err error) *APITokenRepositoryMock {
+	if mmGetByPrefix.mock.funcGetByPrefix != nil {
+		mmGetByPrefix.mock.t.Fatalf("APITokenRepositoryMock.GetByPrefix mock is already set by Set")
+	}
+
+	if mmGetByPrefix.defaultExpectation == nil {
+		mmGetByPrefix.defaultExpectation = &APITokenRepositoryMockGetByPrefixExpectation{mock: mmGetByPrefix.mock}
+	}
+	mmGetByPrefix.defaultExpectation.results = &APITokenRepositoryMockGetByPrefixResults{ap1, err}
+	return mmGetByPrefix.mock
+}
+
+// Set uses given function f to mock the APITokenRepository.GetByPrefix method
+func (mmGetByPrefix *mAPITokenRepositoryMockGetByPrefix) Set(f func(ctx context.Context, prefix string) (ap1 *model.APIToken, err error)) *APITokenRepositoryMock {
+	if mmGetByPrefix.defaultExpectation != nil {
+		mmGetByPrefix.mock.t.Fatalf("Default expectation is already set for the APITokenRepository.GetByPrefix method")
+	}
+
+	if len(mmGetByPrefix.expectations) > 0 {
+		mmGetByPrefix.mock.t.Fatalf("Some expectations are already set for the APITokenRepository.GetByPrefix method")
+	}
+
+	mmGetByPrefix.mock.funcGetByPrefix = f
+	return mmGetByPrefix.mock
+}
+
+// When sets expectation for the APITokenRepository.GetByPrefix which will trigger the result defined by the following
+// Then helper
+func (mmGetByPrefix *mAPITokenRepositoryMockGetByPrefix) When(ctx context.Context, prefix string) *APITokenRepositoryMockGetByPrefixExpectation {
+	if mmGetByPrefix.mock.funcGetByPrefix != nil {
+		mmGetByPrefix.mock.t.Fatalf("APITokenRepositoryMock.GetByPrefix mock is already set by Set")
+	}
+
+	expectation := &APITokenRepositoryMockGetByPrefixExpectation{
+		mock:   mmGetByPrefix.mock,
+		params: &APITokenRepositoryMockGetByPrefixParams{ctx, prefix},
+	}
+	mmGetByPrefix.expectations = append(mmGetByPrefix.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APITokenRepository.GetByPrefix return parameters for the expectation previously defined by the When method
+func (e *APITokenRepositoryMockGetByPrefixExpectation) Then(ap1 *model.APIToken, err error) *APITokenRepositoryMock {
+	e.results = &APITokenRepositoryMockGetByPrefixResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times APITokenRepository.GetByPrefix should be invoked
+func (mmGetByPrefix *mAPITokenRepositoryMockGetByPrefix) Times(n uint64) *mAPITokenRepositoryMockGetByPrefix {
+	if n == 0 {
+		mmGetByPrefix.mock.t.Fatalf("Times of APITokenRepositoryMock.GetByPrefix mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetByPrefix.expectedInvocations, n)
+	return mmGetByPrefix
+}
+
+func (mmGetByPrefix *mAPITokenRepositoryMockGetByPrefix) invocationsDone() bool {
+	if len(mmGetByPrefix.expectations) == 0 && mmGetByPrefix.defaultExpectation == nil && mmGetByPrefix.mock.funcGetByPrefix == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetByPrefix.mock.afterGetByPrefixCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetByPrefix.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetByPrefix implements repository.APITokenRepository
+func (mmGetByPrefix *APITokenRepositoryMock) GetByPrefix(ctx context.Context, prefix string) (ap1 *model.APIToken, err error) {
+	mm_atomic.AddUint64(&mmGetByPrefix.beforeGetByPrefixCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetByPrefix.afterGetByPrefixCounter, 1)
+
+	if mmGetByPrefix.inspectFuncGetByPrefix != nil {
+		mmGetByPrefix.inspectFuncGetByPrefix(ctx, prefix)
+	}
+
+	mm_params := APITokenRepositoryMockGetByPrefixParams{ctx, prefix}
+
+	// Record call args
+	mmGetByPrefix.GetByPrefixMock.mutex.Lock()
+	mmGetByPrefix.GetByPrefixMock.callArgs = append(mmGetByPrefix.GetByPrefixMock.callArgs, &mm_params)
+	mmGetByPrefix.GetByPrefixMock.mutex.Unlock()
+
+	for _, e := range mmGetByPrefix.GetByPrefixMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmGetByPrefix.GetByPrefixMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetByPrefix.GetByPrefixMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetByPrefix.GetByPrefixMock.defaultExpectation.params
+		mm_want_ptrs := mmGetByPrefix.GetByPrefixMock.defaultExpectation.paramPtrs
+
+		mm_got := APITokenRepositoryMockGetByPrefixParams{ctx, prefix}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetByPrefix.t.Errorf("APITokenRepositoryMock.GetByPrefix got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.prefix != nil && !minimock.Equal(*mm_want_ptrs.prefix, mm_got.prefix) {
+				mmGetByPrefix.t.Errorf("APITokenRepositoryMock.GetByPrefix got unexpected parameter prefix, want: %#v, got: %#v%s\n", *mm_want_ptrs.prefix, mm_got.prefix, minimock.Diff(*mm_want_ptrs.prefix, mm_got.prefix))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetByPrefix.t.Errorf("APITokenRepositoryMock.GetByPrefix got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetByPrefix.GetByPrefixMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetByPrefix.t.Fatal("No results are set for the APITokenRepositoryMock.GetByPrefix")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmGetByPrefix.funcGetByPrefix != nil {
+		return mmGetByPrefix.funcGetByPrefix(ctx, prefix)
+	}
+	mmGetByPrefix.t.Fatalf("Unexpected call to APITokenRepositoryMock.GetByPrefix. %v %v", ctx, prefix)
+	return
+}
+
+// GetByPrefixAfterCounter returns a count of finished APITokenRepositoryMock.GetByPrefix invocations
+func (mmGetByPrefix *APITokenRepositoryMock) GetByPrefixAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByPrefix.afterGetByPrefixCounter)
+}
+
+// GetByPrefixBeforeCounter returns a count of APITokenRepositoryMock.GetByPrefix invocations
+func (mmGetByPrefix *APITokenRepositoryMock) GetByPrefixBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByPrefix.beforeGetByPrefixCounter)
+}
+
+// Calls returns a list of arguments used in each call to APITokenRepositoryMock.GetByPrefix.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetByPrefix *mAPITokenRepositoryMockGetByPrefix) Calls() []*APITokenRepositoryMockGetByPrefixParams {
+	mmGetByPrefix.mutex.RLock()
+
+	argCopy := make([]*APITokenRepositoryMockGetByPrefixParams, len(mmGetByPrefix.callArgs))
+	copy(argCopy, mmGetByPrefix.callArgs)
+
+	mmGetByPrefix.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetByPrefixDone returns true if the count of the GetByPrefix invocations corresponds
+// the number of defined expectations
+func (m *APITokenRepositoryMock) MinimockGetByPrefixDone() bool {
+	if m.GetByPrefixMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetByPrefixMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetByPrefixMock.invocationsDone()
+}
+
+// MinimockGetByPrefixInspect logs each unmet expectation
+func (m *APITokenRepositoryMock) MinimockGetByPrefixInspect() {
+	for _, e := range m.GetByPrefixMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APITokenRepositoryMock.GetByPrefix with params: %#v", *e.params)
+		}
+	}
+
+	afterGetByPrefixCounter := mm_atomic.LoadUint64(&m.afterGetByPrefixCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetByPrefixMock.defaultExpectation != nil && afterGetByPrefixCounter < 1 {
+		if m.GetByPrefixMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APITokenRepositoryMock.GetByPrefix")
+		} else {
+			m.t.Errorf("Expected call to APITokenRepositoryMock.GetByPrefix with params: %#v", *m.GetByPrefixMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetByPrefix != nil && afterGetByPrefixCounter < 1 {
+		m.t.Error("Expected call to APITokenRepositoryMock.GetByPrefix")
+	}
+
+	if !m.GetByPrefixMock.invocationsDone() && afterGetByPrefixCounter > 0 {
+		m.t.Errorf("Expected %d calls to APITokenRepositoryMock.GetByPrefix but found %d calls",
+			mm_atomic.LoadUint64(&m.GetByPrefixMock.expectedInvocations), afterGetByPrefixCounter)
+	}
+}
+
+type mAPITokenRepositoryMockList struct {
+	optional           bool
+	mock               *APITokenRepositoryMock
+	defaultExpectation *APITokenRepositoryMockListExpectation
+	expectations       []*APITokenRepositoryMockListExpectation
+
+	callArgs []*APITokenRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APITokenRepositoryMockListExpectation specifies expectation struct of the APITokenRepository.List
+type APITokenRepositoryMockListExpectation struct {
+	mock      *APITokenRepositoryMock
+	params    *APITokenRepositoryMockListParams
+	paramPtrs *APITokenRepositoryMockListParamPtrs
+	results   *APITokenRepositoryMockListResults
+	Counter   uint64
+}
+
+// APITokenRepositoryMockListParams contains parameters of the APITokenRepository.List
+type APITokenRepositoryMockListParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// APITokenRepositoryMockListParamPtrs contains pointers to parameters of the APITokenRepository.List
+type APITokenRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// APITokenRepositoryMockListResults contains results of the APITokenRepository.List
+type APITokenRepositoryMockListResults struct {
+	apa1 []*model.APIToken
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mAPITokenRepositoryMockList) Optional() *mAPITokenRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for APITokenRepository.List
+func (mmList *mAPITokenRepositoryMockList) Expect(ctx context.Context, userID int64) *mAPITokenRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("APITokenRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &APITokenRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("APITokenRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &APITokenRepositoryMockListParams{ctx, userID}
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APITokenRepository.List
+func (mmList *mAPITokenRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mAPITokenRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("APITokenRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &APITokenRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("APITokenRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &APITokenRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmList
+}
+
+// ExpectUserIDParam2 sets up expected param userID for APITokenRepository.List
+func (mmList *mAPITokenRepositoryMockList) ExpectUserIDParam2(userID int64) *mAPITokenRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("APITokenRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &APITokenRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("APITokenRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &APITokenRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the APITokenRepository.List
+func (mmList *mAPITokenRepositoryMockList) Inspect(f func(ctx context.Context, userID int64)) *mAPITokenRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for APITokenRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by APITokenRepository.List
+func (mmList *mAPITokenRepositoryMockList) Return(apa1 []*model.APIToken, err error) *APITokenRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("APITokenRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &APITokenRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &APITokenRepositoryMockListResults{apa1, err}
+	return mmList.mock
+}
+
+// Set uses given function f to mock the APITokenRepository.List method
+func (mmList *mAPITokenRepositoryMockList) Set(f func(ctx context.Context, userID int64) (apa1 []*model.APIToken, err error)) *APITokenRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the APITokenRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the APITokenRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	return mmList.mock
+}
+
+// When sets expectation for the APITokenRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mAPITokenRepositoryMockList) When(ctx context.Context, userID int64) *APITokenRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("APITokenRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &APITokenRepositoryMockListExpectation{
+		mock:   mmList.mock,
+		params: &APITokenRepositoryMockListParams{ctx, userID},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APITokenRepository.List return parameters for the expectation previously defined by the When method
+func (e *APITokenRepositoryMockListExpectation) Then(apa1 []*model.APIToken, err error) *APITokenRepositoryMock {
+	e.results = &APITokenRepositoryMockListResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times APITokenRepository.List should be invoked
+func (mmList *mAPITokenRepositoryMockList) Times(n uint64) *mAPITokenRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of APITokenRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	return mmList
+}
+
+func (mmList *mAPITokenRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements repository.APITokenRepository
+func (mmList *APITokenRepositoryMock) List(ctx context.Context, userID int64) (apa1 []*model.APIToken, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, userID)
+	}
+
+	mm_params := APITokenRepositoryMockListParams{ctx, userID}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := APITokenRepositoryMockListParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("APITokenRepositoryMock.List got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmList.t.Errorf("APITokenRepositoryMock.List got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("APITokenRepositoryMock.List got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the APITokenRepositoryMock.List")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, userID)
+	}
+	mmList.t.Fatalf("Unexpected call to APITokenRepositoryMock.List. %v %v", ctx, userID)
+	return
+}
+
+// ListAfterCounter returns a count of finished APITokenRepositoryMock.List invocations
+func (mmList *APITokenRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of APITokenRepositoryMock.List invocations
+func (mmList *APITokenRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to APITokenRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mAPITokenRepositoryMockList) Calls() []*APITokenRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*APITokenRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *APITokenRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *APITokenRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APITokenRepositoryMock.List with params: %#v", *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APITokenRepositoryMock.List")
+		} else {
+			m.t.Errorf("Expected call to APITokenRepositoryMock.List with params: %#v", *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Error("Expected call to APITokenRepositoryMock.List")
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to APITokenRepositoryMock.List but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), afterListCounter)
+	}
+}
+
+type mAPITokenRepositoryMockRevoke struct {
+	optional           bool
+	mock               *APITokenRepositoryMock
+	defaultExpectation *APITokenRepositoryMockRevokeExpectation
+	expectations       []*APITokenRepositoryMockRevokeExpectation
+
+	callArgs []*APITokenRepositoryMockRevokeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APITokenRepositoryMockRevokeExpectation specifies expectation struct of the APITokenRepository.Revoke
+type APITokenRepositoryMockRevokeExpectation struct {
+	mock      *APITokenRepositoryMock
+	params    *APITokenRepositoryMockRevokeParams
+	paramPtrs *APITokenRepositoryMockRevokeParamPtrs
+	results   *APITokenRepositoryMockRevokeResults
+	Counter   uint64
+}
+
+// APITokenRepositoryMockRevokeParams contains parameters of the APITokenRepository.Revoke
+type APITokenRepositoryMockRevokeParams struct {
+	ctx    context.Context
+	id     int64
+	userID int64
+}
+
+// APITokenRepositoryMockRevokeParamPtrs contains pointers to parameters of the APITokenRepository.Revoke
+type APITokenRepositoryMockRevokeParamPtrs struct {
+	ctx    *context.Context
+	id     *int64
+	userID *int64
+}
+
+// APITokenRepositoryMockRevokeResults contains results of the APITokenRepository.Revoke
+type APITokenRepositoryMockRevokeResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevoke *mAPITokenRepositoryMockRevoke) Optional() *mAPITokenRepositoryMockRevoke {
+	mmRevoke.optional = true
+	return mmRevoke
+}
+
+// Expect sets up expected params for APITokenRepository.Revoke
+func (mmRevoke *mAPITokenRepositoryMockRevoke) Expect(ctx context.Context, id int64, userID int64) *mAPITokenRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APITokenRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &APITokenRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs != nil {
+		mmRevoke.mock.t.Fatalf("APITokenRepositoryMock.Revoke mock is already set by ExpectParams functions")
+	}
+
+	mmRevoke.defaultExpectation.params = &APITokenRepositoryMockRevokeParams{ctx, id, userID}
+	for _, e := range mmRevoke.expectations {
+		if minimock.Equal(e.params, mmRevoke.defaultExpectation.params) {
+			mmRevoke.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevoke.defaultExpectation.params)
+		}
+	}
+
+	return mmRevoke
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APITokenRepository.Revoke
+func (mmRevoke *mAPITokenRepositoryMockRevoke) ExpectCtxParam1(ctx context.Context) *mAPITokenRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APITokenRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &APITokenRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("APITokenRepositoryMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &APITokenRepositoryMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevoke
+}
+
+// ExpectIdParam2 sets up expected param id for APITokenRepository.Revoke
+func (mmRevoke *mAPITokenRepositoryMockRevoke) ExpectIdParam2(id int64) *mAPITokenRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APITokenRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &APITokenRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("APITokenRepositoryMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &APITokenRepositoryMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.id = &id
+
+	return mmRevoke
+}
+
+// ExpectUserIDParam3 sets up expected param userID for APITokenRepository.Revoke
+func (mmRevoke *mAPITokenRepositoryMockRevoke) ExpectUserIDParam3(userID int64) *mAPITokenRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APITokenRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &APITokenRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("APITokenRepositoryMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &APITokenRepositoryMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmRevoke
+}
+
+// Inspect accepts an inspector function that has same arguments as the APITokenRepository.Revoke
+func (mmRevoke *mAPITokenRepositoryMockRevoke) Inspect(f func(ctx context.Context, id int64, userID int64)) *mAPITokenRepositoryMockRevoke {
+	if mmRevoke.mock.inspectFuncRevoke != nil {
+		mmRevoke.mock.t.Fatalf("Inspect function is already set for APITokenRepositoryMock.Revoke")
+	}
+
+	mmRevoke.mock.inspectFuncRevoke = f
+
+	return mmRevoke
+}
+
+// Return sets up results that will be returned by APITokenRepository.Revoke
+func (mmRevoke *mAPITokenRepositoryMockRevoke) Return(err error) *APITokenRepositoryMock {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APITokenRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &APITokenRepositoryMockRevokeExpectation{mock: mmRevoke.mock}
+	}
+	mmRevoke.defaultExpectation.results = &APITokenRepositoryMockRevokeResults{err}
+	return mmRevoke.mock
+}
+
+// Set uses given function f to mock the APITokenRepository.Revoke method
+func (mmRevoke *mAPITokenRepositoryMockRevoke) Set(f func(ctx context.Context, id int64, userID int64) (err error)) *APITokenRepositoryMock {
+	if mmRevoke.defaultExpectation != nil {
+		mmRevoke.mock.t.Fatalf("Default expectation is already set for the APITokenRepository.Revoke method")
+	}
+
+	if len(mmRevoke.expectations) > 0 {
+		mmRevoke.mock.t.Fatalf("Some expectations are already set for the APITokenRepository.Revoke method")
+	}
+
+	mmRevoke.mock.funcRevoke = f
+	return mmRevoke.mock
+}
+
+// When sets expectation for the APITokenRepository.Revoke which will trigger the result defined by the following
+// Then helper
+func (mmRevoke *mAPITokenRepositoryMockRevoke) When(ctx context.Context, id int64, userID int64) *APITokenRepositoryMockRevokeExpectation {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APITokenRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	expectation := &APITokenRepositoryMockRevokeExpectation{
+		mock:   mmRevoke.mock,
+		params: &APITokenRepositoryMockRevokeParams{ctx, id, userID},
+	}
+	mmRevoke.expectations = append(mmRevoke.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APITokenRepository.Revoke return parameters for the expectation previously defined by the When method
+func (e *APITokenRepositoryMockRevokeExpectation) Then(err error) *APITokenRepositoryMock {
+	e.results = &APITokenRepositoryMockRevokeResults{err}
+	return e.mock
+}
+
+// Times sets number of times APITokenRepository.Revoke should be invoked
+func (mmRevoke *mAPITokenRepositoryMockRevoke) Times(n uint64) *mAPITokenRepositoryMockRevoke {
+	if n == 0 {
+		mmRevoke.mock.t.Fatalf("Times of APITokenRepositoryMock.Revoke mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevoke.expectedInvocations, n)
+	return mmRevoke
+}
+
+func (mmRevoke *mAPITokenRepositoryMockRevoke) invocationsDone() bool {
+	if len(mmRevoke.expectations) == 0 && mmRevoke.defaultExpectation == nil && mmRevoke.mock.funcRevoke == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevoke.mock.afterRevokeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevoke.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Revoke implements repository.APITokenRepository
+func (mmRevoke *APITokenRepositoryMock) Revoke(ctx context.Context, id int64, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmRevoke.beforeRevokeCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevoke.afterRevokeCounter, 1)
+
+	if mmRevoke.inspectFuncRevoke != nil {
+		mmRevoke.inspectFuncRevoke(ctx, id, userID)
+	}
+
+	mm_params := APITokenRepositoryMockRevokeParams{ctx, id, userID}
+
+	// Record call args
+	mmRevoke.RevokeMock.mutex.Lock()
+	mmRevoke.RevokeMock.callArgs = append(mmRevoke.RevokeMock.callArgs, &mm_params)
+	mmRevoke.RevokeMock.mutex.Unlock()
+
+	for _, e := range mmRevoke.RevokeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevoke.RevokeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevoke.RevokeMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevoke.RevokeMock.defaultExpectation.params
+		mm_want_ptrs := mmRevoke.RevokeMock.defaultExpectation.paramPtrs
+
+		mm_got := APITokenRepositoryMockRevokeParams{ctx, id, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevoke.t.Errorf("APITokenRepositoryMock.Revoke got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRevoke.t.Errorf("APITokenRepositoryMock.Revoke got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRevoke.t.Errorf("APITokenRepositoryMock.Revoke got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevoke.t.Errorf("APITokenRepositoryMock.Revoke got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevoke.RevokeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevoke.t.Fatal("No results are set for the APITokenRepositoryMock.Revoke")
+		}
+		return (*mm_results).err
+	}
+	if mmRevoke.funcRevoke != nil {
+		return mmRevoke.funcRevoke(ctx, id, userID)
+	}
+	mmRevoke.t.Fatalf("Unexpected call to APITokenRepositoryMock.Revoke. %v %v %v", ctx, id, userID)
+	return
+}
+
+// RevokeAfterCounter returns a count of finished APITokenRepositoryMock.Revoke invocations
+func (mmRevoke *APITokenRepositoryMock) RevokeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.afterRevokeCounter)
+}
+
+// RevokeBeforeCounter returns a count of APITokenRepositoryMock.Revoke invocations
+func (mmRevoke *APITokenRepositoryMock) RevokeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.beforeRevokeCounter)
+}
+
+// Calls returns a list of arguments used in each call to APITokenRepositoryMock.Revoke.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevoke *mAPITokenRepositoryMockRevoke) Calls() []*APITokenRepositoryMockRevokeParams {
+	mmRevoke.mutex.RLock()
+
+	argCopy := make([]*APITokenRepositoryMockRevokeParams, len(mmRevoke.callArgs))
+	copy(argCopy, mmRevoke.callArgs)
+
+	mmRevoke.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeDone returns true if the count of the Revoke invocations corresponds
+// the number of defined expectations
+func (m *APITokenRepositoryMock) MinimockRevokeDone() bool {
+	if m.RevokeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeMock.invocationsDone()
+}
+
+// MinimockRevokeInspect logs each unmet expectation
+func (m *APITokenRepositoryMock) MinimockRevokeInspect() {
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APITokenRepositoryMock.Revoke with params: %#v", *e.params)
+		}
+	}
+
+	afterRevokeCounter := mm_atomic.LoadUint64(&m.afterRevokeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeMock.defaultExpectation != nil && afterRevokeCounter < 1 {
+		if m.RevokeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APITokenRepositoryMock.Revoke")
+		} else {
+			m.t.Errorf("Expected call to APITokenRepositoryMock.Revoke with params: %#v", *m.RevokeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevoke != nil && afterRevokeCounter < 1 {
+		m.t.Error("Expected call to APITokenRepositoryMock.Revoke")
+	}
+
+	if !m.RevokeMock.invocationsDone() && afterRevokeCounter > 0 {
+		m.t.Errorf("Expected %d calls to APITokenRepositoryMock.Revoke but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeMock.expectedInvocations), afterRevokeCounter)
+	}
+}
+
+type mAPITokenRepositoryMockUpdateLastUsed struct {
+	optional           bool
+	mock               *APITokenRepositoryMock
+	defaultExpectation *APITokenRepositoryMockUpdateLastUsedExpectation
+	expectations       []*APITokenRepositoryMockUpdateLastUsedExpectation
+
+	callArgs []*APITokenRepositoryMockUpdateLastUsedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APITokenRepositoryMockUpdateLastUsedExpectation specifies expectation struct of the APITokenRepository.UpdateLastUsed
+type APITokenRepositoryMockUpdateLastUsedExpectation struct {
+	mock      *APITokenRepositoryMock
+	params    *APITokenRepositoryMockUpdateLastUsedParams
+	paramPtrs *APITokenRepositoryMockUpdateLastUsedParamPtrs
+	results   *APITokenRepositoryMockUpdateLastUsedResults
+	Counter   uint64
+}
+
+// APITokenRepositoryMockUpdateLastUsedParams contains parameters of the APITokenRepository.UpdateLastUsed
+type APITokenRepositoryMockUpdateLastUsedParams struct {
+	ctx    context.Context
+	id     int64
+	usedAt time.Time
+}
+
+// APITokenRepositoryMockUpdateLastUsedParamPtrs contains pointers to parameters of the APITokenRepository.UpdateLastUsed
+type APITokenRepositoryMockUpdateLastUsedParamPtrs struct {
+	ctx    *context.Context
+	id     *int64
+	usedAt *time.Time
+}
+
+// APITokenRepositoryMockUpdateLastUsedResults contains results of the APITokenRepository.UpdateLastUsed
+type APITokenRepositoryMockUpdateLastUsedResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateLastUsed *mAPITokenRepositoryMockUpdateLastUsed) Optional() *mAPITokenRepositoryMockUpdateLastUsed {
+	mmUpdateLastUsed.optional = true
+	return mmUpdateLastUsed
+}
+
+// Expect sets up expected params for APITokenRepository.UpdateLastUsed
+func (mmUpdateLastUsed *mAPITokenRepositoryMockUpdateLastUsed) Expect(ctx context.Context, id int64, usedAt time.Time) *mAPITokenRepositoryMockUpdateLastUsed {
+	if mmUpdateLastUsed.mock.funcUpdateLastUsed != nil {
+		mmUpdateLastUsed.mock.t.Fatalf("APITokenRepositoryMock.UpdateLastUsed mock is already set by Set")
+	}
+
+	if mmUpdateLastUsed.defaultExpectation == nil {
+		mmUpdateLastUsed.defaultExpectation = &APITokenRepositoryMockUpdateLastUsedExpectation{}
+	}
+
+	if mmUpdateLastUsed.defaultExpectation.paramPtrs != nil {
+		mmUpdateLastUsed.mock.t.Fatalf("APITokenRepositoryMock.UpdateLastUsed mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateLastUsed.defaultExpectation.params = &APITokenRepositoryMockUpdateLastUsedParams{ctx, id, usedAt}
+	for _, e := range mmUpdateLastUsed.expectations {
+		if minimock.Equal(e.params, mmUpdateLastUsed.defaultExpectation.params) {
+			mmUpdateLastUsed.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateLastUsed.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateLastUsed
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APITokenRepository.UpdateLastUsed
+func (mmUpdateLastUsed *mAPITokenRepositoryMockUpdateLastUsed) ExpectCtxParam1(ctx context.Context) *mAPITokenRepositoryMockUpdateLastUsed {
+	if mmUpdateLastUsed.mock.funcUpdateLastUsed != nil {
+		mmUpdateLastUsed.mock.t.Fatalf("APITokenRepositoryMock.UpdateLastUsed mock is already set by Set")
+	}
+
+	if mmUpdateLastUsed.defaultExpectation == nil {
+		mmUpdateLastUsed.defaultExpectation = &APITokenRepositoryMockUpdateLastUsedExpectation{}
+	}
+
+	if mmUpdateLastUsed.defaultExpectation.params != nil {
+		mmUpdateLastUsed.mock.t.Fatalf("APITokenRepositoryMock.UpdateLastUsed mock is already set by Expect")
+	}
+
+	if mmUpdateLastUsed.defaultExpectation.paramPtrs == nil {
+		mmUpdateLastUsed.defaultExpectation.paramPtrs = &APITokenRepositoryMockUpdateLastUsedParamPtrs{}
+	}
+	mmUpdateLastUsed.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdateLastUsed
+}
+
+// ExpectIdParam2 sets up expected param id for APITokenRepository.UpdateLastUsed
+func (mmUpdateLastUsed *mAPITokenRepositoryMockUpdateLastUsed) ExpectIdParam2(id int64) *mAPITokenRepositoryMockUpdateLastUsed {
+	if mmUpdateLastUsed.mock.funcUpdateLastUsed != nil {
+		mmUpdateLastUsed.mock.t.Fatalf("APITokenRepositoryMock.UpdateLastUsed mock is already set by Set")
+	}
+
+	if mmUpdateLastUsed.defaultExpectation == nil {
+		mmUpdateLastUsed.defaultExpectation = &APITokenRepositoryMockUpdateLastUsedExpectation{}
+	}
+
+	if mmUpdateLastUsed.defaultExpectation.params != nil {
+		mmUpdateLastUsed.mock.t.Fatalf("APITokenRepositoryMock.UpdateLastUsed mock is already set by Expect")
+	}
+
+	if mmUpdateLastUsed.defaultExpectation.paramPtrs == nil {
+		mmUpdateLastUsed.defaultExpectation.paramPtrs = &APITokenRepositoryMockUpdateLastUsedParamPtrs{}
+	}
+	mmUpdateLastUsed.defaultExpectation.paramPtrs.id = &id
+
+	return mmUpdateLastUsed
+}
+
+// ExpectUsedAtParam3 sets up expected param usedAt for APITokenRepository.UpdateLastUsed
+func (mmUpdateLastUsed *mAPITokenRepositoryMockUpdateLastUsed) ExpectUsedAtParam3(usedAt time.Time) *mAPITokenRepositoryMockUpdateLastUsed {
+	if mmUpdateLastUsed.mock.funcUpdateLastUsed != nil {
+		mmUpdateLastUsed.mock.t.Fatalf("APITokenRepositoryMock.UpdateLastUsed mock is already set by Set")
+	}
+
+	if mmUpdateLastUsed.defaultExpectation == nil {
+		mmUpdateLastUsed.defaultExpectation = &APITokenRepositoryMockUpdateLastUsedExpectation{}
+	}
+
+	if mmUpdateLastUsed.defaultExpectation.params != nil {
+		mmUpdateLastUsed.mock.t.Fatalf("APITokenRepositoryMock.UpdateLastUsed mock is already set by Expect")
+	}
+
+	if mmUpdateLastUsed.defaultExpectation.paramPtrs == nil {
+		mmUpdateLastUsed.defaultExpectation.paramPtrs = &APITokenRepositoryMockUpdateLastUsedParamPtrs{}
+	}
+	mmUpdateLastUsed.defaultExpectation.paramPtrs.usedAt = &usedAt
+
+	return mmUpdateLastUsed
+}
+
+// Inspect accepts an inspector function that has same arguments as the APITokenRepository.UpdateLastUsed
+func (mmUpdateLastUsed *mAPITokenRepositoryMockUpdateLastUsed) Inspect(f func(ctx context.Context, id int64, usedAt time.Time)) *mAPITokenRepositoryMockUpdateLastUsed {
+	if mmUpdateLastUsed.mock.inspectFuncUpdateLastUsed != nil {
+		mmUpdateLastUsed.mock.t.Fatalf("Inspect function is already set for APITokenRepositoryMock.UpdateLastUsed")
+	}
+
+	mmUpdateLastUsed.mock.inspectFuncUpdateLastUsed = f
+
+	return mmUpdateLastUsed
+}
+
+// Return sets up results that will be returned by APITokenRepository.UpdateLastUsed
+func (mmUpdateLastUsed *mAPITokenRepositoryMockUpdateLastUsed) Return(err error) *APITokenRepositoryMock {
+	if mmUpdateLastUsed.mock.funcUpdateLastUsed != nil {
+		mmUpdateLastUsed.mock.t.Fatalf("APITokenRepositoryMock.UpdateLastUsed mock is already set by Set")
+	}
+
+	if mmUpdateLastUsed.defaultExpectation == nil {
+		mmUpdateLastUsed.defaultExpectation = &APITokenRepositoryMockUpdateLastUsedExpectation{mock: mmUpdateLastUsed.mock}
+	}
+	mmUpdateLastUsed.defaultExpectation.results = &APITokenRepositoryMockUpdateLastUsedResults{err}
+	return mmUpdateLastUsed.mock
+}
+
+// Set uses given function f to mock the APITokenRepository.UpdateLastUsed method
+func (mmUpdateLastUsed *mAPITokenRepositoryMockUpdateLastUsed) Set(f func(ctx context.Context, id int64, usedAt time.Time) (err error)) *APITokenRepositoryMock {
+	if mmUpdateLastUsed.defaultExpectation != nil {
+		mmUpdateLastUsed.mock.t.Fatalf("Default expectation is already set for the APITokenRepository.UpdateLastUsed method")
+	}
+
+	if len(mmUpdateLastUsed.expectations) > 0 {
+		mmUpdateLastUsed.mock.t.Fatalf("Some expectations are already set for the APITokenRepository.UpdateLastUsed method")
+	}
+
+	mmUpdateLastUsed.mock.funcUpdateLastUsed = f
+	return mmUpdateLastUsed.mock
+}
+
+// When sets expectation for the APITokenRepository.UpdateLastUsed which will trigger the result defined by the following
+// Then helper
+func (mmUpdateLastUsed *mAPITokenRepositoryMockUpdateLastUsed) When(ctx context.Context, id int64, usedAt time.Time) *APITokenRepositoryMockUpdateLastUsedExpectation {
+	if mmUpdateLastUsed.mock.funcUpdateLastUsed != nil {
+		mmUpdateLastUsed.mock.t.Fatalf("APITokenRepositoryMock.UpdateLastUsed mock is already set by Set")
+	}
+
+	expectation := &APITokenRepositoryMockUpdateLastUsedExpectation{
+		mock:   mmUpdateLastUsed.mock,
+		params: &APITokenRepositoryMockUpdateLastUsedParams{ctx, id, usedAt},
+	}
+	mmUpdateLastUsed.expectations = append(mmUpdateLastUsed.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APITokenRepository.UpdateLastUsed return parameters for the expectation previously defined by the When method
+func (e *APITokenRepositoryMockUpdateLastUsedExpectation) Then(err error) *APITokenRepositoryMock {
+	e.results = &APITokenRepositoryMockUpdateLastUsedResults{err}
+	return e.mock
+}
+
+// Times sets number of times APITokenRepository.UpdateLastUsed should be invoked
+func (mmUpdateLastUsed *mAPITokenRepositoryMockUpdateLastUsed) Times(n uint64) *mAPITokenRepositoryMockUpdateLastUsed {
+	if n == 0 {
+		mmUpdateLastUsed.mock.t.Fatalf("Times of APITokenRepositoryMock.UpdateLastUsed mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateLastUsed.expectedInvocations, n)
+	return mmUpdateLastUsed
+}
+
+func (mmUpdateLastUsed *mAPITokenRepositoryMockUpdateLastUsed) invocationsDone() bool {
+	if len(mmUpdateLastUsed.expectations) == 0 && mmUpdateLastUsed.defaultExpectation == nil && mmUpdateLastUsed.mock.funcUpdateLastUsed == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateLastUsed.mock.afterUpdateLastUsedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateLastUsed.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateLastUsed implements repository.APITokenRepository
+func (mmUpdateLastUsed *APITokenRepositoryMock) UpdateLastUsed(ctx context.Context, id int64, usedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmUpdateLastUsed.beforeUpdateLastUsedCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateLastUsed.afterUpdateLastUsedCounter, 1)
+
+	if mmUpdateLastUsed.inspectFuncUpdateLastUsed != nil {
+		mmUpdateLastUsed.inspectFuncUpdateLastUsed(ctx, id, usedAt)
+	}
+
+	mm_params := APITokenRepositoryMockUpdateLastUsedParams{ctx, id, usedAt}
+
+	// Record call args
+	mmUpdateLastUsed.UpdateLastUsedMock.mutex.Lock()
+	mmUpdateLastUsed.UpdateLastUsedMock.callArgs = append(mmUpdateLastUsed.UpdateLastUsedMock.callArgs, &mm_params)
+	mmUpdateLastUsed.UpdateLastUsedMock.mutex.Unlock()
+
+	for _, e := range mmUpdateLastUsed.UpdateLastUsedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateLastUsed.UpdateLastUsedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateLastUsed.UpdateLastUsedMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateLastUsed.UpdateLastUsedMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateLastUsed.UpdateLastUsedMock.defaultExpectation.paramPtrs
+
+		mm_got := APITokenRepositoryMockUpdateLastUsedParams{ctx, id, usedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateLastUsed.t.Errorf("APITokenRepositoryMock.UpdateLastUsed got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateLastUsed.t.Errorf("APITokenRepositoryMock.UpdateLastUsed got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.usedAt != nil && !minimock.Equal(*mm_want_ptrs.usedAt, mm_got.usedAt) {
+				mmUpdateLastUsed.t.Errorf("APITokenRepositoryMock.UpdateLastUsed got unexpected parameter usedAt, want: %#v, got: %#v%s\n", *mm_want_ptrs.usedAt, mm_got.usedAt, minimock.Diff(*mm_want_ptrs.usedAt, mm_got.usedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateLastUsed.t.Errorf("APITokenRepositoryMock.UpdateLastUsed got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateLastUsed.UpdateLastUsedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateLastUsed.t.Fatal("No results are set for the APITokenRepositoryMock.UpdateLastUsed")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateLastUsed.funcUpdateLastUsed != nil {
+		return mmUpdateLastUsed.funcUpdateLastUsed(ctx, id, usedAt)
+	}
+	mmUpdateLastUsed.t.Fatalf("Unexpected call to APITokenRepositoryMock.UpdateLastUsed. %v %v %v", ctx, id, usedAt)
+	return
+}
+
+// UpdateLastUsedAfterCounter returns a count of finished APITokenRepositoryMock.UpdateLastUsed invocations
+func (mmUpdateLastUsed *APITokenRepositoryMock) UpdateLastUsedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateLastUsed.afterUpdateLastUsedCounter)
+}
+
+// UpdateLastUsedBeforeCounter returns a count of APITokenRepositoryMock.UpdateLastUsed invocations
+func (mmUpdateLastUsed *APITokenRepositoryMock) UpdateLastUsedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateLastUsed.beforeUpdateLastUsedCounter)
+}
+
+// Calls returns a list of arguments used in each call to APITokenRepositoryMock.UpdateLastUsed.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateLastUsed *mAPITokenRepositoryMockUpdateLastUsed) Calls() []*APITokenRepositoryMockUpdateLastUsedParams {
+	mmUpdateLastUsed.mutex.RLock()
+
+	argCopy := make([]*APITokenRepositoryMockUpdateLastUsedParams, len(mmUpdateLastUsed.callArgs))
+	copy(argCopy, mmUpdateLastUsed.callArgs)
+
+	mmUpdateLastUsed.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateLastUsedDone returns true if the count of the UpdateLastUsed invocations corresponds
+// the number of defined expectations
+func (m *APITokenRepositoryMock) MinimockUpdateLastUsedDone() bool {
+	if m.UpdateLastUsedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateLastUsedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateLastUsedMock.invocationsDone()
+}
+
+// MinimockUpdateLastUsedInspect logs each unmet expectation
+func (m *APITokenRepositoryMock) MinimockUpdateLastUsedInspect() {
+	for _, e := range m.UpdateLastUsedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APITokenRepositoryMock.UpdateLastUsed with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdateLastUsedCounter := mm_atomic.LoadUint64(&m.afterUpdateLastUsedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateLastUsedMock.defaultExpectation != nil && afterUpdateLastUsedCounter < 1 {
+		if m.UpdateLastUsedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APITokenRepositoryMock.UpdateLastUsed")
+		} else {
+			m.t.Errorf("Expected call to APITokenRepositoryMock.UpdateLastUsed with params: %#v", *m.UpdateLastUsedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateLastUsed != nil && afterUpdateLastUsedCounter < 1 {
+		m.t.Error("Expected call to APITokenRepositoryMock.UpdateLastUsed")
+	}
+
+	if !m.UpdateLastUsedMock.invocationsDone() && afterUpdateLastUsedCounter > 0 {
+		m.t.Errorf("Expected %d calls to APITokenRepositoryMock.UpdateLastUsed but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateLastUsedMock.expectedInvocations), afterUpdateLastUsedCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *APITokenRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockGetByPrefixInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockRevokeInspect()
+
+			m.MinimockUpdateLastUsedInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *APITokenRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *APITokenRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockGetByPrefixDone() &&
+		m.MinimockListDone() &&
+		m.MinimockRevokeDone() &&
+		m.MinimockUpdateLastUsedDone()
+}
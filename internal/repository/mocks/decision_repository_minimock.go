@@ -0,0 +1,728 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/auth/internal/repository.DecisionRepository -o decision_repository_minimock.go -n DecisionRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	decisionFilter "github.com/mikhailsoldatkin/auth/internal/repository/decision/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// DecisionRepositoryMock implements repository.DecisionRepository
+type DecisionRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateBatch          func(ctx context.Context, events []*model.DecisionEvent) (err error)
+	inspectFuncCreateBatch   func(ctx context.Context, events []*model.DecisionEvent)
+	afterCreateBatchCounter  uint64
+	beforeCreateBatchCounter uint64
+	CreateBatchMock          mDecisionRepositoryMockCreateBatch
+
+	funcList          func(ctx context.Context, filter decisionFilter.DecisionFilter) (dpa1 []*model.DecisionEvent, err error)
+	inspectFuncList   func(ctx context.Context, filter decisionFilter.DecisionFilter)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mDecisionRepositoryMockList
+}
+
+// NewDecisionRepositoryMock returns a mock for repository.DecisionRepository
+func NewDecisionRepositoryMock(t minimock.Tester) *DecisionRepositoryMock {
+	m := &DecisionRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateBatchMock = mDecisionRepositoryMockCreateBatch{mock: m}
+	m.CreateBatchMock.callArgs = []*DecisionRepositoryMockCreateBatchParams{}
+
+	m.ListMock = mDecisionRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*DecisionRepositoryMockListParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mDecisionRepositoryMockCreateBatch struct {
+	optional           bool
+	mock               *DecisionRepositoryMock
+	defaultExpectation *DecisionRepositoryMockCreateBatchExpectation
+	expectations       []*DecisionRepositoryMockCreateBatchExpectation
+
+	callArgs []*DecisionRepositoryMockCreateBatchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// DecisionRepositoryMockCreateBatchExpectation specifies expectation struct of the DecisionRepository.CreateBatch
+type DecisionRepositoryMockCreateBatchExpectation struct {
+	mock      *DecisionRepositoryMock
+	params    *DecisionRepositoryMockCreateBatchParams
+	paramPtrs *DecisionRepositoryMockCreateBatchParamPtrs
+	results   *DecisionRepositoryMockCreateBatchResults
+	Counter   uint64
+}
+
+// DecisionRepositoryMockCreateBatchParams contains parameters of the DecisionRepository.CreateBatch
+type DecisionRepositoryMockCreateBatchParams struct {
+	ctx    context.Context
+	events []*model.DecisionEvent
+}
+
+// DecisionRepositoryMockCreateBatchParamPtrs contains pointers to parameters of the DecisionRepository.CreateBatch
+type DecisionRepositoryMockCreateBatchParamPtrs struct {
+	ctx    *context.Context
+	events *[]*model.DecisionEvent
+}
+
+// DecisionRepositoryMockCreateBatchResults contains results of the DecisionRepository.CreateBatch
+type DecisionRepositoryMockCreateBatchResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateBatch *mDecisionRepositoryMockCreateBatch) Optional() *mDecisionRepositoryMockCreateBatch {
+	mmCreateBatch.optional = true
+	return mmCreateBatch
+}
+
+// Expect sets up expected params for DecisionRepository.CreateBatch
+func (mmCreateBatch *mDecisionRepositoryMockCreateBatch) Expect(ctx context.Context, events []*model.DecisionEvent) *mDecisionRepositoryMockCreateBatch {
+	if mmCreateBatch.mock.funcCreateBatch != nil {
+		mmCreateBatch.mock.t.Fatalf("DecisionRepositoryMock.CreateBatch mock is already set by Set")
+	}
+
+	if mmCreateBatch.defaultExpectation == nil {
+		mmCreateBatch.defaultExpectation = &DecisionRepositoryMockCreateBatchExpectation{}
+	}
+
+	if mmCreateBatch.defaultExpectation.paramPtrs != nil {
+		mmCreateBatch.mock.t.Fatalf("DecisionRepositoryMock.CreateBatch mock is already set by ExpectParams functions")
+	}
+
+	mmCreateBatch.defaultExpectation.params = &DecisionRepositoryMockCreateBatchParams{ctx, events}
+	for _, e := range mmCreateBatch.expectations {
+		if minimock.Equal(e.params, mmCreateBatch.defaultExpectation.params) {
+			mmCreateBatch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateBatch.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateBatch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for DecisionRepository.CreateBatch
+func (mmCreateBatch *mDecisionRepositoryMockCreateBatch) ExpectCtxParam1(ctx context.Context) *mDecisionRepositoryMockCreateBatch {
+	if mmCreateBatch.mock.funcCreateBatch != nil {
+		mmCreateBatch.mock.t.Fatalf("DecisionRepositoryMock.CreateBatch mock is already set by Set")
+	}
+
+	if mmCreateBatch.defaultExpectation == nil {
+		mmCreateBatch.defaultExpectation = &DecisionRepositoryMockCreateBatchExpectation{}
+	}
+
+	if mmCreateBatch.defaultExpectation.params != nil {
+		mmCreateBatch.mock.t.Fatalf("DecisionRepositoryMock.CreateBatch mock is already set by Expect")
+	}
+
+	if mmCreateBatch.defaultExpectation.paramPtrs == nil {
+		mmCreateBatch.defaultExpectation.paramPtrs = &DecisionRepositoryMockCreateBatchParamPtrs{}
+	}
+	mmCreateBatch.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateBatch
+}
+
+// ExpectEventsParam2 sets up expected param events for DecisionRepository.CreateBatch
+func (mmCreateBatch *mDecisionRepositoryMockCreateBatch) ExpectEventsParam2(events []*model.DecisionEvent) *mDecisionRepositoryMockCreateBatch {
+	if mmCreateBatch.mock.funcCreateBatch != nil {
+		mmCreateBatch.mock.t.Fatalf("DecisionRepositoryMock.CreateBatch mock is already set by Set")
+	}
+
+	if mmCreateBatch.defaultExpectation == nil {
+		mmCreateBatch.defaultExpectation = &DecisionRepositoryMockCreateBatchExpectation{}
+	}
+
+	if mmCreateBatch.defaultExpectation.params != nil {
+		mmCreateBatch.mock.t.Fatalf("DecisionRepositoryMock.CreateBatch mock is already set by Expect")
+	}
+
+	if mmCreateBatch.defaultExpectation.paramPtrs == nil {
+		mmCreateBatch.defaultExpectation.paramPtrs = &DecisionRepositoryMockCreateBatchParamPtrs{}
+	}
+	mmCreateBatch.defaultExpectation.paramPtrs.events = &events
+
+	return mmCreateBatch
+}
+
+// Inspect accepts an inspector function that has same arguments as the DecisionRepository.CreateBatch
+func (mmCreateBatch *mDecisionRepositoryMockCreateBatch) Inspect(f func(ctx context.Context, events []*model.DecisionEvent)) *mDecisionRepositoryMockCreateBatch {
+	if mmCreateBatch.mock.inspectFuncCreateBatch != nil {
+		mmCreateBatch.mock.t.Fatalf("Inspect function is already set for DecisionRepositoryMock.CreateBatch")
+	}
+
+	mmCreateBatch.mock.inspectFuncCreateBatch = f
+
+	return mmCreateBatch
+}
+
+// Return sets up results that will be returned by DecisionRepository.CreateBatch
+func (mmCreateBatch *mDecisionRepositoryMockCreateBatch) Return(err error) *DecisionRepositoryMock {
+	if mmCreateBatch.mock.funcCreateBatch != nil {
+		mmCreateBatch.mock.t.Fatalf("DecisionRepositoryMock.CreateBatch mock is already set by Set")
+	}
+
+	if mmCreateBatch.defaultExpectation == nil {
+		mmCreateBatch.defaultExpectation = &DecisionRepositoryMockCreateBatchExpectation{mock: mmCreateBatch.mock}
+	}
+	mmCreateBatch.defaultExpectation.results = &DecisionRepositoryMockCreateBatchResults{err}
+	return mmCreateBatch.mock
+}
+
+// Set uses given function f to mock the DecisionRepository.CreateBatch method
+func (mmCreateBatch *mDecisionRepositoryMockCreateBatch) Set(f func(ctx context.Context, events []*model.DecisionEvent) (err error)) *DecisionRepositoryMock {
+	if mmCreateBatch.defaultExpectation != nil {
+		mmCreateBatch.mock.t.Fatalf("Default expectation is already set for the DecisionRepository.CreateBatch method")
+	}
+
+	if len(mmCreateBatch.expectations) > 0 {
+		mmCreateBatch.mock.t.Fatalf("Some expectations are already set for the DecisionRepository.CreateBatch method")
+	}
+
+	mmCreateBatch.mock.funcCreateBatch = f
+	return mmCreateBatch.mock
+}
+
+// When sets expectation for the DecisionRepository.CreateBatch which will trigger the result defined by the following
+// Then helper
+func (mmCreateBatch *mDecisionRepositoryMockCreateBatch) When(ctx context.Context, events []*model.DecisionEvent) *DecisionRepositoryMockCreateBatchExpectation {
+	if mmCreateBatch.mock.funcCreateBatch != nil {
+		mmCreateBatch.mock.t.Fatalf("DecisionRepositoryMock.CreateBatch mock is already set by Set")
+	}
+
+	expectation := &DecisionRepositoryMockCreateBatchExpectation{
+		mock:   mmCreateBatch.mock,
+		params: &DecisionRepositoryMockCreateBatchParams{ctx, events},
+	}
+	mmCreateBatch.expectations = append(mmCreateBatch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DecisionRepository.CreateBatch return parameters for the expectation previously defined by the When method
+func (e *DecisionRepositoryMockCreateBatchExpectation) Then(err error) *DecisionRepositoryMock {
+	e.results = &DecisionRepositoryMockCreateBatchResults{err}
+	return e.mock
+}
+
+// Times sets number of times DecisionRepository.CreateBatch should be invoked
+func (mmCreateBatch *mDecisionRepositoryMockCreateBatch) Times(n uint64) *mDecisionRepositoryMockCreateBatch {
+	if n == 0 {
+		mmCreateBatch.mock.t.Fatalf("Times of DecisionRepositoryMock.CreateBatch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateBatch.expectedInvocations, n)
+	return mmCreateBatch
+}
+
+func (mmCreateBatch *mDecisionRepositoryMockCreateBatch) invocationsDone() bool {
+	if len(mmCreateBatch.expectations) == 0 && mmCreateBatch.defaultExpectation == nil && mmCreateBatch.mock.funcCreateBatch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateBatch.mock.afterCreateBatchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateBatch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateBatch implements repository.DecisionRepository
+func (mmCreateBatch *DecisionRepositoryMock) CreateBatch(ctx context.Context, events []*model.DecisionEvent) (err error) {
+	mm_atomic.AddUint64(&mmCreateBatch.beforeCreateBatchCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateBatch.afterCreateBatchCounter, 1)
+
+	if mmCreateBatch.inspectFuncCreateBatch != nil {
+		mmCreateBatch.inspectFuncCreateBatch(ctx, events)
+	}
+
+	mm_params := DecisionRepositoryMockCreateBatchParams{ctx, events}
+
+	// Record call args
+	mmCreateBatch.CreateBatchMock.mutex.Lock()
+	mmCreateBatch.CreateBatchMock.callArgs = append(mmCreateBatch.CreateBatchMock.callArgs, &mm_params)
+	mmCreateBatch.CreateBatchMock.mutex.Unlock()
+
+	for _, e := range mmCreateBatch.CreateBatchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateBatch.CreateBatchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateBatch.CreateBatchMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateBatch.CreateBatchMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateBatch.CreateBatchMock.defaultExpectation.paramPtrs
+
+		mm_got := DecisionRepositoryMockCreateBatchParams{ctx, events}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateBatch.t.Errorf("DecisionRepositoryMock.CreateBatch got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.events != nil && !minimock.Equal(*mm_want_ptrs.events, mm_got.events) {
+				mmCreateBatch.t.Errorf("DecisionRepositoryMock.CreateBatch got unexpected parameter events, want: %#v, got: %#v%s\n", *mm_want_ptrs.events, mm_got.events, minimock.Diff(*mm_want_ptrs.events, mm_got.events))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateBatch.t.Errorf("DecisionRepositoryMock.CreateBatch got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateBatch.CreateBatchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateBatch.t.Fatal("No results are set for the DecisionRepositoryMock.CreateBatch")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateBatch.funcCreateBatch != nil {
+		return mmCreateBatch.funcCreateBatch(ctx, events)
+	}
+	mmCreateBatch.t.Fatalf("Unexpected call to DecisionRepositoryMock.CreateBatch. %v %v", ctx, events)
+	return
+}
+
+// CreateBatchAfterCounter returns a count of finished DecisionRepositoryMock.CreateBatch invocations
+func (mmCreateBatch *DecisionRepositoryMock) CreateBatchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateBatch.afterCreateBatchCounter)
+}
+
+// CreateBatchBeforeCounter returns a count of DecisionRepositoryMock.CreateBatch invocations
+func (mmCreateBatch *DecisionRepositoryMock) CreateBatchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateBatch.beforeCreateBatchCounter)
+}
+
+// Calls returns a list of arguments used in each call to DecisionRepositoryMock.CreateBatch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateBatch *mDecisionRepositoryMockCreateBatch) Calls() []*DecisionRepositoryMockCreateBatchParams {
+	mmCreateBatch.mutex.RLock()
+
+	argCopy := make([]*DecisionRepositoryMockCreateBatchParams, len(mmCreateBatch.callArgs))
+	copy(argCopy, mmCreateBatch.callArgs)
+
+	mmCreateBatch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateBatchDone returns true if the count of the CreateBatch invocations corresponds
+// the number of defined expectations
+func (m *DecisionRepositoryMock) MinimockCreateBatchDone() bool {
+	if m.CreateBatchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateBatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateBatchMock.invocationsDone()
+}
+
+// MinimockCreateBatchInspect logs each unmet expectation
+func (m *DecisionRepositoryMock) MinimockCreateBatchInspect() {
+	for _, e := range m.CreateBatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DecisionRepositoryMock.CreateBatch with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateBatchCounter := mm_atomic.LoadUint64(&m.afterCreateBatchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateBatchMock.defaultExpectation != nil && afterCreateBatchCounter < 1 {
+		if m.CreateBatchMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DecisionRepositoryMock.CreateBatch")
+		} else {
+			m.t.Errorf("Expected call to DecisionRepositoryMock.CreateBatch with params: %#v", *m.CreateBatchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateBatch != nil && afterCreateBatchCounter < 1 {
+		m.t.Error("Expected call to DecisionRepositoryMock.CreateBatch")
+	}
+
+	if !m.CreateBatchMock.invocationsDone() && afterCreateBatchCounter > 0 {
+		m.t.Errorf("Expected %d calls to DecisionRepositoryMock.CreateBatch but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateBatchMock.expectedInvocations), afterCreateBatchCounter)
+	}
+}
+
+type mDecisionRepositoryMockList struct {
+	optional           bool
+	mock               *DecisionRepositoryMock
+	defaultExpectation *DecisionRepositoryMockListExpectation
+	expectations       []*DecisionRepositoryMockListExpectation
+
+	callArgs []*DecisionRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// DecisionRepositoryMockListExpectation specifies expectation struct of the DecisionRepository.List
+type DecisionRepositoryMockListExpectation struct {
+	mock      *DecisionRepositoryMock
+	params    *DecisionRepositoryMockListParams
+	paramPtrs *DecisionRepositoryMockListParamPtrs
+	results   *DecisionRepositoryMockListResults
+	Counter   uint64
+}
+
+// DecisionRepositoryMockListParams contains parameters of the DecisionRepository.List
+type DecisionRepositoryMockListParams struct {
+	ctx    context.Context
+	filter decisionFilter.DecisionFilter
+}
+
+// DecisionRepositoryMockListParamPtrs contains pointers to parameters of the DecisionRepository.List
+type DecisionRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	filter *decisionFilter.DecisionFilter
+}
+
+// DecisionRepositoryMockListResults contains results of the DecisionRepository.List
+type DecisionRepositoryMockListResults struct {
+	dpa1 []*model.DecisionEvent
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mDecisionRepositoryMockList) Optional() *mDecisionRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for DecisionRepository.List
+func (mmList *mDecisionRepositoryMockList) Expect(ctx context.Context, filter decisionFilter.DecisionFilter) *mDecisionRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("DecisionRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &DecisionRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("DecisionRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &DecisionRepositoryMockListParams{ctx, filter}
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for DecisionRepository.List
+func (mmList *mDecisionRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mDecisionRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("DecisionRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &DecisionRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("DecisionRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &DecisionRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmList
+}
+
+// ExpectFilterParam2 sets up expected param filter for DecisionRepository.List
+func (mmList *mDecisionRepositoryMockList) ExpectFilterParam2(filter decisionFilter.DecisionFilter) *mDecisionRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("DecisionRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &DecisionRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("DecisionRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &DecisionRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the DecisionRepository.List
+func (mmList *mDecisionRepositoryMockList) Inspect(f func(ctx context.Context, filter decisionFilter.DecisionFilter)) *mDecisionRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for DecisionRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by DecisionRepository.List
+func (mmList *mDecisionRepositoryMockList) Return(dpa1 []*model.DecisionEvent, err error) *DecisionRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("DecisionRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &DecisionRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &DecisionRepositoryMockListResults{dpa1, err}
+	return mmList.mock
+}
+
+// Set uses given function f to mock the DecisionRepository.List method
+func (mmList *mDecisionRepositoryMockList) Set(f func(ctx context.Context, filter decisionFilter.DecisionFilter) (dpa1 []*model.DecisionEvent, err error)) *DecisionRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the DecisionRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the DecisionRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	return mmList.mock
+}
+
+// When sets expectation for the DecisionRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mDecisionRepositoryMockList) When(ctx context.Context, filter decisionFilter.DecisionFilter) *DecisionRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("DecisionRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &DecisionRepositoryMockListExpectation{
+		mock:   mmList.mock,
+		params: &DecisionRepositoryMockListParams{ctx, filter},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DecisionRepository.List return parameters for the expectation previously defined by the When method
+func (e *DecisionRepositoryMockListExpectation) Then(dpa1 []*model.DecisionEvent, err error) *DecisionRepositoryMock {
+	e.results = &DecisionRepositoryMockListResults{dpa1, err}
+	return e.mock
+}
+
+// Times sets number of times DecisionRepository.List should be invoked
+func (mmList *mDecisionRepositoryMockList) Times(n uint64) *mDecisionRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of DecisionRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	return mmList
+}
+
+func (mmList *mDecisionRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements repository.DecisionRepository
+func (mmList *DecisionRepositoryMock) List(ctx context.Context, filter decisionFilter.DecisionFilter) (dpa1 []*model.DecisionEvent, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, filter)
+	}
+
+	mm_params := DecisionRepositoryMockListParams{ctx, filter}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.dpa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := DecisionRepositoryMockListParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("DecisionRepositoryMock.List got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmList.t.Errorf("DecisionRepositoryMock.List got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("DecisionRepositoryMock.List got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the DecisionRepositoryMock.List")
+		}
+		return (*mm_results).dpa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, filter)
+	}
+	mmList.t.Fatalf("Unexpected call to DecisionRepositoryMock.List. %v %v", ctx, filter)
+	return
+}
+
+// ListAfterCounter returns a count of finished DecisionRepositoryMock.List invocations
+func (mmList *DecisionRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of DecisionRepositoryMock.List invocations
+func (mmList *DecisionRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to DecisionRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mDecisionRepositoryMockList) Calls() []*DecisionRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*DecisionRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *DecisionRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *DecisionRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DecisionRepositoryMock.List with params: %#v", *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DecisionRepositoryMock.List")
+		} else {
+			m.t.Errorf("Expected call to DecisionRepositoryMock.List with params: %#v", *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Error("Expected call to DecisionRepositoryMock.List")
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to DecisionRepositoryMock.List but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), afterListCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *DecisionRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateBatchInspect()
+
+			m.MinimockListInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *DecisionRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *DecisionRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateBatchDone() &&
+		m.MinimockListDone()
+}
@@ -0,0 +1,1117 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/auth/internal/repository.ElevationRepository -o elevation_repository_minimock.go -n ElevationRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// ElevationRepositoryMock implements repository.ElevationRepository
+type ElevationRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcApprove          func(ctx context.Context, id int64, approverID int64, approvedAt time.Time) (err error)
+	inspectFuncApprove   func(ctx context.Context, id int64, approverID int64, approvedAt time.Time)
+	afterApproveCounter  uint64
+	beforeApproveCounter uint64
+	ApproveMock          mElevationRepositoryMockApprove
+
+	funcCreate          func(ctx context.Context, request *model.ElevationRequest) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, request *model.ElevationRequest)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mElevationRepositoryMockCreate
+
+	funcGet          func(ctx context.Context, id int64) (ep1 *model.ElevationRequest, err error)
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mElevationRepositoryMockGet
+}
+
+// NewElevationRepositoryMock returns a mock for repository.ElevationRepository
+func NewElevationRepositoryMock(t minimock.Tester) *ElevationRepositoryMock {
+	m := &ElevationRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ApproveMock = mElevationRepositoryMockApprove{mock: m}
+	m.ApproveMock.callArgs = []*ElevationRepositoryMockApproveParams{}
+
+	m.CreateMock = mElevationRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*ElevationRepositoryMockCreateParams{}
+
+	m.GetMock = mElevationRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*ElevationRepositoryMockGetParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mElevationRepositoryMockApprove struct {
+	optional           bool
+	mock               *ElevationRepositoryMock
+	defaultExpectation *ElevationRepositoryMockApproveExpectation
+	expectations       []*ElevationRepositoryMockApproveExpectation
+
+	callArgs []*ElevationRepositoryMockApproveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ElevationRepositoryMockApproveExpectation specifies expectation struct of the ElevationRepository.Approve
+type ElevationRepositoryMockApproveExpectation struct {
+	mock      *ElevationRepositoryMock
+	params    *ElevationRepositoryMockApproveParams
+	paramPtrs *ElevationRepositoryMockApproveParamPtrs
+	results   *ElevationRepositoryMockApproveResults
+	Counter   uint64
+}
+
+// ElevationRepositoryMockApproveParams contains parameters of the ElevationRepository.Approve
+type ElevationRepositoryMockApproveParams struct {
+	ctx        context.Context
+	id         int64
+	approverID int64
+	approvedAt time.Time
+}
+
+// ElevationRepositoryMockApproveParamPtrs contains pointers to parameters of the ElevationRepository.Approve
+type ElevationRepositoryMockApproveParamPtrs struct {
+	ctx        *context.Context
+	id         *int64
+	approverID *int64
+	approvedAt *time.Time
+}
+
+// ElevationRepositoryMockApproveResults contains results of the ElevationRepository.Approve
+type ElevationRepositoryMockApproveResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmApprove *mElevationRepositoryMockApprove) Optional() *mElevationRepositoryMockApprove {
+	mmApprove.optional = true
+	return mmApprove
+}
+
+// Expect sets up expected params for ElevationRepository.Approve
+func (mmApprove *mElevationRepositoryMockApprove) Expect(ctx context.Context, id int64, approverID int64, approvedAt time.Time) *mElevationRepositoryMockApprove {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("ElevationRepositoryMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &ElevationRepositoryMockApproveExpectation{}
+	}
+
+	if mmApprove.defaultExpectation.paramPtrs != nil {
+		mmApprove.mock.t.Fatalf("ElevationRepositoryMock.Approve mock is already set by ExpectParams functions")
+	}
+
+	mmApprove.defaultExpectation.params = &ElevationRepositoryMockApproveParams{ctx, id, approverID, approvedAt}
+	for _, e := range mmApprove.expectations {
+		if minimock.Equal(e.params, mmApprove.defaultExpectation.params) {
+			mmApprove.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApprove.defaultExpectation.params)
+		}
+	}
+
+	return mmApprove
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ElevationRepository.Approve
+func (mmApprove *mElevationRepositoryMockApprove) ExpectCtxParam1(ctx context.Context) *mElevationRepositoryMockApprove {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("ElevationRepositoryMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &ElevationRepositoryMockApproveExpectation{}
+	}
+
+	if mmApprove.defaultExpectation.params != nil {
+		mmApprove.mock.t.Fatalf("ElevationRepositoryMock.Approve mock is already set by Expect")
+	}
+
+	if mmApprove.defaultExpectation.paramPtrs == nil {
+		mmApprove.defaultExpectation.paramPtrs = &ElevationRepositoryMockApproveParamPtrs{}
+	}
+	mmApprove.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmApprove
+}
+
+// ExpectIdParam2 sets up expected param id for ElevationRepository.Approve
+func (mmApprove *mElevationRepositoryMockApprove) ExpectIdParam2(id int64) *mElevationRepositoryMockApprove {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("ElevationRepositoryMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &ElevationRepositoryMockApproveExpectation{}
+	}
+
+	if mmApprove.defaultExpectation.params != nil {
+		mmApprove.mock.t.Fatalf("ElevationRepositoryMock.Approve mock is already set by Expect")
+	}
+
+	if mmApprove.defaultExpectation.paramPtrs == nil {
+		mmApprove.defaultExpectation.paramPtrs = &ElevationRepositoryMockApproveParamPtrs{}
+	}
+	mmApprove.defaultExpectation.paramPtrs.id = &id
+
+	return mmApprove
+}
+
+// ExpectApproverIDParam3 sets up expected param approverID for ElevationRepository.Approve
+func (mmApprove *mElevationRepositoryMockApprove) ExpectApproverIDParam3(approverID int64) *mElevationRepositoryMockApprove {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("ElevationRepositoryMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &ElevationRepositoryMockApproveExpectation{}
+	}
+
+	if mmApprove.defaultExpectation.params != nil {
+		mmApprove.mock.t.Fatalf("ElevationRepositoryMock.Approve mock is already set by Expect")
+	}
+
+	if mmApprove.defaultExpectation.paramPtrs == nil {
+		mmApprove.defaultExpectation.paramPtrs = &ElevationRepositoryMockApproveParamPtrs{}
+	}
+	mmApprove.defaultExpectation.paramPtrs.approverID = &approverID
+
+	return mmApprove
+}
+
+// ExpectApprovedAtParam4 sets up expected param approvedAt for ElevationRepository.Approve
+func (mmApprove *mElevationRepositoryMockApprove) ExpectApprovedAtParam4(approvedAt time.Time) *mElevationRepositoryMockApprove {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("ElevationRepositoryMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &ElevationRepositoryMockApproveExpectation{}
+	}
+
+	if mmApprove.defaultExpectation.params != nil {
+		mmApprove.mock.t.Fatalf("ElevationRepositoryMock.Approve mock is already set by Expect")
+	}
+
+	if mmApprove.defaultExpectation.paramPtrs == nil {
+		mmApprove.defaultExpectation.paramPtrs = &ElevationRepositoryMockApproveParamPtrs{}
+	}
+	mmApprove.defaultExpectation.paramPtrs.approvedAt = &approvedAt
+
+	return mmApprove
+}
+
+// Inspect accepts an inspector function that has same arguments as the ElevationRepository.Approve
+func (mmApprove *mElevationRepositoryMockApprove) Inspect(f func(ctx context.Context, id int64, approverID int64, approvedAt time.Time)) *mElevationRepositoryMockApprove {
+	if mmApprove.mock.inspectFuncApprove != nil {
+		mmApprove.mock.t.Fatalf("Inspect function is already set for ElevationRepositoryMock.Approve")
+	}
+
+	mmApprove.mock.inspectFuncApprove = f
+
+	return mmApprove
+}
+
+// Return sets up results that will be returned by ElevationRepository.Approve
+func (mmApprove *mElevationRepositoryMockApprove) Return(err error) *ElevationRepositoryMock {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("ElevationRepositoryMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &ElevationRepositoryMockApproveExpectation{mock: mmApprove.mock}
+	}
+	mmApprove.defaultExpectation.results = &ElevationRepositoryMockApproveResults{err}
+	return mmApprove.mock
+}
+
+// Set uses given function f to mock the ElevationRepository.Approve method
+func (mmApprove *mElevationRepositoryMockApprove) Set(f func(ctx context.Context, id int64, approverID int64, approvedAt time.Time) (err error)) *ElevationRepositoryMock {
+	if mmApprove.defaultExpectation != nil {
+		mmApprove.mock.t.Fatalf("Default expectation is already set for the ElevationRepository.Approve method")
+	}
+
+	if len(mmApprove.expectations) > 0 {
+		mmApprove.mock.t.Fatalf("Some expectations are already set for the ElevationRepository.Approve method")
+	}
+
+	mmApprove.mock.funcApprove = f
+	return mmApprove.mock
+}
+
+// When sets expectation for the ElevationRepository.Approve which will trigger the result defined by the following
+// Then helper
+func (mmApprove *mElevationRepositoryMockApprove) When(ctx context.Context, id int64, approverID int64, approvedAt time.Time) *ElevationRepositoryMockApproveExpectation {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("ElevationRepositoryMock.Approve mock is already set by Set")
+	}
+
+	expectation := &ElevationRepositoryMockApproveExpectation{
+		mock:   mmApprove.mock,
+		params: &ElevationRepositoryMockApproveParams{ctx, id, approverID, approvedAt},
+	}
+	mmApprove.expectations = append(mmApprove.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ElevationRepository.Approve return parameters for the expectation previously defined by the When method
+func (e *ElevationRepositoryMockApproveExpectation) Then(err error) *ElevationRepositoryMock {
+	e.results = &ElevationRepositoryMockApproveResults{err}
+	return e.mock
+}
+
+// Times sets number of times ElevationRepository.Approve should be invoked
+func (mmApprove *mElevationRepositoryMockApprove) Times(n uint64) *mElevationRepositoryMockApprove {
+	if n == 0 {
+		mmApprove.mock.t.Fatalf("Times of ElevationRepositoryMock.Approve mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmApprove.expectedInvocations, n)
+	return mmApprove
+}
+
+func (mmApprove *mElevationRepositoryMockApprove) invocationsDone() bool {
+	if len(mmApprove.expectations) == 0 && mmApprove.defaultExpectation == nil && mmApprove.mock.funcApprove == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmApprove.mock.afterApproveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApprove.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Approve implements repository.ElevationRepository
+func (mmApprove *ElevationRepositoryMock) Approve(ctx context.Context, id int64, approverID int64, approvedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmApprove.beforeApproveCounter, 1)
+	defer mm_atomic.AddUint64(&mmApprove.afterApproveCounter, 1)
+
+	if mmApprove.inspectFuncApprove != nil {
+		mmApprove.inspectFuncApprove(ctx, id, approverID, approvedAt)
+	}
+
+	mm_params := ElevationRepositoryMockApproveParams{ctx, id, approverID, approvedAt}
+
+	// Record call args
+	mmApprove.ApproveMock.mutex.Lock()
+	mmApprove.ApproveMock.callArgs = append(mmApprove.ApproveMock.callArgs, &mm_params)
+	mmApprove.ApproveMock.mutex.Unlock()
+
+	for _, e := range mmApprove.ApproveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmApprove.ApproveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApprove.ApproveMock.defaultExpectation.Counter, 1)
+		mm_want := mmApprove.ApproveMock.defaultExpectation.params
+		mm_want_ptrs := mmApprove.ApproveMock.defaultExpectation.paramPtrs
+
+		mm_got := ElevationRepositoryMockApproveParams{ctx, id, approverID, approvedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmApprove.t.Errorf("ElevationRepositoryMock.Approve got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmApprove.t.Errorf("ElevationRepositoryMock.Approve got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.approverID != nil && !minimock.Equal(*mm_want_ptrs.approverID, mm_got.approverID) {
+				mmApprove.t.Errorf("ElevationRepositoryMock.Approve got unexpected parameter approverID, want: %#v, got: %#v%s\n", *mm_want_ptrs.approverID, mm_got.approverID, minimock.Diff(*mm_want_ptrs.approverID, mm_got.approverID))
+			}
+
+			if mm_want_ptrs.approvedAt != nil && !minimock.Equal(*mm_want_ptrs.approvedAt, mm_got.approvedAt) {
+				mmApprove.t.Errorf("ElevationRepositoryMock.Approve got unexpected parameter approvedAt, want: %#v, got: %#v%s\n", *mm_want_ptrs.approvedAt, mm_got.approvedAt, minimock.Diff(*mm_want_ptrs.approvedAt, mm_got.approvedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmApprove.t.Errorf("ElevationRepositoryMock.Approve got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmApprove.ApproveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmApprove.t.Fatal("No results are set for the ElevationRepositoryMock.Approve")
+		}
+		return (*mm_results).err
+	}
+	if mmApprove.funcApprove != nil {
+		return mmApprove.funcApprove(ctx, id, approverID, approvedAt)
+	}
+	mmApprove.t.Fatalf("Unexpected call to ElevationRepositoryMock.Approve. %v %v %v %v", ctx, id, approverID, approvedAt)
+	return
+}
+
+// ApproveAfterCounter returns a count of finished ElevationRepositoryMock.Approve invocations
+func (mmApprove *ElevationRepositoryMock) ApproveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApprove.afterApproveCounter)
+}
+
+// ApproveBeforeCounter returns a count of ElevationRepositoryMock.Approve invocations
+func (mmApprove *ElevationRepositoryMock) ApproveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApprove.beforeApproveCounter)
+}
+
+// Calls returns a list of arguments used in each call to ElevationRepositoryMock.Approve.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmApprove *mElevationRepositoryMockApprove) Calls() []*ElevationRepositoryMockApproveParams {
+	mmApprove.mutex.RLock()
+
+	argCopy := make([]*ElevationRepositoryMockApproveParams, len(mmApprove.callArgs))
+	copy(argCopy, mmApprove.callArgs)
+
+	mmApprove.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockApproveDone returns true if the count of the Approve invocations corresponds
+// the number of defined expectations
+func (m *ElevationRepositoryMock) MinimockApproveDone() bool {
+	if m.ApproveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ApproveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ApproveMock.invocationsDone()
+}
+
+// MinimockApproveInspect logs each unmet expectation
+func (m *ElevationRepositoryMock) MinimockApproveInspect() {
+	for _, e := range m.ApproveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ElevationRepositoryMock.Approve with params: %#v", *e.params)
+		}
+	}
+
+	afterApproveCounter := mm_atomic.LoadUint64(&m.afterApproveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApproveMock.defaultExpectation != nil && afterApproveCounter < 1 {
+		if m.ApproveMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ElevationRepositoryMock.Approve")
+		} else {
+			m.t.Errorf("Expected call to ElevationRepositoryMock.Approve with params: %#v", *m.ApproveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApprove != nil && afterApproveCounter < 1 {
+		m.t.Error("Expected call to ElevationRepositoryMock.Approve")
+	}
+
+	if !m.ApproveMock.invocationsDone() && afterApproveCounter > 0 {
+		m.t.Errorf("Expected %d calls to ElevationRepositoryMock.Approve but found %d calls",
+			mm_atomic.LoadUint64(&m.ApproveMock.expectedInvocations), afterApproveCounter)
+	}
+}
+
+type mElevationRepositoryMockCreate struct {
+	optional           bool
+	mock               *ElevationRepositoryMock
+	defaultExpectation *ElevationRepositoryMockCreateExpectation
+	expectations       []*ElevationRepositoryMockCreateExpectation
+
+	callArgs []*ElevationRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ElevationRepositoryMockCreateExpectation specifies expectation struct of the ElevationRepository.Create
+type ElevationRepositoryMockCreateExpectation struct {
+	mock      *ElevationRepositoryMock
+	params    *ElevationRepositoryMockCreateParams
+	paramPtrs *ElevationRepositoryMockCreateParamPtrs
+	results   *ElevationRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// ElevationRepositoryMockCreateParams contains parameters of the ElevationRepository.Create
+type ElevationRepositoryMockCreateParams struct {
+	ctx     context.Context
+	request *model.ElevationRequest
+}
+
+// ElevationRepositoryMockCreateParamPtrs contains pointers to parameters of the ElevationRepository.Create
+type ElevationRepositoryMockCreateParamPtrs struct {
+	ctx     *context.Context
+	request **model.ElevationRequest
+}
+
+// ElevationRepositoryMockCreateResults contains results of the ElevationRepository.Create
+type ElevationRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mElevationRepositoryMockCreate) Optional() *mElevationRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for ElevationRepository.Create
+func (mmCreate *mElevationRepositoryMockCreate) Expect(ctx context.Context, request *model.ElevationRequest) *mElevationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ElevationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ElevationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("ElevationRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &ElevationRepositoryMockCreateParams{ctx, request}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ElevationRepository.Create
+func (mmCreate *mElevationRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mElevationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ElevationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ElevationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("ElevationRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &ElevationRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectRequestParam2 sets up expected param request for ElevationRepository.Create
+func (mmCreate *mElevationRepositoryMockCreate) ExpectRequestParam2(request *model.ElevationRequest) *mElevationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ElevationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ElevationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("ElevationRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &ElevationRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.request = &request
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the ElevationRepository.Create
+func (mmCreate *mElevationRepositoryMockCreate) Inspect(f func(ctx context.Context, request *model.ElevationRequest)) *mElevationRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for ElevationRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by ElevationRepository.Create
+func (mmCreate *mElevationRepositoryMockCreate) Return(i1 int64, err error) *ElevationRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ElevationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ElevationRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &ElevationRepositoryMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the ElevationRepository.Create method
+func (mmCreate *mElevationRepositoryMockCreate) Set(f func(ctx context.Context, request *model.ElevationRequest) (i1 int64, err error)) *ElevationRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the ElevationRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the ElevationRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the ElevationRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mElevationRepositoryMockCreate) When(ctx context.Context, request *model.ElevationRequest) *ElevationRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ElevationRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &ElevationRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &ElevationRepositoryMockCreateParams{ctx, request},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ElevationRepository.Create return parameters for the expectation previously defined by the When method
+func (e *ElevationRepositoryMockCreateExpectation) Then(i1 int64, err error) *ElevationRepositoryMock {
+	e.results = &ElevationRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ElevationRepository.Create should be invoked
+func (mmCreate *mElevationRepositoryMockCreate) Times(n uint64) *mElevationRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of ElevationRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	return mmCreate
+}
+
+func (mmCreate *mElevationRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements repository.ElevationRepository
+func (mmCreate *ElevationRepositoryMock) Create(ctx context.Context, request *model.ElevationRequest) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, request)
+	}
+
+	mm_params := ElevationRepositoryMockCreateParams{ctx, request}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := ElevationRepositoryMockCreateParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("ElevationRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmCreate.t.Errorf("ElevationRepositoryMock.Create got unexpected parameter request, want: %#v, got: %#v%s\n", *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("ElevationRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the ElevationRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, request)
+	}
+	mmCreate.t.Fatalf("Unexpected call to ElevationRepositoryMock.Create. %v %v", ctx, request)
+	return
+}
+
+// CreateAfterCounter returns a count of finished ElevationRepositoryMock.Create invocations
+func (mmCreate *ElevationRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of ElevationRepositoryMock.Create invocations
+func (mmCreate *ElevationRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ElevationRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mElevationRepositoryMockCreate) Calls() []*ElevationRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*ElevationRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *ElevationRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *ElevationRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ElevationRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ElevationRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to ElevationRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Error("Expected call to ElevationRepositoryMock.Create")
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to ElevationRepositoryMock.Create but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), afterCreateCounter)
+	}
+}
+
+type mElevationRepositoryMockGet struct {
+	optional           bool
+	mock               *ElevationRepositoryMock
+	defaultExpectation *ElevationRepositoryMockGetExpectation
+	expectations       []*ElevationRepositoryMockGetExpectation
+
+	callArgs []*ElevationRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ElevationRepositoryMockGetExpectation specifies expectation struct of the ElevationRepository.Get
+type ElevationRepositoryMockGetExpectation struct {
+	mock      *ElevationRepositoryMock
+	params    *ElevationRepositoryMockGetParams
+	paramPtrs *ElevationRepositoryMockGetParamPtrs
+	results   *ElevationRepositoryMockGetResults
+	Counter   uint64
+}
+
+// ElevationRepositoryMockGetParams contains parameters of the ElevationRepository.Get
+type ElevationRepositoryMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ElevationRepositoryMockGetParamPtrs contains pointers to parameters of the ElevationRepository.Get
+type ElevationRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ElevationRepositoryMockGetResults contains results of the ElevationRepository.Get
+type ElevationRepositoryMockGetResults struct {
+	ep1 *model.ElevationRequest
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mElevationRepositoryMockGet) Optional() *mElevationRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for ElevationRepository.Get
+func (mmGet *mElevationRepositoryMockGet) Expect(ctx context.Context, id int64) *mElevationRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ElevationRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ElevationRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("ElevationRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &ElevationRepositoryMockGetParams{ctx, id}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ElevationRepository.Get
+func (mmGet *mElevationRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mElevationRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ElevationRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ElevationRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ElevationRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ElevationRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for ElevationRepository.Get
+func (mmGet *mElevationRepositoryMockGet) ExpectIdParam2(id int64) *mElevationRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ElevationRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ElevationRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ElevationRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ElevationRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the ElevationRepository.Get
+func (mmGet *mElevationRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mElevationRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for ElevationRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by ElevationRepository.Get
+func (mmGet *mElevationRepositoryMockGet) Return(ep1 *model.ElevationRequest, err error) *ElevationRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ElevationRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ElevationRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &ElevationRepositoryMockGetResults{ep1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the ElevationRepository.Get method
+func (mmGet *mElevationRepositoryMockGet) Set(f func(ctx context.Context, id int64) (ep1 *model.ElevationRequest, err error)) *ElevationRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the ElevationRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the ElevationRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the ElevationRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mElevationRepositoryMockGet) When(ctx context.Context, id int64) *ElevationRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ElevationRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &ElevationRepositoryMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &ElevationRepositoryMockGetParams{ctx, id},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ElevationRepository.Get return parameters for the expectation previously defined by the When method
+func (e *ElevationRepositoryMockGetExpectation) Then(ep1 *model.ElevationRequest, err error) *ElevationRepositoryMock {
+	e.results = &ElevationRepositoryMockGetResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times ElevationRepository.Get should be invoked
+func (mmGet *mElevationRepositoryMockGet) Times(n uint64) *mElevationRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of ElevationRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	return mmGet
+}
+
+func (mmGet *mElevationRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements repository.ElevationRepository
+func (mmGet *ElevationRepositoryMock) Get(ctx context.Context, id int64) (ep1 *model.ElevationRequest, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := ElevationRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := ElevationRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("ElevationRepositoryMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("ElevationRepositoryMock.Get got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("ElevationRepositoryMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the ElevationRepositoryMock.Get")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to ElevationRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished ElevationRepositoryMock.Get invocations
+func (mmGet *ElevationRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of ElevationRepositoryMock.Get invocations
+func (mmGet *ElevationRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to ElevationRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mElevationRepositoryMockGet) Calls() []*ElevationRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*ElevationRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *ElevationRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *ElevationRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ElevationRepositoryMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ElevationRepositoryMock.Get")
+		} else {
+			m.t.Errorf("Expected call to ElevationRepositoryMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Error("Expected call to ElevationRepositoryMock.Get")
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to ElevationRepositoryMock.Get but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), afterGetCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ElevationRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockApproveInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockGetInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ElevationRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ElevationRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockApproveDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockGetDone()
+}
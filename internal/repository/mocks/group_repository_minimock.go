@@ -0,0 +1,3166 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/auth/internal/repository.GroupRepository -o group_repository_minimock.go -n GroupRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// GroupRepositoryMock implements repository.GroupRepository
+type GroupRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddMember          func(ctx context.Context, groupID int64, userID int64) (err error)
+	inspectFuncAddMember   func(ctx context.Context, groupID int64, userID int64)
+	afterAddMemberCounter  uint64
+	beforeAddMemberCounter uint64
+	AddMemberMock          mGroupRepositoryMockAddMember
+
+	funcAssignRole          func(ctx context.Context, groupID int64, role string) (err error)
+	inspectFuncAssignRole   func(ctx context.Context, groupID int64, role string)
+	afterAssignRoleCounter  uint64
+	beforeAssignRoleCounter uint64
+	AssignRoleMock          mGroupRepositoryMockAssignRole
+
+	funcCreate          func(ctx context.Context, group *model.Group) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, group *model.Group)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mGroupRepositoryMockCreate
+
+	funcDelete          func(ctx context.Context, id int64) (err error)
+	inspectFuncDelete   func(ctx context.Context, id int64)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mGroupRepositoryMockDelete
+
+	funcGet          func(ctx context.Context, id int64) (gp1 *model.Group, err error)
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mGroupRepositoryMockGet
+
+	funcHasMember          func(ctx context.Context, groupID int64, userID int64) (b1 bool, err error)
+	inspectFuncHasMember   func(ctx context.Context, groupID int64, userID int64)
+	afterHasMemberCounter  uint64
+	beforeHasMemberCounter uint64
+	HasMemberMock          mGroupRepositoryMockHasMember
+
+	funcList          func(ctx context.Context) (gpa1 []*model.Group, err error)
+	inspectFuncList   func(ctx context.Context)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mGroupRepositoryMockList
+
+	funcRemoveMember          func(ctx context.Context, groupID int64, userID int64) (err error)
+	inspectFuncRemoveMember   func(ctx context.Context, groupID int64, userID int64)
+	afterRemoveMemberCounter  uint64
+	beforeRemoveMemberCounter uint64
+	RemoveMemberMock          mGroupRepositoryMockRemoveMember
+
+	funcRevokeRole          func(ctx context.Context, groupID int64, role string) (err error)
+	inspectFuncRevokeRole   func(ctx context.Context, groupID int64, role string)
+	afterRevokeRoleCounter  uint64
+	beforeRevokeRoleCounter uint64
+	RevokeRoleMock          mGroupRepositoryMockRevokeRole
+}
+
+// NewGroupRepositoryMock returns a mock for repository.GroupRepository
+func NewGroupRepositoryMock(t minimock.Tester) *GroupRepositoryMock {
+	m := &GroupRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddMemberMock = mGroupRepositoryMockAddMember{mock: m}
+	m.AddMemberMock.callArgs = []*GroupRepositoryMockAddMemberParams{}
+
+	m.AssignRoleMock = mGroupRepositoryMockAssignRole{mock: m}
+	m.AssignRoleMock.callArgs = []*GroupRepositoryMockAssignRoleParams{}
+
+	m.CreateMock = mGroupRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*GroupRepositoryMockCreateParams{}
+
+	m.DeleteMock = mGroupRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*GroupRepositoryMockDeleteParams{}
+
+	m.GetMock = mGroupRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*GroupRepositoryMockGetParams{}
+
+	m.HasMemberMock = mGroupRepositoryMockHasMember{mock: m}
+	m.HasMemberMock.callArgs = []*GroupRepositoryMockHasMemberParams{}
+
+	m.ListMock = mGroupRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*GroupRepositoryMockListParams{}
+
+	m.RemoveMemberMock = mGroupRepositoryMockRemoveMember{mock: m}
+	m.RemoveMemberMock.callArgs = []*GroupRepositoryMockRemoveMemberParams{}
+
+	m.RevokeRoleMock = mGroupRepositoryMockRevokeRole{mock: m}
+	m.RevokeRoleMock.callArgs = []*GroupRepositoryMockRevokeRoleParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mGroupRepositoryMockAddMember struct {
+	optional           bool
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockAddMemberExpectation
+	expectations       []*GroupRepositoryMockAddMemberExpectation
+
+	callArgs []*GroupRepositoryMockAddMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// GroupRepositoryMockAddMemberExpectation specifies expectation struct of the GroupRepository.AddMember
+type GroupRepositoryMockAddMemberExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockAddMemberParams
+	paramPtrs *GroupRepositoryMockAddMemberParamPtrs
+	results   *GroupRepositoryMockAddMemberResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockAddMemberParams contains parameters of the GroupRepository.AddMember
+type GroupRepositoryMockAddMemberParams struct {
+	ctx     context.Context
+	groupID int64
+	userID  int64
+}
+
+// GroupRepositoryMockAddMemberParamPtrs contains pointers to parameters of the GroupRepository.AddMember
+type GroupRepositoryMockAddMemberParamPtrs struct {
+	ctx     *context.Context
+	groupID *int64
+	userID  *int64
+}
+
+// GroupRepositoryMockAddMemberResults contains results of the GroupRepository.AddMember
+type GroupRepositoryMockAddMemberResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddMember *mGroupRepositoryMockAddMember) Optional() *mGroupRepositoryMockAddMember {
+	mmAddMember.optional = true
+	return mmAddMember
+}
+
+// Expect sets up expected params for GroupRepository.AddMember
+func (mmAddMember *mGroupRepositoryMockAddMember) Expect(ctx context.Context, groupID int64, userID int64) *mGroupRepositoryMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &GroupRepositoryMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by ExpectParams functions")
+	}
+
+	mmAddMember.defaultExpectation.params = &GroupRepositoryMockAddMemberParams{ctx, groupID, userID}
+	for _, e := range mmAddMember.expectations {
+		if minimock.Equal(e.params, mmAddMember.defaultExpectation.params) {
+			mmAddMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddMember.defaultExpectation.params)
+		}
+	}
+
+	return mmAddMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.AddMember
+func (mmAddMember *mGroupRepositoryMockAddMember) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &GroupRepositoryMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.params != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Expect")
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs == nil {
+		mmAddMember.defaultExpectation.paramPtrs = &GroupRepositoryMockAddMemberParamPtrs{}
+	}
+	mmAddMember.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAddMember
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for GroupRepository.AddMember
+func (mmAddMember *mGroupRepositoryMockAddMember) ExpectGroupIDParam2(groupID int64) *mGroupRepositoryMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &GroupRepositoryMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.params != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Expect")
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs == nil {
+		mmAddMember.defaultExpectation.paramPtrs = &GroupRepositoryMockAddMemberParamPtrs{}
+	}
+	mmAddMember.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmAddMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for GroupRepository.AddMember
+func (mmAddMember *mGroupRepositoryMockAddMember) ExpectUserIDParam3(userID int64) *mGroupRepositoryMockAddMember {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &GroupRepositoryMockAddMemberExpectation{}
+	}
+
+	if mmAddMember.defaultExpectation.params != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Expect")
+	}
+
+	if mmAddMember.defaultExpectation.paramPtrs == nil {
+		mmAddMember.defaultExpectation.paramPtrs = &GroupRepositoryMockAddMemberParamPtrs{}
+	}
+	mmAddMember.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmAddMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.AddMember
+func (mmAddMember *mGroupRepositoryMockAddMember) Inspect(f func(ctx context.Context, groupID int64, userID int64)) *mGroupRepositoryMockAddMember {
+	if mmAddMember.mock.inspectFuncAddMember != nil {
+		mmAddMember.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.AddMember")
+	}
+
+	mmAddMember.mock.inspectFuncAddMember = f
+
+	return mmAddMember
+}
+
+// Return sets up results that will be returned by GroupRepository.AddMember
+func (mmAddMember *mGroupRepositoryMockAddMember) Return(err error) *GroupRepositoryMock {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	if mmAddMember.defaultExpectation == nil {
+		mmAddMember.defaultExpectation = &GroupRepositoryMockAddMemberExpectation{mock: mmAddMember.mock}
+	}
+	mmAddMember.defaultExpectation.results = &GroupRepositoryMockAddMemberResults{err}
+	return mmAddMember.mock
+}
+
+// Set uses given function f to mock the GroupRepository.AddMember method
+func (mmAddMember *mGroupRepositoryMockAddMember) Set(f func(ctx context.Context, groupID int64, userID int64) (err error)) *GroupRepositoryMock {
+	if mmAddMember.defaultExpectation != nil {
+		mmAddMember.mock.t.Fatalf("Default expectation is already set for the GroupRepository.AddMember method")
+	}
+
+	if len(mmAddMember.expectations) > 0 {
+		mmAddMember.mock.t.Fatalf("Some expectations are already set for the GroupRepository.AddMember method")
+	}
+
+	mmAddMember.mock.funcAddMember = f
+	return mmAddMember.mock
+}
+
+// When sets expectation for the GroupRepository.AddMember which will trigger the result defined by the following
+// Then helper
+func (mmAddMember *mGroupRepositoryMockAddMember) When(ctx context.Context, groupID int64, userID int64) *GroupRepositoryMockAddMemberExpectation {
+	if mmAddMember.mock.funcAddMember != nil {
+		mmAddMember.mock.t.Fatalf("GroupRepositoryMock.AddMember mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockAddMemberExpectation{
+		mock:   mmAddMember.mock,
+		params: &GroupRepositoryMockAddMemberParams{ctx, groupID, userID},
+	}
+	mmAddMember.expectations = append(mmAddMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.AddMember return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockAddMemberExpectation) Then(err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockAddMemberResults{err}
+	return e.mock
+}
+
+// Times sets number of times GroupRepository.AddMember should be invoked
+func (mmAddMember *mGroupRepositoryMockAddMember) Times(n uint64) *mGroupRepositoryMockAddMember {
+	if n == 0 {
+		mmAddMember.mock.t.Fatalf("Times of GroupRepositoryMock.AddMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddMember.expectedInvocations, n)
+	return mmAddMember
+}
+
+func (mmAddMember *mGroupRepositoryMockAddMember) invocationsDone() bool {
+	if len(mmAddMember.expectations) == 0 && mmAddMember.defaultExpectation == nil && mmAddMember.mock.funcAddMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddMember.mock.afterAddMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddMember implements repository.GroupRepository
+func (mmAddMember *GroupRepositoryMock) AddMember(ctx context.Context, groupID int64, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmAddMember.beforeAddMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddMember.afterAddMemberCounter, 1)
+
+	if mmAddMember.inspectFuncAddMember != nil {
+		mmAddMember.inspectFuncAddMember(ctx, groupID, userID)
+	}
+
+	mm_params := GroupRepositoryMockAddMemberParams{ctx, groupID, userID}
+
+	// Record call args
+	mmAddMember.AddMemberMock.mutex.Lock()
+	mmAddMember.AddMemberMock.callArgs = append(mmAddMember.AddMemberMock.callArgs, &mm_params)
+	mmAddMember.AddMemberMock.mutex.Unlock()
+
+	for _, e := range mmAddMember.AddMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddMember.AddMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddMember.AddMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddMember.AddMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmAddMember.AddMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockAddMemberParams{ctx, groupID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddMember.t.Errorf("GroupRepositoryMock.AddMember got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmAddMember.t.Errorf("GroupRepositoryMock.AddMember got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddMember.t.Errorf("GroupRepositoryMock.AddMember got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddMember.t.Errorf("GroupRepositoryMock.AddMember got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddMember.AddMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddMember.t.Fatal("No results are set for the GroupRepositoryMock.AddMember")
+		}
+		return (*mm_results).err
+	}
+	if mmAddMember.funcAddMember != nil {
+		return mmAddMember.funcAddMember(ctx, groupID, userID)
+	}
+	mmAddMember.t.Fatalf("Unexpected call to GroupRepositoryMock.AddMember. %v %v %v", ctx, groupID, userID)
+	return
+}
+
+// AddMemberAfterCounter returns a count of finished GroupRepositoryMock.AddMember invocations
+func (mmAddMember *GroupRepositoryMock) AddMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMember.afterAddMemberCounter)
+}
+
+// AddMemberBeforeCounter returns a count of GroupRepositoryMock.AddMember invocations
+func (mmAddMember *GroupRepositoryMock) AddMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMember.beforeAddMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.AddMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddMember *mGroupRepositoryMockAddMember) Calls() []*GroupRepositoryMockAddMemberParams {
+	mmAddMember.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockAddMemberParams, len(mmAddMember.callArgs))
+	copy(argCopy, mmAddMember.callArgs)
+
+	mmAddMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddMemberDone returns true if the count of the AddMember invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockAddMemberDone() bool {
+	if m.AddMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddMemberMock.invocationsDone()
+}
+
+// MinimockAddMemberInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockAddMemberInspect() {
+	for _, e := range m.AddMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.AddMember with params: %#v", *e.params)
+		}
+	}
+
+	afterAddMemberCounter := mm_atomic.LoadUint64(&m.afterAddMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMemberMock.defaultExpectation != nil && afterAddMemberCounter < 1 {
+		if m.AddMemberMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.AddMember")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.AddMember with params: %#v", *m.AddMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddMember != nil && afterAddMemberCounter < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.AddMember")
+	}
+
+	if !m.AddMemberMock.invocationsDone() && afterAddMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to GroupRepositoryMock.AddMember but found %d calls",
+			mm_atomic.LoadUint64(&m.AddMemberMock.expectedInvocations), afterAddMemberCounter)
+	}
+}
+
+type mGroupRepositoryMockAssignRole struct {
+	optional           bool
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockAssignRoleExpectation
+	expectations       []*GroupRepositoryMockAssignRoleExpectation
+
+	callArgs []*GroupRepositoryMockAssignRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// GroupRepositoryMockAssignRoleExpectation specifies expectation struct of the GroupRepository.AssignRole
+type GroupRepositoryMockAssignRoleExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockAssignRoleParams
+	paramPtrs *GroupRepositoryMockAssignRoleParamPtrs
+	results   *GroupRepositoryMockAssignRoleResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockAssignRoleParams contains parameters of the GroupRepository.AssignRole
+type GroupRepositoryMockAssignRoleParams struct {
+	ctx     context.Context
+	groupID int64
+	role    string
+}
+
+// GroupRepositoryMockAssignRoleParamPtrs contains pointers to parameters of the GroupRepository.AssignRole
+type GroupRepositoryMockAssignRoleParamPtrs struct {
+	ctx     *context.Context
+	groupID *int64
+	role    *string
+}
+
+// GroupRepositoryMockAssignRoleResults contains results of the GroupRepository.AssignRole
+type GroupRepositoryMockAssignRoleResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAssignRole *mGroupRepositoryMockAssignRole) Optional() *mGroupRepositoryMockAssignRole {
+	mmAssignRole.optional = true
+	return mmAssignRole
+}
+
+// Expect sets up expected params for GroupRepository.AssignRole
+func (mmAssignRole *mGroupRepositoryMockAssignRole) Expect(ctx context.Context, groupID int64, role string) *mGroupRepositoryMockAssignRole {
+	if mmAssignRole.mock.funcAssignRole != nil {
+		mmAssignRole.mock.t.Fatalf("GroupRepositoryMock.AssignRole mock is already set by Set")
+	}
+
+	if mmAssignRole.defaultExpectation == nil {
+		mmAssignRole.defaultExpectation = &GroupRepositoryMockAssignRoleExpectation{}
+	}
+
+	if mmAssignRole.defaultExpectation.paramPtrs != nil {
+		mmAssignRole.mock.t.Fatalf("GroupRepositoryMock.AssignRole mock is already set by ExpectParams functions")
+	}
+
+	mmAssignRole.defaultExpectation.params = &GroupRepositoryMockAssignRoleParams{ctx, groupID, role}
+	for _, e := range mmAssignRole.expectations {
+		if minimock.Equal(e.params, mmAssignRole.defaultExpectation.params) {
+			mmAssignRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAssignRole.defaultExpectation.params)
+		}
+	}
+
+	return mmAssignRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.AssignRole
+func (mmAssignRole *mGroupRepositoryMockAssignRole) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockAssignRole {
+	if mmAssignRole.mock.funcAssignRole != nil {
+		mmAssignRole.mock.t.Fatalf("GroupRepositoryMock.AssignRole mock is already set by Set")
+	}
+
+	if mmAssignRole.defaultExpectation == nil {
+		mmAssignRole.defaultExpectation = &GroupRepositoryMockAssignRoleExpectation{}
+	}
+
+	if mmAssignRole.defaultExpectation.params != nil {
+		mmAssignRole.mock.t.Fatalf("GroupRepositoryMock.AssignRole mock is already set by Expect")
+	}
+
+	if mmAssignRole.defaultExpectation.paramPtrs == nil {
+		mmAssignRole.defaultExpectation.paramPtrs = &GroupRepositoryMockAssignRoleParamPtrs{}
+	}
+	mmAssignRole.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAssignRole
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for GroupRepository.AssignRole
+func (mmAssignRole *mGroupRepositoryMockAssignRole) ExpectGroupIDParam2(groupID int64) *mGroupRepositoryMockAssignRole {
+	if mmAssignRole.mock.funcAssignRole != nil {
+		mmAssignRole.mock.t.Fatalf("GroupRepositoryMock.AssignRole mock is already set by Set")
+	}
+
+	if mmAssignRole.defaultExpectation == nil {
+		mmAssignRole.defaultExpectation = &GroupRepositoryMockAssignRoleExpectation{}
+	}
+
+	if mmAssignRole.defaultExpectation.params != nil {
+		mmAssignRole.mock.t.Fatalf("GroupRepositoryMock.AssignRole mock is already set by Expect")
+	}
+
+	if mmAssignRole.defaultExpectation.paramPtrs == nil {
+		mmAssignRole.defaultExpectation.paramPtrs = &GroupRepositoryMockAssignRoleParamPtrs{}
+	}
+	mmAssignRole.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmAssignRole
+}
+
+// ExpectRoleParam3 sets up expected param role for GroupRepository.AssignRole
+func (mmAssignRole *mGroupRepositoryMockAssignRole) ExpectRoleParam3(role string) *mGroupRepositoryMockAssignRole {
+	if mmAssignRole.mock.funcAssignRole != nil {
+		mmAssignRole.mock.t.Fatalf("GroupRepositoryMock.AssignRole mock is already set by Set")
+	}
+
+	if mmAssignRole.defaultExpectation == nil {
+		mmAssignRole.defaultExpectation = &GroupRepositoryMockAssignRoleExpectation{}
+	}
+
+	if mmAssignRole.defaultExpectation.params != nil {
+		mmAssignRole.mock.t.Fatalf("GroupRepositoryMock.AssignRole mock is already set by Expect")
+	}
+
+	if mmAssignRole.defaultExpectation.paramPtrs == nil {
+		mmAssignRole.defaultExpectation.paramPtrs = &GroupRepositoryMockAssignRoleParamPtrs{}
+	}
+	mmAssignRole.defaultExpectation.paramPtrs.role = &role
+
+	return mmAssignRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.AssignRole
+func (mmAssignRole *mGroupRepositoryMockAssignRole) Inspect(f func(ctx context.Context, groupID int64, role string)) *mGroupRepositoryMockAssignRole {
+	if mmAssignRole.mock.inspectFuncAssignRole != nil {
+		mmAssignRole.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.AssignRole")
+	}
+
+	mmAssignRole.mock.inspectFuncAssignRole = f
+
+	return mmAssignRole
+}
+
+// Return sets up results that will be returned by GroupRepository.AssignRole
+func (mmAssignRole *mGroupRepositoryMockAssignRole) Return(err error) *GroupRepositoryMock {
+	if mmAssignRole.mock.funcAssignRole != nil {
+		mmAssignRole.mock.t.Fatalf("GroupRepositoryMock.AssignRole mock is already set by Set")
+	}
+
+	if mmAssignRole.defaultExpectation == nil {
+		mmAssignRole.defaultExpectation = &GroupRepositoryMockAssignRoleExpectation{mock: mmAssignRole.mock}
+	}
+	mmAssignRole.defaultExpectation.results = &GroupRepositoryMockAssignRoleResults{err}
+	return mmAssignRole.mock
+}
+
+// Set uses given function f to mock the GroupRepository.AssignRole method
+func (mmAssignRole *mGroupRepositoryMockAssignRole) Set(f func(ctx context.Context, groupID int64, role string) (err error)) *GroupRepositoryMock {
+	if mmAssignRole.defaultExpectation != nil {
+		mmAssignRole.mock.t.Fatalf("Default expectation is already set for the GroupRepository.AssignRole method")
+	}
+
+	if len(mmAssignRole.expectations) > 0 {
+		mmAssignRole.mock.t.Fatalf("Some expectations are already set for the GroupRepository.AssignRole method")
+	}
+
+	mmAssignRole.mock.funcAssignRole = f
+	return mmAssignRole.mock
+}
+
+// When sets expectation for the GroupRepository.AssignRole which will trigger the result defined by the following
+// Then helper
+func (mmAssignRole *mGroupRepositoryMockAssignRole) When(ctx context.Context, groupID int64, role string) *GroupRepositoryMockAssignRoleExpectation {
+	if mmAssignRole.mock.funcAssignRole != nil {
+		mmAssignRole.mock.t.Fatalf("GroupRepositoryMock.AssignRole mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockAssignRoleExpectation{
+		mock:   mmAssignRole.mock,
+		params: &GroupRepositoryMockAssignRoleParams{ctx, groupID, role},
+	}
+	mmAssignRole.expectations = append(mmAssignRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.AssignRole return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockAssignRoleExpectation) Then(err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockAssignRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times GroupRepository.AssignRole should be invoked
+func (mmAssignRole *mGroupRepositoryMockAssignRole) Times(n uint64) *mGroupRepositoryMockAssignRole {
+	if n == 0 {
+		mmAssignRole.mock.t.Fatalf("Times of GroupRepositoryMock.AssignRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAssignRole.expectedInvocations, n)
+	return mmAssignRole
+}
+
+func (mmAssignRole *mGroupRepositoryMockAssignRole) invocationsDone() bool {
+	if len(mmAssignRole.expectations) == 0 && mmAssignRole.defaultExpectation == nil && mmAssignRole.mock.funcAssignRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAssignRole.mock.afterAssignRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAssignRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AssignRole implements repository.GroupRepository
+func (mmAssignRole *GroupRepositoryMock) AssignRole(ctx context.Context, groupID int64, role string) (err error) {
+	mm_atomic.AddUint64(&mmAssignRole.beforeAssignRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmAssignRole.afterAssignRoleCounter, 1)
+
+	if mmAssignRole.inspectFuncAssignRole != nil {
+		mmAssignRole.inspectFuncAssignRole(ctx, groupID, role)
+	}
+
+	mm_params := GroupRepositoryMockAssignRoleParams{ctx, groupID, role}
+
+	// Record call args
+	mmAssignRole.AssignRoleMock.mutex.Lock()
+	mmAssignRole.AssignRoleMock.callArgs = append(mmAssignRole.AssignRoleMock.callArgs, &mm_params)
+	mmAssignRole.AssignRoleMock.mutex.Unlock()
+
+	for _, e := range mmAssignRole.AssignRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAssignRole.AssignRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAssignRole.AssignRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmAssignRole.AssignRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmAssignRole.AssignRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockAssignRoleParams{ctx, groupID, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAssignRole.t.Errorf("GroupRepositoryMock.AssignRole got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmAssignRole.t.Errorf("GroupRepositoryMock.AssignRole got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmAssignRole.t.Errorf("GroupRepositoryMock.AssignRole got unexpected parameter role, want: %#v, got: %#v%s\n", *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAssignRole.t.Errorf("GroupRepositoryMock.AssignRole got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAssignRole.AssignRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAssignRole.t.Fatal("No results are set for the GroupRepositoryMock.AssignRole")
+		}
+		return (*mm_results).err
+	}
+	if mmAssignRole.funcAssignRole != nil {
+		return mmAssignRole.funcAssignRole(ctx, groupID, role)
+	}
+	mmAssignRole.t.Fatalf("Unexpected call to GroupRepositoryMock.AssignRole. %v %v %v", ctx, groupID, role)
+	return
+}
+
+// AssignRoleAfterCounter returns a count of finished GroupRepositoryMock.AssignRole invocations
+func (mmAssignRole *GroupRepositoryMock) AssignRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAssignRole.afterAssignRoleCounter)
+}
+
+// AssignRoleBeforeCounter returns a count of GroupRepositoryMock.AssignRole invocations
+func (mmAssignRole *GroupRepositoryMock) AssignRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAssignRole.beforeAssignRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.AssignRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAssignRole *mGroupRepositoryMockAssignRole) Calls() []*GroupRepositoryMockAssignRoleParams {
+	mmAssignRole.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockAssignRoleParams, len(mmAssignRole.callArgs))
+	copy(argCopy, mmAssignRole.callArgs)
+
+	mmAssignRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAssignRoleDone returns true if the count of the AssignRole invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockAssignRoleDone() bool {
+	if m.AssignRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AssignRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AssignRoleMock.invocationsDone()
+}
+
+// MinimockAssignRoleInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockAssignRoleInspect() {
+	for _, e := range m.AssignRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.AssignRole with params: %#v", *e.params)
+		}
+	}
+
+	afterAssignRoleCounter := mm_atomic.LoadUint64(&m.afterAssignRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AssignRoleMock.defaultExpectation != nil && afterAssignRoleCounter < 1 {
+		if m.AssignRoleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.AssignRole")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.AssignRole with params: %#v", *m.AssignRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAssignRole != nil && afterAssignRoleCounter < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.AssignRole")
+	}
+
+	if !m.AssignRoleMock.invocationsDone() && afterAssignRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to GroupRepositoryMock.AssignRole but found %d calls",
+			mm_atomic.LoadUint64(&m.AssignRoleMock.expectedInvocations), afterAssignRoleCounter)
+	}
+}
+
+type mGroupRepositoryMockCreate struct {
+	optional           bool
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockCreateExpectation
+	expectations       []*GroupRepositoryMockCreateExpectation
+
+	callArgs []*GroupRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// GroupRepositoryMockCreateExpectation specifies expectation struct of the GroupRepository.Create
+type GroupRepositoryMockCreateExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockCreateParams
+	paramPtrs *GroupRepositoryMockCreateParamPtrs
+	results   *GroupRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockCreateParams contains parameters of the GroupRepository.Create
+type GroupRepositoryMockCreateParams struct {
+	ctx   context.Context
+	group *model.Group
+}
+
+// GroupRepositoryMockCreateParamPtrs contains pointers to parameters of the GroupRepository.Create
+type GroupRepositoryMockCreateParamPtrs struct {
+	ctx   *context.Context
+	group **model.Group
+}
+
+// GroupRepositoryMockCreateResults contains results of the GroupRepository.Create
+type GroupRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mGroupRepositoryMockCreate) Optional() *mGroupRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for GroupRepository.Create
+func (mmCreate *mGroupRepositoryMockCreate) Expect(ctx context.Context, group *model.Group) *mGroupRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &GroupRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &GroupRepositoryMockCreateParams{ctx, group}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.Create
+func (mmCreate *mGroupRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &GroupRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &GroupRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectGroupParam2 sets up expected param group for GroupRepository.Create
+func (mmCreate *mGroupRepositoryMockCreate) ExpectGroupParam2(group *model.Group) *mGroupRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &GroupRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &GroupRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.group = &group
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.Create
+func (mmCreate *mGroupRepositoryMockCreate) Inspect(f func(ctx context.Context, group *model.Group)) *mGroupRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by GroupRepository.Create
+func (mmCreate *mGroupRepositoryMockCreate) Return(i1 int64, err error) *GroupRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &GroupRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &GroupRepositoryMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the GroupRepository.Create method
+func (mmCreate *mGroupRepositoryMockCreate) Set(f func(ctx context.Context, group *model.Group) (i1 int64, err error)) *GroupRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the GroupRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the GroupRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the GroupRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mGroupRepositoryMockCreate) When(ctx context.Context, group *model.Group) *GroupRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("GroupRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &GroupRepositoryMockCreateParams{ctx, group},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.Create return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockCreateExpectation) Then(i1 int64, err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times GroupRepository.Create should be invoked
+func (mmCreate *mGroupRepositoryMockCreate) Times(n uint64) *mGroupRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of GroupRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	return mmCreate
+}
+
+func (mmCreate *mGroupRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements repository.GroupRepository
+func (mmCreate *GroupRepositoryMock) Create(ctx context.Context, group *model.Group) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, group)
+	}
+
+	mm_params := GroupRepositoryMockCreateParams{ctx, group}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockCreateParams{ctx, group}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("GroupRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.group != nil && !minimock.Equal(*mm_want_ptrs.group, mm_got.group) {
+				mmCreate.t.Errorf("GroupRepositoryMock.Create got unexpected parameter group, want: %#v, got: %#v%s\n", *mm_want_ptrs.group, mm_got.group, minimock.Diff(*mm_want_ptrs.group, mm_got.group))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("GroupRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the GroupRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, group)
+	}
+	mmCreate.t.Fatalf("Unexpected call to GroupRepositoryMock.Create. %v %v", ctx, group)
+	return
+}
+
+// CreateAfterCounter returns a count of finished GroupRepositoryMock.Create invocations
+func (mmCreate *GroupRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of GroupRepositoryMock.Create invocations
+func (mmCreate *GroupRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mGroupRepositoryMockCreate) Calls() []*GroupRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.Create")
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to GroupRepositoryMock.Create but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), afterCreateCounter)
+	}
+}
+
+type mGroupRepositoryMockDelete struct {
+	optional           bool
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockDeleteExpectation
+	expectations       []*GroupRepositoryMockDeleteExpectation
+
+	callArgs []*GroupRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// GroupRepositoryMockDeleteExpectation specifies expectation struct of the GroupRepository.Delete
+type GroupRepositoryMockDeleteExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockDeleteParams
+	paramPtrs *GroupRepositoryMockDeleteParamPtrs
+	results   *GroupRepositoryMockDeleteResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockDeleteParams contains parameters of the GroupRepository.Delete
+type GroupRepositoryMockDeleteParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// GroupRepositoryMockDeleteParamPtrs contains pointers to parameters of the GroupRepository.Delete
+type GroupRepositoryMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// GroupRepositoryMockDeleteResults contains results of the GroupRepository.Delete
+type GroupRepositoryMockDeleteResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mGroupRepositoryMockDelete) Optional() *mGroupRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for GroupRepository.Delete
+func (mmDelete *mGroupRepositoryMockDelete) Expect(ctx context.Context, id int64) *mGroupRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &GroupRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &GroupRepositoryMockDeleteParams{ctx, id}
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.Delete
+func (mmDelete *mGroupRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &GroupRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &GroupRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for GroupRepository.Delete
+func (mmDelete *mGroupRepositoryMockDelete) ExpectIdParam2(id int64) *mGroupRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &GroupRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &GroupRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.Delete
+func (mmDelete *mGroupRepositoryMockDelete) Inspect(f func(ctx context.Context, id int64)) *mGroupRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by GroupRepository.Delete
+func (mmDelete *mGroupRepositoryMockDelete) Return(err error) *GroupRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &GroupRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &GroupRepositoryMockDeleteResults{err}
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the GroupRepository.Delete method
+func (mmDelete *mGroupRepositoryMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *GroupRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the GroupRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the GroupRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	return mmDelete.mock
+}
+
+// When sets expectation for the GroupRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mGroupRepositoryMockDelete) When(ctx context.Context, id int64) *GroupRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("GroupRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockDeleteExpectation{
+		mock:   mmDelete.mock,
+		params: &GroupRepositoryMockDeleteParams{ctx, id},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockDeleteExpectation) Then(err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times GroupRepository.Delete should be invoked
+func (mmDelete *mGroupRepositoryMockDelete) Times(n uint64) *mGroupRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of GroupRepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	return mmDelete
+}
+
+func (mmDelete *mGroupRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements repository.GroupRepository
+func (mmDelete *GroupRepositoryMock) Delete(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := GroupRepositoryMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("GroupRepositoryMock.Delete got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("GroupRepositoryMock.Delete got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("GroupRepositoryMock.Delete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the GroupRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to GroupRepositoryMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished GroupRepositoryMock.Delete invocations
+func (mmDelete *GroupRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of GroupRepositoryMock.Delete invocations
+func (mmDelete *GroupRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mGroupRepositoryMockDelete) Calls() []*GroupRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.Delete with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.Delete")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.Delete with params: %#v", *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.Delete")
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to GroupRepositoryMock.Delete but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), afterDeleteCounter)
+	}
+}
+
+type mGroupRepositoryMockGet struct {
+	optional           bool
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockGetExpectation
+	expectations       []*GroupRepositoryMockGetExpectation
+
+	callArgs []*GroupRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// GroupRepositoryMockGetExpectation specifies expectation struct of the GroupRepository.Get
+type GroupRepositoryMockGetExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockGetParams
+	paramPtrs *GroupRepositoryMockGetParamPtrs
+	results   *GroupRepositoryMockGetResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockGetParams contains parameters of the GroupRepository.Get
+type GroupRepositoryMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// GroupRepositoryMockGetParamPtrs contains pointers to parameters of the GroupRepository.Get
+type GroupRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// GroupRepositoryMockGetResults contains results of the GroupRepository.Get
+type GroupRepositoryMockGetResults struct {
+	gp1 *model.Group
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mGroupRepositoryMockGet) Optional() *mGroupRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for GroupRepository.Get
+func (mmGet *mGroupRepositoryMockGet) Expect(ctx context.Context, id int64) *mGroupRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &GroupRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &GroupRepositoryMockGetParams{ctx, id}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.Get
+func (mmGet *mGroupRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &GroupRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &GroupRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for GroupRepository.Get
+func (mmGet *mGroupRepositoryMockGet) ExpectIdParam2(id int64) *mGroupRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &GroupRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &GroupRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.Get
+func (mmGet *mGroupRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mGroupRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by GroupRepository.Get
+func (mmGet *mGroupRepositoryMockGet) Return(gp1 *model.Group, err error) *GroupRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &GroupRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &GroupRepositoryMockGetResults{gp1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the GroupRepository.Get method
+func (mmGet *mGroupRepositoryMockGet) Set(f func(ctx context.Context, id int64) (gp1 *model.Group, err error)) *GroupRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the GroupRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the GroupRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the GroupRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mGroupRepositoryMockGet) When(ctx context.Context, id int64) *GroupRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("GroupRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &GroupRepositoryMockGetParams{ctx, id},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.Get return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockGetExpectation) Then(gp1 *model.Group, err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockGetResults{gp1, err}
+	return e.mock
+}
+
+// Times sets number of times GroupRepository.Get should be invoked
+func (mmGet *mGroupRepositoryMockGet) Times(n uint64) *mGroupRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of GroupRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	return mmGet
+}
+
+func (mmGet *mGroupRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements repository.GroupRepository
+func (mmGet *GroupRepositoryMock) Get(ctx context.Context, id int64) (gp1 *model.Group, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := GroupRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.gp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("GroupRepositoryMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("GroupRepositoryMock.Get got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("GroupRepositoryMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the GroupRepositoryMock.Get")
+		}
+		return (*mm_results).gp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to GroupRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished GroupRepositoryMock.Get invocations
+func (mmGet *GroupRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of GroupRepositoryMock.Get invocations
+func (mmGet *GroupRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mGroupRepositoryMockGet) Calls() []*GroupRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.Get")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.Get")
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to GroupRepositoryMock.Get but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), afterGetCounter)
+	}
+}
+
+type mGroupRepositoryMockHasMember struct {
+	optional           bool
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockHasMemberExpectation
+	expectations       []*GroupRepositoryMockHasMemberExpectation
+
+	callArgs []*GroupRepositoryMockHasMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// GroupRepositoryMockHasMemberExpectation specifies expectation struct of the GroupRepository.HasMember
+type GroupRepositoryMockHasMemberExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockHasMemberParams
+	paramPtrs *GroupRepositoryMockHasMemberParamPtrs
+	results   *GroupRepositoryMockHasMemberResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockHasMemberParams contains parameters of the GroupRepository.HasMember
+type GroupRepositoryMockHasMemberParams struct {
+	ctx     context.Context
+	groupID int64
+	userID  int64
+}
+
+// GroupRepositoryMockHasMemberParamPtrs contains pointers to parameters of the GroupRepository.HasMember
+type GroupRepositoryMockHasMemberParamPtrs struct {
+	ctx     *context.Context
+	groupID *int64
+	userID  *int64
+}
+
+// GroupRepositoryMockHasMemberResults contains results of the GroupRepository.HasMember
+type GroupRepositoryMockHasMemberResults struct {
+	b1  bool
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHasMember *mGroupRepositoryMockHasMember) Optional() *mGroupRepositoryMockHasMember {
+	mmHasMember.optional = true
+	return mmHasMember
+}
+
+// Expect sets up expected params for GroupRepository.HasMember
+func (mmHasMember *mGroupRepositoryMockHasMember) Expect(ctx context.Context, groupID int64, userID int64) *mGroupRepositoryMockHasMember {
+	if mmHasMember.mock.funcHasMember != nil {
+		mmHasMember.mock.t.Fatalf("GroupRepositoryMock.HasMember mock is already set by Set")
+	}
+
+	if mmHasMember.defaultExpectation == nil {
+		mmHasMember.defaultExpectation = &GroupRepositoryMockHasMemberExpectation{}
+	}
+
+	if mmHasMember.defaultExpectation.paramPtrs != nil {
+		mmHasMember.mock.t.Fatalf("GroupRepositoryMock.HasMember mock is already set by ExpectParams functions")
+	}
+
+	mmHasMember.defaultExpectation.params = &GroupRepositoryMockHasMemberParams{ctx, groupID, userID}
+	for _, e := range mmHasMember.expectations {
+		if minimock.Equal(e.params, mmHasMember.defaultExpectation.params) {
+			mmHasMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHasMember.defaultExpectation.params)
+		}
+	}
+
+	return mmHasMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.HasMember
+func (mmHasMember *mGroupRepositoryMockHasMember) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockHasMember {
+	if mmHasMember.mock.funcHasMember != nil {
+		mmHasMember.mock.t.Fatalf("GroupRepositoryMock.HasMember mock is already set by Set")
+	}
+
+	if mmHasMember.defaultExpectation == nil {
+		mmHasMember.defaultExpectation = &GroupRepositoryMockHasMemberExpectation{}
+	}
+
+	if mmHasMember.defaultExpectation.params != nil {
+		mmHasMember.mock.t.Fatalf("GroupRepositoryMock.HasMember mock is already set by Expect")
+	}
+
+	if mmHasMember.defaultExpectation.paramPtrs == nil {
+		mmHasMember.defaultExpectation.paramPtrs = &GroupRepositoryMockHasMemberParamPtrs{}
+	}
+	mmHasMember.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmHasMember
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for GroupRepository.HasMember
+func (mmHasMember *mGroupRepositoryMockHasMember) ExpectGroupIDParam2(groupID int64) *mGroupRepositoryMockHasMember {
+	if mmHasMember.mock.funcHasMember != nil {
+		mmHasMember.mock.t.Fatalf("GroupRepositoryMock.HasMember mock is already set by Set")
+	}
+
+	if mmHasMember.defaultExpectation == nil {
+		mmHasMember.defaultExpectation = &GroupRepositoryMockHasMemberExpectation{}
+	}
+
+	if mmHasMember.defaultExpectation.params != nil {
+		mmHasMember.mock.t.Fatalf("GroupRepositoryMock.HasMember mock is already set by Expect")
+	}
+
+	if mmHasMember.defaultExpectation.paramPtrs == nil {
+		mmHasMember.defaultExpectation.paramPtrs = &GroupRepositoryMockHasMemberParamPtrs{}
+	}
+	mmHasMember.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmHasMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for GroupRepository.HasMember
+func (mmHasMember *mGroupRepositoryMockHasMember) ExpectUserIDParam3(userID int64) *mGroupRepositoryMockHasMember {
+	if mmHasMember.mock.funcHasMember != nil {
+		mmHasMember.mock.t.Fatalf("GroupRepositoryMock.HasMember mock is already set by Set")
+	}
+
+	if mmHasMember.defaultExpectation == nil {
+		mmHasMember.defaultExpectation = &GroupRepositoryMockHasMemberExpectation{}
+	}
+
+	if mmHasMember.defaultExpectation.params != nil {
+		mmHasMember.mock.t.Fatalf("GroupRepositoryMock.HasMember mock is already set by Expect")
+	}
+
+	if mmHasMember.defaultExpectation.paramPtrs == nil {
+		mmHasMember.defaultExpectation.paramPtrs = &GroupRepositoryMockHasMemberParamPtrs{}
+	}
+	mmHasMember.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmHasMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.HasMember
+func (mmHasMember *mGroupRepositoryMockHasMember) Inspect(f func(ctx context.Context, groupID int64, userID int64)) *mGroupRepositoryMockHasMember {
+	if mmHasMember.mock.inspectFuncHasMember != nil {
+		mmHasMember.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.HasMember")
+	}
+
+	mmHasMember.mock.inspectFuncHasMember = f
+
+	return mmHasMember
+}
+
+// Return sets up results that will be returned by GroupRepository.HasMember
+func (mmHasMember *mGroupRepositoryMockHasMember) Return(b1 bool, err error) *GroupRepositoryMock {
+	if mmHasMember.mock.funcHasMember != nil {
+		mmHasMember.mock.t.Fatalf("GroupRepositoryMock.HasMember mock is already set by Set")
+	}
+
+	if mmHasMember.defaultExpectation == nil {
+		mmHasMember.defaultExpectation = &GroupRepositoryMockHasMemberExpectation{mock: mmHasMember.mock}
+	}
+	mmHasMember.defaultExpectation.results = &GroupRepositoryMockHasMemberResults{b1, err}
+	return mmHasMember.mock
+}
+
+// Set uses given function f to mock the GroupRepository.HasMember method
+func (mmHasMember *mGroupRepositoryMockHasMember) Set(f func(ctx context.Context, groupID int64, userID int64) (b1 bool, err error)) *GroupRepositoryMock {
+	if mmHasMember.defaultExpectation != nil {
+		mmHasMember.mock.t.Fatalf("Default expectation is already set for the GroupRepository.HasMember method")
+	}
+
+	if len(mmHasMember.expectations) > 0 {
+		mmHasMember.mock.t.Fatalf("Some expectations are already set for the GroupRepository.HasMember method")
+	}
+
+	mmHasMember.mock.funcHasMember = f
+	return mmHasMember.mock
+}
+
+// When sets expectation for the GroupRepository.HasMember which will trigger the result defined by the following
+// Then helper
+func (mmHasMember *mGroupRepositoryMockHasMember) When(ctx context.Context, groupID int64, userID int64) *GroupRepositoryMockHasMemberExpectation {
+	if mmHasMember.mock.funcHasMember != nil {
+		mmHasMember.mock.t.Fatalf("GroupRepositoryMock.HasMember mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockHasMemberExpectation{
+		mock:   mmHasMember.mock,
+		params: &GroupRepositoryMockHasMemberParams{ctx, groupID, userID},
+	}
+	mmHasMember.expectations = append(mmHasMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.HasMember return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockHasMemberExpectation) Then(b1 bool, err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockHasMemberResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times GroupRepository.HasMember should be invoked
+func (mmHasMember *mGroupRepositoryMockHasMember) Times(n uint64) *mGroupRepositoryMockHasMember {
+	if n == 0 {
+		mmHasMember.mock.t.Fatalf("Times of GroupRepositoryMock.HasMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHasMember.expectedInvocations, n)
+	return mmHasMember
+}
+
+func (mmHasMember *mGroupRepositoryMockHasMember) invocationsDone() bool {
+	if len(mmHasMember.expectations) == 0 && mmHasMember.defaultExpectation == nil && mmHasMember.mock.funcHasMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHasMember.mock.afterHasMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHasMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// HasMember implements repository.GroupRepository
+func (mmHasMember *GroupRepositoryMock) HasMember(ctx context.Context, groupID int64, userID int64) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmHasMember.beforeHasMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmHasMember.afterHasMemberCounter, 1)
+
+	if mmHasMember.inspectFuncHasMember != nil {
+		mmHasMember.inspectFuncHasMember(ctx, groupID, userID)
+	}
+
+	mm_params := GroupRepositoryMockHasMemberParams{ctx, groupID, userID}
+
+	// Record call args
+	mmHasMember.HasMemberMock.mutex.Lock()
+	mmHasMember.HasMemberMock.callArgs = append(mmHasMember.HasMemberMock.callArgs, &mm_params)
+	mmHasMember.HasMemberMock.mutex.Unlock()
+
+	for _, e := range mmHasMember.HasMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmHasMember.HasMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHasMember.HasMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmHasMember.HasMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmHasMember.HasMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockHasMemberParams{ctx, groupID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmHasMember.t.Errorf("GroupRepositoryMock.HasMember got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmHasMember.t.Errorf("GroupRepositoryMock.HasMember got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmHasMember.t.Errorf("GroupRepositoryMock.HasMember got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHasMember.t.Errorf("GroupRepositoryMock.HasMember got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHasMember.HasMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHasMember.t.Fatal("No results are set for the GroupRepositoryMock.HasMember")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmHasMember.funcHasMember != nil {
+		return mmHasMember.funcHasMember(ctx, groupID, userID)
+	}
+	mmHasMember.t.Fatalf("Unexpected call to GroupRepositoryMock.HasMember. %v %v %v", ctx, groupID, userID)
+	return
+}
+
+// HasMemberAfterCounter returns a count of finished GroupRepositoryMock.HasMember invocations
+func (mmHasMember *GroupRepositoryMock) HasMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHasMember.afterHasMemberCounter)
+}
+
+// HasMemberBeforeCounter returns a count of GroupRepositoryMock.HasMember invocations
+func (mmHasMember *GroupRepositoryMock) HasMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHasMember.beforeHasMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.HasMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHasMember *mGroupRepositoryMockHasMember) Calls() []*GroupRepositoryMockHasMemberParams {
+	mmHasMember.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockHasMemberParams, len(mmHasMember.callArgs))
+	copy(argCopy, mmHasMember.callArgs)
+
+	mmHasMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHasMemberDone returns true if the count of the HasMember invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockHasMemberDone() bool {
+	if m.HasMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HasMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HasMemberMock.invocationsDone()
+}
+
+// MinimockHasMemberInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockHasMemberInspect() {
+	for _, e := range m.HasMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.HasMember with params: %#v", *e.params)
+		}
+	}
+
+	afterHasMemberCounter := mm_atomic.LoadUint64(&m.afterHasMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HasMemberMock.defaultExpectation != nil && afterHasMemberCounter < 1 {
+		if m.HasMemberMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.HasMember")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.HasMember with params: %#v", *m.HasMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHasMember != nil && afterHasMemberCounter < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.HasMember")
+	}
+
+	if !m.HasMemberMock.invocationsDone() && afterHasMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to GroupRepositoryMock.HasMember but found %d calls",
+			mm_atomic.LoadUint64(&m.HasMemberMock.expectedInvocations), afterHasMemberCounter)
+	}
+}
+
+type mGroupRepositoryMockList struct {
+	optional           bool
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockListExpectation
+	expectations       []*GroupRepositoryMockListExpectation
+
+	callArgs []*GroupRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// GroupRepositoryMockListExpectation specifies expectation struct of the GroupRepository.List
+type GroupRepositoryMockListExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockListParams
+	paramPtrs *GroupRepositoryMockListParamPtrs
+	results   *GroupRepositoryMockListResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockListParams contains parameters of the GroupRepository.List
+type GroupRepositoryMockListParams struct {
+	ctx context.Context
+}
+
+// GroupRepositoryMockListParamPtrs contains pointers to parameters of the GroupRepository.List
+type GroupRepositoryMockListParamPtrs struct {
+	ctx *context.Context
+}
+
+// GroupRepositoryMockListResults contains results of the GroupRepository.List
+type GroupRepositoryMockListResults struct {
+	gpa1 []*model.Group
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mGroupRepositoryMockList) Optional() *mGroupRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for GroupRepository.List
+func (mmList *mGroupRepositoryMockList) Expect(ctx context.Context) *mGroupRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("GroupRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &GroupRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("GroupRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &GroupRepositoryMockListParams{ctx}
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.List
+func (mmList *mGroupRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("GroupRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &GroupRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("GroupRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &GroupRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.List
+func (mmList *mGroupRepositoryMockList) Inspect(f func(ctx context.Context)) *mGroupRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by GroupRepository.List
+func (mmList *mGroupRepositoryMockList) Return(gpa1 []*model.Group, err error) *GroupRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("GroupRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &GroupRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &GroupRepositoryMockListResults{gpa1, err}
+	return mmList.mock
+}
+
+// Set uses given function f to mock the GroupRepository.List method
+func (mmList *mGroupRepositoryMockList) Set(f func(ctx context.Context) (gpa1 []*model.Group, err error)) *GroupRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the GroupRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the GroupRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	return mmList.mock
+}
+
+// When sets expectation for the GroupRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mGroupRepositoryMockList) When(ctx context.Context) *GroupRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("GroupRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockListExpectation{
+		mock:   mmList.mock,
+		params: &GroupRepositoryMockListParams{ctx},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.List return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockListExpectation) Then(gpa1 []*model.Group, err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockListResults{gpa1, err}
+	return e.mock
+}
+
+// Times sets number of times GroupRepository.List should be invoked
+func (mmList *mGroupRepositoryMockList) Times(n uint64) *mGroupRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of GroupRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	return mmList
+}
+
+func (mmList *mGroupRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements repository.GroupRepository
+func (mmList *GroupRepositoryMock) List(ctx context.Context) (gpa1 []*model.Group, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx)
+	}
+
+	mm_params := GroupRepositoryMockListParams{ctx}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.gpa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockListParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("GroupRepositoryMock.List got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("GroupRepositoryMock.List got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the GroupRepositoryMock.List")
+		}
+		return (*mm_results).gpa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx)
+	}
+	mmList.t.Fatalf("Unexpected call to GroupRepositoryMock.List. %v", ctx)
+	return
+}
+
+// ListAfterCounter returns a count of finished GroupRepositoryMock.List invocations
+func (mmList *GroupRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of GroupRepositoryMock.List invocations
+func (mmList *GroupRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mGroupRepositoryMockList) Calls() []*GroupRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.List with params: %#v", *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.List")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.List with params: %#v", *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.List")
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to GroupRepositoryMock.List but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), afterListCounter)
+	}
+}
+
+type mGroupRepositoryMockRemoveMember struct {
+	optional           bool
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockRemoveMemberExpectation
+	expectations       []*GroupRepositoryMockRemoveMemberExpectation
+
+	callArgs []*GroupRepositoryMockRemoveMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// GroupRepositoryMockRemoveMemberExpectation specifies expectation struct of the GroupRepository.RemoveMember
+type GroupRepositoryMockRemoveMemberExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockRemoveMemberParams
+	paramPtrs *GroupRepositoryMockRemoveMemberParamPtrs
+	results   *GroupRepositoryMockRemoveMemberResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockRemoveMemberParams contains parameters of the GroupRepository.RemoveMember
+type GroupRepositoryMockRemoveMemberParams struct {
+	ctx     context.Context
+	groupID int64
+	userID  int64
+}
+
+// GroupRepositoryMockRemoveMemberParamPtrs contains pointers to parameters of the GroupRepository.RemoveMember
+type GroupRepositoryMockRemoveMemberParamPtrs struct {
+	ctx     *context.Context
+	groupID *int64
+	userID  *int64
+}
+
+// GroupRepositoryMockRemoveMemberResults contains results of the GroupRepository.RemoveMember
+type GroupRepositoryMockRemoveMemberResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) Optional() *mGroupRepositoryMockRemoveMember {
+	mmRemoveMember.optional = true
+	return mmRemoveMember
+}
+
+// Expect sets up expected params for GroupRepository.RemoveMember
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) Expect(ctx context.Context, groupID int64, userID int64) *mGroupRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &GroupRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveMember.defaultExpectation.params = &GroupRepositoryMockRemoveMemberParams{ctx, groupID, userID}
+	for _, e := range mmRemoveMember.expectations {
+		if minimock.Equal(e.params, mmRemoveMember.defaultExpectation.params) {
+			mmRemoveMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveMember.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.RemoveMember
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &GroupRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &GroupRepositoryMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRemoveMember
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for GroupRepository.RemoveMember
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) ExpectGroupIDParam2(groupID int64) *mGroupRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &GroupRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &GroupRepositoryMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmRemoveMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for GroupRepository.RemoveMember
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) ExpectUserIDParam3(userID int64) *mGroupRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &GroupRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &GroupRepositoryMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmRemoveMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.RemoveMember
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) Inspect(f func(ctx context.Context, groupID int64, userID int64)) *mGroupRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.inspectFuncRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.RemoveMember")
+	}
+
+	mmRemoveMember.mock.inspectFuncRemoveMember = f
+
+	return mmRemoveMember
+}
+
+// Return sets up results that will be returned by GroupRepository.RemoveMember
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) Return(err error) *GroupRepositoryMock {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &GroupRepositoryMockRemoveMemberExpectation{mock: mmRemoveMember.mock}
+	}
+	mmRemoveMember.defaultExpectation.results = &GroupRepositoryMockRemoveMemberResults{err}
+	return mmRemoveMember.mock
+}
+
+// Set uses given function f to mock the GroupRepository.RemoveMember method
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) Set(f func(ctx context.Context, groupID int64, userID int64) (err error)) *GroupRepositoryMock {
+	if mmRemoveMember.defaultExpectation != nil {
+		mmRemoveMember.mock.t.Fatalf("Default expectation is already set for the GroupRepository.RemoveMember method")
+	}
+
+	if len(mmRemoveMember.expectations) > 0 {
+		mmRemoveMember.mock.t.Fatalf("Some expectations are already set for the GroupRepository.RemoveMember method")
+	}
+
+	mmRemoveMember.mock.funcRemoveMember = f
+	return mmRemoveMember.mock
+}
+
+// When sets expectation for the GroupRepository.RemoveMember which will trigger the result defined by the following
+// Then helper
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) When(ctx context.Context, groupID int64, userID int64) *GroupRepositoryMockRemoveMemberExpectation {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("GroupRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockRemoveMemberExpectation{
+		mock:   mmRemoveMember.mock,
+		params: &GroupRepositoryMockRemoveMemberParams{ctx, groupID, userID},
+	}
+	mmRemoveMember.expectations = append(mmRemoveMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.RemoveMember return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockRemoveMemberExpectation) Then(err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockRemoveMemberResults{err}
+	return e.mock
+}
+
+// Times sets number of times GroupRepository.RemoveMember should be invoked
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) Times(n uint64) *mGroupRepositoryMockRemoveMember {
+	if n == 0 {
+		mmRemoveMember.mock.t.Fatalf("Times of GroupRepositoryMock.RemoveMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveMember.expectedInvocations, n)
+	return mmRemoveMember
+}
+
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) invocationsDone() bool {
+	if len(mmRemoveMember.expectations) == 0 && mmRemoveMember.defaultExpectation == nil && mmRemoveMember.mock.funcRemoveMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveMember.mock.afterRemoveMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveMember implements repository.GroupRepository
+func (mmRemoveMember *GroupRepositoryMock) RemoveMember(ctx context.Context, groupID int64, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveMember.beforeRemoveMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveMember.afterRemoveMemberCounter, 1)
+
+	if mmRemoveMember.inspectFuncRemoveMember != nil {
+		mmRemoveMember.inspectFuncRemoveMember(ctx, groupID, userID)
+	}
+
+	mm_params := GroupRepositoryMockRemoveMemberParams{ctx, groupID, userID}
+
+	// Record call args
+	mmRemoveMember.RemoveMemberMock.mutex.Lock()
+	mmRemoveMember.RemoveMemberMock.callArgs = append(mmRemoveMember.RemoveMemberMock.callArgs, &mm_params)
+	mmRemoveMember.RemoveMemberMock.mutex.Unlock()
+
+	for _, e := range mmRemoveMember.RemoveMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveMember.RemoveMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveMember.RemoveMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveMember.RemoveMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveMember.RemoveMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockRemoveMemberParams{ctx, groupID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveMember.t.Errorf("GroupRepositoryMock.RemoveMember got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmRemoveMember.t.Errorf("GroupRepositoryMock.RemoveMember got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveMember.t.Errorf("GroupRepositoryMock.RemoveMember got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveMember.t.Errorf("GroupRepositoryMock.RemoveMember got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveMember.RemoveMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveMember.t.Fatal("No results are set for the GroupRepositoryMock.RemoveMember")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveMember.funcRemoveMember != nil {
+		return mmRemoveMember.funcRemoveMember(ctx, groupID, userID)
+	}
+	mmRemoveMember.t.Fatalf("Unexpected call to GroupRepositoryMock.RemoveMember. %v %v %v", ctx, groupID, userID)
+	return
+}
+
+// RemoveMemberAfterCounter returns a count of finished GroupRepositoryMock.RemoveMember invocations
+func (mmRemoveMember *GroupRepositoryMock) RemoveMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.afterRemoveMemberCounter)
+}
+
+// RemoveMemberBeforeCounter returns a count of GroupRepositoryMock.RemoveMember invocations
+func (mmRemoveMember *GroupRepositoryMock) RemoveMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.beforeRemoveMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.RemoveMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveMember *mGroupRepositoryMockRemoveMember) Calls() []*GroupRepositoryMockRemoveMemberParams {
+	mmRemoveMember.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockRemoveMemberParams, len(mmRemoveMember.callArgs))
+	copy(argCopy, mmRemoveMember.callArgs)
+
+	mmRemoveMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveMemberDone returns true if the count of the RemoveMember invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockRemoveMemberDone() bool {
+	if m.RemoveMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveMemberMock.invocationsDone()
+}
+
+// MinimockRemoveMemberInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockRemoveMemberInspect() {
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.RemoveMember with params: %#v", *e.params)
+		}
+	}
+
+	afterRemoveMemberCounter := mm_atomic.LoadUint64(&m.afterRemoveMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMemberMock.defaultExpectation != nil && afterRemoveMemberCounter < 1 {
+		if m.RemoveMemberMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.RemoveMember")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.RemoveMember with params: %#v", *m.RemoveMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveMember != nil && afterRemoveMemberCounter < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.RemoveMember")
+	}
+
+	if !m.RemoveMemberMock.invocationsDone() && afterRemoveMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to GroupRepositoryMock.RemoveMember but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveMemberMock.expectedInvocations), afterRemoveMemberCounter)
+	}
+}
+
+type mGroupRepositoryMockRevokeRole struct {
+	optional           bool
+	mock               *GroupRepositoryMock
+	defaultExpectation *GroupRepositoryMockRevokeRoleExpectation
+	expectations       []*GroupRepositoryMockRevokeRoleExpectation
+
+	callArgs []*GroupRepositoryMockRevokeRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// GroupRepositoryMockRevokeRoleExpectation specifies expectation struct of the GroupRepository.RevokeRole
+type GroupRepositoryMockRevokeRoleExpectation struct {
+	mock      *GroupRepositoryMock
+	params    *GroupRepositoryMockRevokeRoleParams
+	paramPtrs *GroupRepositoryMockRevokeRoleParamPtrs
+	results   *GroupRepositoryMockRevokeRoleResults
+	Counter   uint64
+}
+
+// GroupRepositoryMockRevokeRoleParams contains parameters of the GroupRepository.RevokeRole
+type GroupRepositoryMockRevokeRoleParams struct {
+	ctx     context.Context
+	groupID int64
+	role    string
+}
+
+// GroupRepositoryMockRevokeRoleParamPtrs contains pointers to parameters of the GroupRepository.RevokeRole
+type GroupRepositoryMockRevokeRoleParamPtrs struct {
+	ctx     *context.Context
+	groupID *int64
+	role    *string
+}
+
+// GroupRepositoryMockRevokeRoleResults contains results of the GroupRepository.RevokeRole
+type GroupRepositoryMockRevokeRoleResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeRole *mGroupRepositoryMockRevokeRole) Optional() *mGroupRepositoryMockRevokeRole {
+	mmRevokeRole.optional = true
+	return mmRevokeRole
+}
+
+// Expect sets up expected params for GroupRepository.RevokeRole
+func (mmRevokeRole *mGroupRepositoryMockRevokeRole) Expect(ctx context.Context, groupID int64, role string) *mGroupRepositoryMockRevokeRole {
+	if mmRevokeRole.mock.funcRevokeRole != nil {
+		mmRevokeRole.mock.t.Fatalf("GroupRepositoryMock.RevokeRole mock is already set by Set")
+	}
+
+	if mmRevokeRole.defaultExpectation == nil {
+		mmRevokeRole.defaultExpectation = &GroupRepositoryMockRevokeRoleExpectation{}
+	}
+
+	if mmRevokeRole.defaultExpectation.paramPtrs != nil {
+		mmRevokeRole.mock.t.Fatalf("GroupRepositoryMock.RevokeRole mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeRole.defaultExpectation.params = &GroupRepositoryMockRevokeRoleParams{ctx, groupID, role}
+	for _, e := range mmRevokeRole.expectations {
+		if minimock.Equal(e.params, mmRevokeRole.defaultExpectation.params) {
+			mmRevokeRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeRole.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GroupRepository.RevokeRole
+func (mmRevokeRole *mGroupRepositoryMockRevokeRole) ExpectCtxParam1(ctx context.Context) *mGroupRepositoryMockRevokeRole {
+	if mmRevokeRole.mock.funcRevokeRole != nil {
+		mmRevokeRole.mock.t.Fatalf("GroupRepositoryMock.RevokeRole mock is already set by Set")
+	}
+
+	if mmRevokeRole.defaultExpectation == nil {
+		mmRevokeRole.defaultExpectation = &GroupRepositoryMockRevokeRoleExpectation{}
+	}
+
+	if mmRevokeRole.defaultExpectation.params != nil {
+		mmRevokeRole.mock.t.Fatalf("GroupRepositoryMock.RevokeRole mock is already set by Expect")
+	}
+
+	if mmRevokeRole.defaultExpectation.paramPtrs == nil {
+		mmRevokeRole.defaultExpectation.paramPtrs = &GroupRepositoryMockRevokeRoleParamPtrs{}
+	}
+	mmRevokeRole.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevokeRole
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for GroupRepository.RevokeRole
+func (mmRevokeRole *mGroupRepositoryMockRevokeRole) ExpectGroupIDParam2(groupID int64) *mGroupRepositoryMockRevokeRole {
+	if mmRevokeRole.mock.funcRevokeRole != nil {
+		mmRevokeRole.mock.t.Fatalf("GroupRepositoryMock.RevokeRole mock is already set by Set")
+	}
+
+	if mmRevokeRole.defaultExpectation == nil {
+		mmRevokeRole.defaultExpectation = &GroupRepositoryMockRevokeRoleExpectation{}
+	}
+
+	if mmRevokeRole.defaultExpectation.params != nil {
+		mmRevokeRole.mock.t.Fatalf("GroupRepositoryMock.RevokeRole mock is already set by Expect")
+	}
+
+	if mmRevokeRole.defaultExpectation.paramPtrs == nil {
+		mmRevokeRole.defaultExpectation.paramPtrs = &GroupRepositoryMockRevokeRoleParamPtrs{}
+	}
+	mmRevokeRole.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmRevokeRole
+}
+
+// ExpectRoleParam3 sets up expected param role for GroupRepository.RevokeRole
+func (mmRevokeRole *mGroupRepositoryMockRevokeRole) ExpectRoleParam3(role string) *mGroupRepositoryMockRevokeRole {
+	if mmRevokeRole.mock.funcRevokeRole != nil {
+		mmRevokeRole.mock.t.Fatalf("GroupRepositoryMock.RevokeRole mock is already set by Set")
+	}
+
+	if mmRevokeRole.defaultExpectation == nil {
+		mmRevokeRole.defaultExpectation = &GroupRepositoryMockRevokeRoleExpectation{}
+	}
+
+	if mmRevokeRole.defaultExpectation.params != nil {
+		mmRevokeRole.mock.t.Fatalf("GroupRepositoryMock.RevokeRole mock is already set by Expect")
+	}
+
+	if mmRevokeRole.defaultExpectation.paramPtrs == nil {
+		mmRevokeRole.defaultExpectation.paramPtrs = &GroupRepositoryMockRevokeRoleParamPtrs{}
+	}
+	mmRevokeRole.defaultExpectation.paramPtrs.role = &role
+
+	return mmRevokeRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the GroupRepository.RevokeRole
+func (mmRevokeRole *mGroupRepositoryMockRevokeRole) Inspect(f func(ctx context.Context, groupID int64, role string)) *mGroupRepositoryMockRevokeRole {
+	if mmRevokeRole.mock.inspectFuncRevokeRole != nil {
+		mmRevokeRole.mock.t.Fatalf("Inspect function is already set for GroupRepositoryMock.RevokeRole")
+	}
+
+	mmRevokeRole.mock.inspectFuncRevokeRole = f
+
+	return mmRevokeRole
+}
+
+// Return sets up results that will be returned by GroupRepository.RevokeRole
+func (mmRevokeRole *mGroupRepositoryMockRevokeRole) Return(err error) *GroupRepositoryMock {
+	if mmRevokeRole.mock.funcRevokeRole != nil {
+		mmRevokeRole.mock.t.Fatalf("GroupRepositoryMock.RevokeRole mock is already set by Set")
+	}
+
+	if mmRevokeRole.defaultExpectation == nil {
+		mmRevokeRole.defaultExpectation = &GroupRepositoryMockRevokeRoleExpectation{mock: mmRevokeRole.mock}
+	}
+	mmRevokeRole.defaultExpectation.results = &GroupRepositoryMockRevokeRoleResults{err}
+	return mmRevokeRole.mock
+}
+
+// Set uses given function f to mock the GroupRepository.RevokeRole method
+func (mmRevokeRole *mGroupRepositoryMockRevokeRole) Set(f func(ctx context.Context, groupID int64, role string) (err error)) *GroupRepositoryMock {
+	if mmRevokeRole.defaultExpectation != nil {
+		mmRevokeRole.mock.t.Fatalf("Default expectation is already set for the GroupRepository.RevokeRole method")
+	}
+
+	if len(mmRevokeRole.expectations) > 0 {
+		mmRevokeRole.mock.t.Fatalf("Some expectations are already set for the GroupRepository.RevokeRole method")
+	}
+
+	mmRevokeRole.mock.funcRevokeRole = f
+	return mmRevokeRole.mock
+}
+
+// When sets expectation for the GroupRepository.RevokeRole which will trigger the result defined by the following
+// Then helper
+func (mmRevokeRole *mGroupRepositoryMockRevokeRole) When(ctx context.Context, groupID int64, role string) *GroupRepositoryMockRevokeRoleExpectation {
+	if mmRevokeRole.mock.funcRevokeRole != nil {
+		mmRevokeRole.mock.t.Fatalf("GroupRepositoryMock.RevokeRole mock is already set by Set")
+	}
+
+	expectation := &GroupRepositoryMockRevokeRoleExpectation{
+		mock:   mmRevokeRole.mock,
+		params: &GroupRepositoryMockRevokeRoleParams{ctx, groupID, role},
+	}
+	mmRevokeRole.expectations = append(mmRevokeRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GroupRepository.RevokeRole return parameters for the expectation previously defined by the When method
+func (e *GroupRepositoryMockRevokeRoleExpectation) Then(err error) *GroupRepositoryMock {
+	e.results = &GroupRepositoryMockRevokeRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times GroupRepository.RevokeRole should be invoked
+func (mmRevokeRole *mGroupRepositoryMockRevokeRole) Times(n uint64) *mGroupRepositoryMockRevokeRole {
+	if n == 0 {
+		mmRevokeRole.mock.t.Fatalf("Times of GroupRepositoryMock.RevokeRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeRole.expectedInvocations, n)
+	return mmRevokeRole
+}
+
+func (mmRevokeRole *mGroupRepositoryMockRevokeRole) invocationsDone() bool {
+	if len(mmRevokeRole.expectations) == 0 && mmRevokeRole.defaultExpectation == nil && mmRevokeRole.mock.funcRevokeRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeRole.mock.afterRevokeRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeRole implements repository.GroupRepository
+func (mmRevokeRole *GroupRepositoryMock) RevokeRole(ctx context.Context, groupID int64, role string) (err error) {
+	mm_atomic.AddUint64(&mmRevokeRole.beforeRevokeRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeRole.afterRevokeRoleCounter, 1)
+
+	if mmRevokeRole.inspectFuncRevokeRole != nil {
+		mmRevokeRole.inspectFuncRevokeRole(ctx, groupID, role)
+	}
+
+	mm_params := GroupRepositoryMockRevokeRoleParams{ctx, groupID, role}
+
+	// Record call args
+	mmRevokeRole.RevokeRoleMock.mutex.Lock()
+	mmRevokeRole.RevokeRoleMock.callArgs = append(mmRevokeRole.RevokeRoleMock.callArgs, &mm_params)
+	mmRevokeRole.RevokeRoleMock.mutex.Unlock()
+
+	for _, e := range mmRevokeRole.RevokeRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeRole.RevokeRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeRole.RevokeRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeRole.RevokeRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeRole.RevokeRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := GroupRepositoryMockRevokeRoleParams{ctx, groupID, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeRole.t.Errorf("GroupRepositoryMock.RevokeRole got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmRevokeRole.t.Errorf("GroupRepositoryMock.RevokeRole got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmRevokeRole.t.Errorf("GroupRepositoryMock.RevokeRole got unexpected parameter role, want: %#v, got: %#v%s\n", *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeRole.t.Errorf("GroupRepositoryMock.RevokeRole got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeRole.RevokeRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeRole.t.Fatal("No results are set for the GroupRepositoryMock.RevokeRole")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeRole.funcRevokeRole != nil {
+		return mmRevokeRole.funcRevokeRole(ctx, groupID, role)
+	}
+	mmRevokeRole.t.Fatalf("Unexpected call to GroupRepositoryMock.RevokeRole. %v %v %v", ctx, groupID, role)
+	return
+}
+
+// RevokeRoleAfterCounter returns a count of finished GroupRepositoryMock.RevokeRole invocations
+func (mmRevokeRole *GroupRepositoryMock) RevokeRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeRole.afterRevokeRoleCounter)
+}
+
+// RevokeRoleBeforeCounter returns a count of GroupRepositoryMock.RevokeRole invocations
+func (mmRevokeRole *GroupRepositoryMock) RevokeRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeRole.beforeRevokeRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to GroupRepositoryMock.RevokeRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeRole *mGroupRepositoryMockRevokeRole) Calls() []*GroupRepositoryMockRevokeRoleParams {
+	mmRevokeRole.mutex.RLock()
+
+	argCopy := make([]*GroupRepositoryMockRevokeRoleParams, len(mmRevokeRole.callArgs))
+	copy(argCopy, mmRevokeRole.callArgs)
+
+	mmRevokeRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeRoleDone returns true if the count of the RevokeRole invocations corresponds
+// the number of defined expectations
+func (m *GroupRepositoryMock) MinimockRevokeRoleDone() bool {
+	if m.RevokeRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeRoleMock.invocationsDone()
+}
+
+// MinimockRevokeRoleInspect logs each unmet expectation
+func (m *GroupRepositoryMock) MinimockRevokeRoleInspect() {
+	for _, e := range m.RevokeRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GroupRepositoryMock.RevokeRole with params: %#v", *e.params)
+		}
+	}
+
+	afterRevokeRoleCounter := mm_atomic.LoadUint64(&m.afterRevokeRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeRoleMock.defaultExpectation != nil && afterRevokeRoleCounter < 1 {
+		if m.RevokeRoleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GroupRepositoryMock.RevokeRole")
+		} else {
+			m.t.Errorf("Expected call to GroupRepositoryMock.RevokeRole with params: %#v", *m.RevokeRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeRole != nil && afterRevokeRoleCounter < 1 {
+		m.t.Error("Expected call to GroupRepositoryMock.RevokeRole")
+	}
+
+	if !m.RevokeRoleMock.invocationsDone() && afterRevokeRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to GroupRepositoryMock.RevokeRole but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeRoleMock.expectedInvocations), afterRevokeRoleCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *GroupRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddMemberInspect()
+
+			m.MinimockAssignRoleInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockHasMemberInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockRemoveMemberInspect()
+
+			m.MinimockRevokeRoleInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *GroupRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *GroupRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddMemberDone() &&
+		m.MinimockAssignRoleDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockHasMemberDone() &&
+		m.MinimockListDone() &&
+		m.MinimockRemoveMemberDone() &&
+		m.MinimockRevokeRoleDone()
+}
@@ -0,0 +1,755 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/auth/internal/repository.IdentityRepository -o identity_repository_minimock.go -n IdentityRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// IdentityRepositoryMock implements repository.IdentityRepository
+type IdentityRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, identity *model.FederatedIdentity) (err error)
+	inspectFuncCreate   func(ctx context.Context, identity *model.FederatedIdentity)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mIdentityRepositoryMockCreate
+
+	funcGet          func(ctx context.Context, provider string, subject string) (fp1 *model.FederatedIdentity, err error)
+	inspectFuncGet   func(ctx context.Context, provider string, subject string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mIdentityRepositoryMockGet
+}
+
+// NewIdentityRepositoryMock returns a mock for repository.IdentityRepository
+func NewIdentityRepositoryMock(t minimock.Tester) *IdentityRepositoryMock {
+	m := &IdentityRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mIdentityRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*IdentityRepositoryMockCreateParams{}
+
+	m.GetMock = mIdentityRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*IdentityRepositoryMockGetParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mIdentityRepositoryMockCreate struct {
+	optional           bool
+	mock               *IdentityRepositoryMock
+	defaultExpectation *IdentityRepositoryMockCreateExpectation
+	expectations       []*IdentityRepositoryMockCreateExpectation
+
+	callArgs []*IdentityRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// IdentityRepositoryMockCreateExpectation specifies expectation struct of the IdentityRepository.Create
+type IdentityRepositoryMockCreateExpectation struct {
+	mock      *IdentityRepositoryMock
+	params    *IdentityRepositoryMockCreateParams
+	paramPtrs *IdentityRepositoryMockCreateParamPtrs
+	results   *IdentityRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// IdentityRepositoryMockCreateParams contains parameters of the IdentityRepository.Create
+type IdentityRepositoryMockCreateParams struct {
+	ctx      context.Context
+	identity *model.FederatedIdentity
+}
+
+// IdentityRepositoryMockCreateParamPtrs contains pointers to parameters of the IdentityRepository.Create
+type IdentityRepositoryMockCreateParamPtrs struct {
+	ctx      *context.Context
+	identity **model.FederatedIdentity
+}
+
+// IdentityRepositoryMockCreateResults contains results of the IdentityRepository.Create
+type IdentityRepositoryMockCreateResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mIdentityRepositoryMockCreate) Optional() *mIdentityRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for IdentityRepository.Create
+func (mmCreate *mIdentityRepositoryMockCreate) Expect(ctx context.Context, identity *model.FederatedIdentity) *mIdentityRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &IdentityRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &IdentityRepositoryMockCreateParams{ctx, identity}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IdentityRepository.Create
+func (mmCreate *mIdentityRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mIdentityRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &IdentityRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &IdentityRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectIdentityParam2 sets up expected param identity for IdentityRepository.Create
+func (mmCreate *mIdentityRepositoryMockCreate) ExpectIdentityParam2(identity *model.FederatedIdentity) *mIdentityRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &IdentityRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &IdentityRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.identity = &identity
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the IdentityRepository.Create
+func (mmCreate *mIdentityRepositoryMockCreate) Inspect(f func(ctx context.Context, identity *model.FederatedIdentity)) *mIdentityRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for IdentityRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by IdentityRepository.Create
+func (mmCreate *mIdentityRepositoryMockCreate) Return(err error) *IdentityRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &IdentityRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &IdentityRepositoryMockCreateResults{err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the IdentityRepository.Create method
+func (mmCreate *mIdentityRepositoryMockCreate) Set(f func(ctx context.Context, identity *model.FederatedIdentity) (err error)) *IdentityRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the IdentityRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the IdentityRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the IdentityRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mIdentityRepositoryMockCreate) When(ctx context.Context, identity *model.FederatedIdentity) *IdentityRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("IdentityRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &IdentityRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &IdentityRepositoryMockCreateParams{ctx, identity},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IdentityRepository.Create return parameters for the expectation previously defined by the When method
+func (e *IdentityRepositoryMockCreateExpectation) Then(err error) *IdentityRepositoryMock {
+	e.results = &IdentityRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times IdentityRepository.Create should be invoked
+func (mmCreate *mIdentityRepositoryMockCreate) Times(n uint64) *mIdentityRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of IdentityRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	return mmCreate
+}
+
+func (mmCreate *mIdentityRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements repository.IdentityRepository
+func (mmCreate *IdentityRepositoryMock) Create(ctx context.Context, identity *model.FederatedIdentity) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, identity)
+	}
+
+	mm_params := IdentityRepositoryMockCreateParams{ctx, identity}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := IdentityRepositoryMockCreateParams{ctx, identity}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("IdentityRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.identity != nil && !minimock.Equal(*mm_want_ptrs.identity, mm_got.identity) {
+				mmCreate.t.Errorf("IdentityRepositoryMock.Create got unexpected parameter identity, want: %#v, got: %#v%s\n", *mm_want_ptrs.identity, mm_got.identity, minimock.Diff(*mm_want_ptrs.identity, mm_got.identity))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("IdentityRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the IdentityRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, identity)
+	}
+	mmCreate.t.Fatalf("Unexpected call to IdentityRepositoryMock.Create. %v %v", ctx, identity)
+	return
+}
+
+// CreateAfterCounter returns a count of finished IdentityRepositoryMock.Create invocations
+func (mmCreate *IdentityRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of IdentityRepositoryMock.Create invocations
+func (mmCreate *IdentityRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to IdentityRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mIdentityRepositoryMockCreate) Calls() []*IdentityRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*IdentityRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *IdentityRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *IdentityRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IdentityRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to IdentityRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to IdentityRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Error("Expected call to IdentityRepositoryMock.Create")
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to IdentityRepositoryMock.Create but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), afterCreateCounter)
+	}
+}
+
+type mIdentityRepositoryMockGet struct {
+	optional           bool
+	mock               *IdentityRepositoryMock
+	defaultExpectation *IdentityRepositoryMockGetExpectation
+	expectations       []*IdentityRepositoryMockGetExpectation
+
+	callArgs []*IdentityRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// IdentityRepositoryMockGetExpectation specifies expectation struct of the IdentityRepository.Get
+type IdentityRepositoryMockGetExpectation struct {
+	mock      *IdentityRepositoryMock
+	params    *IdentityRepositoryMockGetParams
+	paramPtrs *IdentityRepositoryMockGetParamPtrs
+	results   *IdentityRepositoryMockGetResults
+	Counter   uint64
+}
+
+// IdentityRepositoryMockGetParams contains parameters of the IdentityRepository.Get
+type IdentityRepositoryMockGetParams struct {
+	ctx      context.Context
+	provider string
+	subject  string
+}
+
+// IdentityRepositoryMockGetParamPtrs contains pointers to parameters of the IdentityRepository.Get
+type IdentityRepositoryMockGetParamPtrs struct {
+	ctx      *context.Context
+	provider *string
+	subject  *string
+}
+
+// IdentityRepositoryMockGetResults contains results of the IdentityRepository.Get
+type IdentityRepositoryMockGetResults struct {
+	fp1 *model.FederatedIdentity
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mIdentityRepositoryMockGet) Optional() *mIdentityRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for IdentityRepository.Get
+func (mmGet *mIdentityRepositoryMockGet) Expect(ctx context.Context, provider string, subject string) *mIdentityRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IdentityRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IdentityRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("IdentityRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &IdentityRepositoryMockGetParams{ctx, provider, subject}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IdentityRepository.Get
+func (mmGet *mIdentityRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mIdentityRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IdentityRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IdentityRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("IdentityRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &IdentityRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectProviderParam2 sets up expected param provider for IdentityRepository.Get
+func (mmGet *mIdentityRepositoryMockGet) ExpectProviderParam2(provider string) *mIdentityRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IdentityRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IdentityRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("IdentityRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &IdentityRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.provider = &provider
+
+	return mmGet
+}
+
+// ExpectSubjectParam3 sets up expected param subject for IdentityRepository.Get
+func (mmGet *mIdentityRepositoryMockGet) ExpectSubjectParam3(subject string) *mIdentityRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IdentityRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IdentityRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("IdentityRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &IdentityRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.subject = &subject
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the IdentityRepository.Get
+func (mmGet *mIdentityRepositoryMockGet) Inspect(f func(ctx context.Context, provider string, subject string)) *mIdentityRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for IdentityRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by IdentityRepository.Get
+func (mmGet *mIdentityRepositoryMockGet) Return(fp1 *model.FederatedIdentity, err error) *IdentityRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IdentityRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IdentityRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &IdentityRepositoryMockGetResults{fp1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the IdentityRepository.Get method
+func (mmGet *mIdentityRepositoryMockGet) Set(f func(ctx context.Context, provider string, subject string) (fp1 *model.FederatedIdentity, err error)) *IdentityRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the IdentityRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the IdentityRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the IdentityRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mIdentityRepositoryMockGet) When(ctx context.Context, provider string, subject string) *IdentityRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IdentityRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &IdentityRepositoryMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &IdentityRepositoryMockGetParams{ctx, provider, subject},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IdentityRepository.Get return parameters for the expectation previously defined by the When method
+func (e *IdentityRepositoryMockGetExpectation) Then(fp1 *model.FederatedIdentity, err error) *IdentityRepositoryMock {
+	e.results = &IdentityRepositoryMockGetResults{fp1, err}
+	return e.mock
+}
+
+// Times sets number of times IdentityRepository.Get should be invoked
+func (mmGet *mIdentityRepositoryMockGet) Times(n uint64) *mIdentityRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of IdentityRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	return mmGet
+}
+
+func (mmGet *mIdentityRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements repository.IdentityRepository
+func (mmGet *IdentityRepositoryMock) Get(ctx context.Context, provider string, subject string) (fp1 *model.FederatedIdentity, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, provider, subject)
+	}
+
+	mm_params := IdentityRepositoryMockGetParams{ctx, provider, subject}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := IdentityRepositoryMockGetParams{ctx, provider, subject}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("IdentityRepositoryMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.provider != nil && !minimock.Equal(*mm_want_ptrs.provider, mm_got.provider) {
+				mmGet.t.Errorf("IdentityRepositoryMock.Get got unexpected parameter provider, want: %#v, got: %#v%s\n", *mm_want_ptrs.provider, mm_got.provider, minimock.Diff(*mm_want_ptrs.provider, mm_got.provider))
+			}
+
+			if mm_want_ptrs.subject != nil && !minimock.Equal(*mm_want_ptrs.subject, mm_got.subject) {
+				mmGet.t.Errorf("IdentityRepositoryMock.Get got unexpected parameter subject, want: %#v, got: %#v%s\n", *mm_want_ptrs.subject, mm_got.subject, minimock.Diff(*mm_want_ptrs.subject, mm_got.subject))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("IdentityRepositoryMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the IdentityRepositoryMock.Get")
+		}
+		return (*mm_results).fp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, provider, subject)
+	}
+	mmGet.t.Fatalf("Unexpected call to IdentityRepositoryMock.Get. %v %v %v", ctx, provider, subject)
+	return
+}
+
+// GetAfterCounter returns a count of finished IdentityRepositoryMock.Get invocations
+func (mmGet *IdentityRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of IdentityRepositoryMock.Get invocations
+func (mmGet *IdentityRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to IdentityRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mIdentityRepositoryMockGet) Calls() []*IdentityRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*IdentityRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *IdentityRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *IdentityRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IdentityRepositoryMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to IdentityRepositoryMock.Get")
+		} else {
+			m.t.Errorf("Expected call to IdentityRepositoryMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Error("Expected call to IdentityRepositoryMock.Get")
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to IdentityRepositoryMock.Get but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), afterGetCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IdentityRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockGetInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *IdentityRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *IdentityRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockGetDone()
+}
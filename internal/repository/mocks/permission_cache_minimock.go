@@ -0,0 +1,1003 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/auth/internal/repository.PermissionCache -o permission_cache_minimock.go -n PermissionCacheMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// PermissionCacheMock implements repository.PermissionCache
+type PermissionCacheMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetEndpointPermissions          func(ctx context.Context, endpoint string) (ppa1 []*model.Permission, err error)
+	inspectFuncGetEndpointPermissions   func(ctx context.Context, endpoint string)
+	afterGetEndpointPermissionsCounter  uint64
+	beforeGetEndpointPermissionsCounter uint64
+	GetEndpointPermissionsMock          mPermissionCacheMockGetEndpointPermissions
+
+	funcInvalidate          func(ctx context.Context) (err error)
+	inspectFuncInvalidate   func(ctx context.Context)
+	afterInvalidateCounter  uint64
+	beforeInvalidateCounter uint64
+	InvalidateMock          mPermissionCacheMockInvalidate
+
+	funcListen          func(ctx context.Context) (err error)
+	inspectFuncListen   func(ctx context.Context)
+	afterListenCounter  uint64
+	beforeListenCounter uint64
+	ListenMock          mPermissionCacheMockListen
+}
+
+// NewPermissionCacheMock returns a mock for repository.PermissionCache
+func NewPermissionCacheMock(t minimock.Tester) *PermissionCacheMock {
+	m := &PermissionCacheMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetEndpointPermissionsMock = mPermissionCacheMockGetEndpointPermissions{mock: m}
+	m.GetEndpointPermissionsMock.callArgs = []*PermissionCacheMockGetEndpointPermissionsParams{}
+
+	m.InvalidateMock = mPermissionCacheMockInvalidate{mock: m}
+	m.InvalidateMock.callArgs = []*PermissionCacheMockInvalidateParams{}
+
+	m.ListenMock = mPermissionCacheMockListen{mock: m}
+	m.ListenMock.callArgs = []*PermissionCacheMockListenParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPermissionCacheMockGetEndpointPermissions struct {
+	optional           bool
+	mock               *PermissionCacheMock
+	defaultExpectation *PermissionCacheMockGetEndpointPermissionsExpectation
+	expectations       []*PermissionCacheMockGetEndpointPermissionsExpectation
+
+	callArgs []*PermissionCacheMockGetEndpointPermissionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PermissionCacheMockGetEndpointPermissionsExpectation specifies expectation struct of the PermissionCache.GetEndpointPermissions
+type PermissionCacheMockGetEndpointPermissionsExpectation struct {
+	mock      *PermissionCacheMock
+	params    *PermissionCacheMockGetEndpointPermissionsParams
+	paramPtrs *PermissionCacheMockGetEndpointPermissionsParamPtrs
+	results   *PermissionCacheMockGetEndpointPermissionsResults
+	Counter   uint64
+}
+
+// PermissionCacheMockGetEndpointPermissionsParams contains parameters of the PermissionCache.GetEndpointPermissions
+type PermissionCacheMockGetEndpointPermissionsParams struct {
+	ctx      context.Context
+	endpoint string
+}
+
+// PermissionCacheMockGetEndpointPermissionsParamPtrs contains pointers to parameters of the PermissionCache.GetEndpointPermissions
+type PermissionCacheMockGetEndpointPermissionsParamPtrs struct {
+	ctx      *context.Context
+	endpoint *string
+}
+
+// PermissionCacheMockGetEndpointPermissionsResults contains results of the PermissionCache.GetEndpointPermissions
+type PermissionCacheMockGetEndpointPermissionsResults struct {
+	ppa1 []*model.Permission
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetEndpointPermissions *mPermissionCacheMockGetEndpointPermissions) Optional() *mPermissionCacheMockGetEndpointPermissions {
+	mmGetEndpointPermissions.optional = true
+	return mmGetEndpointPermissions
+}
+
+// Expect sets up expected params for PermissionCache.GetEndpointPermissions
+func (mmGetEndpointPermissions *mPermissionCacheMockGetEndpointPermissions) Expect(ctx context.Context, endpoint string) *mPermissionCacheMockGetEndpointPermissions {
+	if mmGetEndpointPermissions.mock.funcGetEndpointPermissions != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("PermissionCacheMock.GetEndpointPermissions mock is already set by Set")
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation == nil {
+		mmGetEndpointPermissions.defaultExpectation = &PermissionCacheMockGetEndpointPermissionsExpectation{}
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation.paramPtrs != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("PermissionCacheMock.GetEndpointPermissions mock is already set by ExpectParams functions")
+	}
+
+	mmGetEndpointPermissions.defaultExpectation.params = &PermissionCacheMockGetEndpointPermissionsParams{ctx, endpoint}
+	for _, e := range mmGetEndpointPermissions.expectations {
+		if minimock.Equal(e.params, mmGetEndpointPermissions.defaultExpectation.params) {
+			mmGetEndpointPermissions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetEndpointPermissions.defaultExpectation.params)
+		}
+	}
+
+	return mmGetEndpointPermissions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionCache.GetEndpointPermissions
+func (mmGetEndpointPermissions *mPermissionCacheMockGetEndpointPermissions) ExpectCtxParam1(ctx context.Context) *mPermissionCacheMockGetEndpointPermissions {
+	if mmGetEndpointPermissions.mock.funcGetEndpointPermissions != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("PermissionCacheMock.GetEndpointPermissions mock is already set by Set")
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation == nil {
+		mmGetEndpointPermissions.defaultExpectation = &PermissionCacheMockGetEndpointPermissionsExpectation{}
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation.params != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("PermissionCacheMock.GetEndpointPermissions mock is already set by Expect")
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation.paramPtrs == nil {
+		mmGetEndpointPermissions.defaultExpectation.paramPtrs = &PermissionCacheMockGetEndpointPermissionsParamPtrs{}
+	}
+	mmGetEndpointPermissions.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetEndpointPermissions
+}
+
+// ExpectEndpointParam2 sets up expected param endpoint for PermissionCache.GetEndpointPermissions
+func (mmGetEndpointPermissions *mPermissionCacheMockGetEndpointPermissions) ExpectEndpointParam2(endpoint string) *mPermissionCacheMockGetEndpointPermissions {
+	if mmGetEndpointPermissions.mock.funcGetEndpointPermissions != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("PermissionCacheMock.GetEndpointPermissions mock is already set by Set")
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation == nil {
+		mmGetEndpointPermissions.defaultExpectation = &PermissionCacheMockGetEndpointPermissionsExpectation{}
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation.params != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("PermissionCacheMock.GetEndpointPermissions mock is already set by Expect")
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation.paramPtrs == nil {
+		mmGetEndpointPermissions.defaultExpectation.paramPtrs = &PermissionCacheMockGetEndpointPermissionsParamPtrs{}
+	}
+	mmGetEndpointPermissions.defaultExpectation.paramPtrs.endpoint = &endpoint
+
+	return mmGetEndpointPermissions
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionCache.GetEndpointPermissions
+func (mmGetEndpointPermissions *mPermissionCacheMockGetEndpointPermissions) Inspect(f func(ctx context.Context, endpoint string)) *mPermissionCacheMockGetEndpointPermissions {
+	if mmGetEndpointPermissions.mock.inspectFuncGetEndpointPermissions != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("Inspect function is already set for PermissionCacheMock.GetEndpointPermissions")
+	}
+
+	mmGetEndpointPermissions.mock.inspectFuncGetEndpointPermissions = f
+
+	return mmGetEndpointPermissions
+}
+
+// Return sets up results that will be returned by PermissionCache.GetEndpointPermissions
+func (mmGetEndpointPermissions *mPermissionCacheMockGetEndpointPermissions) Return(ppa1 []*model.Permission, err error) *PermissionCacheMock {
+	if mmGetEndpointPermissions.mock.funcGetEndpointPermissions != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("PermissionCacheMock.GetEndpointPermissions mock is already set by Set")
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation == nil {
+		mmGetEndpointPermissions.defaultExpectation = &PermissionCacheMockGetEndpointPermissionsExpectation{mock: mmGetEndpointPermissions.mock}
+	}
+	mmGetEndpointPermissions.defaultExpectation.results = &PermissionCacheMockGetEndpointPermissionsResults{ppa1, err}
+	return mmGetEndpointPermissions.mock
+}
+
+// Set uses given function f to mock the PermissionCache.GetEndpointPermissions method
+func (mmGetEndpointPermissions *mPermissionCacheMockGetEndpointPermissions) Set(f func(ctx context.Context, endpoint string) (ppa1 []*model.Permission, err error)) *PermissionCacheMock {
+	if mmGetEndpointPermissions.defaultExpectation != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("Default expectation is already set for the PermissionCache.GetEndpointPermissions method")
+	}
+
+	if len(mmGetEndpointPermissions.expectations) > 0 {
+		mmGetEndpointPermissions.mock.t.Fatalf("Some expectations are already set for the PermissionCache.GetEndpointPermissions method")
+	}
+
+	mmGetEndpointPermissions.mock.funcGetEndpointPermissions = f
+	return mmGetEndpointPermissions.mock
+}
+
+// When sets expectation for the PermissionCache.GetEndpointPermissions which will trigger the result defined by the following
+// Then helper
+func (mmGetEndpointPermissions *mPermissionCacheMockGetEndpointPermissions) When(ctx context.Context, endpoint string) *PermissionCacheMockGetEndpointPermissionsExpectation {
+	if mmGetEndpointPermissions.mock.funcGetEndpointPermissions != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("PermissionCacheMock.GetEndpointPermissions mock is already set by Set")
+	}
+
+	expectation := &PermissionCacheMockGetEndpointPermissionsExpectation{
+		mock:   mmGetEndpointPermissions.mock,
+		params: &PermissionCacheMockGetEndpointPermissionsParams{ctx, endpoint},
+	}
+	mmGetEndpointPermissions.expectations = append(mmGetEndpointPermissions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionCache.GetEndpointPermissions return parameters for the expectation previously defined by the When method
+func (e *PermissionCacheMockGetEndpointPermissionsExpectation) Then(ppa1 []*model.Permission, err error) *PermissionCacheMock {
+	e.results = &PermissionCacheMockGetEndpointPermissionsResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times PermissionCache.GetEndpointPermissions should be invoked
+func (mmGetEndpointPermissions *mPermissionCacheMockGetEndpointPermissions) Times(n uint64) *mPermissionCacheMockGetEndpointPermissions {
+	if n == 0 {
+		mmGetEndpointPermissions.mock.t.Fatalf("Times of PermissionCacheMock.GetEndpointPermissions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetEndpointPermissions.expectedInvocations, n)
+	return mmGetEndpointPermissions
+}
+
+func (mmGetEndpointPermissions *mPermissionCacheMockGetEndpointPermissions) invocationsDone() bool {
+	if len(mmGetEndpointPermissions.expectations) == 0 && mmGetEndpointPermissions.defaultExpectation == nil && mmGetEndpointPermissions.mock.funcGetEndpointPermissions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetEndpointPermissions.mock.afterGetEndpointPermissionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetEndpointPermissions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetEndpointPermissions implements repository.PermissionCache
+func (mmGetEndpointPermissions *PermissionCacheMock) GetEndpointPermissions(ctx context.Context, endpoint string) (ppa1 []*model.Permission, err error) {
+	mm_atomic.AddUint64(&mmGetEndpointPermissions.beforeGetEndpointPermissionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetEndpointPermissions.afterGetEndpointPermissionsCounter, 1)
+
+	if mmGetEndpointPermissions.inspectFuncGetEndpointPermissions != nil {
+		mmGetEndpointPermissions.inspectFuncGetEndpointPermissions(ctx, endpoint)
+	}
+
+	mm_params := PermissionCacheMockGetEndpointPermissionsParams{ctx, endpoint}
+
+	// Record call args
+	mmGetEndpointPermissions.GetEndpointPermissionsMock.mutex.Lock()
+	mmGetEndpointPermissions.GetEndpointPermissionsMock.callArgs = append(mmGetEndpointPermissions.GetEndpointPermissionsMock.callArgs, &mm_params)
+	mmGetEndpointPermissions.GetEndpointPermissionsMock.mutex.Unlock()
+
+	for _, e := range mmGetEndpointPermissions.GetEndpointPermissionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmGetEndpointPermissions.GetEndpointPermissionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetEndpointPermissions.GetEndpointPermissionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetEndpointPermissions.GetEndpointPermissionsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetEndpointPermissions.GetEndpointPermissionsMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionCacheMockGetEndpointPermissionsParams{ctx, endpoint}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetEndpointPermissions.t.Errorf("PermissionCacheMock.GetEndpointPermissions got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.endpoint != nil && !minimock.Equal(*mm_want_ptrs.endpoint, mm_got.endpoint) {
+				mmGetEndpointPermissions.t.Errorf("PermissionCacheMock.GetEndpointPermissions got unexpected parameter endpoint, want: %#v, got: %#v%s\n", *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetEndpointPermissions.t.Errorf("PermissionCacheMock.GetEndpointPermissions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetEndpointPermissions.GetEndpointPermissionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetEndpointPermissions.t.Fatal("No results are set for the PermissionCacheMock.GetEndpointPermissions")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmGetEndpointPermissions.funcGetEndpointPermissions != nil {
+		return mmGetEndpointPermissions.funcGetEndpointPermissions(ctx, endpoint)
+	}
+	mmGetEndpointPermissions.t.Fatalf("Unexpected call to PermissionCacheMock.GetEndpointPermissions. %v %v", ctx, endpoint)
+	return
+}
+
+// GetEndpointPermissionsAfterCounter returns a count of finished PermissionCacheMock.GetEndpointPermissions invocations
+func (mmGetEndpointPermissions *PermissionCacheMock) GetEndpointPermissionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetEndpointPermissions.afterGetEndpointPermissionsCounter)
+}
+
+// GetEndpointPermissionsBeforeCounter returns a count of PermissionCacheMock.GetEndpointPermissions invocations
+func (mmGetEndpointPermissions *PermissionCacheMock) GetEndpointPermissionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetEndpointPermissions.beforeGetEndpointPermissionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionCacheMock.GetEndpointPermissions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetEndpointPermissions *mPermissionCacheMockGetEndpointPermissions) Calls() []*PermissionCacheMockGetEndpointPermissionsParams {
+	mmGetEndpointPermissions.mutex.RLock()
+
+	argCopy := make([]*PermissionCacheMockGetEndpointPermissionsParams, len(mmGetEndpointPermissions.callArgs))
+	copy(argCopy, mmGetEndpointPermissions.callArgs)
+
+	mmGetEndpointPermissions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetEndpointPermissionsDone returns true if the count of the GetEndpointPermissions invocations corresponds
+// the number of defined expectations
+func (m *PermissionCacheMock) MinimockGetEndpointPermissionsDone() bool {
+	if m.GetEndpointPermissionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetEndpointPermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetEndpointPermissionsMock.invocationsDone()
+}
+
+// MinimockGetEndpointPermissionsInspect logs each unmet expectation
+func (m *PermissionCacheMock) MinimockGetEndpointPermissionsInspect() {
+	for _, e := range m.GetEndpointPermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionCacheMock.GetEndpointPermissions with params: %#v", *e.params)
+		}
+	}
+
+	afterGetEndpointPermissionsCounter := mm_atomic.LoadUint64(&m.afterGetEndpointPermissionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetEndpointPermissionsMock.defaultExpectation != nil && afterGetEndpointPermissionsCounter < 1 {
+		if m.GetEndpointPermissionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PermissionCacheMock.GetEndpointPermissions")
+		} else {
+			m.t.Errorf("Expected call to PermissionCacheMock.GetEndpointPermissions with params: %#v", *m.GetEndpointPermissionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetEndpointPermissions != nil && afterGetEndpointPermissionsCounter < 1 {
+		m.t.Error("Expected call to PermissionCacheMock.GetEndpointPermissions")
+	}
+
+	if !m.GetEndpointPermissionsMock.invocationsDone() && afterGetEndpointPermissionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionCacheMock.GetEndpointPermissions but found %d calls",
+			mm_atomic.LoadUint64(&m.GetEndpointPermissionsMock.expectedInvocations), afterGetEndpointPermissionsCounter)
+	}
+}
+
+type mPermissionCacheMockInvalidate struct {
+	optional           bool
+	mock               *PermissionCacheMock
+	defaultExpectation *PermissionCacheMockInvalidateExpectation
+	expectations       []*PermissionCacheMockInvalidateExpectation
+
+	callArgs []*PermissionCacheMockInvalidateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PermissionCacheMockInvalidateExpectation specifies expectation struct of the PermissionCache.Invalidate
+type PermissionCacheMockInvalidateExpectation struct {
+	mock      *PermissionCacheMock
+	params    *PermissionCacheMockInvalidateParams
+	paramPtrs *PermissionCacheMockInvalidateParamPtrs
+	results   *PermissionCacheMockInvalidateResults
+	Counter   uint64
+}
+
+// PermissionCacheMockInvalidateParams contains parameters of the PermissionCache.Invalidate
+type PermissionCacheMockInvalidateParams struct {
+	ctx context.Context
+}
+
+// PermissionCacheMockInvalidateParamPtrs contains pointers to parameters of the PermissionCache.Invalidate
+type PermissionCacheMockInvalidateParamPtrs struct {
+	ctx *context.Context
+}
+
+// PermissionCacheMockInvalidateResults contains results of the PermissionCache.Invalidate
+type PermissionCacheMockInvalidateResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmInvalidate *mPermissionCacheMockInvalidate) Optional() *mPermissionCacheMockInvalidate {
+	mmInvalidate.optional = true
+	return mmInvalidate
+}
+
+// Expect sets up expected params for PermissionCache.Invalidate
+func (mmInvalidate *mPermissionCacheMockInvalidate) Expect(ctx context.Context) *mPermissionCacheMockInvalidate {
+	if mmInvalidate.mock.funcInvalidate != nil {
+		mmInvalidate.mock.t.Fatalf("PermissionCacheMock.Invalidate mock is already set by Set")
+	}
+
+	if mmInvalidate.defaultExpectation == nil {
+		mmInvalidate.defaultExpectation = &PermissionCacheMockInvalidateExpectation{}
+	}
+
+	if mmInvalidate.defaultExpectation.paramPtrs != nil {
+		mmInvalidate.mock.t.Fatalf("PermissionCacheMock.Invalidate mock is already set by ExpectParams functions")
+	}
+
+	mmInvalidate.defaultExpectation.params = &PermissionCacheMockInvalidateParams{ctx}
+	for _, e := range mmInvalidate.expectations {
+		if minimock.Equal(e.params, mmInvalidate.defaultExpectation.params) {
+			mmInvalidate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmInvalidate.defaultExpectation.params)
+		}
+	}
+
+	return mmInvalidate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionCache.Invalidate
+func (mmInvalidate *mPermissionCacheMockInvalidate) ExpectCtxParam1(ctx context.Context) *mPermissionCacheMockInvalidate {
+	if mmInvalidate.mock.funcInvalidate != nil {
+		mmInvalidate.mock.t.Fatalf("PermissionCacheMock.Invalidate mock is already set by Set")
+	}
+
+	if mmInvalidate.defaultExpectation == nil {
+		mmInvalidate.defaultExpectation = &PermissionCacheMockInvalidateExpectation{}
+	}
+
+	if mmInvalidate.defaultExpectation.params != nil {
+		mmInvalidate.mock.t.Fatalf("PermissionCacheMock.Invalidate mock is already set by Expect")
+	}
+
+	if mmInvalidate.defaultExpectation.paramPtrs == nil {
+		mmInvalidate.defaultExpectation.paramPtrs = &PermissionCacheMockInvalidateParamPtrs{}
+	}
+	mmInvalidate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmInvalidate
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionCache.Invalidate
+func (mmInvalidate *mPermissionCacheMockInvalidate) Inspect(f func(ctx context.Context)) *mPermissionCacheMockInvalidate {
+	if mmInvalidate.mock.inspectFuncInvalidate != nil {
+		mmInvalidate.mock.t.Fatalf("Inspect function is already set for PermissionCacheMock.Invalidate")
+	}
+
+	mmInvalidate.mock.inspectFuncInvalidate = f
+
+	return mmInvalidate
+}
+
+// Return sets up results that will be returned by PermissionCache.Invalidate
+func (mmInvalidate *mPermissionCacheMockInvalidate) Return(err error) *PermissionCacheMock {
+	if mmInvalidate.mock.funcInvalidate != nil {
+		mmInvalidate.mock.t.Fatalf("PermissionCacheMock.Invalidate mock is already set by Set")
+	}
+
+	if mmInvalidate.defaultExpectation == nil {
+		mmInvalidate.defaultExpectation = &PermissionCacheMockInvalidateExpectation{mock: mmInvalidate.mock}
+	}
+	mmInvalidate.defaultExpectation.results = &PermissionCacheMockInvalidateResults{err}
+	return mmInvalidate.mock
+}
+
+// Set uses given function f to mock the PermissionCache.Invalidate method
+func (mmInvalidate *mPermissionCacheMockInvalidate) Set(f func(ctx context.Context) (err error)) *PermissionCacheMock {
+	if mmInvalidate.defaultExpectation != nil {
+		mmInvalidate.mock.t.Fatalf("Default expectation is already set for the PermissionCache.Invalidate method")
+	}
+
+	if len(mmInvalidate.expectations) > 0 {
+		mmInvalidate.mock.t.Fatalf("Some expectations are already set for the PermissionCache.Invalidate method")
+	}
+
+	mmInvalidate.mock.funcInvalidate = f
+	return mmInvalidate.mock
+}
+
+// When sets expectation for the PermissionCache.Invalidate which will trigger the result defined by the following
+// Then helper
+func (mmInvalidate *mPermissionCacheMockInvalidate) When(ctx context.Context) *PermissionCacheMockInvalidateExpectation {
+	if mmInvalidate.mock.funcInvalidate != nil {
+		mmInvalidate.mock.t.Fatalf("PermissionCacheMock.Invalidate mock is already set by Set")
+	}
+
+	expectation := &PermissionCacheMockInvalidateExpectation{
+		mock:   mmInvalidate.mock,
+		params: &PermissionCacheMockInvalidateParams{ctx},
+	}
+	mmInvalidate.expectations = append(mmInvalidate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionCache.Invalidate return parameters for the expectation previously defined by the When method
+func (e *PermissionCacheMockInvalidateExpectation) Then(err error) *PermissionCacheMock {
+	e.results = &PermissionCacheMockInvalidateResults{err}
+	return e.mock
+}
+
+// Times sets number of times PermissionCache.Invalidate should be invoked
+func (mmInvalidate *mPermissionCacheMockInvalidate) Times(n uint64) *mPermissionCacheMockInvalidate {
+	if n == 0 {
+		mmInvalidate.mock.t.Fatalf("Times of PermissionCacheMock.Invalidate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmInvalidate.expectedInvocations, n)
+	return mmInvalidate
+}
+
+func (mmInvalidate *mPermissionCacheMockInvalidate) invocationsDone() bool {
+	if len(mmInvalidate.expectations) == 0 && mmInvalidate.defaultExpectation == nil && mmInvalidate.mock.funcInvalidate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmInvalidate.mock.afterInvalidateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmInvalidate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Invalidate implements repository.PermissionCache
+func (mmInvalidate *PermissionCacheMock) Invalidate(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmInvalidate.beforeInvalidateCounter, 1)
+	defer mm_atomic.AddUint64(&mmInvalidate.afterInvalidateCounter, 1)
+
+	if mmInvalidate.inspectFuncInvalidate != nil {
+		mmInvalidate.inspectFuncInvalidate(ctx)
+	}
+
+	mm_params := PermissionCacheMockInvalidateParams{ctx}
+
+	// Record call args
+	mmInvalidate.InvalidateMock.mutex.Lock()
+	mmInvalidate.InvalidateMock.callArgs = append(mmInvalidate.InvalidateMock.callArgs, &mm_params)
+	mmInvalidate.InvalidateMock.mutex.Unlock()
+
+	for _, e := range mmInvalidate.InvalidateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmInvalidate.InvalidateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmInvalidate.InvalidateMock.defaultExpectation.Counter, 1)
+		mm_want := mmInvalidate.InvalidateMock.defaultExpectation.params
+		mm_want_ptrs := mmInvalidate.InvalidateMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionCacheMockInvalidateParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmInvalidate.t.Errorf("PermissionCacheMock.Invalidate got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmInvalidate.t.Errorf("PermissionCacheMock.Invalidate got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmInvalidate.InvalidateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmInvalidate.t.Fatal("No results are set for the PermissionCacheMock.Invalidate")
+		}
+		return (*mm_results).err
+	}
+	if mmInvalidate.funcInvalidate != nil {
+		return mmInvalidate.funcInvalidate(ctx)
+	}
+	mmInvalidate.t.Fatalf("Unexpected call to PermissionCacheMock.Invalidate. %v", ctx)
+	return
+}
+
+// InvalidateAfterCounter returns a count of finished PermissionCacheMock.Invalidate invocations
+func (mmInvalidate *PermissionCacheMock) InvalidateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInvalidate.afterInvalidateCounter)
+}
+
+// InvalidateBeforeCounter returns a count of PermissionCacheMock.Invalidate invocations
+func (mmInvalidate *PermissionCacheMock) InvalidateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInvalidate.beforeInvalidateCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionCacheMock.Invalidate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmInvalidate *mPermissionCacheMockInvalidate) Calls() []*PermissionCacheMockInvalidateParams {
+	mmInvalidate.mutex.RLock()
+
+	argCopy := make([]*PermissionCacheMockInvalidateParams, len(mmInvalidate.callArgs))
+	copy(argCopy, mmInvalidate.callArgs)
+
+	mmInvalidate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockInvalidateDone returns true if the count of the Invalidate invocations corresponds
+// the number of defined expectations
+func (m *PermissionCacheMock) MinimockInvalidateDone() bool {
+	if m.InvalidateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.InvalidateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.InvalidateMock.invocationsDone()
+}
+
+// MinimockInvalidateInspect logs each unmet expectation
+func (m *PermissionCacheMock) MinimockInvalidateInspect() {
+	for _, e := range m.InvalidateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionCacheMock.Invalidate with params: %#v", *e.params)
+		}
+	}
+
+	afterInvalidateCounter := mm_atomic.LoadUint64(&m.afterInvalidateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.InvalidateMock.defaultExpectation != nil && afterInvalidateCounter < 1 {
+		if m.InvalidateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PermissionCacheMock.Invalidate")
+		} else {
+			m.t.Errorf("Expected call to PermissionCacheMock.Invalidate with params: %#v", *m.InvalidateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcInvalidate != nil && afterInvalidateCounter < 1 {
+		m.t.Error("Expected call to PermissionCacheMock.Invalidate")
+	}
+
+	if !m.InvalidateMock.invocationsDone() && afterInvalidateCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionCacheMock.Invalidate but found %d calls",
+			mm_atomic.LoadUint64(&m.InvalidateMock.expectedInvocations), afterInvalidateCounter)
+	}
+}
+
+type mPermissionCacheMockListen struct {
+	optional           bool
+	mock               *PermissionCacheMock
+	defaultExpectation *PermissionCacheMockListenExpectation
+	expectations       []*PermissionCacheMockListenExpectation
+
+	callArgs []*PermissionCacheMockListenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PermissionCacheMockListenExpectation specifies expectation struct of the PermissionCache.Listen
+type PermissionCacheMockListenExpectation struct {
+	mock      *PermissionCacheMock
+	params    *PermissionCacheMockListenParams
+	paramPtrs *PermissionCacheMockListenParamPtrs
+	results   *PermissionCacheMockListenResults
+	Counter   uint64
+}
+
+// PermissionCacheMockListenParams contains parameters of the PermissionCache.Listen
+type PermissionCacheMockListenParams struct {
+	ctx context.Context
+}
+
+// PermissionCacheMockListenParamPtrs contains pointers to parameters of the PermissionCache.Listen
+type PermissionCacheMockListenParamPtrs struct {
+	ctx *context.Context
+}
+
+// PermissionCacheMockListenResults contains results of the PermissionCache.Listen
+type PermissionCacheMockListenResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListen *mPermissionCacheMockListen) Optional() *mPermissionCacheMockListen {
+	mmListen.optional = true
+	return mmListen
+}
+
+// Expect sets up expected params for PermissionCache.Listen
+func (mmListen *mPermissionCacheMockListen) Expect(ctx context.Context) *mPermissionCacheMockListen {
+	if mmListen.mock.funcListen != nil {
+		mmListen.mock.t.Fatalf("PermissionCacheMock.Listen mock is already set by Set")
+	}
+
+	if mmListen.defaultExpectation == nil {
+		mmListen.defaultExpectation = &PermissionCacheMockListenExpectation{}
+	}
+
+	if mmListen.defaultExpectation.paramPtrs != nil {
+		mmListen.mock.t.Fatalf("PermissionCacheMock.Listen mock is already set by ExpectParams functions")
+	}
+
+	mmListen.defaultExpectation.params = &PermissionCacheMockListenParams{ctx}
+	for _, e := range mmListen.expectations {
+		if minimock.Equal(e.params, mmListen.defaultExpectation.params) {
+			mmListen.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListen.defaultExpectation.params)
+		}
+	}
+
+	return mmListen
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionCache.Listen
+func (mmListen *mPermissionCacheMockListen) ExpectCtxParam1(ctx context.Context) *mPermissionCacheMockListen {
+	if mmListen.mock.funcListen != nil {
+		mmListen.mock.t.Fatalf("PermissionCacheMock.Listen mock is already set by Set")
+	}
+
+	if mmListen.defaultExpectation == nil {
+		mmListen.defaultExpectation = &PermissionCacheMockListenExpectation{}
+	}
+
+	if mmListen.defaultExpectation.params != nil {
+		mmListen.mock.t.Fatalf("PermissionCacheMock.Listen mock is already set by Expect")
+	}
+
+	if mmListen.defaultExpectation.paramPtrs == nil {
+		mmListen.defaultExpectation.paramPtrs = &PermissionCacheMockListenParamPtrs{}
+	}
+	mmListen.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListen
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionCache.Listen
+func (mmListen *mPermissionCacheMockListen) Inspect(f func(ctx context.Context)) *mPermissionCacheMockListen {
+	if mmListen.mock.inspectFuncListen != nil {
+		mmListen.mock.t.Fatalf("Inspect function is already set for PermissionCacheMock.Listen")
+	}
+
+	mmListen.mock.inspectFuncListen = f
+
+	return mmListen
+}
+
+// Return sets up results that will be returned by PermissionCache.Listen
+func (mmListen *mPermissionCacheMockListen) Return(err error) *PermissionCacheMock {
+	if mmListen.mock.funcListen != nil {
+		mmListen.mock.t.Fatalf("PermissionCacheMock.Listen mock is already set by Set")
+	}
+
+	if mmListen.defaultExpectation == nil {
+		mmListen.defaultExpectation = &PermissionCacheMockListenExpectation{mock: mmListen.mock}
+	}
+	mmListen.defaultExpectation.results = &PermissionCacheMockListenResults{err}
+	return mmListen.mock
+}
+
+// Set uses given function f to mock the PermissionCache.Listen method
+func (mmListen *mPermissionCacheMockListen) Set(f func(ctx context.Context) (err error)) *PermissionCacheMock {
+	if mmListen.defaultExpectation != nil {
+		mmListen.mock.t.Fatalf("Default expectation is already set for the PermissionCache.Listen method")
+	}
+
+	if len(mmListen.expectations) > 0 {
+		mmListen.mock.t.Fatalf("Some expectations are already set for the PermissionCache.Listen method")
+	}
+
+	mmListen.mock.funcListen = f
+	return mmListen.mock
+}
+
+// When sets expectation for the PermissionCache.Listen which will trigger the result defined by the following
+// Then helper
+func (mmListen *mPermissionCacheMockListen) When(ctx context.Context) *PermissionCacheMockListenExpectation {
+	if mmListen.mock.funcListen != nil {
+		mmListen.mock.t.Fatalf("PermissionCacheMock.Listen mock is already set by Set")
+	}
+
+	expectation := &PermissionCacheMockListenExpectation{
+		mock:   mmListen.mock,
+		params: &PermissionCacheMockListenParams{ctx},
+	}
+	mmListen.expectations = append(mmListen.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionCache.Listen return parameters for the expectation previously defined by the When method
+func (e *PermissionCacheMockListenExpectation) Then(err error) *PermissionCacheMock {
+	e.results = &PermissionCacheMockListenResults{err}
+	return e.mock
+}
+
+// Times sets number of times PermissionCache.Listen should be invoked
+func (mmListen *mPermissionCacheMockListen) Times(n uint64) *mPermissionCacheMockListen {
+	if n == 0 {
+		mmListen.mock.t.Fatalf("Times of PermissionCacheMock.Listen mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListen.expectedInvocations, n)
+	return mmListen
+}
+
+func (mmListen *mPermissionCacheMockListen) invocationsDone() bool {
+	if len(mmListen.expectations) == 0 && mmListen.defaultExpectation == nil && mmListen.mock.funcListen == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListen.mock.afterListenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListen.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Listen implements repository.PermissionCache
+func (mmListen *PermissionCacheMock) Listen(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmListen.beforeListenCounter, 1)
+	defer mm_atomic.AddUint64(&mmListen.afterListenCounter, 1)
+
+	if mmListen.inspectFuncListen != nil {
+		mmListen.inspectFuncListen(ctx)
+	}
+
+	mm_params := PermissionCacheMockListenParams{ctx}
+
+	// Record call args
+	mmListen.ListenMock.mutex.Lock()
+	mmListen.ListenMock.callArgs = append(mmListen.ListenMock.callArgs, &mm_params)
+	mmListen.ListenMock.mutex.Unlock()
+
+	for _, e := range mmListen.ListenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmListen.ListenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListen.ListenMock.defaultExpectation.Counter, 1)
+		mm_want := mmListen.ListenMock.defaultExpectation.params
+		mm_want_ptrs := mmListen.ListenMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionCacheMockListenParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListen.t.Errorf("PermissionCacheMock.Listen got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListen.t.Errorf("PermissionCacheMock.Listen got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListen.ListenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListen.t.Fatal("No results are set for the PermissionCacheMock.Listen")
+		}
+		return (*mm_results).err
+	}
+	if mmListen.funcListen != nil {
+		return mmListen.funcListen(ctx)
+	}
+	mmListen.t.Fatalf("Unexpected call to PermissionCacheMock.Listen. %v", ctx)
+	return
+}
+
+// ListenAfterCounter returns a count of finished PermissionCacheMock.Listen invocations
+func (mmListen *PermissionCacheMock) ListenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListen.afterListenCounter)
+}
+
+// ListenBeforeCounter returns a count of PermissionCacheMock.Listen invocations
+func (mmListen *PermissionCacheMock) ListenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListen.beforeListenCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionCacheMock.Listen.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListen *mPermissionCacheMockListen) Calls() []*PermissionCacheMockListenParams {
+	mmListen.mutex.RLock()
+
+	argCopy := make([]*PermissionCacheMockListenParams, len(mmListen.callArgs))
+	copy(argCopy, mmListen.callArgs)
+
+	mmListen.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListenDone returns true if the count of the Listen invocations corresponds
+// the number of defined expectations
+func (m *PermissionCacheMock) MinimockListenDone() bool {
+	if m.ListenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListenMock.invocationsDone()
+}
+
+// MinimockListenInspect logs each unmet expectation
+func (m *PermissionCacheMock) MinimockListenInspect() {
+	for _, e := range m.ListenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionCacheMock.Listen with params: %#v", *e.params)
+		}
+	}
+
+	afterListenCounter := mm_atomic.LoadUint64(&m.afterListenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListenMock.defaultExpectation != nil && afterListenCounter < 1 {
+		if m.ListenMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PermissionCacheMock.Listen")
+		} else {
+			m.t.Errorf("Expected call to PermissionCacheMock.Listen with params: %#v", *m.ListenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListen != nil && afterListenCounter < 1 {
+		m.t.Error("Expected call to PermissionCacheMock.Listen")
+	}
+
+	if !m.ListenMock.invocationsDone() && afterListenCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionCacheMock.Listen but found %d calls",
+			mm_atomic.LoadUint64(&m.ListenMock.expectedInvocations), afterListenCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PermissionCacheMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetEndpointPermissionsInspect()
+
+			m.MinimockInvalidateInspect()
+
+			m.MinimockListenInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PermissionCacheMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PermissionCacheMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetEndpointPermissionsDone() &&
+		m.MinimockInvalidateDone() &&
+		m.MinimockListenDone()
+}
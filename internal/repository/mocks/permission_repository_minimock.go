@@ -0,0 +1,2031 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/auth/internal/repository.PermissionRepository -o permission_repository_minimock.go -n PermissionRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	permissionFilter "github.com/mikhailsoldatkin/auth/internal/repository/permission/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// PermissionRepositoryMock implements repository.PermissionRepository
+type PermissionRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, permission *model.Permission) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, permission *model.Permission)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mPermissionRepositoryMockCreate
+
+	funcDelete          func(ctx context.Context, id int64) (err error)
+	inspectFuncDelete   func(ctx context.Context, id int64)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mPermissionRepositoryMockDelete
+
+	funcGet          func(ctx context.Context, id int64) (pp1 *model.Permission, err error)
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mPermissionRepositoryMockGet
+
+	funcList          func(ctx context.Context, filter permissionFilter.PermissionFilter) (ppa1 []*model.Permission, err error)
+	inspectFuncList   func(ctx context.Context, filter permissionFilter.PermissionFilter)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mPermissionRepositoryMockList
+
+	funcListGranted          func(ctx context.Context) (ppa1 []*model.Permission, err error)
+	inspectFuncListGranted   func(ctx context.Context)
+	afterListGrantedCounter  uint64
+	beforeListGrantedCounter uint64
+	ListGrantedMock          mPermissionRepositoryMockListGranted
+
+	funcUpdate          func(ctx context.Context, permission *model.Permission) (err error)
+	inspectFuncUpdate   func(ctx context.Context, permission *model.Permission)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mPermissionRepositoryMockUpdate
+}
+
+// NewPermissionRepositoryMock returns a mock for repository.PermissionRepository
+func NewPermissionRepositoryMock(t minimock.Tester) *PermissionRepositoryMock {
+	m := &PermissionRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mPermissionRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*PermissionRepositoryMockCreateParams{}
+
+	m.DeleteMock = mPermissionRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*PermissionRepositoryMockDeleteParams{}
+
+	m.GetMock = mPermissionRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*PermissionRepositoryMockGetParams{}
+
+	m.ListMock = mPermissionRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*PermissionRepositoryMockListParams{}
+
+	m.ListGrantedMock = mPermissionRepositoryMockListGranted{mock: m}
+	m.ListGrantedMock.callArgs = []*PermissionRepositoryMockListGrantedParams{}
+
+	m.UpdateMock = mPermissionRepositoryMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*PermissionRepositoryMockUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPermissionRepositoryMockCreate struct {
+	optional           bool
+	mock               *PermissionRepositoryMock
+	defaultExpectation *PermissionRepositoryMockCreateExpectation
+	expectations       []*PermissionRepositoryMockCreateExpectation
+
+	callArgs []*PermissionRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PermissionRepositoryMockCreateExpectation specifies expectation struct of the PermissionRepository.Create
+type PermissionRepositoryMockCreateExpectation struct {
+	mock      *PermissionRepositoryMock
+	params    *PermissionRepositoryMockCreateParams
+	paramPtrs *PermissionRepositoryMockCreateParamPtrs
+	results   *PermissionRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// PermissionRepositoryMockCreateParams contains parameters of the PermissionRepository.Create
+type PermissionRepositoryMockCreateParams struct {
+	ctx        context.Context
+	permission *model.Permission
+}
+
+// PermissionRepositoryMockCreateParamPtrs contains pointers to parameters of the PermissionRepository.Create
+type PermissionRepositoryMockCreateParamPtrs struct {
+	ctx        *context.Context
+	permission **model.Permission
+}
+
+// PermissionRepositoryMockCreateResults contains results of the PermissionRepository.Create
+type PermissionRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mPermissionRepositoryMockCreate) Optional() *mPermissionRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for PermissionRepository.Create
+func (mmCreate *mPermissionRepositoryMockCreate) Expect(ctx context.Context, permission *model.Permission) *mPermissionRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PermissionRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PermissionRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("PermissionRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &PermissionRepositoryMockCreateParams{ctx, permission}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionRepository.Create
+func (mmCreate *mPermissionRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mPermissionRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PermissionRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PermissionRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("PermissionRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &PermissionRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectPermissionParam2 sets up expected param permission for PermissionRepository.Create
+func (mmCreate *mPermissionRepositoryMockCreate) ExpectPermissionParam2(permission *model.Permission) *mPermissionRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PermissionRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PermissionRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("PermissionRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &PermissionRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.permission = &permission
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionRepository.Create
+func (mmCreate *mPermissionRepositoryMockCreate) Inspect(f func(ctx context.Context, permission *model.Permission)) *mPermissionRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for PermissionRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by PermissionRepository.Create
+func (mmCreate *mPermissionRepositoryMockCreate) Return(i1 int64, err error) *PermissionRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PermissionRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PermissionRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &PermissionRepositoryMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the PermissionRepository.Create method
+func (mmCreate *mPermissionRepositoryMockCreate) Set(f func(ctx context.Context, permission *model.Permission) (i1 int64, err error)) *PermissionRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the PermissionRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the PermissionRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the PermissionRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mPermissionRepositoryMockCreate) When(ctx context.Context, permission *model.Permission) *PermissionRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PermissionRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &PermissionRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &PermissionRepositoryMockCreateParams{ctx, permission},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionRepository.Create return parameters for the expectation previously defined by the When method
+func (e *PermissionRepositoryMockCreateExpectation) Then(i1 int64, err error) *PermissionRepositoryMock {
+	e.results = &PermissionRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times PermissionRepository.Create should be invoked
+func (mmCreate *mPermissionRepositoryMockCreate) Times(n uint64) *mPermissionRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of PermissionRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	return mmCreate
+}
+
+func (mmCreate *mPermissionRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements repository.PermissionRepository
+func (mmCreate *PermissionRepositoryMock) Create(ctx context.Context, permission *model.Permission) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, permission)
+	}
+
+	mm_params := PermissionRepositoryMockCreateParams{ctx, permission}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionRepositoryMockCreateParams{ctx, permission}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("PermissionRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.permission != nil && !minimock.Equal(*mm_want_ptrs.permission, mm_got.permission) {
+				mmCreate.t.Errorf("PermissionRepositoryMock.Create got unexpected parameter permission, want: %#v, got: %#v%s\n", *mm_want_ptrs.permission, mm_got.permission, minimock.Diff(*mm_want_ptrs.permission, mm_got.permission))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("PermissionRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the PermissionRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, permission)
+	}
+	mmCreate.t.Fatalf("Unexpected call to PermissionRepositoryMock.Create. %v %v", ctx, permission)
+	return
+}
+
+// CreateAfterCounter returns a count of finished PermissionRepositoryMock.Create invocations
+func (mmCreate *PermissionRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of PermissionRepositoryMock.Create invocations
+func (mmCreate *PermissionRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mPermissionRepositoryMockCreate) Calls() []*PermissionRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*PermissionRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *PermissionRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *PermissionRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PermissionRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Error("Expected call to PermissionRepositoryMock.Create")
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionRepositoryMock.Create but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), afterCreateCounter)
+	}
+}
+
+type mPermissionRepositoryMockDelete struct {
+	optional           bool
+	mock               *PermissionRepositoryMock
+	defaultExpectation *PermissionRepositoryMockDeleteExpectation
+	expectations       []*PermissionRepositoryMockDeleteExpectation
+
+	callArgs []*PermissionRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PermissionRepositoryMockDeleteExpectation specifies expectation struct of the PermissionRepository.Delete
+type PermissionRepositoryMockDeleteExpectation struct {
+	mock      *PermissionRepositoryMock
+	params    *PermissionRepositoryMockDeleteParams
+	paramPtrs *PermissionRepositoryMockDeleteParamPtrs
+	results   *PermissionRepositoryMockDeleteResults
+	Counter   uint64
+}
+
+// PermissionRepositoryMockDeleteParams contains parameters of the PermissionRepository.Delete
+type PermissionRepositoryMockDeleteParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// PermissionRepositoryMockDeleteParamPtrs contains pointers to parameters of the PermissionRepository.Delete
+type PermissionRepositoryMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// PermissionRepositoryMockDeleteResults contains results of the PermissionRepository.Delete
+type PermissionRepositoryMockDeleteResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mPermissionRepositoryMockDelete) Optional() *mPermissionRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for PermissionRepository.Delete
+func (mmDelete *mPermissionRepositoryMockDelete) Expect(ctx context.Context, id int64) *mPermissionRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("PermissionRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &PermissionRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("PermissionRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &PermissionRepositoryMockDeleteParams{ctx, id}
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionRepository.Delete
+func (mmDelete *mPermissionRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mPermissionRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("PermissionRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &PermissionRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("PermissionRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &PermissionRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for PermissionRepository.Delete
+func (mmDelete *mPermissionRepositoryMockDelete) ExpectIdParam2(id int64) *mPermissionRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("PermissionRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &PermissionRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("PermissionRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &PermissionRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionRepository.Delete
+func (mmDelete *mPermissionRepositoryMockDelete) Inspect(f func(ctx context.Context, id int64)) *mPermissionRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for PermissionRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by PermissionRepository.Delete
+func (mmDelete *mPermissionRepositoryMockDelete) Return(err error) *PermissionRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("PermissionRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &PermissionRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &PermissionRepositoryMockDeleteResults{err}
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the PermissionRepository.Delete method
+func (mmDelete *mPermissionRepositoryMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *PermissionRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the PermissionRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the PermissionRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	return mmDelete.mock
+}
+
+// When sets expectation for the PermissionRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mPermissionRepositoryMockDelete) When(ctx context.Context, id int64) *PermissionRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("PermissionRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &PermissionRepositoryMockDeleteExpectation{
+		mock:   mmDelete.mock,
+		params: &PermissionRepositoryMockDeleteParams{ctx, id},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *PermissionRepositoryMockDeleteExpectation) Then(err error) *PermissionRepositoryMock {
+	e.results = &PermissionRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times PermissionRepository.Delete should be invoked
+func (mmDelete *mPermissionRepositoryMockDelete) Times(n uint64) *mPermissionRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of PermissionRepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	return mmDelete
+}
+
+func (mmDelete *mPermissionRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements repository.PermissionRepository
+func (mmDelete *PermissionRepositoryMock) Delete(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := PermissionRepositoryMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionRepositoryMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("PermissionRepositoryMock.Delete got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("PermissionRepositoryMock.Delete got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("PermissionRepositoryMock.Delete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the PermissionRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to PermissionRepositoryMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished PermissionRepositoryMock.Delete invocations
+func (mmDelete *PermissionRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of PermissionRepositoryMock.Delete invocations
+func (mmDelete *PermissionRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mPermissionRepositoryMockDelete) Calls() []*PermissionRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*PermissionRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *PermissionRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *PermissionRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.Delete with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PermissionRepositoryMock.Delete")
+		} else {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.Delete with params: %#v", *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Error("Expected call to PermissionRepositoryMock.Delete")
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionRepositoryMock.Delete but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), afterDeleteCounter)
+	}
+}
+
+type mPermissionRepositoryMockGet struct {
+	optional           bool
+	mock               *PermissionRepositoryMock
+	defaultExpectation *PermissionRepositoryMockGetExpectation
+	expectations       []*PermissionRepositoryMockGetExpectation
+
+	callArgs []*PermissionRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PermissionRepositoryMockGetExpectation specifies expectation struct of the PermissionRepository.Get
+type PermissionRepositoryMockGetExpectation struct {
+	mock      *PermissionRepositoryMock
+	params    *PermissionRepositoryMockGetParams
+	paramPtrs *PermissionRepositoryMockGetParamPtrs
+	results   *PermissionRepositoryMockGetResults
+	Counter   uint64
+}
+
+// PermissionRepositoryMockGetParams contains parameters of the PermissionRepository.Get
+type PermissionRepositoryMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// PermissionRepositoryMockGetParamPtrs contains pointers to parameters of the PermissionRepository.Get
+type PermissionRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// PermissionRepositoryMockGetResults contains results of the PermissionRepository.Get
+type PermissionRepositoryMockGetResults struct {
+	pp1 *model.Permission
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mPermissionRepositoryMockGet) Optional() *mPermissionRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for PermissionRepository.Get
+func (mmGet *mPermissionRepositoryMockGet) Expect(ctx context.Context, id int64) *mPermissionRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("PermissionRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &PermissionRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("PermissionRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &PermissionRepositoryMockGetParams{ctx, id}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionRepository.Get
+func (mmGet *mPermissionRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mPermissionRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("PermissionRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &PermissionRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("PermissionRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &PermissionRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for PermissionRepository.Get
+func (mmGet *mPermissionRepositoryMockGet) ExpectIdParam2(id int64) *mPermissionRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("PermissionRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &PermissionRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("PermissionRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &PermissionRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionRepository.Get
+func (mmGet *mPermissionRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mPermissionRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for PermissionRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by PermissionRepository.Get
+func (mmGet *mPermissionRepositoryMockGet) Return(pp1 *model.Permission, err error) *PermissionRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("PermissionRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &PermissionRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &PermissionRepositoryMockGetResults{pp1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the PermissionRepository.Get method
+func (mmGet *mPermissionRepositoryMockGet) Set(f func(ctx context.Context, id int64) (pp1 *model.Permission, err error)) *PermissionRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the PermissionRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the PermissionRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the PermissionRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mPermissionRepositoryMockGet) When(ctx context.Context, id int64) *PermissionRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("PermissionRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &PermissionRepositoryMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &PermissionRepositoryMockGetParams{ctx, id},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionRepository.Get return parameters for the expectation previously defined by the When method
+func (e *PermissionRepositoryMockGetExpectation) Then(pp1 *model.Permission, err error) *PermissionRepositoryMock {
+	e.results = &PermissionRepositoryMockGetResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times PermissionRepository.Get should be invoked
+func (mmGet *mPermissionRepositoryMockGet) Times(n uint64) *mPermissionRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of PermissionRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	return mmGet
+}
+
+func (mmGet *mPermissionRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements repository.PermissionRepository
+func (mmGet *PermissionRepositoryMock) Get(ctx context.Context, id int64) (pp1 *model.Permission, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := PermissionRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("PermissionRepositoryMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("PermissionRepositoryMock.Get got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("PermissionRepositoryMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the PermissionRepositoryMock.Get")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to PermissionRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished PermissionRepositoryMock.Get invocations
+func (mmGet *PermissionRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of PermissionRepositoryMock.Get invocations
+func (mmGet *PermissionRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mPermissionRepositoryMockGet) Calls() []*PermissionRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*PermissionRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *PermissionRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *PermissionRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PermissionRepositoryMock.Get")
+		} else {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Error("Expected call to PermissionRepositoryMock.Get")
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionRepositoryMock.Get but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), afterGetCounter)
+	}
+}
+
+type mPermissionRepositoryMockList struct {
+	optional           bool
+	mock               *PermissionRepositoryMock
+	defaultExpectation *PermissionRepositoryMockListExpectation
+	expectations       []*PermissionRepositoryMockListExpectation
+
+	callArgs []*PermissionRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PermissionRepositoryMockListExpectation specifies expectation struct of the PermissionRepository.List
+type PermissionRepositoryMockListExpectation struct {
+	mock      *PermissionRepositoryMock
+	params    *PermissionRepositoryMockListParams
+	paramPtrs *PermissionRepositoryMockListParamPtrs
+	results   *PermissionRepositoryMockListResults
+	Counter   uint64
+}
+
+// PermissionRepositoryMockListParams contains parameters of the PermissionRepository.List
+type PermissionRepositoryMockListParams struct {
+	ctx    context.Context
+	filter permissionFilter.PermissionFilter
+}
+
+// PermissionRepositoryMockListParamPtrs contains pointers to parameters of the PermissionRepository.List
+type PermissionRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	filter *permissionFilter.PermissionFilter
+}
+
+// PermissionRepositoryMockListResults contains results of the PermissionRepository.List
+type PermissionRepositoryMockListResults struct {
+	ppa1 []*model.Permission
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mPermissionRepositoryMockList) Optional() *mPermissionRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for PermissionRepository.List
+func (mmList *mPermissionRepositoryMockList) Expect(ctx context.Context, filter permissionFilter.PermissionFilter) *mPermissionRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("PermissionRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &PermissionRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("PermissionRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &PermissionRepositoryMockListParams{ctx, filter}
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionRepository.List
+func (mmList *mPermissionRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mPermissionRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("PermissionRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &PermissionRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("PermissionRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &PermissionRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmList
+}
+
+// ExpectFilterParam2 sets up expected param filter for PermissionRepository.List
+func (mmList *mPermissionRepositoryMockList) ExpectFilterParam2(filter permissionFilter.PermissionFilter) *mPermissionRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("PermissionRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &PermissionRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("PermissionRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &PermissionRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionRepository.List
+func (mmList *mPermissionRepositoryMockList) Inspect(f func(ctx context.Context, filter permissionFilter.PermissionFilter)) *mPermissionRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for PermissionRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by PermissionRepository.List
+func (mmList *mPermissionRepositoryMockList) Return(ppa1 []*model.Permission, err error) *PermissionRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("PermissionRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &PermissionRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &PermissionRepositoryMockListResults{ppa1, err}
+	return mmList.mock
+}
+
+// Set uses given function f to mock the PermissionRepository.List method
+func (mmList *mPermissionRepositoryMockList) Set(f func(ctx context.Context, filter permissionFilter.PermissionFilter) (ppa1 []*model.Permission, err error)) *PermissionRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the PermissionRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the PermissionRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	return mmList.mock
+}
+
+// When sets expectation for the PermissionRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mPermissionRepositoryMockList) When(ctx context.Context, filter permissionFilter.PermissionFilter) *PermissionRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("PermissionRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &PermissionRepositoryMockListExpectation{
+		mock:   mmList.mock,
+		params: &PermissionRepositoryMockListParams{ctx, filter},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionRepository.List return parameters for the expectation previously defined by the When method
+func (e *PermissionRepositoryMockListExpectation) Then(ppa1 []*model.Permission, err error) *PermissionRepositoryMock {
+	e.results = &PermissionRepositoryMockListResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times PermissionRepository.List should be invoked
+func (mmList *mPermissionRepositoryMockList) Times(n uint64) *mPermissionRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of PermissionRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	return mmList
+}
+
+func (mmList *mPermissionRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements repository.PermissionRepository
+func (mmList *PermissionRepositoryMock) List(ctx context.Context, filter permissionFilter.PermissionFilter) (ppa1 []*model.Permission, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, filter)
+	}
+
+	mm_params := PermissionRepositoryMockListParams{ctx, filter}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionRepositoryMockListParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("PermissionRepositoryMock.List got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmList.t.Errorf("PermissionRepositoryMock.List got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("PermissionRepositoryMock.List got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the PermissionRepositoryMock.List")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, filter)
+	}
+	mmList.t.Fatalf("Unexpected call to PermissionRepositoryMock.List. %v %v", ctx, filter)
+	return
+}
+
+// ListAfterCounter returns a count of finished PermissionRepositoryMock.List invocations
+func (mmList *PermissionRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of PermissionRepositoryMock.List invocations
+func (mmList *PermissionRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mPermissionRepositoryMockList) Calls() []*PermissionRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*PermissionRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *PermissionRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *PermissionRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.List with params: %#v", *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PermissionRepositoryMock.List")
+		} else {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.List with params: %#v", *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Error("Expected call to PermissionRepositoryMock.List")
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionRepositoryMock.List but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), afterListCounter)
+	}
+}
+
+type mPermissionRepositoryMockListGranted struct {
+	optional           bool
+	mock               *PermissionRepositoryMock
+	defaultExpectation *PermissionRepositoryMockListGrantedExpectation
+	expectations       []*PermissionRepositoryMockListGrantedExpectation
+
+	callArgs []*PermissionRepositoryMockListGrantedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PermissionRepositoryMockListGrantedExpectation specifies expectation struct of the PermissionRepository.ListGranted
+type PermissionRepositoryMockListGrantedExpectation struct {
+	mock      *PermissionRepositoryMock
+	params    *PermissionRepositoryMockListGrantedParams
+	paramPtrs *PermissionRepositoryMockListGrantedParamPtrs
+	results   *PermissionRepositoryMockListGrantedResults
+	Counter   uint64
+}
+
+// PermissionRepositoryMockListGrantedParams contains parameters of the PermissionRepository.ListGranted
+type PermissionRepositoryMockListGrantedParams struct {
+	ctx context.Context
+}
+
+// PermissionRepositoryMockListGrantedParamPtrs contains pointers to parameters of the PermissionRepository.ListGranted
+type PermissionRepositoryMockListGrantedParamPtrs struct {
+	ctx *context.Context
+}
+
+// PermissionRepositoryMockListGrantedResults contains results of the PermissionRepository.ListGranted
+type PermissionRepositoryMockListGrantedResults struct {
+	ppa1 []*model.Permission
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListGranted *mPermissionRepositoryMockListGranted) Optional() *mPermissionRepositoryMockListGranted {
+	mmListGranted.optional = true
+	return mmListGranted
+}
+
+// Expect sets up expected params for PermissionRepository.ListGranted
+func (mmListGranted *mPermissionRepositoryMockListGranted) Expect(ctx context.Context) *mPermissionRepositoryMockListGranted {
+	if mmListGranted.mock.funcListGranted != nil {
+		mmListGranted.mock.t.Fatalf("PermissionRepositoryMock.ListGranted mock is already set by Set")
+	}
+
+	if mmListGranted.defaultExpectation == nil {
+		mmListGranted.defaultExpectation = &PermissionRepositoryMockListGrantedExpectation{}
+	}
+
+	if mmListGranted.defaultExpectation.paramPtrs != nil {
+		mmListGranted.mock.t.Fatalf("PermissionRepositoryMock.ListGranted mock is already set by ExpectParams functions")
+	}
+
+	mmListGranted.defaultExpectation.params = &PermissionRepositoryMockListGrantedParams{ctx}
+	for _, e := range mmListGranted.expectations {
+		if minimock.Equal(e.params, mmListGranted.defaultExpectation.params) {
+			mmListGranted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListGranted.defaultExpectation.params)
+		}
+	}
+
+	return mmListGranted
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionRepository.ListGranted
+func (mmListGranted *mPermissionRepositoryMockListGranted) ExpectCtxParam1(ctx context.Context) *mPermissionRepositoryMockListGranted {
+	if mmListGranted.mock.funcListGranted != nil {
+		mmListGranted.mock.t.Fatalf("PermissionRepositoryMock.ListGranted mock is already set by Set")
+	}
+
+	if mmListGranted.defaultExpectation == nil {
+		mmListGranted.defaultExpectation = &PermissionRepositoryMockListGrantedExpectation{}
+	}
+
+	if mmListGranted.defaultExpectation.params != nil {
+		mmListGranted.mock.t.Fatalf("PermissionRepositoryMock.ListGranted mock is already set by Expect")
+	}
+
+	if mmListGranted.defaultExpectation.paramPtrs == nil {
+		mmListGranted.defaultExpectation.paramPtrs = &PermissionRepositoryMockListGrantedParamPtrs{}
+	}
+	mmListGranted.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListGranted
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionRepository.ListGranted
+func (mmListGranted *mPermissionRepositoryMockListGranted) Inspect(f func(ctx context.Context)) *mPermissionRepositoryMockListGranted {
+	if mmListGranted.mock.inspectFuncListGranted != nil {
+		mmListGranted.mock.t.Fatalf("Inspect function is already set for PermissionRepositoryMock.ListGranted")
+	}
+
+	mmListGranted.mock.inspectFuncListGranted = f
+
+	return mmListGranted
+}
+
+// Return sets up results that will be returned by PermissionRepository.ListGranted
+func (mmListGranted *mPermissionRepositoryMockListGranted) Return(ppa1 []*model.Permission, err error) *PermissionRepositoryMock {
+	if mmListGranted.mock.funcListGranted != nil {
+		mmListGranted.mock.t.Fatalf("PermissionRepositoryMock.ListGranted mock is already set by Set")
+	}
+
+	if mmListGranted.defaultExpectation == nil {
+		mmListGranted.defaultExpectation = &PermissionRepositoryMockListGrantedExpectation{mock: mmListGranted.mock}
+	}
+	mmListGranted.defaultExpectation.results = &PermissionRepositoryMockListGrantedResults{ppa1, err}
+	return mmListGranted.mock
+}
+
+// Set uses given function f to mock the PermissionRepository.ListGranted method
+func (mmListGranted *mPermissionRepositoryMockListGranted) Set(f func(ctx context.Context) (ppa1 []*model.Permission, err error)) *PermissionRepositoryMock {
+	if mmListGranted.defaultExpectation != nil {
+		mmListGranted.mock.t.Fatalf("Default expectation is already set for the PermissionRepository.ListGranted method")
+	}
+
+	if len(mmListGranted.expectations) > 0 {
+		mmListGranted.mock.t.Fatalf("Some expectations are already set for the PermissionRepository.ListGranted method")
+	}
+
+	mmListGranted.mock.funcListGranted = f
+	return mmListGranted.mock
+}
+
+// When sets expectation for the PermissionRepository.ListGranted which will trigger the result defined by the following
+// Then helper
+func (mmListGranted *mPermissionRepositoryMockListGranted) When(ctx context.Context) *PermissionRepositoryMockListGrantedExpectation {
+	if mmListGranted.mock.funcListGranted != nil {
+		mmListGranted.mock.t.Fatalf("PermissionRepositoryMock.ListGranted mock is already set by Set")
+	}
+
+	expectation := &PermissionRepositoryMockListGrantedExpectation{
+		mock:   mmListGranted.mock,
+		params: &PermissionRepositoryMockListGrantedParams{ctx},
+	}
+	mmListGranted.expectations = append(mmListGranted.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionRepository.ListGranted return parameters for the expectation previously defined by the When method
+func (e *PermissionRepositoryMockListGrantedExpectation) Then(ppa1 []*model.Permission, err error) *PermissionRepositoryMock {
+	e.results = &PermissionRepositoryMockListGrantedResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times PermissionRepository.ListGranted should be invoked
+func (mmListGranted *mPermissionRepositoryMockListGranted) Times(n uint64) *mPermissionRepositoryMockListGranted {
+	if n == 0 {
+		mmListGranted.mock.t.Fatalf("Times of PermissionRepositoryMock.ListGranted mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListGranted.expectedInvocations, n)
+	return mmListGranted
+}
+
+func (mmListGranted *mPermissionRepositoryMockListGranted) invocationsDone() bool {
+	if len(mmListGranted.expectations) == 0 && mmListGranted.defaultExpectation == nil && mmListGranted.mock.funcListGranted == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListGranted.mock.afterListGrantedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListGranted.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListGranted implements repository.PermissionRepository
+func (mmListGranted *PermissionRepositoryMock) ListGranted(ctx context.Context) (ppa1 []*model.Permission, err error) {
+	mm_atomic.AddUint64(&mmListGranted.beforeListGrantedCounter, 1)
+	defer mm_atomic.AddUint64(&mmListGranted.afterListGrantedCounter, 1)
+
+	if mmListGranted.inspectFuncListGranted != nil {
+		mmListGranted.inspectFuncListGranted(ctx)
+	}
+
+	mm_params := PermissionRepositoryMockListGrantedParams{ctx}
+
+	// Record call args
+	mmListGranted.ListGrantedMock.mutex.Lock()
+	mmListGranted.ListGrantedMock.callArgs = append(mmListGranted.ListGrantedMock.callArgs, &mm_params)
+	mmListGranted.ListGrantedMock.mutex.Unlock()
+
+	for _, e := range mmListGranted.ListGrantedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmListGranted.ListGrantedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListGranted.ListGrantedMock.defaultExpectation.Counter, 1)
+		mm_want := mmListGranted.ListGrantedMock.defaultExpectation.params
+		mm_want_ptrs := mmListGranted.ListGrantedMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionRepositoryMockListGrantedParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListGranted.t.Errorf("PermissionRepositoryMock.ListGranted got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListGranted.t.Errorf("PermissionRepositoryMock.ListGranted got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListGranted.ListGrantedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListGranted.t.Fatal("No results are set for the PermissionRepositoryMock.ListGranted")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmListGranted.funcListGranted != nil {
+		return mmListGranted.funcListGranted(ctx)
+	}
+	mmListGranted.t.Fatalf("Unexpected call to PermissionRepositoryMock.ListGranted. %v", ctx)
+	return
+}
+
+// ListGrantedAfterCounter returns a count of finished PermissionRepositoryMock.ListGranted invocations
+func (mmListGranted *PermissionRepositoryMock) ListGrantedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListGranted.afterListGrantedCounter)
+}
+
+// ListGrantedBeforeCounter returns a count of PermissionRepositoryMock.ListGranted invocations
+func (mmListGranted *PermissionRepositoryMock) ListGrantedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListGranted.beforeListGrantedCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionRepositoryMock.ListGranted.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListGranted *mPermissionRepositoryMockListGranted) Calls() []*PermissionRepositoryMockListGrantedParams {
+	mmListGranted.mutex.RLock()
+
+	argCopy := make([]*PermissionRepositoryMockListGrantedParams, len(mmListGranted.callArgs))
+	copy(argCopy, mmListGranted.callArgs)
+
+	mmListGranted.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListGrantedDone returns true if the count of the ListGranted invocations corresponds
+// the number of defined expectations
+func (m *PermissionRepositoryMock) MinimockListGrantedDone() bool {
+	if m.ListGrantedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListGrantedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListGrantedMock.invocationsDone()
+}
+
+// MinimockListGrantedInspect logs each unmet expectation
+func (m *PermissionRepositoryMock) MinimockListGrantedInspect() {
+	for _, e := range m.ListGrantedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.ListGranted with params: %#v", *e.params)
+		}
+	}
+
+	afterListGrantedCounter := mm_atomic.LoadUint64(&m.afterListGrantedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListGrantedMock.defaultExpectation != nil && afterListGrantedCounter < 1 {
+		if m.ListGrantedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PermissionRepositoryMock.ListGranted")
+		} else {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.ListGranted with params: %#v", *m.ListGrantedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListGranted != nil && afterListGrantedCounter < 1 {
+		m.t.Error("Expected call to PermissionRepositoryMock.ListGranted")
+	}
+
+	if !m.ListGrantedMock.invocationsDone() && afterListGrantedCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionRepositoryMock.ListGranted but found %d calls",
+			mm_atomic.LoadUint64(&m.ListGrantedMock.expectedInvocations), afterListGrantedCounter)
+	}
+}
+
+type mPermissionRepositoryMockUpdate struct {
+	optional           bool
+	mock               *PermissionRepositoryMock
+	defaultExpectation *PermissionRepositoryMockUpdateExpectation
+	expectations       []*PermissionRepositoryMockUpdateExpectation
+
+	callArgs []*PermissionRepositoryMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PermissionRepositoryMockUpdateExpectation specifies expectation struct of the PermissionRepository.Update
+type PermissionRepositoryMockUpdateExpectation struct {
+	mock      *PermissionRepositoryMock
+	params    *PermissionRepositoryMockUpdateParams
+	paramPtrs *PermissionRepositoryMockUpdateParamPtrs
+	results   *PermissionRepositoryMockUpdateResults
+	Counter   uint64
+}
+
+// PermissionRepositoryMockUpdateParams contains parameters of the PermissionRepository.Update
+type PermissionRepositoryMockUpdateParams struct {
+	ctx        context.Context
+	permission *model.Permission
+}
+
+// PermissionRepositoryMockUpdateParamPtrs contains pointers to parameters of the PermissionRepository.Update
+type PermissionRepositoryMockUpdateParamPtrs struct {
+	ctx        *context.Context
+	permission **model.Permission
+}
+
+// PermissionRepositoryMockUpdateResults contains results of the PermissionRepository.Update
+type PermissionRepositoryMockUpdateResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mPermissionRepositoryMockUpdate) Optional() *mPermissionRepositoryMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for PermissionRepository.Update
+func (mmUpdate *mPermissionRepositoryMockUpdate) Expect(ctx context.Context, permission *model.Permission) *mPermissionRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("PermissionRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &PermissionRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("PermissionRepositoryMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &PermissionRepositoryMockUpdateParams{ctx, permission}
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionRepository.Update
+func (mmUpdate *mPermissionRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mPermissionRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("PermissionRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &PermissionRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("PermissionRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &PermissionRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdate
+}
+
+// ExpectPermissionParam2 sets up expected param permission for PermissionRepository.Update
+func (mmUpdate *mPermissionRepositoryMockUpdate) ExpectPermissionParam2(permission *model.Permission) *mPermissionRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("PermissionRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &PermissionRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("PermissionRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &PermissionRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.permission = &permission
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionRepository.Update
+func (mmUpdate *mPermissionRepositoryMockUpdate) Inspect(f func(ctx context.Context, permission *model.Permission)) *mPermissionRepositoryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for PermissionRepositoryMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by PermissionRepository.Update
+func (mmUpdate *mPermissionRepositoryMockUpdate) Return(err error) *PermissionRepositoryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("PermissionRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &PermissionRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &PermissionRepositoryMockUpdateResults{err}
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the PermissionRepository.Update method
+func (mmUpdate *mPermissionRepositoryMockUpdate) Set(f func(ctx context.Context, permission *model.Permission) (err error)) *PermissionRepositoryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the PermissionRepository.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the PermissionRepository.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	return mmUpdate.mock
+}
+
+// When sets expectation for the PermissionRepository.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mPermissionRepositoryMockUpdate) When(ctx context.Context, permission *model.Permission) *PermissionRepositoryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("PermissionRepositoryMock.Update mock is already set by Set")
+	}
+
+	expectation := &PermissionRepositoryMockUpdateExpectation{
+		mock:   mmUpdate.mock,
+		params: &PermissionRepositoryMockUpdateParams{ctx, permission},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionRepository.Update return parameters for the expectation previously defined by the When method
+func (e *PermissionRepositoryMockUpdateExpectation) Then(err error) *PermissionRepositoryMock {
+	e.results = &PermissionRepositoryMockUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times PermissionRepository.Update should be invoked
+func (mmUpdate *mPermissionRepositoryMockUpdate) Times(n uint64) *mPermissionRepositoryMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of PermissionRepositoryMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	return mmUpdate
+}
+
+func (mmUpdate *mPermissionRepositoryMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements repository.PermissionRepository
+func (mmUpdate *PermissionRepositoryMock) Update(ctx context.Context, permission *model.Permission) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, permission)
+	}
+
+	mm_params := PermissionRepositoryMockUpdateParams{ctx, permission}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionRepositoryMockUpdateParams{ctx, permission}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("PermissionRepositoryMock.Update got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.permission != nil && !minimock.Equal(*mm_want_ptrs.permission, mm_got.permission) {
+				mmUpdate.t.Errorf("PermissionRepositoryMock.Update got unexpected parameter permission, want: %#v, got: %#v%s\n", *mm_want_ptrs.permission, mm_got.permission, minimock.Diff(*mm_want_ptrs.permission, mm_got.permission))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("PermissionRepositoryMock.Update got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the PermissionRepositoryMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, permission)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to PermissionRepositoryMock.Update. %v %v", ctx, permission)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished PermissionRepositoryMock.Update invocations
+func (mmUpdate *PermissionRepositoryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of PermissionRepositoryMock.Update invocations
+func (mmUpdate *PermissionRepositoryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionRepositoryMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mPermissionRepositoryMockUpdate) Calls() []*PermissionRepositoryMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*PermissionRepositoryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *PermissionRepositoryMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *PermissionRepositoryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.Update with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PermissionRepositoryMock.Update")
+		} else {
+			m.t.Errorf("Expected call to PermissionRepositoryMock.Update with params: %#v", *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Error("Expected call to PermissionRepositoryMock.Update")
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionRepositoryMock.Update but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), afterUpdateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PermissionRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockListGrantedInspect()
+
+			m.MinimockUpdateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PermissionRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PermissionRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListDone() &&
+		m.MinimockListGrantedDone() &&
+		m.MinimockUpdateDone()
+}
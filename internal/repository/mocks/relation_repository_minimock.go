@@ -0,0 +1,1061 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/auth/internal/repository.RelationRepository -o relation_repository_minimock.go -n RelationRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	relationFilter "github.com/mikhailsoldatkin/auth/internal/repository/relation/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// RelationRepositoryMock implements repository.RelationRepository
+type RelationRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, tuple *model.RelationTuple) (b1 bool, err error)
+	inspectFuncCreate   func(ctx context.Context, tuple *model.RelationTuple)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mRelationRepositoryMockCreate
+
+	funcDelete          func(ctx context.Context, tuple *model.RelationTuple) (err error)
+	inspectFuncDelete   func(ctx context.Context, tuple *model.RelationTuple)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mRelationRepositoryMockDelete
+
+	funcList          func(ctx context.Context, filter relationFilter.RelationFilter) (rpa1 []*model.RelationTuple, err error)
+	inspectFuncList   func(ctx context.Context, filter relationFilter.RelationFilter)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mRelationRepositoryMockList
+}
+
+// NewRelationRepositoryMock returns a mock for repository.RelationRepository
+func NewRelationRepositoryMock(t minimock.Tester) *RelationRepositoryMock {
+	m := &RelationRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mRelationRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*RelationRepositoryMockCreateParams{}
+
+	m.DeleteMock = mRelationRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*RelationRepositoryMockDeleteParams{}
+
+	m.ListMock = mRelationRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*RelationRepositoryMockListParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRelationRepositoryMockCreate struct {
+	optional           bool
+	mock               *RelationRepositoryMock
+	defaultExpectation *RelationRepositoryMockCreateExpectation
+	expectations       []*RelationRepositoryMockCreateExpectation
+
+	callArgs []*RelationRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RelationRepositoryMockCreateExpectation specifies expectation struct of the RelationRepository.Create
+type RelationRepositoryMockCreateExpectation struct {
+	mock      *RelationRepositoryMock
+	params    *RelationRepositoryMockCreateParams
+	paramPtrs *RelationRepositoryMockCreateParamPtrs
+	results   *RelationRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// RelationRepositoryMockCreateParams contains parameters of the RelationRepository.Create
+type RelationRepositoryMockCreateParams struct {
+	ctx   context.Context
+	tuple *model.RelationTuple
+}
+
+// RelationRepositoryMockCreateParamPtrs contains pointers to parameters of the RelationRepository.Create
+type RelationRepositoryMockCreateParamPtrs struct {
+	ctx   *context.Context
+	tuple **model.RelationTuple
+}
+
+// RelationRepositoryMockCreateResults contains results of the RelationRepository.Create
+type RelationRepositoryMockCreateResults struct {
+	b1  bool
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mRelationRepositoryMockCreate) Optional() *mRelationRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for RelationRepository.Create
+func (mmCreate *mRelationRepositoryMockCreate) Expect(ctx context.Context, tuple *model.RelationTuple) *mRelationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RelationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RelationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("RelationRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &RelationRepositoryMockCreateParams{ctx, tuple}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationRepository.Create
+func (mmCreate *mRelationRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mRelationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RelationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RelationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RelationRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RelationRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectTupleParam2 sets up expected param tuple for RelationRepository.Create
+func (mmCreate *mRelationRepositoryMockCreate) ExpectTupleParam2(tuple *model.RelationTuple) *mRelationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RelationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RelationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RelationRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RelationRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.tuple = &tuple
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationRepository.Create
+func (mmCreate *mRelationRepositoryMockCreate) Inspect(f func(ctx context.Context, tuple *model.RelationTuple)) *mRelationRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for RelationRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by RelationRepository.Create
+func (mmCreate *mRelationRepositoryMockCreate) Return(b1 bool, err error) *RelationRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RelationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RelationRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &RelationRepositoryMockCreateResults{b1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the RelationRepository.Create method
+func (mmCreate *mRelationRepositoryMockCreate) Set(f func(ctx context.Context, tuple *model.RelationTuple) (b1 bool, err error)) *RelationRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the RelationRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the RelationRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the RelationRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mRelationRepositoryMockCreate) When(ctx context.Context, tuple *model.RelationTuple) *RelationRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RelationRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &RelationRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &RelationRepositoryMockCreateParams{ctx, tuple},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationRepository.Create return parameters for the expectation previously defined by the When method
+func (e *RelationRepositoryMockCreateExpectation) Then(b1 bool, err error) *RelationRepositoryMock {
+	e.results = &RelationRepositoryMockCreateResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationRepository.Create should be invoked
+func (mmCreate *mRelationRepositoryMockCreate) Times(n uint64) *mRelationRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of RelationRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	return mmCreate
+}
+
+func (mmCreate *mRelationRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements repository.RelationRepository
+func (mmCreate *RelationRepositoryMock) Create(ctx context.Context, tuple *model.RelationTuple) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, tuple)
+	}
+
+	mm_params := RelationRepositoryMockCreateParams{ctx, tuple}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationRepositoryMockCreateParams{ctx, tuple}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("RelationRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tuple != nil && !minimock.Equal(*mm_want_ptrs.tuple, mm_got.tuple) {
+				mmCreate.t.Errorf("RelationRepositoryMock.Create got unexpected parameter tuple, want: %#v, got: %#v%s\n", *mm_want_ptrs.tuple, mm_got.tuple, minimock.Diff(*mm_want_ptrs.tuple, mm_got.tuple))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("RelationRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the RelationRepositoryMock.Create")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, tuple)
+	}
+	mmCreate.t.Fatalf("Unexpected call to RelationRepositoryMock.Create. %v %v", ctx, tuple)
+	return
+}
+
+// CreateAfterCounter returns a count of finished RelationRepositoryMock.Create invocations
+func (mmCreate *RelationRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of RelationRepositoryMock.Create invocations
+func (mmCreate *RelationRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mRelationRepositoryMockCreate) Calls() []*RelationRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*RelationRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *RelationRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *RelationRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RelationRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to RelationRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Error("Expected call to RelationRepositoryMock.Create")
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationRepositoryMock.Create but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), afterCreateCounter)
+	}
+}
+
+type mRelationRepositoryMockDelete struct {
+	optional           bool
+	mock               *RelationRepositoryMock
+	defaultExpectation *RelationRepositoryMockDeleteExpectation
+	expectations       []*RelationRepositoryMockDeleteExpectation
+
+	callArgs []*RelationRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RelationRepositoryMockDeleteExpectation specifies expectation struct of the RelationRepository.Delete
+type RelationRepositoryMockDeleteExpectation struct {
+	mock      *RelationRepositoryMock
+	params    *RelationRepositoryMockDeleteParams
+	paramPtrs *RelationRepositoryMockDeleteParamPtrs
+	results   *RelationRepositoryMockDeleteResults
+	Counter   uint64
+}
+
+// RelationRepositoryMockDeleteParams contains parameters of the RelationRepository.Delete
+type RelationRepositoryMockDeleteParams struct {
+	ctx   context.Context
+	tuple *model.RelationTuple
+}
+
+// RelationRepositoryMockDeleteParamPtrs contains pointers to parameters of the RelationRepository.Delete
+type RelationRepositoryMockDeleteParamPtrs struct {
+	ctx   *context.Context
+	tuple **model.RelationTuple
+}
+
+// RelationRepositoryMockDeleteResults contains results of the RelationRepository.Delete
+type RelationRepositoryMockDeleteResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mRelationRepositoryMockDelete) Optional() *mRelationRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for RelationRepository.Delete
+func (mmDelete *mRelationRepositoryMockDelete) Expect(ctx context.Context, tuple *model.RelationTuple) *mRelationRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RelationRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RelationRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("RelationRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &RelationRepositoryMockDeleteParams{ctx, tuple}
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationRepository.Delete
+func (mmDelete *mRelationRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mRelationRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RelationRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RelationRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("RelationRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &RelationRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDelete
+}
+
+// ExpectTupleParam2 sets up expected param tuple for RelationRepository.Delete
+func (mmDelete *mRelationRepositoryMockDelete) ExpectTupleParam2(tuple *model.RelationTuple) *mRelationRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RelationRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RelationRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("RelationRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &RelationRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.tuple = &tuple
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationRepository.Delete
+func (mmDelete *mRelationRepositoryMockDelete) Inspect(f func(ctx context.Context, tuple *model.RelationTuple)) *mRelationRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for RelationRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by RelationRepository.Delete
+func (mmDelete *mRelationRepositoryMockDelete) Return(err error) *RelationRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RelationRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RelationRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &RelationRepositoryMockDeleteResults{err}
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the RelationRepository.Delete method
+func (mmDelete *mRelationRepositoryMockDelete) Set(f func(ctx context.Context, tuple *model.RelationTuple) (err error)) *RelationRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the RelationRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the RelationRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	return mmDelete.mock
+}
+
+// When sets expectation for the RelationRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mRelationRepositoryMockDelete) When(ctx context.Context, tuple *model.RelationTuple) *RelationRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RelationRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &RelationRepositoryMockDeleteExpectation{
+		mock:   mmDelete.mock,
+		params: &RelationRepositoryMockDeleteParams{ctx, tuple},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *RelationRepositoryMockDeleteExpectation) Then(err error) *RelationRepositoryMock {
+	e.results = &RelationRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times RelationRepository.Delete should be invoked
+func (mmDelete *mRelationRepositoryMockDelete) Times(n uint64) *mRelationRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of RelationRepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	return mmDelete
+}
+
+func (mmDelete *mRelationRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements repository.RelationRepository
+func (mmDelete *RelationRepositoryMock) Delete(ctx context.Context, tuple *model.RelationTuple) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, tuple)
+	}
+
+	mm_params := RelationRepositoryMockDeleteParams{ctx, tuple}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationRepositoryMockDeleteParams{ctx, tuple}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("RelationRepositoryMock.Delete got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tuple != nil && !minimock.Equal(*mm_want_ptrs.tuple, mm_got.tuple) {
+				mmDelete.t.Errorf("RelationRepositoryMock.Delete got unexpected parameter tuple, want: %#v, got: %#v%s\n", *mm_want_ptrs.tuple, mm_got.tuple, minimock.Diff(*mm_want_ptrs.tuple, mm_got.tuple))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("RelationRepositoryMock.Delete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the RelationRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, tuple)
+	}
+	mmDelete.t.Fatalf("Unexpected call to RelationRepositoryMock.Delete. %v %v", ctx, tuple)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished RelationRepositoryMock.Delete invocations
+func (mmDelete *RelationRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of RelationRepositoryMock.Delete invocations
+func (mmDelete *RelationRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mRelationRepositoryMockDelete) Calls() []*RelationRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*RelationRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *RelationRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *RelationRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationRepositoryMock.Delete with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RelationRepositoryMock.Delete")
+		} else {
+			m.t.Errorf("Expected call to RelationRepositoryMock.Delete with params: %#v", *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Error("Expected call to RelationRepositoryMock.Delete")
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationRepositoryMock.Delete but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), afterDeleteCounter)
+	}
+}
+
+type mRelationRepositoryMockList struct {
+	optional           bool
+	mock               *RelationRepositoryMock
+	defaultExpectation *RelationRepositoryMockListExpectation
+	expectations       []*RelationRepositoryMockListExpectation
+
+	callArgs []*RelationRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RelationRepositoryMockListExpectation specifies expectation struct of the RelationRepository.List
+type RelationRepositoryMockListExpectation struct {
+	mock      *RelationRepositoryMock
+	params    *RelationRepositoryMockListParams
+	paramPtrs *RelationRepositoryMockListParamPtrs
+	results   *RelationRepositoryMockListResults
+	Counter   uint64
+}
+
+// RelationRepositoryMockListParams contains parameters of the RelationRepository.List
+type RelationRepositoryMockListParams struct {
+	ctx    context.Context
+	filter relationFilter.RelationFilter
+}
+
+// RelationRepositoryMockListParamPtrs contains pointers to parameters of the RelationRepository.List
+type RelationRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	filter *relationFilter.RelationFilter
+}
+
+// RelationRepositoryMockListResults contains results of the RelationRepository.List
+type RelationRepositoryMockListResults struct {
+	rpa1 []*model.RelationTuple
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mRelationRepositoryMockList) Optional() *mRelationRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for RelationRepository.List
+func (mmList *mRelationRepositoryMockList) Expect(ctx context.Context, filter relationFilter.RelationFilter) *mRelationRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RelationRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RelationRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("RelationRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &RelationRepositoryMockListParams{ctx, filter}
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RelationRepository.List
+func (mmList *mRelationRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mRelationRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RelationRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RelationRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("RelationRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &RelationRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmList
+}
+
+// ExpectFilterParam2 sets up expected param filter for RelationRepository.List
+func (mmList *mRelationRepositoryMockList) ExpectFilterParam2(filter relationFilter.RelationFilter) *mRelationRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RelationRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RelationRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("RelationRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &RelationRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the RelationRepository.List
+func (mmList *mRelationRepositoryMockList) Inspect(f func(ctx context.Context, filter relationFilter.RelationFilter)) *mRelationRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for RelationRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by RelationRepository.List
+func (mmList *mRelationRepositoryMockList) Return(rpa1 []*model.RelationTuple, err error) *RelationRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RelationRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RelationRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &RelationRepositoryMockListResults{rpa1, err}
+	return mmList.mock
+}
+
+// Set uses given function f to mock the RelationRepository.List method
+func (mmList *mRelationRepositoryMockList) Set(f func(ctx context.Context, filter relationFilter.RelationFilter) (rpa1 []*model.RelationTuple, err error)) *RelationRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the RelationRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the RelationRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	return mmList.mock
+}
+
+// When sets expectation for the RelationRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mRelationRepositoryMockList) When(ctx context.Context, filter relationFilter.RelationFilter) *RelationRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RelationRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &RelationRepositoryMockListExpectation{
+		mock:   mmList.mock,
+		params: &RelationRepositoryMockListParams{ctx, filter},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RelationRepository.List return parameters for the expectation previously defined by the When method
+func (e *RelationRepositoryMockListExpectation) Then(rpa1 []*model.RelationTuple, err error) *RelationRepositoryMock {
+	e.results = &RelationRepositoryMockListResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times RelationRepository.List should be invoked
+func (mmList *mRelationRepositoryMockList) Times(n uint64) *mRelationRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of RelationRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	return mmList
+}
+
+func (mmList *mRelationRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements repository.RelationRepository
+func (mmList *RelationRepositoryMock) List(ctx context.Context, filter relationFilter.RelationFilter) (rpa1 []*model.RelationTuple, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, filter)
+	}
+
+	mm_params := RelationRepositoryMockListParams{ctx, filter}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := RelationRepositoryMockListParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("RelationRepositoryMock.List got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmList.t.Errorf("RelationRepositoryMock.List got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("RelationRepositoryMock.List got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the RelationRepositoryMock.List")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, filter)
+	}
+	mmList.t.Fatalf("Unexpected call to RelationRepositoryMock.List. %v %v", ctx, filter)
+	return
+}
+
+// ListAfterCounter returns a count of finished RelationRepositoryMock.List invocations
+func (mmList *RelationRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of RelationRepositoryMock.List invocations
+func (mmList *RelationRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to RelationRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mRelationRepositoryMockList) Calls() []*RelationRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*RelationRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *RelationRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *RelationRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RelationRepositoryMock.List with params: %#v", *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RelationRepositoryMock.List")
+		} else {
+			m.t.Errorf("Expected call to RelationRepositoryMock.List with params: %#v", *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Error("Expected call to RelationRepositoryMock.List")
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to RelationRepositoryMock.List but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), afterListCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RelationRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockListInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RelationRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RelationRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockListDone()
+}
@@ -0,0 +1,3027 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/auth/internal/repository.RoleRepository -o role_repository_minimock.go -n RoleRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// RoleRepositoryMock implements repository.RoleRepository
+type RoleRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAssignToUser          func(ctx context.Context, grant *model.RoleGrant) (err error)
+	inspectFuncAssignToUser   func(ctx context.Context, grant *model.RoleGrant)
+	afterAssignToUserCounter  uint64
+	beforeAssignToUserCounter uint64
+	AssignToUserMock          mRoleRepositoryMockAssignToUser
+
+	funcCountUsersByRoles          func(ctx context.Context) (rpa1 []*model.RoleSet, err error)
+	inspectFuncCountUsersByRoles   func(ctx context.Context)
+	afterCountUsersByRolesCounter  uint64
+	beforeCountUsersByRolesCounter uint64
+	CountUsersByRolesMock          mRoleRepositoryMockCountUsersByRoles
+
+	funcCreate          func(ctx context.Context, role *model.Role) (err error)
+	inspectFuncCreate   func(ctx context.Context, role *model.Role)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mRoleRepositoryMockCreate
+
+	funcDelete          func(ctx context.Context, name string) (err error)
+	inspectFuncDelete   func(ctx context.Context, name string)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mRoleRepositoryMockDelete
+
+	funcDeleteExpired          func(ctx context.Context, now time.Time) (rpa1 []*model.RoleGrant, err error)
+	inspectFuncDeleteExpired   func(ctx context.Context, now time.Time)
+	afterDeleteExpiredCounter  uint64
+	beforeDeleteExpiredCounter uint64
+	DeleteExpiredMock          mRoleRepositoryMockDeleteExpired
+
+	funcGet          func(ctx context.Context, name string) (rp1 *model.Role, err error)
+	inspectFuncGet   func(ctx context.Context, name string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mRoleRepositoryMockGet
+
+	funcList          func(ctx context.Context) (rpa1 []*model.Role, err error)
+	inspectFuncList   func(ctx context.Context)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mRoleRepositoryMockList
+
+	funcRevokeFromUser          func(ctx context.Context, userID int64, role string) (err error)
+	inspectFuncRevokeFromUser   func(ctx context.Context, userID int64, role string)
+	afterRevokeFromUserCounter  uint64
+	beforeRevokeFromUserCounter uint64
+	RevokeFromUserMock          mRoleRepositoryMockRevokeFromUser
+
+	funcUpdate          func(ctx context.Context, updates *model.RoleUpdate) (err error)
+	inspectFuncUpdate   func(ctx context.Context, updates *model.RoleUpdate)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mRoleRepositoryMockUpdate
+}
+
+// NewRoleRepositoryMock returns a mock for repository.RoleRepository
+func NewRoleRepositoryMock(t minimock.Tester) *RoleRepositoryMock {
+	m := &RoleRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AssignToUserMock = mRoleRepositoryMockAssignToUser{mock: m}
+	m.AssignToUserMock.callArgs = []*RoleRepositoryMockAssignToUserParams{}
+
+	m.CountUsersByRolesMock = mRoleRepositoryMockCountUsersByRoles{mock: m}
+	m.CountUsersByRolesMock.callArgs = []*RoleRepositoryMockCountUsersByRolesParams{}
+
+	m.CreateMock = mRoleRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*RoleRepositoryMockCreateParams{}
+
+	m.DeleteMock = mRoleRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*RoleRepositoryMockDeleteParams{}
+
+	m.DeleteExpiredMock = mRoleRepositoryMockDeleteExpired{mock: m}
+	m.DeleteExpiredMock.callArgs = []*RoleRepositoryMockDeleteExpiredParams{}
+
+	m.GetMock = mRoleRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*RoleRepositoryMockGetParams{}
+
+	m.ListMock = mRoleRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*RoleRepositoryMockListParams{}
+
+	m.RevokeFromUserMock = mRoleRepositoryMockRevokeFromUser{mock: m}
+	m.RevokeFromUserMock.callArgs = []*RoleRepositoryMockRevokeFromUserParams{}
+
+	m.UpdateMock = mRoleRepositoryMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*RoleRepositoryMockUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRoleRepositoryMockAssignToUser struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockAssignToUserExpectation
+	expectations       []*RoleRepositoryMockAssignToUserExpectation
+
+	callArgs []*RoleRepositoryMockAssignToUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RoleRepositoryMockAssignToUserExpectation specifies expectation struct of the RoleRepository.AssignToUser
+type RoleRepositoryMockAssignToUserExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockAssignToUserParams
+	paramPtrs *RoleRepositoryMockAssignToUserParamPtrs
+	results   *RoleRepositoryMockAssignToUserResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockAssignToUserParams contains parameters of the RoleRepository.AssignToUser
+type RoleRepositoryMockAssignToUserParams struct {
+	ctx   context.Context
+	grant *model.RoleGrant
+}
+
+// RoleRepositoryMockAssignToUserParamPtrs contains pointers to parameters of the RoleRepository.AssignToUser
+type RoleRepositoryMockAssignToUserParamPtrs struct {
+	ctx   *context.Context
+	grant **model.RoleGrant
+}
+
+// RoleRepositoryMockAssignToUserResults contains results of the RoleRepository.AssignToUser
+type RoleRepositoryMockAssignToUserResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAssignToUser *mRoleRepositoryMockAssignToUser) Optional() *mRoleRepositoryMockAssignToUser {
+	mmAssignToUser.optional = true
+	return mmAssignToUser
+}
+
+// Expect sets up expected params for RoleRepository.AssignToUser
+func (mmAssignToUser *mRoleRepositoryMockAssignToUser) Expect(ctx context.Context, grant *model.RoleGrant) *mRoleRepositoryMockAssignToUser {
+	if mmAssignToUser.mock.funcAssignToUser != nil {
+		mmAssignToUser.mock.t.Fatalf("RoleRepositoryMock.AssignToUser mock is already set by Set")
+	}
+
+	if mmAssignToUser.defaultExpectation == nil {
+		mmAssignToUser.defaultExpectation = &RoleRepositoryMockAssignToUserExpectation{}
+	}
+
+	if mmAssignToUser.defaultExpectation.paramPtrs != nil {
+		mmAssignToUser.mock.t.Fatalf("RoleRepositoryMock.AssignToUser mock is already set by ExpectParams functions")
+	}
+
+	mmAssignToUser.defaultExpectation.params = &RoleRepositoryMockAssignToUserParams{ctx, grant}
+	for _, e := range mmAssignToUser.expectations {
+		if minimock.Equal(e.params, mmAssignToUser.defaultExpectation.params) {
+			mmAssignToUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAssignToUser.defaultExpectation.params)
+		}
+	}
+
+	return mmAssignToUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.AssignToUser
+func (mmAssignToUser *mRoleRepositoryMockAssignToUser) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockAssignToUser {
+	if mmAssignToUser.mock.funcAssignToUser != nil {
+		mmAssignToUser.mock.t.Fatalf("RoleRepositoryMock.AssignToUser mock is already set by Set")
+	}
+
+	if mmAssignToUser.defaultExpectation == nil {
+		mmAssignToUser.defaultExpectation = &RoleRepositoryMockAssignToUserExpectation{}
+	}
+
+	if mmAssignToUser.defaultExpectation.params != nil {
+		mmAssignToUser.mock.t.Fatalf("RoleRepositoryMock.AssignToUser mock is already set by Expect")
+	}
+
+	if mmAssignToUser.defaultExpectation.paramPtrs == nil {
+		mmAssignToUser.defaultExpectation.paramPtrs = &RoleRepositoryMockAssignToUserParamPtrs{}
+	}
+	mmAssignToUser.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAssignToUser
+}
+
+// ExpectGrantParam2 sets up expected param grant for RoleRepository.AssignToUser
+func (mmAssignToUser *mRoleRepositoryMockAssignToUser) ExpectGrantParam2(grant *model.RoleGrant) *mRoleRepositoryMockAssignToUser {
+	if mmAssignToUser.mock.funcAssignToUser != nil {
+		mmAssignToUser.mock.t.Fatalf("RoleRepositoryMock.AssignToUser mock is already set by Set")
+	}
+
+	if mmAssignToUser.defaultExpectation == nil {
+		mmAssignToUser.defaultExpectation = &RoleRepositoryMockAssignToUserExpectation{}
+	}
+
+	if mmAssignToUser.defaultExpectation.params != nil {
+		mmAssignToUser.mock.t.Fatalf("RoleRepositoryMock.AssignToUser mock is already set by Expect")
+	}
+
+	if mmAssignToUser.defaultExpectation.paramPtrs == nil {
+		mmAssignToUser.defaultExpectation.paramPtrs = &RoleRepositoryMockAssignToUserParamPtrs{}
+	}
+	mmAssignToUser.defaultExpectation.paramPtrs.grant = &grant
+
+	return mmAssignToUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.AssignToUser
+func (mmAssignToUser *mRoleRepositoryMockAssignToUser) Inspect(f func(ctx context.Context, grant *model.RoleGrant)) *mRoleRepositoryMockAssignToUser {
+	if mmAssignToUser.mock.inspectFuncAssignToUser != nil {
+		mmAssignToUser.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.AssignToUser")
+	}
+
+	mmAssignToUser.mock.inspectFuncAssignToUser = f
+
+	return mmAssignToUser
+}
+
+// Return sets up results that will be returned by RoleRepository.AssignToUser
+func (mmAssignToUser *mRoleRepositoryMockAssignToUser) Return(err error) *RoleRepositoryMock {
+	if mmAssignToUser.mock.funcAssignToUser != nil {
+		mmAssignToUser.mock.t.Fatalf("RoleRepositoryMock.AssignToUser mock is already set by Set")
+	}
+
+	if mmAssignToUser.defaultExpectation == nil {
+		mmAssignToUser.defaultExpectation = &RoleRepositoryMockAssignToUserExpectation{mock: mmAssignToUser.mock}
+	}
+	mmAssignToUser.defaultExpectation.results = &RoleRepositoryMockAssignToUserResults{err}
+	return mmAssignToUser.mock
+}
+
+// Set uses given function f to mock the RoleRepository.AssignToUser method
+func (mmAssignToUser *mRoleRepositoryMockAssignToUser) Set(f func(ctx context.Context, grant *model.RoleGrant) (err error)) *RoleRepositoryMock {
+	if mmAssignToUser.defaultExpectation != nil {
+		mmAssignToUser.mock.t.Fatalf("Default expectation is already set for the RoleRepository.AssignToUser method")
+	}
+
+	if len(mmAssignToUser.expectations) > 0 {
+		mmAssignToUser.mock.t.Fatalf("Some expectations are already set for the RoleRepository.AssignToUser method")
+	}
+
+	mmAssignToUser.mock.funcAssignToUser = f
+	return mmAssignToUser.mock
+}
+
+// When sets expectation for the RoleRepository.AssignToUser which will trigger the result defined by the following
+// Then helper
+func (mmAssignToUser *mRoleRepositoryMockAssignToUser) When(ctx context.Context, grant *model.RoleGrant) *RoleRepositoryMockAssignToUserExpectation {
+	if mmAssignToUser.mock.funcAssignToUser != nil {
+		mmAssignToUser.mock.t.Fatalf("RoleRepositoryMock.AssignToUser mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockAssignToUserExpectation{
+		mock:   mmAssignToUser.mock,
+		params: &RoleRepositoryMockAssignToUserParams{ctx, grant},
+	}
+	mmAssignToUser.expectations = append(mmAssignToUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.AssignToUser return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockAssignToUserExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockAssignToUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.AssignToUser should be invoked
+func (mmAssignToUser *mRoleRepositoryMockAssignToUser) Times(n uint64) *mRoleRepositoryMockAssignToUser {
+	if n == 0 {
+		mmAssignToUser.mock.t.Fatalf("Times of RoleRepositoryMock.AssignToUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAssignToUser.expectedInvocations, n)
+	return mmAssignToUser
+}
+
+func (mmAssignToUser *mRoleRepositoryMockAssignToUser) invocationsDone() bool {
+	if len(mmAssignToUser.expectations) == 0 && mmAssignToUser.defaultExpectation == nil && mmAssignToUser.mock.funcAssignToUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAssignToUser.mock.afterAssignToUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAssignToUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AssignToUser implements repository.RoleRepository
+func (mmAssignToUser *RoleRepositoryMock) AssignToUser(ctx context.Context, grant *model.RoleGrant) (err error) {
+	mm_atomic.AddUint64(&mmAssignToUser.beforeAssignToUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmAssignToUser.afterAssignToUserCounter, 1)
+
+	if mmAssignToUser.inspectFuncAssignToUser != nil {
+		mmAssignToUser.inspectFuncAssignToUser(ctx, grant)
+	}
+
+	mm_params := RoleRepositoryMockAssignToUserParams{ctx, grant}
+
+	// Record call args
+	mmAssignToUser.AssignToUserMock.mutex.Lock()
+	mmAssignToUser.AssignToUserMock.callArgs = append(mmAssignToUser.AssignToUserMock.callArgs, &mm_params)
+	mmAssignToUser.AssignToUserMock.mutex.Unlock()
+
+	for _, e := range mmAssignToUser.AssignToUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAssignToUser.AssignToUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAssignToUser.AssignToUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmAssignToUser.AssignToUserMock.defaultExpectation.params
+		mm_want_ptrs := mmAssignToUser.AssignToUserMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockAssignToUserParams{ctx, grant}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAssignToUser.t.Errorf("RoleRepositoryMock.AssignToUser got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.grant != nil && !minimock.Equal(*mm_want_ptrs.grant, mm_got.grant) {
+				mmAssignToUser.t.Errorf("RoleRepositoryMock.AssignToUser got unexpected parameter grant, want: %#v, got: %#v%s\n", *mm_want_ptrs.grant, mm_got.grant, minimock.Diff(*mm_want_ptrs.grant, mm_got.grant))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAssignToUser.t.Errorf("RoleRepositoryMock.AssignToUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAssignToUser.AssignToUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAssignToUser.t.Fatal("No results are set for the RoleRepositoryMock.AssignToUser")
+		}
+		return (*mm_results).err
+	}
+	if mmAssignToUser.funcAssignToUser != nil {
+		return mmAssignToUser.funcAssignToUser(ctx, grant)
+	}
+	mmAssignToUser.t.Fatalf("Unexpected call to RoleRepositoryMock.AssignToUser. %v %v", ctx, grant)
+	return
+}
+
+// AssignToUserAfterCounter returns a count of finished RoleRepositoryMock.AssignToUser invocations
+func (mmAssignToUser *RoleRepositoryMock) AssignToUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAssignToUser.afterAssignToUserCounter)
+}
+
+// AssignToUserBeforeCounter returns a count of RoleRepositoryMock.AssignToUser invocations
+func (mmAssignToUser *RoleRepositoryMock) AssignToUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAssignToUser.beforeAssignToUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.AssignToUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAssignToUser *mRoleRepositoryMockAssignToUser) Calls() []*RoleRepositoryMockAssignToUserParams {
+	mmAssignToUser.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockAssignToUserParams, len(mmAssignToUser.callArgs))
+	copy(argCopy, mmAssignToUser.callArgs)
+
+	mmAssignToUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAssignToUserDone returns true if the count of the AssignToUser invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockAssignToUserDone() bool {
+	if m.AssignToUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AssignToUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AssignToUserMock.invocationsDone()
+}
+
+// MinimockAssignToUserInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockAssignToUserInspect() {
+	for _, e := range m.AssignToUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.AssignToUser with params: %#v", *e.params)
+		}
+	}
+
+	afterAssignToUserCounter := mm_atomic.LoadUint64(&m.afterAssignToUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AssignToUserMock.defaultExpectation != nil && afterAssignToUserCounter < 1 {
+		if m.AssignToUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.AssignToUser")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.AssignToUser with params: %#v", *m.AssignToUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAssignToUser != nil && afterAssignToUserCounter < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.AssignToUser")
+	}
+
+	if !m.AssignToUserMock.invocationsDone() && afterAssignToUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.AssignToUser but found %d calls",
+			mm_atomic.LoadUint64(&m.AssignToUserMock.expectedInvocations), afterAssignToUserCounter)
+	}
+}
+
+type mRoleRepositoryMockCountUsersByRoles struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockCountUsersByRolesExpectation
+	expectations       []*RoleRepositoryMockCountUsersByRolesExpectation
+
+	callArgs []*RoleRepositoryMockCountUsersByRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RoleRepositoryMockCountUsersByRolesExpectation specifies expectation struct of the RoleRepository.CountUsersByRoles
+type RoleRepositoryMockCountUsersByRolesExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockCountUsersByRolesParams
+	paramPtrs *RoleRepositoryMockCountUsersByRolesParamPtrs
+	results   *RoleRepositoryMockCountUsersByRolesResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockCountUsersByRolesParams contains parameters of the RoleRepository.CountUsersByRoles
+type RoleRepositoryMockCountUsersByRolesParams struct {
+	ctx context.Context
+}
+
+// RoleRepositoryMockCountUsersByRolesParamPtrs contains pointers to parameters of the RoleRepository.CountUsersByRoles
+type RoleRepositoryMockCountUsersByRolesParamPtrs struct {
+	ctx *context.Context
+}
+
+// RoleRepositoryMockCountUsersByRolesResults contains results of the RoleRepository.CountUsersByRoles
+type RoleRepositoryMockCountUsersByRolesResults struct {
+	rpa1 []*model.RoleSet
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountUsersByRoles *mRoleRepositoryMockCountUsersByRoles) Optional() *mRoleRepositoryMockCountUsersByRoles {
+	mmCountUsersByRoles.optional = true
+	return mmCountUsersByRoles
+}
+
+// Expect sets up expected params for RoleRepository.CountUsersByRoles
+func (mmCountUsersByRoles *mRoleRepositoryMockCountUsersByRoles) Expect(ctx context.Context) *mRoleRepositoryMockCountUsersByRoles {
+	if mmCountUsersByRoles.mock.funcCountUsersByRoles != nil {
+		mmCountUsersByRoles.mock.t.Fatalf("RoleRepositoryMock.CountUsersByRoles mock is already set by Set")
+	}
+
+	if mmCountUsersByRoles.defaultExpectation == nil {
+		mmCountUsersByRoles.defaultExpectation = &RoleRepositoryMockCountUsersByRolesExpectation{}
+	}
+
+	if mmCountUsersByRoles.defaultExpectation.paramPtrs != nil {
+		mmCountUsersByRoles.mock.t.Fatalf("RoleRepositoryMock.CountUsersByRoles mock is already set by ExpectParams functions")
+	}
+
+	mmCountUsersByRoles.defaultExpectation.params = &RoleRepositoryMockCountUsersByRolesParams{ctx}
+	for _, e := range mmCountUsersByRoles.expectations {
+		if minimock.Equal(e.params, mmCountUsersByRoles.defaultExpectation.params) {
+			mmCountUsersByRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountUsersByRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmCountUsersByRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.CountUsersByRoles
+func (mmCountUsersByRoles *mRoleRepositoryMockCountUsersByRoles) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockCountUsersByRoles {
+	if mmCountUsersByRoles.mock.funcCountUsersByRoles != nil {
+		mmCountUsersByRoles.mock.t.Fatalf("RoleRepositoryMock.CountUsersByRoles mock is already set by Set")
+	}
+
+	if mmCountUsersByRoles.defaultExpectation == nil {
+		mmCountUsersByRoles.defaultExpectation = &RoleRepositoryMockCountUsersByRolesExpectation{}
+	}
+
+	if mmCountUsersByRoles.defaultExpectation.params != nil {
+		mmCountUsersByRoles.mock.t.Fatalf("RoleRepositoryMock.CountUsersByRoles mock is already set by Expect")
+	}
+
+	if mmCountUsersByRoles.defaultExpectation.paramPtrs == nil {
+		mmCountUsersByRoles.defaultExpectation.paramPtrs = &RoleRepositoryMockCountUsersByRolesParamPtrs{}
+	}
+	mmCountUsersByRoles.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCountUsersByRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.CountUsersByRoles
+func (mmCountUsersByRoles *mRoleRepositoryMockCountUsersByRoles) Inspect(f func(ctx context.Context)) *mRoleRepositoryMockCountUsersByRoles {
+	if mmCountUsersByRoles.mock.inspectFuncCountUsersByRoles != nil {
+		mmCountUsersByRoles.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.CountUsersByRoles")
+	}
+
+	mmCountUsersByRoles.mock.inspectFuncCountUsersByRoles = f
+
+	return mmCountUsersByRoles
+}
+
+// Return sets up results that will be returned by RoleRepository.CountUsersByRoles
+func (mmCountUsersByRoles *mRoleRepositoryMockCountUsersByRoles) Return(rpa1 []*model.RoleSet, err error) *RoleRepositoryMock {
+	if mmCountUsersByRoles.mock.funcCountUsersByRoles != nil {
+		mmCountUsersByRoles.mock.t.Fatalf("RoleRepositoryMock.CountUsersByRoles mock is already set by Set")
+	}
+
+	if mmCountUsersByRoles.defaultExpectation == nil {
+		mmCountUsersByRoles.defaultExpectation = &RoleRepositoryMockCountUsersByRolesExpectation{mock: mmCountUsersByRoles.mock}
+	}
+	mmCountUsersByRoles.defaultExpectation.results = &RoleRepositoryMockCountUsersByRolesResults{rpa1, err}
+	return mmCountUsersByRoles.mock
+}
+
+// Set uses given function f to mock the RoleRepository.CountUsersByRoles method
+func (mmCountUsersByRoles *mRoleRepositoryMockCountUsersByRoles) Set(f func(ctx context.Context) (rpa1 []*model.RoleSet, err error)) *RoleRepositoryMock {
+	if mmCountUsersByRoles.defaultExpectation != nil {
+		mmCountUsersByRoles.mock.t.Fatalf("Default expectation is already set for the RoleRepository.CountUsersByRoles method")
+	}
+
+	if len(mmCountUsersByRoles.expectations) > 0 {
+		mmCountUsersByRoles.mock.t.Fatalf("Some expectations are already set for the RoleRepository.CountUsersByRoles method")
+	}
+
+	mmCountUsersByRoles.mock.funcCountUsersByRoles = f
+	return mmCountUsersByRoles.mock
+}
+
+// When sets expectation for the RoleRepository.CountUsersByRoles which will trigger the result defined by the following
+// Then helper
+func (mmCountUsersByRoles *mRoleRepositoryMockCountUsersByRoles) When(ctx context.Context) *RoleRepositoryMockCountUsersByRolesExpectation {
+	if mmCountUsersByRoles.mock.funcCountUsersByRoles != nil {
+		mmCountUsersByRoles.mock.t.Fatalf("RoleRepositoryMock.CountUsersByRoles mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockCountUsersByRolesExpectation{
+		mock:   mmCountUsersByRoles.mock,
+		params: &RoleRepositoryMockCountUsersByRolesParams{ctx},
+	}
+	mmCountUsersByRoles.expectations = append(mmCountUsersByRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.CountUsersByRoles return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockCountUsersByRolesExpectation) Then(rpa1 []*model.RoleSet, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockCountUsersByRolesResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.CountUsersByRoles should be invoked
+func (mmCountUsersByRoles *mRoleRepositoryMockCountUsersByRoles) Times(n uint64) *mRoleRepositoryMockCountUsersByRoles {
+	if n == 0 {
+		mmCountUsersByRoles.mock.t.Fatalf("Times of RoleRepositoryMock.CountUsersByRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountUsersByRoles.expectedInvocations, n)
+	return mmCountUsersByRoles
+}
+
+func (mmCountUsersByRoles *mRoleRepositoryMockCountUsersByRoles) invocationsDone() bool {
+	if len(mmCountUsersByRoles.expectations) == 0 && mmCountUsersByRoles.defaultExpectation == nil && mmCountUsersByRoles.mock.funcCountUsersByRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountUsersByRoles.mock.afterCountUsersByRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountUsersByRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountUsersByRoles implements repository.RoleRepository
+func (mmCountUsersByRoles *RoleRepositoryMock) CountUsersByRoles(ctx context.Context) (rpa1 []*model.RoleSet, err error) {
+	mm_atomic.AddUint64(&mmCountUsersByRoles.beforeCountUsersByRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountUsersByRoles.afterCountUsersByRolesCounter, 1)
+
+	if mmCountUsersByRoles.inspectFuncCountUsersByRoles != nil {
+		mmCountUsersByRoles.inspectFuncCountUsersByRoles(ctx)
+	}
+
+	mm_params := RoleRepositoryMockCountUsersByRolesParams{ctx}
+
+	// Record call args
+	mmCountUsersByRoles.CountUsersByRolesMock.mutex.Lock()
+	mmCountUsersByRoles.CountUsersByRolesMock.callArgs = append(mmCountUsersByRoles.CountUsersByRolesMock.callArgs, &mm_params)
+	mmCountUsersByRoles.CountUsersByRolesMock.mutex.Unlock()
+
+	for _, e := range mmCountUsersByRoles.CountUsersByRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmCountUsersByRoles.CountUsersByRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountUsersByRoles.CountUsersByRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountUsersByRoles.CountUsersByRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmCountUsersByRoles.CountUsersByRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockCountUsersByRolesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountUsersByRoles.t.Errorf("RoleRepositoryMock.CountUsersByRoles got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountUsersByRoles.t.Errorf("RoleRepositoryMock.CountUsersByRoles got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountUsersByRoles.CountUsersByRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountUsersByRoles.t.Fatal("No results are set for the RoleRepositoryMock.CountUsersByRoles")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmCountUsersByRoles.funcCountUsersByRoles != nil {
+		return mmCountUsersByRoles.funcCountUsersByRoles(ctx)
+	}
+	mmCountUsersByRoles.t.Fatalf("Unexpected call to RoleRepositoryMock.CountUsersByRoles. %v", ctx)
+	return
+}
+
+// CountUsersByRolesAfterCounter returns a count of finished RoleRepositoryMock.CountUsersByRoles invocations
+func (mmCountUsersByRoles *RoleRepositoryMock) CountUsersByRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountUsersByRoles.afterCountUsersByRolesCounter)
+}
+
+// CountUsersByRolesBeforeCounter returns a count of RoleRepositoryMock.CountUsersByRoles invocations
+func (mmCountUsersByRoles *RoleRepositoryMock) CountUsersByRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountUsersByRoles.beforeCountUsersByRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.CountUsersByRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountUsersByRoles *mRoleRepositoryMockCountUsersByRoles) Calls() []*RoleRepositoryMockCountUsersByRolesParams {
+	mmCountUsersByRoles.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockCountUsersByRolesParams, len(mmCountUsersByRoles.callArgs))
+	copy(argCopy, mmCountUsersByRoles.callArgs)
+
+	mmCountUsersByRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountUsersByRolesDone returns true if the count of the CountUsersByRoles invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockCountUsersByRolesDone() bool {
+	if m.CountUsersByRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountUsersByRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountUsersByRolesMock.invocationsDone()
+}
+
+// MinimockCountUsersByRolesInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockCountUsersByRolesInspect() {
+	for _, e := range m.CountUsersByRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.CountUsersByRoles with params: %#v", *e.params)
+		}
+	}
+
+	afterCountUsersByRolesCounter := mm_atomic.LoadUint64(&m.afterCountUsersByRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountUsersByRolesMock.defaultExpectation != nil && afterCountUsersByRolesCounter < 1 {
+		if m.CountUsersByRolesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.CountUsersByRoles")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.CountUsersByRoles with params: %#v", *m.CountUsersByRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountUsersByRoles != nil && afterCountUsersByRolesCounter < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.CountUsersByRoles")
+	}
+
+	if !m.CountUsersByRolesMock.invocationsDone() && afterCountUsersByRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.CountUsersByRoles but found %d calls",
+			mm_atomic.LoadUint64(&m.CountUsersByRolesMock.expectedInvocations), afterCountUsersByRolesCounter)
+	}
+}
+
+type mRoleRepositoryMockCreate struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockCreateExpectation
+	expectations       []*RoleRepositoryMockCreateExpectation
+
+	callArgs []*RoleRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RoleRepositoryMockCreateExpectation specifies expectation struct of the RoleRepository.Create
+type RoleRepositoryMockCreateExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockCreateParams
+	paramPtrs *RoleRepositoryMockCreateParamPtrs
+	results   *RoleRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockCreateParams contains parameters of the RoleRepository.Create
+type RoleRepositoryMockCreateParams struct {
+	ctx  context.Context
+	role *model.Role
+}
+
+// RoleRepositoryMockCreateParamPtrs contains pointers to parameters of the RoleRepository.Create
+type RoleRepositoryMockCreateParamPtrs struct {
+	ctx  *context.Context
+	role **model.Role
+}
+
+// RoleRepositoryMockCreateResults contains results of the RoleRepository.Create
+type RoleRepositoryMockCreateResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mRoleRepositoryMockCreate) Optional() *mRoleRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for RoleRepository.Create
+func (mmCreate *mRoleRepositoryMockCreate) Expect(ctx context.Context, role *model.Role) *mRoleRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &RoleRepositoryMockCreateParams{ctx, role}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.Create
+func (mmCreate *mRoleRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RoleRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectRoleParam2 sets up expected param role for RoleRepository.Create
+func (mmCreate *mRoleRepositoryMockCreate) ExpectRoleParam2(role *model.Role) *mRoleRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RoleRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.role = &role
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.Create
+func (mmCreate *mRoleRepositoryMockCreate) Inspect(f func(ctx context.Context, role *model.Role)) *mRoleRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by RoleRepository.Create
+func (mmCreate *mRoleRepositoryMockCreate) Return(err error) *RoleRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RoleRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &RoleRepositoryMockCreateResults{err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the RoleRepository.Create method
+func (mmCreate *mRoleRepositoryMockCreate) Set(f func(ctx context.Context, role *model.Role) (err error)) *RoleRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the RoleRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the RoleRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the RoleRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mRoleRepositoryMockCreate) When(ctx context.Context, role *model.Role) *RoleRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RoleRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &RoleRepositoryMockCreateParams{ctx, role},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.Create return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockCreateExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.Create should be invoked
+func (mmCreate *mRoleRepositoryMockCreate) Times(n uint64) *mRoleRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of RoleRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	return mmCreate
+}
+
+func (mmCreate *mRoleRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements repository.RoleRepository
+func (mmCreate *RoleRepositoryMock) Create(ctx context.Context, role *model.Role) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, role)
+	}
+
+	mm_params := RoleRepositoryMockCreateParams{ctx, role}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockCreateParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("RoleRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmCreate.t.Errorf("RoleRepositoryMock.Create got unexpected parameter role, want: %#v, got: %#v%s\n", *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("RoleRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the RoleRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, role)
+	}
+	mmCreate.t.Fatalf("Unexpected call to RoleRepositoryMock.Create. %v %v", ctx, role)
+	return
+}
+
+// CreateAfterCounter returns a count of finished RoleRepositoryMock.Create invocations
+func (mmCreate *RoleRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of RoleRepositoryMock.Create invocations
+func (mmCreate *RoleRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mRoleRepositoryMockCreate) Calls() []*RoleRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.Create")
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.Create but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), afterCreateCounter)
+	}
+}
+
+type mRoleRepositoryMockDelete struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockDeleteExpectation
+	expectations       []*RoleRepositoryMockDeleteExpectation
+
+	callArgs []*RoleRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RoleRepositoryMockDeleteExpectation specifies expectation struct of the RoleRepository.Delete
+type RoleRepositoryMockDeleteExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockDeleteParams
+	paramPtrs *RoleRepositoryMockDeleteParamPtrs
+	results   *RoleRepositoryMockDeleteResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockDeleteParams contains parameters of the RoleRepository.Delete
+type RoleRepositoryMockDeleteParams struct {
+	ctx  context.Context
+	name string
+}
+
+// RoleRepositoryMockDeleteParamPtrs contains pointers to parameters of the RoleRepository.Delete
+type RoleRepositoryMockDeleteParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// RoleRepositoryMockDeleteResults contains results of the RoleRepository.Delete
+type RoleRepositoryMockDeleteResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mRoleRepositoryMockDelete) Optional() *mRoleRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for RoleRepository.Delete
+func (mmDelete *mRoleRepositoryMockDelete) Expect(ctx context.Context, name string) *mRoleRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RoleRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &RoleRepositoryMockDeleteParams{ctx, name}
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.Delete
+func (mmDelete *mRoleRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RoleRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &RoleRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDelete
+}
+
+// ExpectNameParam2 sets up expected param name for RoleRepository.Delete
+func (mmDelete *mRoleRepositoryMockDelete) ExpectNameParam2(name string) *mRoleRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RoleRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &RoleRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.name = &name
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.Delete
+func (mmDelete *mRoleRepositoryMockDelete) Inspect(f func(ctx context.Context, name string)) *mRoleRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by RoleRepository.Delete
+func (mmDelete *mRoleRepositoryMockDelete) Return(err error) *RoleRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RoleRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &RoleRepositoryMockDeleteResults{err}
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the RoleRepository.Delete method
+func (mmDelete *mRoleRepositoryMockDelete) Set(f func(ctx context.Context, name string) (err error)) *RoleRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the RoleRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the RoleRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	return mmDelete.mock
+}
+
+// When sets expectation for the RoleRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mRoleRepositoryMockDelete) When(ctx context.Context, name string) *RoleRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RoleRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockDeleteExpectation{
+		mock:   mmDelete.mock,
+		params: &RoleRepositoryMockDeleteParams{ctx, name},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockDeleteExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.Delete should be invoked
+func (mmDelete *mRoleRepositoryMockDelete) Times(n uint64) *mRoleRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of RoleRepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	return mmDelete
+}
+
+func (mmDelete *mRoleRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements repository.RoleRepository
+func (mmDelete *RoleRepositoryMock) Delete(ctx context.Context, name string) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, name)
+	}
+
+	mm_params := RoleRepositoryMockDeleteParams{ctx, name}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockDeleteParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("RoleRepositoryMock.Delete got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDelete.t.Errorf("RoleRepositoryMock.Delete got unexpected parameter name, want: %#v, got: %#v%s\n", *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("RoleRepositoryMock.Delete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the RoleRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, name)
+	}
+	mmDelete.t.Fatalf("Unexpected call to RoleRepositoryMock.Delete. %v %v", ctx, name)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished RoleRepositoryMock.Delete invocations
+func (mmDelete *RoleRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of RoleRepositoryMock.Delete invocations
+func (mmDelete *RoleRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mRoleRepositoryMockDelete) Calls() []*RoleRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.Delete with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.Delete")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.Delete with params: %#v", *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.Delete")
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.Delete but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), afterDeleteCounter)
+	}
+}
+
+type mRoleRepositoryMockDeleteExpired struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockDeleteExpiredExpectation
+	expectations       []*RoleRepositoryMockDeleteExpiredExpectation
+
+	callArgs []*RoleRepositoryMockDeleteExpiredParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RoleRepositoryMockDeleteExpiredExpectation specifies expectation struct of the RoleRepository.DeleteExpired
+type RoleRepositoryMockDeleteExpiredExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockDeleteExpiredParams
+	paramPtrs *RoleRepositoryMockDeleteExpiredParamPtrs
+	results   *RoleRepositoryMockDeleteExpiredResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockDeleteExpiredParams contains parameters of the RoleRepository.DeleteExpired
+type RoleRepositoryMockDeleteExpiredParams struct {
+	ctx context.Context
+	now time.Time
+}
+
+// RoleRepositoryMockDeleteExpiredParamPtrs contains pointers to parameters of the RoleRepository.DeleteExpired
+type RoleRepositoryMockDeleteExpiredParamPtrs struct {
+	ctx *context.Context
+	now *time.Time
+}
+
+// RoleRepositoryMockDeleteExpiredResults contains results of the RoleRepository.DeleteExpired
+type RoleRepositoryMockDeleteExpiredResults struct {
+	rpa1 []*model.RoleGrant
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteExpired *mRoleRepositoryMockDeleteExpired) Optional() *mRoleRepositoryMockDeleteExpired {
+	mmDeleteExpired.optional = true
+	return mmDeleteExpired
+}
+
+// Expect sets up expected params for RoleRepository.DeleteExpired
+func (mmDeleteExpired *mRoleRepositoryMockDeleteExpired) Expect(ctx context.Context, now time.Time) *mRoleRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("RoleRepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &RoleRepositoryMockDeleteExpiredExpectation{}
+	}
+
+	if mmDeleteExpired.defaultExpectation.paramPtrs != nil {
+		mmDeleteExpired.mock.t.Fatalf("RoleRepositoryMock.DeleteExpired mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteExpired.defaultExpectation.params = &RoleRepositoryMockDeleteExpiredParams{ctx, now}
+	for _, e := range mmDeleteExpired.expectations {
+		if minimock.Equal(e.params, mmDeleteExpired.defaultExpectation.params) {
+			mmDeleteExpired.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteExpired.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteExpired
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.DeleteExpired
+func (mmDeleteExpired *mRoleRepositoryMockDeleteExpired) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("RoleRepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &RoleRepositoryMockDeleteExpiredExpectation{}
+	}
+
+	if mmDeleteExpired.defaultExpectation.params != nil {
+		mmDeleteExpired.mock.t.Fatalf("RoleRepositoryMock.DeleteExpired mock is already set by Expect")
+	}
+
+	if mmDeleteExpired.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpired.defaultExpectation.paramPtrs = &RoleRepositoryMockDeleteExpiredParamPtrs{}
+	}
+	mmDeleteExpired.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteExpired
+}
+
+// ExpectNowParam2 sets up expected param now for RoleRepository.DeleteExpired
+func (mmDeleteExpired *mRoleRepositoryMockDeleteExpired) ExpectNowParam2(now time.Time) *mRoleRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("RoleRepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &RoleRepositoryMockDeleteExpiredExpectation{}
+	}
+
+	if mmDeleteExpired.defaultExpectation.params != nil {
+		mmDeleteExpired.mock.t.Fatalf("RoleRepositoryMock.DeleteExpired mock is already set by Expect")
+	}
+
+	if mmDeleteExpired.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpired.defaultExpectation.paramPtrs = &RoleRepositoryMockDeleteExpiredParamPtrs{}
+	}
+	mmDeleteExpired.defaultExpectation.paramPtrs.now = &now
+
+	return mmDeleteExpired
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.DeleteExpired
+func (mmDeleteExpired *mRoleRepositoryMockDeleteExpired) Inspect(f func(ctx context.Context, now time.Time)) *mRoleRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.inspectFuncDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.DeleteExpired")
+	}
+
+	mmDeleteExpired.mock.inspectFuncDeleteExpired = f
+
+	return mmDeleteExpired
+}
+
+// Return sets up results that will be returned by RoleRepository.DeleteExpired
+func (mmDeleteExpired *mRoleRepositoryMockDeleteExpired) Return(rpa1 []*model.RoleGrant, err error) *RoleRepositoryMock {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("RoleRepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &RoleRepositoryMockDeleteExpiredExpectation{mock: mmDeleteExpired.mock}
+	}
+	mmDeleteExpired.defaultExpectation.results = &RoleRepositoryMockDeleteExpiredResults{rpa1, err}
+	return mmDeleteExpired.mock
+}
+
+// Set uses given function f to mock the RoleRepository.DeleteExpired method
+func (mmDeleteExpired *mRoleRepositoryMockDeleteExpired) Set(f func(ctx context.Context, now time.Time) (rpa1 []*model.RoleGrant, err error)) *RoleRepositoryMock {
+	if mmDeleteExpired.defaultExpectation != nil {
+		mmDeleteExpired.mock.t.Fatalf("Default expectation is already set for the RoleRepository.DeleteExpired method")
+	}
+
+	if len(mmDeleteExpired.expectations) > 0 {
+		mmDeleteExpired.mock.t.Fatalf("Some expectations are already set for the RoleRepository.DeleteExpired method")
+	}
+
+	mmDeleteExpired.mock.funcDeleteExpired = f
+	return mmDeleteExpired.mock
+}
+
+// When sets expectation for the RoleRepository.DeleteExpired which will trigger the result defined by the following
+// Then helper
+func (mmDeleteExpired *mRoleRepositoryMockDeleteExpired) When(ctx context.Context, now time.Time) *RoleRepositoryMockDeleteExpiredExpectation {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("RoleRepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockDeleteExpiredExpectation{
+		mock:   mmDeleteExpired.mock,
+		params: &RoleRepositoryMockDeleteExpiredParams{ctx, now},
+	}
+	mmDeleteExpired.expectations = append(mmDeleteExpired.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.DeleteExpired return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockDeleteExpiredExpectation) Then(rpa1 []*model.RoleGrant, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockDeleteExpiredResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.DeleteExpired should be invoked
+func (mmDeleteExpired *mRoleRepositoryMockDeleteExpired) Times(n uint64) *mRoleRepositoryMockDeleteExpired {
+	if n == 0 {
+		mmDeleteExpired.mock.t.Fatalf("Times of RoleRepositoryMock.DeleteExpired mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteExpired.expectedInvocations, n)
+	return mmDeleteExpired
+}
+
+func (mmDeleteExpired *mRoleRepositoryMockDeleteExpired) invocationsDone() bool {
+	if len(mmDeleteExpired.expectations) == 0 && mmDeleteExpired.defaultExpectation == nil && mmDeleteExpired.mock.funcDeleteExpired == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteExpired.mock.afterDeleteExpiredCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteExpired.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteExpired implements repository.RoleRepository
+func (mmDeleteExpired *RoleRepositoryMock) DeleteExpired(ctx context.Context, now time.Time) (rpa1 []*model.RoleGrant, err error) {
+	mm_atomic.AddUint64(&mmDeleteExpired.beforeDeleteExpiredCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteExpired.afterDeleteExpiredCounter, 1)
+
+	if mmDeleteExpired.inspectFuncDeleteExpired != nil {
+		mmDeleteExpired.inspectFuncDeleteExpired(ctx, now)
+	}
+
+	mm_params := RoleRepositoryMockDeleteExpiredParams{ctx, now}
+
+	// Record call args
+	mmDeleteExpired.DeleteExpiredMock.mutex.Lock()
+	mmDeleteExpired.DeleteExpiredMock.callArgs = append(mmDeleteExpired.DeleteExpiredMock.callArgs, &mm_params)
+	mmDeleteExpired.DeleteExpiredMock.mutex.Unlock()
+
+	for _, e := range mmDeleteExpired.DeleteExpiredMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmDeleteExpired.DeleteExpiredMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteExpired.DeleteExpiredMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteExpired.DeleteExpiredMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteExpired.DeleteExpiredMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockDeleteExpiredParams{ctx, now}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteExpired.t.Errorf("RoleRepositoryMock.DeleteExpired got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmDeleteExpired.t.Errorf("RoleRepositoryMock.DeleteExpired got unexpected parameter now, want: %#v, got: %#v%s\n", *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteExpired.t.Errorf("RoleRepositoryMock.DeleteExpired got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteExpired.DeleteExpiredMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteExpired.t.Fatal("No results are set for the RoleRepositoryMock.DeleteExpired")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmDeleteExpired.funcDeleteExpired != nil {
+		return mmDeleteExpired.funcDeleteExpired(ctx, now)
+	}
+	mmDeleteExpired.t.Fatalf("Unexpected call to RoleRepositoryMock.DeleteExpired. %v %v", ctx, now)
+	return
+}
+
+// DeleteExpiredAfterCounter returns a count of finished RoleRepositoryMock.DeleteExpired invocations
+func (mmDeleteExpired *RoleRepositoryMock) DeleteExpiredAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpired.afterDeleteExpiredCounter)
+}
+
+// DeleteExpiredBeforeCounter returns a count of RoleRepositoryMock.DeleteExpired invocations
+func (mmDeleteExpired *RoleRepositoryMock) DeleteExpiredBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpired.beforeDeleteExpiredCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.DeleteExpired.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteExpired *mRoleRepositoryMockDeleteExpired) Calls() []*RoleRepositoryMockDeleteExpiredParams {
+	mmDeleteExpired.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockDeleteExpiredParams, len(mmDeleteExpired.callArgs))
+	copy(argCopy, mmDeleteExpired.callArgs)
+
+	mmDeleteExpired.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteExpiredDone returns true if the count of the DeleteExpired invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockDeleteExpiredDone() bool {
+	if m.DeleteExpiredMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteExpiredMock.invocationsDone()
+}
+
+// MinimockDeleteExpiredInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockDeleteExpiredInspect() {
+	for _, e := range m.DeleteExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.DeleteExpired with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteExpiredCounter := mm_atomic.LoadUint64(&m.afterDeleteExpiredCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredMock.defaultExpectation != nil && afterDeleteExpiredCounter < 1 {
+		if m.DeleteExpiredMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.DeleteExpired")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.DeleteExpired with params: %#v", *m.DeleteExpiredMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpired != nil && afterDeleteExpiredCounter < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.DeleteExpired")
+	}
+
+	if !m.DeleteExpiredMock.invocationsDone() && afterDeleteExpiredCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.DeleteExpired but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteExpiredMock.expectedInvocations), afterDeleteExpiredCounter)
+	}
+}
+
+type mRoleRepositoryMockGet struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockGetExpectation
+	expectations       []*RoleRepositoryMockGetExpectation
+
+	callArgs []*RoleRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RoleRepositoryMockGetExpectation specifies expectation struct of the RoleRepository.Get
+type RoleRepositoryMockGetExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockGetParams
+	paramPtrs *RoleRepositoryMockGetParamPtrs
+	results   *RoleRepositoryMockGetResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockGetParams contains parameters of the RoleRepository.Get
+type RoleRepositoryMockGetParams struct {
+	ctx  context.Context
+	name string
+}
+
+// RoleRepositoryMockGetParamPtrs contains pointers to parameters of the RoleRepository.Get
+type RoleRepositoryMockGetParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// RoleRepositoryMockGetResults contains results of the RoleRepository.Get
+type RoleRepositoryMockGetResults struct {
+	rp1 *model.Role
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mRoleRepositoryMockGet) Optional() *mRoleRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for RoleRepository.Get
+func (mmGet *mRoleRepositoryMockGet) Expect(ctx context.Context, name string) *mRoleRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("RoleRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &RoleRepositoryMockGetParams{ctx, name}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.Get
+func (mmGet *mRoleRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RoleRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RoleRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectNameParam2 sets up expected param name for RoleRepository.Get
+func (mmGet *mRoleRepositoryMockGet) ExpectNameParam2(name string) *mRoleRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RoleRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RoleRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.name = &name
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.Get
+func (mmGet *mRoleRepositoryMockGet) Inspect(f func(ctx context.Context, name string)) *mRoleRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by RoleRepository.Get
+func (mmGet *mRoleRepositoryMockGet) Return(rp1 *model.Role, err error) *RoleRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RoleRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &RoleRepositoryMockGetResults{rp1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the RoleRepository.Get method
+func (mmGet *mRoleRepositoryMockGet) Set(f func(ctx context.Context, name string) (rp1 *model.Role, err error)) *RoleRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the RoleRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the RoleRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the RoleRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mRoleRepositoryMockGet) When(ctx context.Context, name string) *RoleRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RoleRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &RoleRepositoryMockGetParams{ctx, name},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.Get return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockGetExpectation) Then(rp1 *model.Role, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockGetResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.Get should be invoked
+func (mmGet *mRoleRepositoryMockGet) Times(n uint64) *mRoleRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of RoleRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	return mmGet
+}
+
+func (mmGet *mRoleRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements repository.RoleRepository
+func (mmGet *RoleRepositoryMock) Get(ctx context.Context, name string) (rp1 *model.Role, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, name)
+	}
+
+	mm_params := RoleRepositoryMockGetParams{ctx, name}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockGetParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("RoleRepositoryMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmGet.t.Errorf("RoleRepositoryMock.Get got unexpected parameter name, want: %#v, got: %#v%s\n", *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("RoleRepositoryMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the RoleRepositoryMock.Get")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, name)
+	}
+	mmGet.t.Fatalf("Unexpected call to RoleRepositoryMock.Get. %v %v", ctx, name)
+	return
+}
+
+// GetAfterCounter returns a count of finished RoleRepositoryMock.Get invocations
+func (mmGet *RoleRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of RoleRepositoryMock.Get invocations
+func (mmGet *RoleRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mRoleRepositoryMockGet) Calls() []*RoleRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.Get")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.Get")
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.Get but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), afterGetCounter)
+	}
+}
+
+type mRoleRepositoryMockList struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockListExpectation
+	expectations       []*RoleRepositoryMockListExpectation
+
+	callArgs []*RoleRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RoleRepositoryMockListExpectation specifies expectation struct of the RoleRepository.List
+type RoleRepositoryMockListExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockListParams
+	paramPtrs *RoleRepositoryMockListParamPtrs
+	results   *RoleRepositoryMockListResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockListParams contains parameters of the RoleRepository.List
+type RoleRepositoryMockListParams struct {
+	ctx context.Context
+}
+
+// RoleRepositoryMockListParamPtrs contains pointers to parameters of the RoleRepository.List
+type RoleRepositoryMockListParamPtrs struct {
+	ctx *context.Context
+}
+
+// RoleRepositoryMockListResults contains results of the RoleRepository.List
+type RoleRepositoryMockListResults struct {
+	rpa1 []*model.Role
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mRoleRepositoryMockList) Optional() *mRoleRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for RoleRepository.List
+func (mmList *mRoleRepositoryMockList) Expect(ctx context.Context) *mRoleRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RoleRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RoleRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("RoleRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &RoleRepositoryMockListParams{ctx}
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.List
+func (mmList *mRoleRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RoleRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RoleRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("RoleRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &RoleRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.List
+func (mmList *mRoleRepositoryMockList) Inspect(f func(ctx context.Context)) *mRoleRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by RoleRepository.List
+func (mmList *mRoleRepositoryMockList) Return(rpa1 []*model.Role, err error) *RoleRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RoleRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RoleRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &RoleRepositoryMockListResults{rpa1, err}
+	return mmList.mock
+}
+
+// Set uses given function f to mock the RoleRepository.List method
+func (mmList *mRoleRepositoryMockList) Set(f func(ctx context.Context) (rpa1 []*model.Role, err error)) *RoleRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the RoleRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the RoleRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	return mmList.mock
+}
+
+// When sets expectation for the RoleRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mRoleRepositoryMockList) When(ctx context.Context) *RoleRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RoleRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockListExpectation{
+		mock:   mmList.mock,
+		params: &RoleRepositoryMockListParams{ctx},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.List return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockListExpectation) Then(rpa1 []*model.Role, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockListResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.List should be invoked
+func (mmList *mRoleRepositoryMockList) Times(n uint64) *mRoleRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of RoleRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	return mmList
+}
+
+func (mmList *mRoleRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements repository.RoleRepository
+func (mmList *RoleRepositoryMock) List(ctx context.Context) (rpa1 []*model.Role, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx)
+	}
+
+	mm_params := RoleRepositoryMockListParams{ctx}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockListParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("RoleRepositoryMock.List got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("RoleRepositoryMock.List got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the RoleRepositoryMock.List")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx)
+	}
+	mmList.t.Fatalf("Unexpected call to RoleRepositoryMock.List. %v", ctx)
+	return
+}
+
+// ListAfterCounter returns a count of finished RoleRepositoryMock.List invocations
+func (mmList *RoleRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of RoleRepositoryMock.List invocations
+func (mmList *RoleRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mRoleRepositoryMockList) Calls() []*RoleRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.List with params: %#v", *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.List")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.List with params: %#v", *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.List")
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.List but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), afterListCounter)
+	}
+}
+
+type mRoleRepositoryMockRevokeFromUser struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockRevokeFromUserExpectation
+	expectations       []*RoleRepositoryMockRevokeFromUserExpectation
+
+	callArgs []*RoleRepositoryMockRevokeFromUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RoleRepositoryMockRevokeFromUserExpectation specifies expectation struct of the RoleRepository.RevokeFromUser
+type RoleRepositoryMockRevokeFromUserExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockRevokeFromUserParams
+	paramPtrs *RoleRepositoryMockRevokeFromUserParamPtrs
+	results   *RoleRepositoryMockRevokeFromUserResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockRevokeFromUserParams contains parameters of the RoleRepository.RevokeFromUser
+type RoleRepositoryMockRevokeFromUserParams struct {
+	ctx    context.Context
+	userID int64
+	role   string
+}
+
+// RoleRepositoryMockRevokeFromUserParamPtrs contains pointers to parameters of the RoleRepository.RevokeFromUser
+type RoleRepositoryMockRevokeFromUserParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	role   *string
+}
+
+// RoleRepositoryMockRevokeFromUserResults contains results of the RoleRepository.RevokeFromUser
+type RoleRepositoryMockRevokeFromUserResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeFromUser *mRoleRepositoryMockRevokeFromUser) Optional() *mRoleRepositoryMockRevokeFromUser {
+	mmRevokeFromUser.optional = true
+	return mmRevokeFromUser
+}
+
+// Expect sets up expected params for RoleRepository.RevokeFromUser
+func (mmRevokeFromUser *mRoleRepositoryMockRevokeFromUser) Expect(ctx context.Context, userID int64, role string) *mRoleRepositoryMockRevokeFromUser {
+	if mmRevokeFromUser.mock.funcRevokeFromUser != nil {
+		mmRevokeFromUser.mock.t.Fatalf("RoleRepositoryMock.RevokeFromUser mock is already set by Set")
+	}
+
+	if mmRevokeFromUser.defaultExpectation == nil {
+		mmRevokeFromUser.defaultExpectation = &RoleRepositoryMockRevokeFromUserExpectation{}
+	}
+
+	if mmRevokeFromUser.defaultExpectation.paramPtrs != nil {
+		mmRevokeFromUser.mock.t.Fatalf("RoleRepositoryMock.RevokeFromUser mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeFromUser.defaultExpectation.params = &RoleRepositoryMockRevokeFromUserParams{ctx, userID, role}
+	for _, e := range mmRevokeFromUser.expectations {
+		if minimock.Equal(e.params, mmRevokeFromUser.defaultExpectation.params) {
+			mmRevokeFromUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeFromUser.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeFromUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.RevokeFromUser
+func (mmRevokeFromUser *mRoleRepositoryMockRevokeFromUser) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockRevokeFromUser {
+	if mmRevokeFromUser.mock.funcRevokeFromUser != nil {
+		mmRevokeFromUser.mock.t.Fatalf("RoleRepositoryMock.RevokeFromUser mock is already set by Set")
+	}
+
+	if mmRevokeFromUser.defaultExpectation == nil {
+		mmRevokeFromUser.defaultExpectation = &RoleRepositoryMockRevokeFromUserExpectation{}
+	}
+
+	if mmRevokeFromUser.defaultExpectation.params != nil {
+		mmRevokeFromUser.mock.t.Fatalf("RoleRepositoryMock.RevokeFromUser mock is already set by Expect")
+	}
+
+	if mmRevokeFromUser.defaultExpectation.paramPtrs == nil {
+		mmRevokeFromUser.defaultExpectation.paramPtrs = &RoleRepositoryMockRevokeFromUserParamPtrs{}
+	}
+	mmRevokeFromUser.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevokeFromUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RoleRepository.RevokeFromUser
+func (mmRevokeFromUser *mRoleRepositoryMockRevokeFromUser) ExpectUserIDParam2(userID int64) *mRoleRepositoryMockRevokeFromUser {
+	if mmRevokeFromUser.mock.funcRevokeFromUser != nil {
+		mmRevokeFromUser.mock.t.Fatalf("RoleRepositoryMock.RevokeFromUser mock is already set by Set")
+	}
+
+	if mmRevokeFromUser.defaultExpectation == nil {
+		mmRevokeFromUser.defaultExpectation = &RoleRepositoryMockRevokeFromUserExpectation{}
+	}
+
+	if mmRevokeFromUser.defaultExpectation.params != nil {
+		mmRevokeFromUser.mock.t.Fatalf("RoleRepositoryMock.RevokeFromUser mock is already set by Expect")
+	}
+
+	if mmRevokeFromUser.defaultExpectation.paramPtrs == nil {
+		mmRevokeFromUser.defaultExpectation.paramPtrs = &RoleRepositoryMockRevokeFromUserParamPtrs{}
+	}
+	mmRevokeFromUser.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmRevokeFromUser
+}
+
+// ExpectRoleParam3 sets up expected param role for RoleRepository.RevokeFromUser
+func (mmRevokeFromUser *mRoleRepositoryMockRevokeFromUser) ExpectRoleParam3(role string) *mRoleRepositoryMockRevokeFromUser {
+	if mmRevokeFromUser.mock.funcRevokeFromUser != nil {
+		mmRevokeFromUser.mock.t.Fatalf("RoleRepositoryMock.RevokeFromUser mock is already set by Set")
+	}
+
+	if mmRevokeFromUser.defaultExpectation == nil {
+		mmRevokeFromUser.defaultExpectation = &RoleRepositoryMockRevokeFromUserExpectation{}
+	}
+
+	if mmRevokeFromUser.defaultExpectation.params != nil {
+		mmRevokeFromUser.mock.t.Fatalf("RoleRepositoryMock.RevokeFromUser mock is already set by Expect")
+	}
+
+	if mmRevokeFromUser.defaultExpectation.paramPtrs == nil {
+		mmRevokeFromUser.defaultExpectation.paramPtrs = &RoleRepositoryMockRevokeFromUserParamPtrs{}
+	}
+	mmRevokeFromUser.defaultExpectation.paramPtrs.role = &role
+
+	return mmRevokeFromUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.RevokeFromUser
+func (mmRevokeFromUser *mRoleRepositoryMockRevokeFromUser) Inspect(f func(ctx context.Context, userID int64, role string)) *mRoleRepositoryMockRevokeFromUser {
+	if mmRevokeFromUser.mock.inspectFuncRevokeFromUser != nil {
+		mmRevokeFromUser.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.RevokeFromUser")
+	}
+
+	mmRevokeFromUser.mock.inspectFuncRevokeFromUser = f
+
+	return mmRevokeFromUser
+}
+
+// Return sets up results that will be returned by RoleRepository.RevokeFromUser
+func (mmRevokeFromUser *mRoleRepositoryMockRevokeFromUser) Return(err error) *RoleRepositoryMock {
+	if mmRevokeFromUser.mock.funcRevokeFromUser != nil {
+		mmRevokeFromUser.mock.t.Fatalf("RoleRepositoryMock.RevokeFromUser mock is already set by Set")
+	}
+
+	if mmRevokeFromUser.defaultExpectation == nil {
+		mmRevokeFromUser.defaultExpectation = &RoleRepositoryMockRevokeFromUserExpectation{mock: mmRevokeFromUser.mock}
+	}
+	mmRevokeFromUser.defaultExpectation.results = &RoleRepositoryMockRevokeFromUserResults{err}
+	return mmRevokeFromUser.mock
+}
+
+// Set uses given function f to mock the RoleRepository.RevokeFromUser method
+func (mmRevokeFromUser *mRoleRepositoryMockRevokeFromUser) Set(f func(ctx context.Context, userID int64, role string) (err error)) *RoleRepositoryMock {
+	if mmRevokeFromUser.defaultExpectation != nil {
+		mmRevokeFromUser.mock.t.Fatalf("Default expectation is already set for the RoleRepository.RevokeFromUser method")
+	}
+
+	if len(mmRevokeFromUser.expectations) > 0 {
+		mmRevokeFromUser.mock.t.Fatalf("Some expectations are already set for the RoleRepository.RevokeFromUser method")
+	}
+
+	mmRevokeFromUser.mock.funcRevokeFromUser = f
+	return mmRevokeFromUser.mock
+}
+
+// When sets expectation for the RoleRepository.RevokeFromUser which will trigger the result defined by the following
+// Then helper
+func (mmRevokeFromUser *mRoleRepositoryMockRevokeFromUser) When(ctx context.Context, userID int64, role string) *RoleRepositoryMockRevokeFromUserExpectation {
+	if mmRevokeFromUser.mock.funcRevokeFromUser != nil {
+		mmRevokeFromUser.mock.t.Fatalf("RoleRepositoryMock.RevokeFromUser mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockRevokeFromUserExpectation{
+		mock:   mmRevokeFromUser.mock,
+		params: &RoleRepositoryMockRevokeFromUserParams{ctx, userID, role},
+	}
+	mmRevokeFromUser.expectations = append(mmRevokeFromUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.RevokeFromUser return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockRevokeFromUserExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockRevokeFromUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.RevokeFromUser should be invoked
+func (mmRevokeFromUser *mRoleRepositoryMockRevokeFromUser) Times(n uint64) *mRoleRepositoryMockRevokeFromUser {
+	if n == 0 {
+		mmRevokeFromUser.mock.t.Fatalf("Times of RoleRepositoryMock.RevokeFromUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeFromUser.expectedInvocations, n)
+	return mmRevokeFromUser
+}
+
+func (mmRevokeFromUser *mRoleRepositoryMockRevokeFromUser) invocationsDone() bool {
+	if len(mmRevokeFromUser.expectations) == 0 && mmRevokeFromUser.defaultExpectation == nil && mmRevokeFromUser.mock.funcRevokeFromUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeFromUser.mock.afterRevokeFromUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeFromUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeFromUser implements repository.RoleRepository
+func (mmRevokeFromUser *RoleRepositoryMock) RevokeFromUser(ctx context.Context, userID int64, role string) (err error) {
+	mm_atomic.AddUint64(&mmRevokeFromUser.beforeRevokeFromUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeFromUser.afterRevokeFromUserCounter, 1)
+
+	if mmRevokeFromUser.inspectFuncRevokeFromUser != nil {
+		mmRevokeFromUser.inspectFuncRevokeFromUser(ctx, userID, role)
+	}
+
+	mm_params := RoleRepositoryMockRevokeFromUserParams{ctx, userID, role}
+
+	// Record call args
+	mmRevokeFromUser.RevokeFromUserMock.mutex.Lock()
+	mmRevokeFromUser.RevokeFromUserMock.callArgs = append(mmRevokeFromUser.RevokeFromUserMock.callArgs, &mm_params)
+	mmRevokeFromUser.RevokeFromUserMock.mutex.Unlock()
+
+	for _, e := range mmRevokeFromUser.RevokeFromUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeFromUser.RevokeFromUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeFromUser.RevokeFromUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeFromUser.RevokeFromUserMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeFromUser.RevokeFromUserMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockRevokeFromUserParams{ctx, userID, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeFromUser.t.Errorf("RoleRepositoryMock.RevokeFromUser got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRevokeFromUser.t.Errorf("RoleRepositoryMock.RevokeFromUser got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmRevokeFromUser.t.Errorf("RoleRepositoryMock.RevokeFromUser got unexpected parameter role, want: %#v, got: %#v%s\n", *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeFromUser.t.Errorf("RoleRepositoryMock.RevokeFromUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeFromUser.RevokeFromUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeFromUser.t.Fatal("No results are set for the RoleRepositoryMock.RevokeFromUser")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeFromUser.funcRevokeFromUser != nil {
+		return mmRevokeFromUser.funcRevokeFromUser(ctx, userID, role)
+	}
+	mmRevokeFromUser.t.Fatalf("Unexpected call to RoleRepositoryMock.RevokeFromUser. %v %v %v", ctx, userID, role)
+	return
+}
+
+// RevokeFromUserAfterCounter returns a count of finished RoleRepositoryMock.RevokeFromUser invocations
+func (mmRevokeFromUser *RoleRepositoryMock) RevokeFromUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeFromUser.afterRevokeFromUserCounter)
+}
+
+// RevokeFromUserBeforeCounter returns a count of RoleRepositoryMock.RevokeFromUser invocations
+func (mmRevokeFromUser *RoleRepositoryMock) RevokeFromUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeFromUser.beforeRevokeFromUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.RevokeFromUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeFromUser *mRoleRepositoryMockRevokeFromUser) Calls() []*RoleRepositoryMockRevokeFromUserParams {
+	mmRevokeFromUser.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockRevokeFromUserParams, len(mmRevokeFromUser.callArgs))
+	copy(argCopy, mmRevokeFromUser.callArgs)
+
+	mmRevokeFromUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeFromUserDone returns true if the count of the RevokeFromUser invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockRevokeFromUserDone() bool {
+	if m.RevokeFromUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeFromUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeFromUserMock.invocationsDone()
+}
+
+// MinimockRevokeFromUserInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockRevokeFromUserInspect() {
+	for _, e := range m.RevokeFromUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.RevokeFromUser with params: %#v", *e.params)
+		}
+	}
+
+	afterRevokeFromUserCounter := mm_atomic.LoadUint64(&m.afterRevokeFromUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeFromUserMock.defaultExpectation != nil && afterRevokeFromUserCounter < 1 {
+		if m.RevokeFromUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.RevokeFromUser")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.RevokeFromUser with params: %#v", *m.RevokeFromUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeFromUser != nil && afterRevokeFromUserCounter < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.RevokeFromUser")
+	}
+
+	if !m.RevokeFromUserMock.invocationsDone() && afterRevokeFromUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.RevokeFromUser but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeFromUserMock.expectedInvocations), afterRevokeFromUserCounter)
+	}
+}
+
+type mRoleRepositoryMockUpdate struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockUpdateExpectation
+	expectations       []*RoleRepositoryMockUpdateExpectation
+
+	callArgs []*RoleRepositoryMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RoleRepositoryMockUpdateExpectation specifies expectation struct of the RoleRepository.Update
+type RoleRepositoryMockUpdateExpectation struct {
+	mock      *RoleRepositoryMock
+	params    *RoleRepositoryMockUpdateParams
+	paramPtrs *RoleRepositoryMockUpdateParamPtrs
+	results   *RoleRepositoryMockUpdateResults
+	Counter   uint64
+}
+
+// RoleRepositoryMockUpdateParams contains parameters of the RoleRepository.Update
+type RoleRepositoryMockUpdateParams struct {
+	ctx     context.Context
+	updates *model.RoleUpdate
+}
+
+// RoleRepositoryMockUpdateParamPtrs contains pointers to parameters of the RoleRepository.Update
+type RoleRepositoryMockUpdateParamPtrs struct {
+	ctx     *context.Context
+	updates **model.RoleUpdate
+}
+
+// RoleRepositoryMockUpdateResults contains results of the RoleRepository.Update
+type RoleRepositoryMockUpdateResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mRoleRepositoryMockUpdate) Optional() *mRoleRepositoryMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for RoleRepository.Update
+func (mmUpdate *mRoleRepositoryMockUpdate) Expect(ctx context.Context, updates *model.RoleUpdate) *mRoleRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RoleRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &RoleRepositoryMockUpdateParams{ctx, updates}
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.Update
+func (mmUpdate *mRoleRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RoleRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &RoleRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdate
+}
+
+// ExpectUpdatesParam2 sets up expected param updates for RoleRepository.Update
+func (mmUpdate *mRoleRepositoryMockUpdate) ExpectUpdatesParam2(updates *model.RoleUpdate) *mRoleRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RoleRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &RoleRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.updates = &updates
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.Update
+func (mmUpdate *mRoleRepositoryMockUpdate) Inspect(f func(ctx context.Context, updates *model.RoleUpdate)) *mRoleRepositoryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by RoleRepository.Update
+func (mmUpdate *mRoleRepositoryMockUpdate) Return(err error) *RoleRepositoryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RoleRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &RoleRepositoryMockUpdateResults{err}
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the RoleRepository.Update method
+func (mmUpdate *mRoleRepositoryMockUpdate) Set(f func(ctx context.Context, updates *model.RoleUpdate) (err error)) *RoleRepositoryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the RoleRepository.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the RoleRepository.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	return mmUpdate.mock
+}
+
+// When sets expectation for the RoleRepository.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mRoleRepositoryMockUpdate) When(ctx context.Context, updates *model.RoleUpdate) *RoleRepositoryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RoleRepositoryMock.Update mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockUpdateExpectation{
+		mock:   mmUpdate.mock,
+		params: &RoleRepositoryMockUpdateParams{ctx, updates},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.Update return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockUpdateExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.Update should be invoked
+func (mmUpdate *mRoleRepositoryMockUpdate) Times(n uint64) *mRoleRepositoryMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of RoleRepositoryMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	return mmUpdate
+}
+
+func (mmUpdate *mRoleRepositoryMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements repository.RoleRepository
+func (mmUpdate *RoleRepositoryMock) Update(ctx context.Context, updates *model.RoleUpdate) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, updates)
+	}
+
+	mm_params := RoleRepositoryMockUpdateParams{ctx, updates}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockUpdateParams{ctx, updates}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("RoleRepositoryMock.Update got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.updates != nil && !minimock.Equal(*mm_want_ptrs.updates, mm_got.updates) {
+				mmUpdate.t.Errorf("RoleRepositoryMock.Update got unexpected parameter updates, want: %#v, got: %#v%s\n", *mm_want_ptrs.updates, mm_got.updates, minimock.Diff(*mm_want_ptrs.updates, mm_got.updates))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("RoleRepositoryMock.Update got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the RoleRepositoryMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, updates)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to RoleRepositoryMock.Update. %v %v", ctx, updates)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished RoleRepositoryMock.Update invocations
+func (mmUpdate *RoleRepositoryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of RoleRepositoryMock.Update invocations
+func (mmUpdate *RoleRepositoryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mRoleRepositoryMockUpdate) Calls() []*RoleRepositoryMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.Update with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RoleRepositoryMock.Update")
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.Update with params: %#v", *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Error("Expected call to RoleRepositoryMock.Update")
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.Update but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), afterUpdateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RoleRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAssignToUserInspect()
+
+			m.MinimockCountUsersByRolesInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockDeleteExpiredInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockRevokeFromUserInspect()
+
+			m.MinimockUpdateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RoleRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RoleRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAssignToUserDone() &&
+		m.MinimockCountUsersByRolesDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockDeleteExpiredDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListDone() &&
+		m.MinimockRevokeFromUserDone() &&
+		m.MinimockUpdateDone()
+}
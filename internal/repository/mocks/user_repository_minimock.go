@@ -26,6 +26,12 @@ type UserRepositoryMock struct {
 	beforeCheckUsersExistCounter uint64
 	CheckUsersExistMock          mUserRepositoryMockCheckUsersExist
 
+	funcCount          func(ctx context.Context, filter filter.UserListFilter) (i1 int64, err error)
+	inspectFuncCount   func(ctx context.Context, filter filter.UserListFilter)
+	afterCountCounter  uint64
+	beforeCountCounter uint64
+	CountMock          mUserRepositoryMockCount
+
 	funcCreate          func(ctx context.Context, user *model.User) (i1 int64, err error)
 	inspectFuncCreate   func(ctx context.Context, user *model.User)
 	afterCreateCounter  uint64
@@ -44,14 +50,8 @@ type UserRepositoryMock struct {
 	beforeGetCounter uint64
 	GetMock          mUserRepositoryMockGet
 
-	funcGetEndpointRoles          func(ctx context.Context, endpoint string) (sa1 []string, err error)
-	inspectFuncGetEndpointRoles   func(ctx context.Context, endpoint string)
-	afterGetEndpointRolesCounter  uint64
-	beforeGetEndpointRolesCounter uint64
-	GetEndpointRolesMock          mUserRepositoryMockGetEndpointRoles
-
-	funcList          func(ctx context.Context, limit int64, offset int64) (upa1 []*model.User, err error)
-	inspectFuncList   func(ctx context.Context, limit int64, offset int64)
+	funcList          func(ctx context.Context, filter filter.UserListFilter) (upa1 []*model.User, err error)
+	inspectFuncList   func(ctx context.Context, filter filter.UserListFilter)
 	afterListCounter  uint64
 	beforeListCounter uint64
 	ListMock          mUserRepositoryMockList
@@ -74,6 +74,9 @@ func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
 	m.CheckUsersExistMock = mUserRepositoryMockCheckUsersExist{mock: m}
 	m.CheckUsersExistMock.callArgs = []*UserRepositoryMockCheckUsersExistParams{}
 
+	m.CountMock = mUserRepositoryMockCount{mock: m}
+	m.CountMock.callArgs = []*UserRepositoryMockCountParams{}
+
 	m.CreateMock = mUserRepositoryMockCreate{mock: m}
 	m.CreateMock.callArgs = []*UserRepositoryMockCreateParams{}
 
@@ -83,9 +86,6 @@ func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
 	m.GetMock = mUserRepositoryMockGet{mock: m}
 	m.GetMock.callArgs = []*UserRepositoryMockGetParams{}
 
-	m.GetEndpointRolesMock = mUserRepositoryMockGetEndpointRoles{mock: m}
-	m.GetEndpointRolesMock.callArgs = []*UserRepositoryMockGetEndpointRolesParams{}
-
 	m.ListMock = mUserRepositoryMockList{mock: m}
 	m.ListMock.callArgs = []*UserRepositoryMockListParams{}
 
@@ -417,6 +417,327 @@ func (m *UserRepositoryMock) MinimockCheckUsersExistInspect() {
 	}
 }
 
+type mUserRepositoryMockCount struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockCountExpectation
+	expectations       []*UserRepositoryMockCountExpectation
+
+	callArgs []*UserRepositoryMockCountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockCountExpectation specifies expectation struct of the UserRepository.Count
+type UserRepositoryMockCountExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockCountParams
+	paramPtrs *UserRepositoryMockCountParamPtrs
+	results   *UserRepositoryMockCountResults
+	Counter   uint64
+}
+
+// UserRepositoryMockCountParams contains parameters of the UserRepository.Count
+type UserRepositoryMockCountParams struct {
+	ctx    context.Context
+	filter filter.UserListFilter
+}
+
+// UserRepositoryMockCountParamPtrs contains pointers to parameters of the UserRepository.Count
+type UserRepositoryMockCountParamPtrs struct {
+	ctx    *context.Context
+	filter *filter.UserListFilter
+}
+
+// UserRepositoryMockCountResults contains results of the UserRepository.Count
+type UserRepositoryMockCountResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCount *mUserRepositoryMockCount) Optional() *mUserRepositoryMockCount {
+	mmCount.optional = true
+	return mmCount
+}
+
+// Expect sets up expected params for UserRepository.Count
+func (mmCount *mUserRepositoryMockCount) Expect(ctx context.Context, filter filter.UserListFilter) *mUserRepositoryMockCount {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &UserRepositoryMockCountExpectation{}
+	}
+
+	if mmCount.defaultExpectation.paramPtrs != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by ExpectParams functions")
+	}
+
+	mmCount.defaultExpectation.params = &UserRepositoryMockCountParams{ctx, filter}
+	for _, e := range mmCount.expectations {
+		if minimock.Equal(e.params, mmCount.defaultExpectation.params) {
+			mmCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCount.defaultExpectation.params)
+		}
+	}
+
+	return mmCount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.Count
+func (mmCount *mUserRepositoryMockCount) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockCount {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &UserRepositoryMockCountExpectation{}
+	}
+
+	if mmCount.defaultExpectation.params != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Expect")
+	}
+
+	if mmCount.defaultExpectation.paramPtrs == nil {
+		mmCount.defaultExpectation.paramPtrs = &UserRepositoryMockCountParamPtrs{}
+	}
+	mmCount.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCount
+}
+
+// ExpectFilterParam2 sets up expected param filter for UserRepository.Count
+func (mmCount *mUserRepositoryMockCount) ExpectFilterParam2(filter filter.UserListFilter) *mUserRepositoryMockCount {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &UserRepositoryMockCountExpectation{}
+	}
+
+	if mmCount.defaultExpectation.params != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Expect")
+	}
+
+	if mmCount.defaultExpectation.paramPtrs == nil {
+		mmCount.defaultExpectation.paramPtrs = &UserRepositoryMockCountParamPtrs{}
+	}
+	mmCount.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmCount
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.Count
+func (mmCount *mUserRepositoryMockCount) Inspect(f func(ctx context.Context, filter filter.UserListFilter)) *mUserRepositoryMockCount {
+	if mmCount.mock.inspectFuncCount != nil {
+		mmCount.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Count")
+	}
+
+	mmCount.mock.inspectFuncCount = f
+
+	return mmCount
+}
+
+// Return sets up results that will be returned by UserRepository.Count
+func (mmCount *mUserRepositoryMockCount) Return(i1 int64, err error) *UserRepositoryMock {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &UserRepositoryMockCountExpectation{mock: mmCount.mock}
+	}
+	mmCount.defaultExpectation.results = &UserRepositoryMockCountResults{i1, err}
+	return mmCount.mock
+}
+
+// Set uses given function f to mock the UserRepository.Count method
+func (mmCount *mUserRepositoryMockCount) Set(f func(ctx context.Context, filter filter.UserListFilter) (i1 int64, err error)) *UserRepositoryMock {
+	if mmCount.defaultExpectation != nil {
+		mmCount.mock.t.Fatalf("Default expectation is already set for the UserRepository.Count method")
+	}
+
+	if len(mmCount.expectations) > 0 {
+		mmCount.mock.t.Fatalf("Some expectations are already set for the UserRepository.Count method")
+	}
+
+	mmCount.mock.funcCount = f
+	return mmCount.mock
+}
+
+// When sets expectation for the UserRepository.Count which will trigger the result defined by the following
+// Then helper
+func (mmCount *mUserRepositoryMockCount) When(ctx context.Context, filter filter.UserListFilter) *UserRepositoryMockCountExpectation {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockCountExpectation{
+		mock:   mmCount.mock,
+		params: &UserRepositoryMockCountParams{ctx, filter},
+	}
+	mmCount.expectations = append(mmCount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.Count return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockCountExpectation) Then(i1 int64, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockCountResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.Count should be invoked
+func (mmCount *mUserRepositoryMockCount) Times(n uint64) *mUserRepositoryMockCount {
+	if n == 0 {
+		mmCount.mock.t.Fatalf("Times of UserRepositoryMock.Count mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCount.expectedInvocations, n)
+	return mmCount
+}
+
+func (mmCount *mUserRepositoryMockCount) invocationsDone() bool {
+	if len(mmCount.expectations) == 0 && mmCount.defaultExpectation == nil && mmCount.mock.funcCount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCount.mock.afterCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Count implements repository.UserRepository
+func (mmCount *UserRepositoryMock) Count(ctx context.Context, filter filter.UserListFilter) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCount.beforeCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmCount.afterCountCounter, 1)
+
+	if mmCount.inspectFuncCount != nil {
+		mmCount.inspectFuncCount(ctx, filter)
+	}
+
+	mm_params := UserRepositoryMockCountParams{ctx, filter}
+
+	// Record call args
+	mmCount.CountMock.mutex.Lock()
+	mmCount.CountMock.callArgs = append(mmCount.CountMock.callArgs, &mm_params)
+	mmCount.CountMock.mutex.Unlock()
+
+	for _, e := range mmCount.CountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCount.CountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCount.CountMock.defaultExpectation.Counter, 1)
+		mm_want := mmCount.CountMock.defaultExpectation.params
+		mm_want_ptrs := mmCount.CountMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockCountParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCount.t.Errorf("UserRepositoryMock.Count got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmCount.t.Errorf("UserRepositoryMock.Count got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCount.t.Errorf("UserRepositoryMock.Count got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCount.CountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCount.t.Fatal("No results are set for the UserRepositoryMock.Count")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCount.funcCount != nil {
+		return mmCount.funcCount(ctx, filter)
+	}
+	mmCount.t.Fatalf("Unexpected call to UserRepositoryMock.Count. %v %v", ctx, filter)
+	return
+}
+
+// CountAfterCounter returns a count of finished UserRepositoryMock.Count invocations
+func (mmCount *UserRepositoryMock) CountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCount.afterCountCounter)
+}
+
+// CountBeforeCounter returns a count of UserRepositoryMock.Count invocations
+func (mmCount *UserRepositoryMock) CountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCount.beforeCountCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.Count.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCount *mUserRepositoryMockCount) Calls() []*UserRepositoryMockCountParams {
+	mmCount.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockCountParams, len(mmCount.callArgs))
+	copy(argCopy, mmCount.callArgs)
+
+	mmCount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountDone returns true if the count of the Count invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockCountDone() bool {
+	if m.CountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountMock.invocationsDone()
+}
+
+// MinimockCountInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockCountInspect() {
+	for _, e := range m.CountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.Count with params: %#v", *e.params)
+		}
+	}
+
+	afterCountCounter := mm_atomic.LoadUint64(&m.afterCountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountMock.defaultExpectation != nil && afterCountCounter < 1 {
+		if m.CountMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.Count")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.Count with params: %#v", *m.CountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCount != nil && afterCountCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.Count")
+	}
+
+	if !m.CountMock.invocationsDone() && afterCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.Count but found %d calls",
+			mm_atomic.LoadUint64(&m.CountMock.expectedInvocations), afterCountCounter)
+	}
+}
+
 type mUserRepositoryMockCreate struct {
 	optional           bool
 	mock               *UserRepositoryMock
@@ -1379,327 +1700,6 @@ func (m *UserRepositoryMock) MinimockGetInspect() {
 	}
 }
 
-type mUserRepositoryMockGetEndpointRoles struct {
-	optional           bool
-	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockGetEndpointRolesExpectation
-	expectations       []*UserRepositoryMockGetEndpointRolesExpectation
-
-	callArgs []*UserRepositoryMockGetEndpointRolesParams
-	mutex    sync.RWMutex
-
-	expectedInvocations uint64
-}
-
-// UserRepositoryMockGetEndpointRolesExpectation specifies expectation struct of the UserRepository.GetEndpointRoles
-type UserRepositoryMockGetEndpointRolesExpectation struct {
-	mock      *UserRepositoryMock
-	params    *UserRepositoryMockGetEndpointRolesParams
-	paramPtrs *UserRepositoryMockGetEndpointRolesParamPtrs
-	results   *UserRepositoryMockGetEndpointRolesResults
-	Counter   uint64
-}
-
-// UserRepositoryMockGetEndpointRolesParams contains parameters of the UserRepository.GetEndpointRoles
-type UserRepositoryMockGetEndpointRolesParams struct {
-	ctx      context.Context
-	endpoint string
-}
-
-// UserRepositoryMockGetEndpointRolesParamPtrs contains pointers to parameters of the UserRepository.GetEndpointRoles
-type UserRepositoryMockGetEndpointRolesParamPtrs struct {
-	ctx      *context.Context
-	endpoint *string
-}
-
-// UserRepositoryMockGetEndpointRolesResults contains results of the UserRepository.GetEndpointRoles
-type UserRepositoryMockGetEndpointRolesResults struct {
-	sa1 []string
-	err error
-}
-
-// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
-// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
-// Optional() makes method check to work in '0 or more' mode.
-// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
-// catch the problems when the expected method call is totally skipped during test run.
-func (mmGetEndpointRoles *mUserRepositoryMockGetEndpointRoles) Optional() *mUserRepositoryMockGetEndpointRoles {
-	mmGetEndpointRoles.optional = true
-	return mmGetEndpointRoles
-}
-
-// Expect sets up expected params for UserRepository.GetEndpointRoles
-func (mmGetEndpointRoles *mUserRepositoryMockGetEndpointRoles) Expect(ctx context.Context, endpoint string) *mUserRepositoryMockGetEndpointRoles {
-	if mmGetEndpointRoles.mock.funcGetEndpointRoles != nil {
-		mmGetEndpointRoles.mock.t.Fatalf("UserRepositoryMock.GetEndpointRoles mock is already set by Set")
-	}
-
-	if mmGetEndpointRoles.defaultExpectation == nil {
-		mmGetEndpointRoles.defaultExpectation = &UserRepositoryMockGetEndpointRolesExpectation{}
-	}
-
-	if mmGetEndpointRoles.defaultExpectation.paramPtrs != nil {
-		mmGetEndpointRoles.mock.t.Fatalf("UserRepositoryMock.GetEndpointRoles mock is already set by ExpectParams functions")
-	}
-
-	mmGetEndpointRoles.defaultExpectation.params = &UserRepositoryMockGetEndpointRolesParams{ctx, endpoint}
-	for _, e := range mmGetEndpointRoles.expectations {
-		if minimock.Equal(e.params, mmGetEndpointRoles.defaultExpectation.params) {
-			mmGetEndpointRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetEndpointRoles.defaultExpectation.params)
-		}
-	}
-
-	return mmGetEndpointRoles
-}
-
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetEndpointRoles
-func (mmGetEndpointRoles *mUserRepositoryMockGetEndpointRoles) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetEndpointRoles {
-	if mmGetEndpointRoles.mock.funcGetEndpointRoles != nil {
-		mmGetEndpointRoles.mock.t.Fatalf("UserRepositoryMock.GetEndpointRoles mock is already set by Set")
-	}
-
-	if mmGetEndpointRoles.defaultExpectation == nil {
-		mmGetEndpointRoles.defaultExpectation = &UserRepositoryMockGetEndpointRolesExpectation{}
-	}
-
-	if mmGetEndpointRoles.defaultExpectation.params != nil {
-		mmGetEndpointRoles.mock.t.Fatalf("UserRepositoryMock.GetEndpointRoles mock is already set by Expect")
-	}
-
-	if mmGetEndpointRoles.defaultExpectation.paramPtrs == nil {
-		mmGetEndpointRoles.defaultExpectation.paramPtrs = &UserRepositoryMockGetEndpointRolesParamPtrs{}
-	}
-	mmGetEndpointRoles.defaultExpectation.paramPtrs.ctx = &ctx
-
-	return mmGetEndpointRoles
-}
-
-// ExpectEndpointParam2 sets up expected param endpoint for UserRepository.GetEndpointRoles
-func (mmGetEndpointRoles *mUserRepositoryMockGetEndpointRoles) ExpectEndpointParam2(endpoint string) *mUserRepositoryMockGetEndpointRoles {
-	if mmGetEndpointRoles.mock.funcGetEndpointRoles != nil {
-		mmGetEndpointRoles.mock.t.Fatalf("UserRepositoryMock.GetEndpointRoles mock is already set by Set")
-	}
-
-	if mmGetEndpointRoles.defaultExpectation == nil {
-		mmGetEndpointRoles.defaultExpectation = &UserRepositoryMockGetEndpointRolesExpectation{}
-	}
-
-	if mmGetEndpointRoles.defaultExpectation.params != nil {
-		mmGetEndpointRoles.mock.t.Fatalf("UserRepositoryMock.GetEndpointRoles mock is already set by Expect")
-	}
-
-	if mmGetEndpointRoles.defaultExpectation.paramPtrs == nil {
-		mmGetEndpointRoles.defaultExpectation.paramPtrs = &UserRepositoryMockGetEndpointRolesParamPtrs{}
-	}
-	mmGetEndpointRoles.defaultExpectation.paramPtrs.endpoint = &endpoint
-
-	return mmGetEndpointRoles
-}
-
-// Inspect accepts an inspector function that has same arguments as the UserRepository.GetEndpointRoles
-func (mmGetEndpointRoles *mUserRepositoryMockGetEndpointRoles) Inspect(f func(ctx context.Context, endpoint string)) *mUserRepositoryMockGetEndpointRoles {
-	if mmGetEndpointRoles.mock.inspectFuncGetEndpointRoles != nil {
-		mmGetEndpointRoles.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetEndpointRoles")
-	}
-
-	mmGetEndpointRoles.mock.inspectFuncGetEndpointRoles = f
-
-	return mmGetEndpointRoles
-}
-
-// Return sets up results that will be returned by UserRepository.GetEndpointRoles
-func (mmGetEndpointRoles *mUserRepositoryMockGetEndpointRoles) Return(sa1 []string, err error) *UserRepositoryMock {
-	if mmGetEndpointRoles.mock.funcGetEndpointRoles != nil {
-		mmGetEndpointRoles.mock.t.Fatalf("UserRepositoryMock.GetEndpointRoles mock is already set by Set")
-	}
-
-	if mmGetEndpointRoles.defaultExpectation == nil {
-		mmGetEndpointRoles.defaultExpectation = &UserRepositoryMockGetEndpointRolesExpectation{mock: mmGetEndpointRoles.mock}
-	}
-	mmGetEndpointRoles.defaultExpectation.results = &UserRepositoryMockGetEndpointRolesResults{sa1, err}
-	return mmGetEndpointRoles.mock
-}
-
-// Set uses given function f to mock the UserRepository.GetEndpointRoles method
-func (mmGetEndpointRoles *mUserRepositoryMockGetEndpointRoles) Set(f func(ctx context.Context, endpoint string) (sa1 []string, err error)) *UserRepositoryMock {
-	if mmGetEndpointRoles.defaultExpectation != nil {
-		mmGetEndpointRoles.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetEndpointRoles method")
-	}
-
-	if len(mmGetEndpointRoles.expectations) > 0 {
-		mmGetEndpointRoles.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetEndpointRoles method")
-	}
-
-	mmGetEndpointRoles.mock.funcGetEndpointRoles = f
-	return mmGetEndpointRoles.mock
-}
-
-// When sets expectation for the UserRepository.GetEndpointRoles which will trigger the result defined by the following
-// Then helper
-func (mmGetEndpointRoles *mUserRepositoryMockGetEndpointRoles) When(ctx context.Context, endpoint string) *UserRepositoryMockGetEndpointRolesExpectation {
-	if mmGetEndpointRoles.mock.funcGetEndpointRoles != nil {
-		mmGetEndpointRoles.mock.t.Fatalf("UserRepositoryMock.GetEndpointRoles mock is already set by Set")
-	}
-
-	expectation := &UserRepositoryMockGetEndpointRolesExpectation{
-		mock:   mmGetEndpointRoles.mock,
-		params: &UserRepositoryMockGetEndpointRolesParams{ctx, endpoint},
-	}
-	mmGetEndpointRoles.expectations = append(mmGetEndpointRoles.expectations, expectation)
-	return expectation
-}
-
-// Then sets up UserRepository.GetEndpointRoles return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockGetEndpointRolesExpectation) Then(sa1 []string, err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockGetEndpointRolesResults{sa1, err}
-	return e.mock
-}
-
-// Times sets number of times UserRepository.GetEndpointRoles should be invoked
-func (mmGetEndpointRoles *mUserRepositoryMockGetEndpointRoles) Times(n uint64) *mUserRepositoryMockGetEndpointRoles {
-	if n == 0 {
-		mmGetEndpointRoles.mock.t.Fatalf("Times of UserRepositoryMock.GetEndpointRoles mock can not be zero")
-	}
-	mm_atomic.StoreUint64(&mmGetEndpointRoles.expectedInvocations, n)
-	return mmGetEndpointRoles
-}
-
-func (mmGetEndpointRoles *mUserRepositoryMockGetEndpointRoles) invocationsDone() bool {
-	if len(mmGetEndpointRoles.expectations) == 0 && mmGetEndpointRoles.defaultExpectation == nil && mmGetEndpointRoles.mock.funcGetEndpointRoles == nil {
-		return true
-	}
-
-	totalInvocations := mm_atomic.LoadUint64(&mmGetEndpointRoles.mock.afterGetEndpointRolesCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetEndpointRoles.expectedInvocations)
-
-	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
-}
-
-// GetEndpointRoles implements repository.UserRepository
-func (mmGetEndpointRoles *UserRepositoryMock) GetEndpointRoles(ctx context.Context, endpoint string) (sa1 []string, err error) {
-	mm_atomic.AddUint64(&mmGetEndpointRoles.beforeGetEndpointRolesCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetEndpointRoles.afterGetEndpointRolesCounter, 1)
-
-	if mmGetEndpointRoles.inspectFuncGetEndpointRoles != nil {
-		mmGetEndpointRoles.inspectFuncGetEndpointRoles(ctx, endpoint)
-	}
-
-	mm_params := UserRepositoryMockGetEndpointRolesParams{ctx, endpoint}
-
-	// Record call args
-	mmGetEndpointRoles.GetEndpointRolesMock.mutex.Lock()
-	mmGetEndpointRoles.GetEndpointRolesMock.callArgs = append(mmGetEndpointRoles.GetEndpointRolesMock.callArgs, &mm_params)
-	mmGetEndpointRoles.GetEndpointRolesMock.mutex.Unlock()
-
-	for _, e := range mmGetEndpointRoles.GetEndpointRolesMock.expectations {
-		if minimock.Equal(*e.params, mm_params) {
-			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.sa1, e.results.err
-		}
-	}
-
-	if mmGetEndpointRoles.GetEndpointRolesMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetEndpointRoles.GetEndpointRolesMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetEndpointRoles.GetEndpointRolesMock.defaultExpectation.params
-		mm_want_ptrs := mmGetEndpointRoles.GetEndpointRolesMock.defaultExpectation.paramPtrs
-
-		mm_got := UserRepositoryMockGetEndpointRolesParams{ctx, endpoint}
-
-		if mm_want_ptrs != nil {
-
-			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetEndpointRoles.t.Errorf("UserRepositoryMock.GetEndpointRoles got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.endpoint != nil && !minimock.Equal(*mm_want_ptrs.endpoint, mm_got.endpoint) {
-				mmGetEndpointRoles.t.Errorf("UserRepositoryMock.GetEndpointRoles got unexpected parameter endpoint, want: %#v, got: %#v%s\n", *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
-			}
-
-		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetEndpointRoles.t.Errorf("UserRepositoryMock.GetEndpointRoles got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
-		}
-
-		mm_results := mmGetEndpointRoles.GetEndpointRolesMock.defaultExpectation.results
-		if mm_results == nil {
-			mmGetEndpointRoles.t.Fatal("No results are set for the UserRepositoryMock.GetEndpointRoles")
-		}
-		return (*mm_results).sa1, (*mm_results).err
-	}
-	if mmGetEndpointRoles.funcGetEndpointRoles != nil {
-		return mmGetEndpointRoles.funcGetEndpointRoles(ctx, endpoint)
-	}
-	mmGetEndpointRoles.t.Fatalf("Unexpected call to UserRepositoryMock.GetEndpointRoles. %v %v", ctx, endpoint)
-	return
-}
-
-// GetEndpointRolesAfterCounter returns a count of finished UserRepositoryMock.GetEndpointRoles invocations
-func (mmGetEndpointRoles *UserRepositoryMock) GetEndpointRolesAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetEndpointRoles.afterGetEndpointRolesCounter)
-}
-
-// GetEndpointRolesBeforeCounter returns a count of UserRepositoryMock.GetEndpointRoles invocations
-func (mmGetEndpointRoles *UserRepositoryMock) GetEndpointRolesBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetEndpointRoles.beforeGetEndpointRolesCounter)
-}
-
-// Calls returns a list of arguments used in each call to UserRepositoryMock.GetEndpointRoles.
-// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetEndpointRoles *mUserRepositoryMockGetEndpointRoles) Calls() []*UserRepositoryMockGetEndpointRolesParams {
-	mmGetEndpointRoles.mutex.RLock()
-
-	argCopy := make([]*UserRepositoryMockGetEndpointRolesParams, len(mmGetEndpointRoles.callArgs))
-	copy(argCopy, mmGetEndpointRoles.callArgs)
-
-	mmGetEndpointRoles.mutex.RUnlock()
-
-	return argCopy
-}
-
-// MinimockGetEndpointRolesDone returns true if the count of the GetEndpointRoles invocations corresponds
-// the number of defined expectations
-func (m *UserRepositoryMock) MinimockGetEndpointRolesDone() bool {
-	if m.GetEndpointRolesMock.optional {
-		// Optional methods provide '0 or more' call count restriction.
-		return true
-	}
-
-	for _, e := range m.GetEndpointRolesMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
-		}
-	}
-
-	return m.GetEndpointRolesMock.invocationsDone()
-}
-
-// MinimockGetEndpointRolesInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockGetEndpointRolesInspect() {
-	for _, e := range m.GetEndpointRolesMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.GetEndpointRoles with params: %#v", *e.params)
-		}
-	}
-
-	afterGetEndpointRolesCounter := mm_atomic.LoadUint64(&m.afterGetEndpointRolesCounter)
-	// if default expectation was set then invocations count should be greater than zero
-	if m.GetEndpointRolesMock.defaultExpectation != nil && afterGetEndpointRolesCounter < 1 {
-		if m.GetEndpointRolesMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to UserRepositoryMock.GetEndpointRoles")
-		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.GetEndpointRoles with params: %#v", *m.GetEndpointRolesMock.defaultExpectation.params)
-		}
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcGetEndpointRoles != nil && afterGetEndpointRolesCounter < 1 {
-		m.t.Error("Expected call to UserRepositoryMock.GetEndpointRoles")
-	}
-
-	if !m.GetEndpointRolesMock.invocationsDone() && afterGetEndpointRolesCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetEndpointRoles but found %d calls",
-			mm_atomic.LoadUint64(&m.GetEndpointRolesMock.expectedInvocations), afterGetEndpointRolesCounter)
-	}
-}
-
 type mUserRepositoryMockList struct {
 	optional           bool
 	mock               *UserRepositoryMock
@@ -1724,15 +1724,13 @@ type UserRepositoryMockListExpectation struct {
 // UserRepositoryMockListParams contains parameters of the UserRepository.List
 type UserRepositoryMockListParams struct {
 	ctx    context.Context
-	limit  int64
-	offset int64
+	filter filter.UserListFilter
 }
 
 // UserRepositoryMockListParamPtrs contains pointers to parameters of the UserRepository.List
 type UserRepositoryMockListParamPtrs struct {
 	ctx    *context.Context
-	limit  *int64
-	offset *int64
+	filter *filter.UserListFilter
 }
 
 // UserRepositoryMockListResults contains results of the UserRepository.List
@@ -1752,7 +1750,7 @@ func (mmList *mUserRepositoryMockList) Optional() *mUserRepositoryMockList {
 }
 
 // Expect sets up expected params for UserRepository.List
-func (mmList *mUserRepositoryMockList) Expect(ctx context.Context, limit int64, offset int64) *mUserRepositoryMockList {
+func (mmList *mUserRepositoryMockList) Expect(ctx context.Context, filter filter.UserListFilter) *mUserRepositoryMockList {
 	if mmList.mock.funcList != nil {
 		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Set")
 	}
@@ -1765,7 +1763,7 @@ func (mmList *mUserRepositoryMockList) Expect(ctx context.Context, limit int64,
 		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by ExpectParams functions")
 	}
 
-	mmList.defaultExpectation.params = &UserRepositoryMockListParams{ctx, limit, offset}
+	mmList.defaultExpectation.params = &UserRepositoryMockListParams{ctx, filter}
 	for _, e := range mmList.expectations {
 		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
 			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
@@ -1797,30 +1795,8 @@ func (mmList *mUserRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mUs
 	return mmList
 }
 
-// ExpectLimitParam2 sets up expected param limit for UserRepository.List
-func (mmList *mUserRepositoryMockList) ExpectLimitParam2(limit int64) *mUserRepositoryMockList {
-	if mmList.mock.funcList != nil {
-		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Set")
-	}
-
-	if mmList.defaultExpectation == nil {
-		mmList.defaultExpectation = &UserRepositoryMockListExpectation{}
-	}
-
-	if mmList.defaultExpectation.params != nil {
-		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Expect")
-	}
-
-	if mmList.defaultExpectation.paramPtrs == nil {
-		mmList.defaultExpectation.paramPtrs = &UserRepositoryMockListParamPtrs{}
-	}
-	mmList.defaultExpectation.paramPtrs.limit = &limit
-
-	return mmList
-}
-
-// ExpectOffsetParam3 sets up expected param offset for UserRepository.List
-func (mmList *mUserRepositoryMockList) ExpectOffsetParam3(offset int64) *mUserRepositoryMockList {
+// ExpectFilterParam2 sets up expected param filter for UserRepository.List
+func (mmList *mUserRepositoryMockList) ExpectFilterParam2(filter filter.UserListFilter) *mUserRepositoryMockList {
 	if mmList.mock.funcList != nil {
 		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Set")
 	}
@@ -1836,13 +1812,13 @@ func (mmList *mUserRepositoryMockList) ExpectOffsetParam3(offset int64) *mUserRe
 	if mmList.defaultExpectation.paramPtrs == nil {
 		mmList.defaultExpectation.paramPtrs = &UserRepositoryMockListParamPtrs{}
 	}
-	mmList.defaultExpectation.paramPtrs.offset = &offset
+	mmList.defaultExpectation.paramPtrs.filter = &filter
 
 	return mmList
 }
 
 // Inspect accepts an inspector function that has same arguments as the UserRepository.List
-func (mmList *mUserRepositoryMockList) Inspect(f func(ctx context.Context, limit int64, offset int64)) *mUserRepositoryMockList {
+func (mmList *mUserRepositoryMockList) Inspect(f func(ctx context.Context, filter filter.UserListFilter)) *mUserRepositoryMockList {
 	if mmList.mock.inspectFuncList != nil {
 		mmList.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.List")
 	}
@@ -1866,7 +1842,7 @@ func (mmList *mUserRepositoryMockList) Return(upa1 []*model.User, err error) *Us
 }
 
 // Set uses given function f to mock the UserRepository.List method
-func (mmList *mUserRepositoryMockList) Set(f func(ctx context.Context, limit int64, offset int64) (upa1 []*model.User, err error)) *UserRepositoryMock {
+func (mmList *mUserRepositoryMockList) Set(f func(ctx context.Context, filter filter.UserListFilter) (upa1 []*model.User, err error)) *UserRepositoryMock {
 	if mmList.defaultExpectation != nil {
 		mmList.mock.t.Fatalf("Default expectation is already set for the UserRepository.List method")
 	}
@@ -1881,14 +1857,14 @@ func (mmList *mUserRepositoryMockList) Set(f func(ctx context.Context, limit int
 
 // When sets expectation for the UserRepository.List which will trigger the result defined by the following
 // Then helper
-func (mmList *mUserRepositoryMockList) When(ctx context.Context, limit int64, offset int64) *UserRepositoryMockListExpectation {
+func (mmList *mUserRepositoryMockList) When(ctx context.Context, filter filter.UserListFilter) *UserRepositoryMockListExpectation {
 	if mmList.mock.funcList != nil {
 		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Set")
 	}
 
 	expectation := &UserRepositoryMockListExpectation{
 		mock:   mmList.mock,
-		params: &UserRepositoryMockListParams{ctx, limit, offset},
+		params: &UserRepositoryMockListParams{ctx, filter},
 	}
 	mmList.expectations = append(mmList.expectations, expectation)
 	return expectation
@@ -1921,15 +1897,15 @@ func (mmList *mUserRepositoryMockList) invocationsDone() bool {
 }
 
 // List implements repository.UserRepository
-func (mmList *UserRepositoryMock) List(ctx context.Context, limit int64, offset int64) (upa1 []*model.User, err error) {
+func (mmList *UserRepositoryMock) List(ctx context.Context, filter filter.UserListFilter) (upa1 []*model.User, err error) {
 	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
 	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
 
 	if mmList.inspectFuncList != nil {
-		mmList.inspectFuncList(ctx, limit, offset)
+		mmList.inspectFuncList(ctx, filter)
 	}
 
-	mm_params := UserRepositoryMockListParams{ctx, limit, offset}
+	mm_params := UserRepositoryMockListParams{ctx, filter}
 
 	// Record call args
 	mmList.ListMock.mutex.Lock()
@@ -1948,7 +1924,7 @@ func (mmList *UserRepositoryMock) List(ctx context.Context, limit int64, offset
 		mm_want := mmList.ListMock.defaultExpectation.params
 		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockListParams{ctx, limit, offset}
+		mm_got := UserRepositoryMockListParams{ctx, filter}
 
 		if mm_want_ptrs != nil {
 
@@ -1956,12 +1932,8 @@ func (mmList *UserRepositoryMock) List(ctx context.Context, limit int64, offset
 				mmList.t.Errorf("UserRepositoryMock.List got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
-				mmList.t.Errorf("UserRepositoryMock.List got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
-			}
-
-			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
-				mmList.t.Errorf("UserRepositoryMock.List got unexpected parameter offset, want: %#v, got: %#v%s\n", *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmList.t.Errorf("UserRepositoryMock.List got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
@@ -1975,9 +1947,9 @@ func (mmList *UserRepositoryMock) List(ctx context.Context, limit int64, offset
 		return (*mm_results).upa1, (*mm_results).err
 	}
 	if mmList.funcList != nil {
-		return mmList.funcList(ctx, limit, offset)
+		return mmList.funcList(ctx, filter)
 	}
-	mmList.t.Fatalf("Unexpected call to UserRepositoryMock.List. %v %v %v", ctx, limit, offset)
+	mmList.t.Fatalf("Unexpected call to UserRepositoryMock.List. %v %v", ctx, filter)
 	return
 }
 
@@ -2375,14 +2347,14 @@ func (m *UserRepositoryMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockCheckUsersExistInspect()
 
+			m.MinimockCountInspect()
+
 			m.MinimockCreateInspect()
 
 			m.MinimockDeleteInspect()
 
 			m.MinimockGetInspect()
 
-			m.MinimockGetEndpointRolesInspect()
-
 			m.MinimockListInspect()
 
 			m.MinimockUpdateInspect()
@@ -2410,10 +2382,10 @@ func (m *UserRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockCheckUsersExistDone() &&
+		m.MinimockCountDone() &&
 		m.MinimockCreateDone() &&
 		m.MinimockDeleteDone() &&
 		m.MinimockGetDone() &&
-		m.MinimockGetEndpointRolesDone() &&
 		m.MinimockListDone() &&
 		m.MinimockUpdateDone()
 }
@@ -0,0 +1,280 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+
+	"github.com/mikhailsoldatkin/auth/internal/client/pubsub"
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/metric"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+const (
+	levelMemory   = "memory"
+	levelRedis    = "redis"
+	levelDatabase = "database"
+
+	resultHit   = "hit"
+	resultMiss  = "miss"
+	resultError = "error"
+
+	invalidateMessage = "invalidate"
+
+	initialEpoch = "0"
+)
+
+// Store defines the shared second level of the cache, it is satisfied by cache.RedisClient.
+type Store interface {
+	Get(ctx context.Context, key string) (interface{}, error)
+	Set(ctx context.Context, key string, value interface{}) error
+	Expire(ctx context.Context, key string, expiration time.Duration) error
+}
+
+var _ repository.PermissionCache = (*permissionCache)(nil)
+
+// table is the compiled permission table, permissions of each endpoint are kept in the order they were loaded.
+type table struct {
+	exact     map[string][]*model.Permission
+	patterns  []*model.Permission
+	expiresAt time.Time
+}
+
+type permissionCache struct {
+	permissionRepo repository.PermissionRepository
+	store          Store
+	pubsub         pubsub.Client
+	config         config.PermissionCache
+
+	mu         sync.RWMutex
+	table      *table
+	generation uint64
+	loadMu     sync.Mutex
+}
+
+// NewPermissionCache creates a new permission cache.
+// Permissions of all endpoints are loaded at once and kept in memory, Redis serves as the shared second level,
+// so a single instance queries the database after each change. Failures of Redis fall back to the database.
+func NewPermissionCache(
+	permissionRepo repository.PermissionRepository,
+	store Store,
+	pubsub pubsub.Client,
+	config config.PermissionCache,
+) repository.PermissionCache {
+	return &permissionCache{
+		permissionRepo: permissionRepo,
+		store:          store,
+		pubsub:         pubsub,
+		config:         config,
+	}
+}
+
+// GetEndpointPermissions returns permissions of the endpoint and all wildcard permissions expanded to the roles
+// inheriting them, nearest grants come first (see PermissionRepository.ListGranted).
+func (c *permissionCache) GetEndpointPermissions(ctx context.Context, endpoint string) ([]*model.Permission, error) {
+	t := c.current()
+	if t != nil {
+		metric.IncPermissionCacheCounter(levelMemory, resultHit)
+		return t.lookup(endpoint), nil
+	}
+	metric.IncPermissionCacheCounter(levelMemory, resultMiss)
+
+	t, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.lookup(endpoint), nil
+}
+
+// Invalidate drops the cached permissions on all instances by starting a new epoch of the shared copy.
+// The local copy is dropped even if Redis is unavailable, other instances then refresh on expiration.
+func (c *permissionCache) Invalidate(ctx context.Context) error {
+	c.reset()
+
+	err := c.store.Set(ctx, c.epochKey(), strconv.FormatInt(time.Now().UnixNano(), 10))
+	if err != nil {
+		return fmt.Errorf("failed to delete cached permissions: %w", err)
+	}
+
+	err = c.pubsub.Publish(ctx, c.config.Channel, invalidateMessage)
+	if err != nil {
+		return fmt.Errorf("failed to publish permissions invalidation: %w", err)
+	}
+
+	return nil
+}
+
+// Listen drops the local copy of permissions on invalidation messages from other instances
+// and on each (re)subscription, as messages may have been missed, until the context is canceled.
+func (c *permissionCache) Listen(ctx context.Context) error {
+	return c.pubsub.Subscribe(
+		ctx,
+		c.config.Channel,
+		func(_ context.Context, _ string) {
+			c.reset()
+		},
+		func(_ context.Context) {
+			c.reset()
+		},
+	)
+}
+
+func (c *permissionCache) current() *table {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.table == nil || time.Now().After(c.table.expiresAt) {
+		return nil
+	}
+
+	return c.table
+}
+
+func (c *permissionCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.table = nil
+	c.generation++
+}
+
+// load fills the local copy from Redis or, on a miss, from the database. Concurrent misses wait for a single load.
+// A table loaded while an invalidation arrived is returned to the caller but isn't kept.
+func (c *permissionCache) load(ctx context.Context) (*table, error) {
+	c.loadMu.Lock()
+	defer c.loadMu.Unlock()
+
+	if t := c.current(); t != nil {
+		return t, nil
+	}
+
+	c.mu.RLock()
+	generation := c.generation
+	c.mu.RUnlock()
+
+	var permissions []*model.Permission
+	key, shared := c.sharedKey(ctx)
+	if shared {
+		permissions, shared = c.getShared(ctx, key)
+	}
+	if !shared {
+		var err error
+		permissions, err = c.permissionRepo.ListGranted(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load permissions: %w", err)
+		}
+		metric.IncPermissionCacheCounter(levelDatabase, resultHit)
+		if key != "" {
+			c.setShared(ctx, key, permissions)
+		}
+	}
+
+	t := compile(permissions, time.Now().Add(c.ttl()))
+
+	c.mu.Lock()
+	if c.generation == generation {
+		c.table = t
+	}
+	c.mu.Unlock()
+
+	return t, nil
+}
+
+// sharedKey returns the Redis key of the shared copy in the current epoch. The epoch is read before the database,
+// so permissions loaded before an invalidation are never stored where they are looked up after it.
+func (c *permissionCache) sharedKey(ctx context.Context) (string, bool) {
+	epoch, err := redigo.String(c.store.Get(ctx, c.epochKey()))
+	if errors.Is(err, redigo.ErrNil) {
+		epoch, err = initialEpoch, nil
+	}
+	if err != nil {
+		metric.IncPermissionCacheCounter(levelRedis, resultError)
+		log.Printf("failed to get permissions cache epoch: %v", err)
+		return "", false
+	}
+
+	return c.config.Key + ":" + epoch, true
+}
+
+func (c *permissionCache) epochKey() string {
+	return c.config.Key + ":epoch"
+}
+
+func (c *permissionCache) getShared(ctx context.Context, key string) ([]*model.Permission, bool) {
+	value, err := redigo.Bytes(c.store.Get(ctx, key))
+	if err != nil {
+		if errors.Is(err, redigo.ErrNil) {
+			metric.IncPermissionCacheCounter(levelRedis, resultMiss)
+		} else {
+			metric.IncPermissionCacheCounter(levelRedis, resultError)
+			log.Printf("failed to get cached permissions: %v", err)
+		}
+		return nil, false
+	}
+
+	var permissions []*model.Permission
+	err = json.Unmarshal(value, &permissions)
+	if err != nil {
+		metric.IncPermissionCacheCounter(levelRedis, resultError)
+		log.Printf("failed to decode cached permissions: %v", err)
+		return nil, false
+	}
+
+	metric.IncPermissionCacheCounter(levelRedis, resultHit)
+	return permissions, true
+}
+
+func (c *permissionCache) setShared(ctx context.Context, key string, permissions []*model.Permission) {
+	value, err := json.Marshal(permissions)
+	if err != nil {
+		log.Printf("failed to encode permissions: %v", err)
+		return
+	}
+
+	err = c.store.Set(ctx, key, value)
+	if err == nil {
+		err = c.store.Expire(ctx, key, c.ttl())
+	}
+	if err != nil {
+		log.Printf("failed to cache permissions: %v", err)
+	}
+}
+
+func (c *permissionCache) ttl() time.Duration {
+	return time.Duration(c.config.TTLSec) * time.Second
+}
+
+func compile(permissions []*model.Permission, expiresAt time.Time) *table {
+	t := &table{
+		exact:     make(map[string][]*model.Permission),
+		expiresAt: expiresAt,
+	}
+
+	for _, permission := range permissions {
+		if strings.Contains(permission.Endpoint, "*") {
+			t.patterns = append(t.patterns, permission)
+			continue
+		}
+		t.exact[permission.Endpoint] = append(t.exact[permission.Endpoint], permission)
+	}
+
+	return t
+}
+
+func (t *table) lookup(endpoint string) []*model.Permission {
+	exact := t.exact[endpoint]
+	permissions := make([]*model.Permission, 0, len(exact)+len(t.patterns))
+	permissions = append(permissions, exact...)
+	return append(permissions, t.patterns...)
+}
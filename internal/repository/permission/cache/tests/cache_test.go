@@ -0,0 +1,238 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikhailsoldatkin/auth/internal/client/pubsub"
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/repository/permission/cache"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+var (
+	cfg = config.PermissionCache{TTLSec: 60, Key: "permissions:granted", Channel: "permissions:invalidate"}
+
+	exact   = &model.Permission{Endpoint: "/chat_v1.ChatV1/Delete", Role: "ADMIN", Effect: model.EffectAllow}
+	other   = &model.Permission{Endpoint: "/chat_v1.ChatV1/Create", Role: "USER", Effect: model.EffectAllow}
+	pattern = &model.Permission{Endpoint: "/chat_v1.ChatV1/*", Role: "USER", Effect: model.EffectDeny}
+	granted = []*model.Permission{other, exact, pattern}
+)
+
+type store struct {
+	mu     sync.Mutex
+	values map[string]interface{}
+	err    error
+}
+
+func newStore() *store {
+	return &store{values: make(map[string]interface{})}
+}
+
+func (s *store) Get(_ context.Context, key string) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.values[key], s.err
+}
+
+func (s *store) Set(_ context.Context, key string, value interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		s.values[key] = value
+	}
+	return s.err
+}
+
+func (s *store) Expire(_ context.Context, _ string, _ time.Duration) error {
+	return s.err
+}
+
+// broker confirms the subscription and delivers a published message to the handler passed to Subscribe.
+type broker struct {
+	messages chan string
+}
+
+func newBroker() *broker {
+	return &broker{messages: make(chan string, 1)}
+}
+
+func (b *broker) Publish(_ context.Context, _, message string) error {
+	b.messages <- message
+	return nil
+}
+
+func (b *broker) Subscribe(ctx context.Context, _ string, handler pubsub.Handler, subscribed pubsub.SubscribedHandler) error {
+	subscribed(ctx)
+	select {
+	case message := <-b.messages:
+		handler(ctx, message)
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+func TestGetEndpointPermissions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+	)
+
+	t.Run("loads from database once", func(t *testing.T) {
+		t.Parallel()
+
+		permissionRepoMock := repoMocks.NewPermissionRepositoryMock(mc)
+		permissionRepoMock.ListGrantedMock.Times(1).Return(granted, nil)
+		s := newStore()
+		c := cache.NewPermissionCache(permissionRepoMock, s, newBroker(), cfg)
+
+		for range 3 {
+			permissions, err := c.GetEndpointPermissions(ctx, exact.Endpoint)
+			require.NoError(t, err)
+			require.Equal(t, []*model.Permission{exact, pattern}, permissions)
+		}
+		require.NotNil(t, s.values[cfg.Key+":0"])
+
+		permissions, err := c.GetEndpointPermissions(ctx, "/user_v1.UserV1/Get")
+		require.NoError(t, err)
+		require.Equal(t, []*model.Permission{pattern}, permissions)
+	})
+
+	t.Run("shared level hit", func(t *testing.T) {
+		t.Parallel()
+
+		s := newStore()
+		warm := cache.NewPermissionCache(repoMocks.NewPermissionRepositoryMock(mc).ListGrantedMock.Return(granted, nil), s, newBroker(), cfg)
+		_, err := warm.GetEndpointPermissions(ctx, exact.Endpoint)
+		require.NoError(t, err)
+
+		c := cache.NewPermissionCache(repoMocks.NewPermissionRepositoryMock(mc), s, newBroker(), cfg)
+		permissions, err := c.GetEndpointPermissions(ctx, other.Endpoint)
+		require.NoError(t, err)
+		require.Equal(t, []*model.Permission{other, pattern}, permissions)
+	})
+
+	t.Run("redis unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		s := newStore()
+		s.err = errors.New("connection refused")
+		c := cache.NewPermissionCache(repoMocks.NewPermissionRepositoryMock(mc).ListGrantedMock.Return(granted, nil), s, newBroker(), cfg)
+
+		permissions, err := c.GetEndpointPermissions(ctx, exact.Endpoint)
+		require.NoError(t, err)
+		require.Equal(t, []*model.Permission{exact, pattern}, permissions)
+	})
+
+	t.Run("database unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		dbErr := errors.New("connection refused")
+		c := cache.NewPermissionCache(repoMocks.NewPermissionRepositoryMock(mc).ListGrantedMock.Return(nil, dbErr), newStore(), newBroker(), cfg)
+
+		_, err := c.GetEndpointPermissions(ctx, exact.Endpoint)
+		require.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestInvalidate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+	)
+
+	permissionRepoMock := repoMocks.NewPermissionRepositoryMock(mc)
+	permissionRepoMock.ListGrantedMock.Times(2).Return(granted, nil)
+	s := newStore()
+	b := newBroker()
+	c := cache.NewPermissionCache(permissionRepoMock, s, b, cfg)
+	remote := cache.NewPermissionCache(permissionRepoMock, s, b, cfg)
+
+	_, err := remote.GetEndpointPermissions(ctx, exact.Endpoint)
+	require.NoError(t, err)
+
+	// the local copy is dropped and a new epoch of the shared one is started, the next lookup goes to the database
+	require.NoError(t, c.Invalidate(ctx))
+	require.NotNil(t, s.values[cfg.Key+":epoch"])
+	_, err = c.GetEndpointPermissions(ctx, exact.Endpoint)
+	require.NoError(t, err)
+
+	// the remote instance drops its local copy on the published message and reads the new epoch
+	require.NoError(t, remote.Listen(ctx))
+	_, err = remote.GetEndpointPermissions(ctx, exact.Endpoint)
+	require.NoError(t, err)
+}
+
+func TestInvalidateDuringLoad(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx   = context.Background()
+		mc    = minimock.NewController(t)
+		stale = []*model.Permission{other, pattern}
+		s     = newStore()
+		b     = newBroker()
+	)
+
+	permissionRepoMock := repoMocks.NewPermissionRepositoryMock(mc)
+	writer := cache.NewPermissionCache(permissionRepoMock, s, b, cfg)
+
+	// another instance changes permissions and invalidates the cache after the rows were read
+	loads := 0
+	permissionRepoMock.ListGrantedMock.Times(2).Set(func(_ context.Context) ([]*model.Permission, error) {
+		loads++
+		if loads == 1 {
+			require.NoError(t, writer.Invalidate(ctx))
+			return stale, nil
+		}
+		return granted, nil
+	})
+
+	c := cache.NewPermissionCache(permissionRepoMock, s, b, cfg)
+	permissions, err := c.GetEndpointPermissions(ctx, exact.Endpoint)
+	require.NoError(t, err)
+	require.Equal(t, []*model.Permission{pattern}, permissions)
+
+	// the stale rows were stored in the old epoch, the next instance loads the changed permissions
+	fresh := cache.NewPermissionCache(permissionRepoMock, s, b, cfg)
+	permissions, err = fresh.GetEndpointPermissions(ctx, exact.Endpoint)
+	require.NoError(t, err)
+	require.Equal(t, []*model.Permission{exact, pattern}, permissions)
+}
+
+func TestListenResetsOnSubscribe(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+	)
+
+	permissionRepoMock := repoMocks.NewPermissionRepositoryMock(mc)
+	permissionRepoMock.ListGrantedMock.Times(2).Return(granted, nil)
+	s := newStore()
+	c := cache.NewPermissionCache(permissionRepoMock, s, newBroker(), cfg)
+
+	_, err := c.GetEndpointPermissions(ctx, exact.Endpoint)
+	require.NoError(t, err)
+
+	// invalidations published while unsubscribed are lost, the local copy is dropped on resubscription
+	s.values = make(map[string]interface{})
+	listenCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.NoError(t, c.Listen(listenCtx))
+
+	_, err = c.GetEndpointPermissions(ctx, exact.Endpoint)
+	require.NoError(t, err)
+}
@@ -0,0 +1,27 @@
+package converter
+
+import (
+	modelRepo "github.com/mikhailsoldatkin/auth/internal/repository/permission/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// FromRepoToService converter from Postgres repository Permission model to service Permission model.
+func FromRepoToService(permission *modelRepo.Permission) *model.Permission {
+	return &model.Permission{
+		ID:            permission.ID,
+		Endpoint:      permission.Endpoint,
+		Role:          permission.Role,
+		MaxAuthAgeMin: int(permission.MaxAuthAgeMin.Int32),
+		Effect:        permission.Effect,
+		Condition:     permission.Condition,
+	}
+}
+
+// FromRepoToServiceList converts list of Postgres repository Permission models to list of service Permission models.
+func FromRepoToServiceList(permissions []*modelRepo.Permission) []*model.Permission {
+	servicePermissions := make([]*model.Permission, len(permissions))
+	for i, permission := range permissions {
+		servicePermissions[i] = FromRepoToService(permission)
+	}
+	return servicePermissions
+}
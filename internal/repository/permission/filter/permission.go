@@ -0,0 +1,7 @@
+package filter
+
+// PermissionFilter is used to narrow down the list of permissions, empty fields are ignored.
+type PermissionFilter struct {
+	Endpoint string
+	Role     string
+}
@@ -0,0 +1,13 @@
+package model
+
+import "database/sql"
+
+// Permission represents an endpoint permission entity in the Postgres database.
+type Permission struct {
+	ID            int64         `db:"id"`
+	Endpoint      string        `db:"endpoint"`
+	Role          string        `db:"role"`
+	MaxAuthAgeMin sql.NullInt32 `db:"max_auth_age_min"`
+	Effect        string        `db:"effect"`
+	Condition     string        `db:"condition"`
+}
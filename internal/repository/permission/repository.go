@@ -0,0 +1,245 @@
+package permission
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	"github.com/mikhailsoldatkin/auth/internal/repository/permission/converter"
+	"github.com/mikhailsoldatkin/auth/internal/repository/permission/filter"
+	repoModel "github.com/mikhailsoldatkin/auth/internal/repository/permission/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+const (
+	tablePermissions    = "permissions"
+	columnID            = "id"
+	columnEndpoint      = "endpoint"
+	columnRole          = "role"
+	columnMaxAuthAgeMin = "max_auth_age_min"
+	columnEffect        = "effect"
+	columnCondition     = "condition"
+	permissionEntity    = "permission"
+
+	// grantedPermissionsCTE expands all endpoint permissions to the roles inheriting them,
+	// a permission granted to a role is granted to all of its descendants.
+	grantedPermissionsCTE = `WITH RECURSIVE granted (id, endpoint, role, max_auth_age_min, effect, condition, depth) AS (
+    SELECT id, endpoint, role, max_auth_age_min, effect, condition, 0 FROM permissions
+    UNION ALL
+    SELECT g.id, g.endpoint, r.name, g.max_auth_age_min, g.effect, g.condition, g.depth + 1
+    FROM roles r JOIN granted g ON r.parent = g.role
+    WHERE g.depth < 16
+)`
+	tableGrantedPermissions = "granted"
+	columnDepth             = "depth"
+)
+
+var _ repository.PermissionRepository = (*repo)(nil)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of the permission repository.
+func NewRepository(db db.Client) repository.PermissionRepository {
+	return &repo{db: db}
+}
+
+// Create inserts a new endpoint permission into the database.
+// It returns ErrInvalidArgument if the role is already granted access to the endpoint.
+func (r *repo) Create(ctx context.Context, permission *model.Permission) (int64, error) {
+	maxAuthAgeMin := sql.NullInt32{
+		Int32: int32(permission.MaxAuthAgeMin),
+		Valid: permission.RequiresFreshAuth(),
+	}
+
+	effect := permission.Effect
+	if effect == "" {
+		effect = model.EffectAllow
+	}
+
+	builder := sq.Insert(tablePermissions).
+		PlaceholderFormat(sq.Dollar).
+		Columns(columnEndpoint, columnRole, columnMaxAuthAgeMin, columnEffect, columnCondition).
+		Values(permission.Endpoint, permission.Role, maxAuthAgeMin, effect, permission.Condition).
+		Suffix("ON CONFLICT (endpoint, role) DO NOTHING RETURNING id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.Create",
+		QueryRaw: query,
+	}
+
+	var id int64
+	err = r.db.DB().ScanOneContext(ctx, &id, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, customerrors.NewErrInvalidArgument("permission already exists")
+		}
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Get retrieves an endpoint permission by its ID.
+func (r *repo) Get(ctx context.Context, id int64) (*model.Permission, error) {
+	builder := sq.Select(columnID, columnEndpoint, columnRole, columnMaxAuthAgeMin, columnEffect, columnCondition).
+		From(tablePermissions).
+		Where(sq.Eq{columnID: id}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.Get",
+		QueryRaw: query,
+	}
+
+	var permission repoModel.Permission
+	err = r.db.DB().ScanOneContext(ctx, &permission, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, customerrors.NewErrNotFound(permissionEntity, id)
+		}
+		return nil, err
+	}
+
+	return converter.FromRepoToService(&permission), nil
+}
+
+// List retrieves endpoint permissions matching the filter ordered by endpoint and role.
+func (r *repo) List(ctx context.Context, filter filter.PermissionFilter) ([]*model.Permission, error) {
+	builder := sq.Select(columnID, columnEndpoint, columnRole, columnMaxAuthAgeMin, columnEffect, columnCondition).
+		From(tablePermissions).
+		OrderBy(columnEndpoint, columnRole).
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.Endpoint != "" {
+		builder = builder.Where(sq.Eq{columnEndpoint: filter.Endpoint})
+	}
+	if filter.Role != "" {
+		builder = builder.Where(sq.Eq{columnRole: filter.Role})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.List",
+		QueryRaw: query,
+	}
+
+	var permissions []*repoModel.Permission
+	err = r.db.DB().ScanAllContext(ctx, &permissions, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepoToServiceList(permissions), nil
+}
+
+// ListGranted retrieves permissions of all endpoints expanded to the roles inheriting them.
+// Nearest grants come first, permissions of ancestor roles follow the role's own permissions.
+func (r *repo) ListGranted(ctx context.Context) ([]*model.Permission, error) {
+	builder := sq.Select(columnID, columnEndpoint, columnRole, columnMaxAuthAgeMin, columnEffect, columnCondition).
+		Prefix(grantedPermissionsCTE).
+		From(tableGrantedPermissions).
+		OrderBy(columnDepth, columnEndpoint, columnRole).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.ListGranted",
+		QueryRaw: query,
+	}
+
+	var permissions []*repoModel.Permission
+	err = r.db.DB().ScanAllContext(ctx, &permissions, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepoToServiceList(permissions), nil
+}
+
+// Update changes the effect, the required authentication age and the condition of an endpoint permission by its ID.
+func (r *repo) Update(ctx context.Context, permission *model.Permission) error {
+	builder := sq.Update(tablePermissions).
+		Set(columnMaxAuthAgeMin, sql.NullInt32{
+			Int32: int32(permission.MaxAuthAgeMin),
+			Valid: permission.RequiresFreshAuth(),
+		}).
+		Set(columnEffect, permission.Effect).
+		Set(columnCondition, permission.Condition).
+		Where(sq.Eq{columnID: permission.ID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.Update",
+		QueryRaw: query,
+	}
+
+	result, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return customerrors.NewErrNotFound(permissionEntity, permission.ID)
+	}
+
+	return nil
+}
+
+// Delete removes an endpoint permission by its ID.
+func (r *repo) Delete(ctx context.Context, id int64) error {
+	builder := sq.Delete(tablePermissions).
+		Where(sq.Eq{columnID: id}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "permission_repository.Delete",
+		QueryRaw: query,
+	}
+
+	result, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return customerrors.NewErrNotFound(permissionEntity, id)
+	}
+
+	return nil
+}
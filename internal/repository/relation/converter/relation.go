@@ -0,0 +1,25 @@
+package converter
+
+import (
+	modelRepo "github.com/mikhailsoldatkin/auth/internal/repository/relation/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// FromRepoToService converter from Postgres repository RelationTuple model to service RelationTuple model.
+func FromRepoToService(tuple *modelRepo.RelationTuple) *model.RelationTuple {
+	return &model.RelationTuple{
+		Object:    tuple.Object,
+		Relation:  tuple.Relation,
+		Subject:   tuple.Subject,
+		CreatedAt: tuple.CreatedAt,
+	}
+}
+
+// FromRepoToServiceList converts list of Postgres repository RelationTuple models to list of service RelationTuple models.
+func FromRepoToServiceList(tuples []*modelRepo.RelationTuple) []*model.RelationTuple {
+	serviceTuples := make([]*model.RelationTuple, len(tuples))
+	for i, tuple := range tuples {
+		serviceTuples[i] = FromRepoToService(tuple)
+	}
+	return serviceTuples
+}
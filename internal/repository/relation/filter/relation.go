@@ -0,0 +1,9 @@
+package filter
+
+// RelationFilter is used to narrow down the list of relation tuples, empty fields are ignored.
+// Tuples having any of Relations are selected.
+type RelationFilter struct {
+	Object    string
+	Relations []string
+	Subject   string
+}
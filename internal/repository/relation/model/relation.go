@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// RelationTuple represents a relationship entity in the Postgres database.
+type RelationTuple struct {
+	Object    string    `db:"object"`
+	Relation  string    `db:"relation"`
+	Subject   string    `db:"subject"`
+	CreatedAt time.Time `db:"created_at"`
+}
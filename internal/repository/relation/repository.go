@@ -0,0 +1,131 @@
+package relation
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	"github.com/mikhailsoldatkin/auth/internal/repository/relation/converter"
+	"github.com/mikhailsoldatkin/auth/internal/repository/relation/filter"
+	repoModel "github.com/mikhailsoldatkin/auth/internal/repository/relation/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+const (
+	tableRelationTuples = "relation_tuples"
+	columnObject        = "object"
+	columnRelation      = "relation"
+	columnSubject       = "subject"
+	columnCreatedAt     = "created_at"
+	relationEntity      = "relation"
+)
+
+var _ repository.RelationRepository = (*repo)(nil)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of the relation tuple repository.
+func NewRepository(db db.Client) repository.RelationRepository {
+	return &repo{db: db}
+}
+
+// Create inserts a new relation tuple into the database.
+// It reports whether the tuple was added, writing an existing tuple is a no-op.
+func (r *repo) Create(ctx context.Context, tuple *model.RelationTuple) (bool, error) {
+	builder := sq.Insert(tableRelationTuples).
+		PlaceholderFormat(sq.Dollar).
+		Columns(columnObject, columnRelation, columnSubject, columnCreatedAt).
+		Values(tuple.Object, tuple.Relation, tuple.Subject, time.Now()).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.Create",
+		QueryRaw: query,
+	}
+
+	result, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// List retrieves relation tuples matching the filter ordered by object, relation and subject.
+func (r *repo) List(ctx context.Context, filter filter.RelationFilter) ([]*model.RelationTuple, error) {
+	builder := sq.Select(columnObject, columnRelation, columnSubject, columnCreatedAt).
+		From(tableRelationTuples).
+		OrderBy(columnObject, columnRelation, columnSubject).
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.Object != "" {
+		builder = builder.Where(sq.Eq{columnObject: filter.Object})
+	}
+	if len(filter.Relations) > 0 {
+		builder = builder.Where(sq.Eq{columnRelation: filter.Relations})
+	}
+	if filter.Subject != "" {
+		builder = builder.Where(sq.Eq{columnSubject: filter.Subject})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.List",
+		QueryRaw: query,
+	}
+
+	var tuples []*repoModel.RelationTuple
+	err = r.db.DB().ScanAllContext(ctx, &tuples, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepoToServiceList(tuples), nil
+}
+
+// Delete removes the relation tuple.
+func (r *repo) Delete(ctx context.Context, tuple *model.RelationTuple) error {
+	builder := sq.Delete(tableRelationTuples).
+		Where(sq.Eq{
+			columnObject:   tuple.Object,
+			columnRelation: tuple.Relation,
+			columnSubject:  tuple.Subject,
+		}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "relation_repository.Delete",
+		QueryRaw: query,
+	}
+
+	result, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return customerrors.NewErrNotFound(relationEntity, tuple.Object+"#"+tuple.Relation+"@"+tuple.Subject)
+	}
+
+	return nil
+}
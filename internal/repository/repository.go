@@ -2,7 +2,11 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	decisionFilter "github.com/mikhailsoldatkin/auth/internal/repository/decision/filter"
+	permissionFilter "github.com/mikhailsoldatkin/auth/internal/repository/permission/filter"
+	relationFilter "github.com/mikhailsoldatkin/auth/internal/repository/relation/filter"
 	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
 	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
 )
@@ -11,10 +15,10 @@ import (
 type UserRepository interface {
 	Create(ctx context.Context, user *model.User) (int64, error)
 	Get(ctx context.Context, filter filter.UserFilter) (*model.User, error)
-	GetEndpointRoles(ctx context.Context, endpoint string) ([]string, error)
 	Delete(ctx context.Context, id int64) error
 	Update(ctx context.Context, updates *model.User) error
-	List(ctx context.Context, limit, offset int64) ([]*model.User, error)
+	List(ctx context.Context, filter filter.UserListFilter) ([]*model.User, error)
+	Count(ctx context.Context, filter filter.UserListFilter) (int64, error)
 	CheckUsersExist(ctx context.Context, ids []int64) error
 }
 
@@ -22,3 +26,81 @@ type UserRepository interface {
 type LogRepository interface {
 	Log(ctx context.Context, id int64, details string) error
 }
+
+// APITokenRepository defines the interface for personal access token database operations.
+type APITokenRepository interface {
+	Create(ctx context.Context, token *model.APIToken) (int64, error)
+	GetByPrefix(ctx context.Context, prefix string) (*model.APIToken, error)
+	List(ctx context.Context, userID int64) ([]*model.APIToken, error)
+	Revoke(ctx context.Context, id, userID int64) error
+	UpdateLastUsed(ctx context.Context, id int64, usedAt time.Time) error
+}
+
+// IdentityRepository defines the interface for federated identity (account link) database operations.
+type IdentityRepository interface {
+	Create(ctx context.Context, identity *model.FederatedIdentity) error
+	Get(ctx context.Context, provider, subject string) (*model.FederatedIdentity, error)
+}
+
+// PermissionRepository defines the interface for endpoint permission database operations.
+type PermissionRepository interface {
+	Create(ctx context.Context, permission *model.Permission) (int64, error)
+	Get(ctx context.Context, id int64) (*model.Permission, error)
+	List(ctx context.Context, filter permissionFilter.PermissionFilter) ([]*model.Permission, error)
+	ListGranted(ctx context.Context) ([]*model.Permission, error)
+	Update(ctx context.Context, permission *model.Permission) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// PermissionCache defines the interface for the cached lookup of endpoint permissions.
+type PermissionCache interface {
+	GetEndpointPermissions(ctx context.Context, endpoint string) ([]*model.Permission, error)
+	Invalidate(ctx context.Context) error
+	Listen(ctx context.Context) error
+}
+
+// RoleRepository defines the interface for role database operations.
+type RoleRepository interface {
+	Create(ctx context.Context, role *model.Role) error
+	Get(ctx context.Context, name string) (*model.Role, error)
+	List(ctx context.Context) ([]*model.Role, error)
+	Update(ctx context.Context, updates *model.RoleUpdate) error
+	Delete(ctx context.Context, name string) error
+	AssignToUser(ctx context.Context, grant *model.RoleGrant) error
+	RevokeFromUser(ctx context.Context, userID int64, role string) error
+	DeleteExpired(ctx context.Context, now time.Time) ([]*model.RoleGrant, error)
+	CountUsersByRoles(ctx context.Context) ([]*model.RoleSet, error)
+}
+
+// ElevationRepository defines the interface for elevation request database operations.
+type ElevationRepository interface {
+	Create(ctx context.Context, request *model.ElevationRequest) (int64, error)
+	Get(ctx context.Context, id int64) (*model.ElevationRequest, error)
+	Approve(ctx context.Context, id, approverID int64, approvedAt time.Time) error
+}
+
+// GroupRepository defines the interface for user group database operations.
+type GroupRepository interface {
+	Create(ctx context.Context, group *model.Group) (int64, error)
+	Get(ctx context.Context, id int64) (*model.Group, error)
+	List(ctx context.Context) ([]*model.Group, error)
+	Delete(ctx context.Context, id int64) error
+	AddMember(ctx context.Context, groupID, userID int64) error
+	RemoveMember(ctx context.Context, groupID, userID int64) error
+	AssignRole(ctx context.Context, groupID int64, role string) error
+	RevokeRole(ctx context.Context, groupID int64, role string) error
+	HasMember(ctx context.Context, groupID, userID int64) (bool, error)
+}
+
+// RelationRepository defines the interface for relation tuple database operations.
+type RelationRepository interface {
+	Create(ctx context.Context, tuple *model.RelationTuple) (bool, error)
+	List(ctx context.Context, filter relationFilter.RelationFilter) ([]*model.RelationTuple, error)
+	Delete(ctx context.Context, tuple *model.RelationTuple) error
+}
+
+// DecisionRepository defines the interface for access decision database operations.
+type DecisionRepository interface {
+	CreateBatch(ctx context.Context, events []*model.DecisionEvent) error
+	List(ctx context.Context, filter decisionFilter.DecisionFilter) ([]*model.DecisionEvent, error)
+}
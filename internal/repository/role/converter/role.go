@@ -0,0 +1,49 @@
+package converter
+
+import (
+	modelRepo "github.com/mikhailsoldatkin/auth/internal/repository/role/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// FromRepoToService converter from Postgres repository Role model to service Role model.
+func FromRepoToService(role *modelRepo.Role) *model.Role {
+	return &model.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Parent:      role.Parent.String,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
+// FromRepoToServiceList converts list of Postgres repository Role models to list of service Role models.
+func FromRepoToServiceList(roles []*modelRepo.Role) []*model.Role {
+	serviceRoles := make([]*model.Role, len(roles))
+	for i, role := range roles {
+		serviceRoles[i] = FromRepoToService(role)
+	}
+	return serviceRoles
+}
+
+// FromRepoToServiceGrantList converts list of Postgres repository RoleGrant models to list of service RoleGrant models.
+func FromRepoToServiceGrantList(grants []*modelRepo.RoleGrant) []*model.RoleGrant {
+	serviceGrants := make([]*model.RoleGrant, len(grants))
+	for i, grant := range grants {
+		serviceGrants[i] = &model.RoleGrant{
+			UserID:     grant.UserID,
+			Role:       grant.Role,
+			ValidFrom:  grant.ValidFrom,
+			ValidUntil: grant.ValidUntil.Time,
+		}
+	}
+	return serviceGrants
+}
+
+// FromRepoToServiceRoleSetList converts list of Postgres repository RoleSet models to list of service RoleSet models.
+func FromRepoToServiceRoleSetList(sets []*modelRepo.RoleSet) []*model.RoleSet {
+	serviceSets := make([]*model.RoleSet, len(sets))
+	for i, set := range sets {
+		serviceSets[i] = &model.RoleSet{Roles: set.Roles, Users: set.Users}
+	}
+	return serviceSets
+}
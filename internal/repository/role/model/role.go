@@ -0,0 +1,29 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Role represents a role entity in the Postgres database.
+type Role struct {
+	Name        string         `db:"name"`
+	Description string         `db:"description"`
+	Parent      sql.NullString `db:"parent"`
+	CreatedAt   time.Time      `db:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+}
+
+// RoleGrant represents a role assigned to a user in the Postgres database.
+type RoleGrant struct {
+	UserID     int64        `db:"user_id"`
+	Role       string       `db:"role"`
+	ValidFrom  time.Time    `db:"valid_from"`
+	ValidUntil sql.NullTime `db:"valid_until"`
+}
+
+// RoleSet represents the number of users holding the same set of roles in the Postgres database.
+type RoleSet struct {
+	Roles []string `db:"roles"`
+	Users int64    `db:"users"`
+}
@@ -0,0 +1,339 @@
+package role
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	"github.com/mikhailsoldatkin/auth/internal/repository/role/converter"
+	repoModel "github.com/mikhailsoldatkin/auth/internal/repository/role/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+const (
+	tableRoles        = "roles"
+	tableUserRoles    = "user_roles"
+	columnUserID      = "user_id"
+	columnRole        = "role"
+	columnValidFrom   = "valid_from"
+	columnValidUntil  = "valid_until"
+	userRoleEntity    = "user role"
+	columnName        = "name"
+	columnDescription = "description"
+	columnParent      = "parent"
+	columnCreatedAt   = "created_at"
+	columnUpdatedAt   = "updated_at"
+	roleEntity        = "role"
+
+	// foreignKeyViolation is the Postgres error code raised when a deleted role is still referenced.
+	foreignKeyViolation = "23503"
+
+	// queryUserRoleSets counts users by the sorted set of their primary role and currently granted roles.
+	queryUserRoleSets = `SELECT roles, COUNT(*) AS users FROM (
+    SELECT ARRAY(
+        SELECT users.role
+        UNION
+        SELECT user_roles.role FROM user_roles
+        WHERE user_roles.user_id = users.id AND user_roles.valid_from <= now()
+          AND (user_roles.valid_until IS NULL OR user_roles.valid_until > now())
+        UNION
+        SELECT group_roles.role FROM group_roles
+        JOIN group_members ON group_members.group_id = group_roles.group_id
+        WHERE group_members.user_id = users.id
+        ORDER BY 1
+    ) AS roles FROM users
+) AS role_sets GROUP BY roles ORDER BY roles`
+)
+
+var _ repository.RoleRepository = (*repo)(nil)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of the role repository.
+func NewRepository(db db.Client) repository.RoleRepository {
+	return &repo{db: db}
+}
+
+// Create inserts a new role into the database.
+// It returns ErrInvalidArgument if a role with the same name already exists.
+func (r *repo) Create(ctx context.Context, role *model.Role) error {
+	now := time.Now()
+
+	builder := sq.Insert(tableRoles).
+		PlaceholderFormat(sq.Dollar).
+		Columns(columnName, columnDescription, columnParent, columnCreatedAt, columnUpdatedAt).
+		Values(role.Name, role.Description, nullString(role.Parent), now, now).
+		Suffix("ON CONFLICT (name) DO NOTHING")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Create",
+		QueryRaw: query,
+	}
+
+	result, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return customerrors.NewErrInvalidArgument("role already exists")
+	}
+
+	return nil
+}
+
+// Get retrieves a role by its name.
+func (r *repo) Get(ctx context.Context, name string) (*model.Role, error) {
+	builder := sq.Select(columnName, columnDescription, columnParent, columnCreatedAt, columnUpdatedAt).
+		From(tableRoles).
+		Where(sq.Eq{columnName: name}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Get",
+		QueryRaw: query,
+	}
+
+	var role repoModel.Role
+	err = r.db.DB().ScanOneContext(ctx, &role, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, customerrors.NewErrNotFound(roleEntity, name)
+		}
+		return nil, err
+	}
+
+	return converter.FromRepoToService(&role), nil
+}
+
+// List retrieves all roles ordered by name.
+func (r *repo) List(ctx context.Context) ([]*model.Role, error) {
+	builder := sq.Select(columnName, columnDescription, columnParent, columnCreatedAt, columnUpdatedAt).
+		From(tableRoles).
+		OrderBy(columnName).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.List",
+		QueryRaw: query,
+	}
+
+	var roles []*repoModel.Role
+	err = r.db.DB().ScanAllContext(ctx, &roles, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepoToServiceList(roles), nil
+}
+
+// Update changes the description and the parent of a role.
+func (r *repo) Update(ctx context.Context, updates *model.RoleUpdate) error {
+	builder := sq.Update(tableRoles).
+		Set(columnUpdatedAt, time.Now()).
+		Where(sq.Eq{columnName: updates.Name}).
+		PlaceholderFormat(sq.Dollar)
+
+	if updates.Description != nil {
+		builder = builder.Set(columnDescription, *updates.Description)
+	}
+	if updates.Parent != nil {
+		builder = builder.Set(columnParent, nullString(*updates.Parent))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Update",
+		QueryRaw: query,
+	}
+
+	result, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return customerrors.NewErrNotFound(roleEntity, updates.Name)
+	}
+
+	return nil
+}
+
+// Delete removes a role by its name, permissions of the role are removed with it.
+// It returns ErrInvalidArgument if the role is still assigned to users or inherited by other roles.
+func (r *repo) Delete(ctx context.Context, name string) error {
+	builder := sq.Delete(tableRoles).
+		Where(sq.Eq{columnName: name}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Delete",
+		QueryRaw: query,
+	}
+
+	result, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return customerrors.NewErrInvalidArgument("role is assigned to users or inherited by other roles")
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return customerrors.NewErrNotFound(roleEntity, name)
+	}
+
+	return nil
+}
+
+// AssignToUser assigns the role to the user in addition to the user's primary role from grant.ValidFrom
+// (now if not set) until grant.ValidUntil (permanently if not set). Assigning the role again replaces
+// the validity period, a temporary grant never replaces a permanent one.
+func (r *repo) AssignToUser(ctx context.Context, grant *model.RoleGrant) error {
+	validFrom := grant.ValidFrom
+	if validFrom.IsZero() {
+		validFrom = time.Now()
+	}
+
+	var validUntil *time.Time
+	suffix := "ON CONFLICT (user_id, role) DO UPDATE SET valid_from = EXCLUDED.valid_from, valid_until = NULL"
+	if grant.IsTemporary() {
+		validUntil = &grant.ValidUntil
+		suffix = "ON CONFLICT (user_id, role) DO UPDATE " +
+			"SET valid_from = EXCLUDED.valid_from, valid_until = EXCLUDED.valid_until " +
+			"WHERE user_roles.valid_until IS NOT NULL"
+	}
+
+	builder := sq.Insert(tableUserRoles).
+		PlaceholderFormat(sq.Dollar).
+		Columns(columnUserID, columnRole, columnValidFrom, columnValidUntil).
+		Values(grant.UserID, grant.Role, validFrom, validUntil).
+		Suffix(suffix)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.AssignToUser",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RevokeFromUser removes the role assigned to the user.
+func (r *repo) RevokeFromUser(ctx context.Context, userID int64, role string) error {
+	builder := sq.Delete(tableUserRoles).
+		Where(sq.Eq{columnUserID: userID, columnRole: role}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.RevokeFromUser",
+		QueryRaw: query,
+	}
+
+	result, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return customerrors.NewErrNotFound(userRoleEntity, role)
+	}
+
+	return nil
+}
+
+// DeleteExpired removes the role grants which expired by now and returns them.
+func (r *repo) DeleteExpired(ctx context.Context, now time.Time) ([]*model.RoleGrant, error) {
+	builder := sq.Delete(tableUserRoles).
+		Where(sq.LtOrEq{columnValidUntil: now}).
+		Suffix("RETURNING user_id, role, valid_from, valid_until").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.DeleteExpired",
+		QueryRaw: query,
+	}
+
+	var grants []*repoModel.RoleGrant
+	err = r.db.DB().ScanAllContext(ctx, &grants, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepoToServiceGrantList(grants), nil
+}
+
+// CountUsersByRoles groups users by the set of roles they currently hold directly: the primary role,
+// valid user grants and roles of their groups. Role sets are sorted by role name.
+func (r *repo) CountUsersByRoles(ctx context.Context) ([]*model.RoleSet, error) {
+	q := db.Query{
+		Name:     "role_repository.CountUsersByRoles",
+		QueryRaw: queryUserRoleSets,
+	}
+
+	var sets []*repoModel.RoleSet
+	err := r.db.DB().ScanAllContext(ctx, &sets, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepoToServiceRoleSetList(sets), nil
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,41 @@
+package converter
+
+import (
+	"database/sql"
+	"time"
+
+	modelRepo "github.com/mikhailsoldatkin/auth/internal/repository/token/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// FromRepoToService converter from Postgres repository APIToken model to service APIToken model.
+func FromRepoToService(token *modelRepo.APIToken) *model.APIToken {
+	return &model.APIToken{
+		ID:         token.ID,
+		UserID:     token.UserID,
+		Name:       token.Name,
+		Prefix:     token.Prefix,
+		Hash:       token.Hash,
+		Scopes:     token.Scopes,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: fromNullTime(token.LastUsedAt),
+		RevokedAt:  fromNullTime(token.RevokedAt),
+		CreatedAt:  token.CreatedAt,
+	}
+}
+
+// FromRepoToServiceList converts list of Postgres repository APIToken models to list of service APIToken models.
+func FromRepoToServiceList(tokens []*modelRepo.APIToken) []*model.APIToken {
+	serviceTokens := make([]*model.APIToken, len(tokens))
+	for i, token := range tokens {
+		serviceTokens[i] = FromRepoToService(token)
+	}
+	return serviceTokens
+}
+
+func fromNullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// APIToken represents a personal access token entity in the Postgres database.
+type APIToken struct {
+	ID         int64        `db:"id"`
+	UserID     int64        `db:"user_id"`
+	Name       string       `db:"name"`
+	Prefix     string       `db:"prefix"`
+	Hash       string       `db:"token_hash"`
+	Scopes     []string     `db:"scopes"`
+	ExpiresAt  time.Time    `db:"expires_at"`
+	LastUsedAt sql.NullTime `db:"last_used_at"`
+	RevokedAt  sql.NullTime `db:"revoked_at"`
+	CreatedAt  time.Time    `db:"created_at"`
+}
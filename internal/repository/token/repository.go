@@ -0,0 +1,204 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	"github.com/mikhailsoldatkin/auth/internal/repository/token/converter"
+	repoModel "github.com/mikhailsoldatkin/auth/internal/repository/token/model"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+const (
+	tableAPITokens   = "api_tokens"
+	columnID         = "id"
+	columnUserID     = "user_id"
+	columnName       = "name"
+	columnPrefix     = "prefix"
+	columnTokenHash  = "token_hash"
+	columnScopes     = "scopes"
+	columnExpiresAt  = "expires_at"
+	columnLastUsedAt = "last_used_at"
+	columnRevokedAt  = "revoked_at"
+	columnCreatedAt  = "created_at"
+	tokenEntity      = "api token"
+)
+
+var _ repository.APITokenRepository = (*repo)(nil)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of the API token repository.
+func NewRepository(db db.Client) repository.APITokenRepository {
+	return &repo{db: db}
+}
+
+// Create inserts a new API token into the database.
+func (r *repo) Create(ctx context.Context, token *model.APIToken) (int64, error) {
+	scopes := token.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	builder := sq.Insert(tableAPITokens).
+		PlaceholderFormat(sq.Dollar).
+		Columns(
+			columnUserID,
+			columnName,
+			columnPrefix,
+			columnTokenHash,
+			columnScopes,
+			columnExpiresAt,
+		).
+		Values(token.UserID, token.Name, token.Prefix, token.Hash, scopes, token.ExpiresAt).
+		Suffix("RETURNING id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "token_repository.Create",
+		QueryRaw: query,
+	}
+
+	var id int64
+	err = r.db.DB().ScanOneContext(ctx, &id, q, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetByPrefix retrieves an API token by its public prefix.
+func (r *repo) GetByPrefix(ctx context.Context, prefix string) (*model.APIToken, error) {
+	builder := selectTokens().Where(sq.Eq{columnPrefix: prefix})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "token_repository.GetByPrefix",
+		QueryRaw: query,
+	}
+
+	var token repoModel.APIToken
+	err = r.db.DB().ScanOneContext(ctx, &token, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, customerrors.NewErrInvalidToken()
+		}
+		return nil, err
+	}
+
+	return converter.FromRepoToService(&token), nil
+}
+
+// List retrieves all API tokens owned by the user.
+func (r *repo) List(ctx context.Context, userID int64) ([]*model.APIToken, error) {
+	builder := selectTokens().
+		Where(sq.Eq{columnUserID: userID}).
+		OrderBy(columnID)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "token_repository.List",
+		QueryRaw: query,
+	}
+
+	var tokens []*repoModel.APIToken
+	err = r.db.DB().ScanAllContext(ctx, &tokens, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepoToServiceList(tokens), nil
+}
+
+// Revoke marks the user's API token as revoked.
+func (r *repo) Revoke(ctx context.Context, id, userID int64) error {
+	builder := sq.Update(tableAPITokens).
+		Set(columnRevokedAt, time.Now()).
+		Where(sq.Eq{columnID: id, columnUserID: userID, columnRevokedAt: nil}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "token_repository.Revoke",
+		QueryRaw: query,
+	}
+
+	result, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return customerrors.NewErrNotFound(tokenEntity, id)
+	}
+
+	return nil
+}
+
+// UpdateLastUsed records the moment the API token was last used.
+func (r *repo) UpdateLastUsed(ctx context.Context, id int64, usedAt time.Time) error {
+	builder := sq.Update(tableAPITokens).
+		Set(columnLastUsedAt, usedAt).
+		Where(sq.Eq{columnID: id}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "token_repository.UpdateLastUsed",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func selectTokens() sq.SelectBuilder {
+	return sq.Select(
+		columnID,
+		columnUserID,
+		columnName,
+		columnPrefix,
+		columnTokenHash,
+		columnScopes,
+		columnExpiresAt,
+		columnLastUsedAt,
+		columnRevokedAt,
+		columnCreatedAt,
+	).
+		From(tableAPITokens).
+		PlaceholderFormat(sq.Dollar)
+}
@@ -18,16 +18,35 @@ const (
 // FromRepoToService converter from Postgres repository User model to service User model.
 func FromRepoToService(user *modelRepo.User) *model.User {
 	return &model.User{
-		ID:        user.ID,
-		Username:  user.Username,
-		Email:     user.Email,
-		Role:      user.Role,
-		Password:  user.Password,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:            user.ID,
+		Username:      user.Username,
+		Email:         user.Email,
+		Role:          user.Role,
+		Roles:         user.Roles,
+		RolesUntil:    rolesUntil(user),
+		Password:      user.Password,
+		PrincipalType: user.PrincipalType,
+		OwnerID:       user.OwnerID.Int64,
+		AuthProvider:  user.AuthProvider,
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
 	}
 }
 
+// rolesUntil pairs temporary roles with their expiry times.
+func rolesUntil(user *modelRepo.User) map[string]time.Time {
+	if len(user.TemporaryRoles) == 0 || len(user.TemporaryRoles) != len(user.TemporaryRolesUntil) {
+		return nil
+	}
+
+	until := make(map[string]time.Time, len(user.TemporaryRoles))
+	for i, role := range user.TemporaryRoles {
+		until[role] = user.TemporaryRolesUntil[i]
+	}
+
+	return until
+}
+
 // FromRepoToServiceList converts list of Postgres repository User models to list of service User models.
 func FromRepoToServiceList(users []*modelRepo.User) []*model.User {
 	serviceUsers := make([]*model.User, len(users))
@@ -1,6 +1,9 @@
 package filter
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // UserFilter is used to filter users by ID or Username (unique fields).
 type UserFilter struct {
@@ -19,3 +22,37 @@ func (f *UserFilter) Validate() error {
 	}
 	return nil
 }
+
+// Columns users can be sorted by.
+const (
+	SortByID        = "id"
+	SortByUsername  = "username"
+	SortByEmail     = "email"
+	SortByCreatedAt = "created_at"
+)
+
+// UserListFilter is used to paginate, sort and narrow down the list of users.
+// After is the cursor decoded from PageToken, users sorted after it are returned instead of skipping Offset users.
+type UserListFilter struct {
+	Limit         int64
+	Offset        int64
+	PrincipalType string
+	Username      string
+	Email         string
+	Substring     bool
+	Role          string
+	CreatedFrom   time.Time
+	CreatedTo     time.Time
+	SortBy        string
+	Descending    bool
+	PageToken     string
+	After         *UserCursor
+}
+
+// UserCursor holds the sort keys of the last user of a page.
+type UserCursor struct {
+	ID        int64
+	Username  string
+	Email     string
+	CreatedAt time.Time
+}
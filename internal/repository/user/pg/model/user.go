@@ -1,16 +1,24 @@
 package model
 
 import (
+	"database/sql"
 	"time"
 )
 
 // User represents a user entity in the Postgres database.
 type User struct {
-	ID        int64     `db:"id"`
-	Username  string    `db:"username"`
-	Email     string    `db:"email"`
-	Role      string    `db:"role"`
-	Password  string    `db:"password"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID            int64         `db:"id"`
+	Username      string        `db:"username"`
+	Email         string        `db:"email"`
+	Role          string        `db:"role"`
+	Password      string        `db:"password"`
+	CreatedAt     time.Time     `db:"created_at"`
+	UpdatedAt     time.Time     `db:"updated_at"`
+	PrincipalType string        `db:"principal_type"`
+	OwnerID       sql.NullInt64 `db:"owner_id"`
+	AuthProvider  string        `db:"auth_provider"`
+	Roles         []string      `db:"roles"`
+	// TemporaryRoles and TemporaryRolesUntil hold roles granted for a limited time and their expiry in the same order.
+	TemporaryRoles      []string    `db:"temporary_roles"`
+	TemporaryRolesUntil []time.Time `db:"temporary_roles_until"`
 }
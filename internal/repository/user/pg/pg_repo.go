@@ -3,9 +3,12 @@ package pg
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
 	"github.com/mikhailsoldatkin/platform_common/pkg/db"
@@ -19,21 +22,71 @@ import (
 )
 
 const (
-	tableUsers       = "users"
-	tablePermissions = "permissions"
-	columnID         = "id"
-	columnUsername   = "username"
-	columnEmail      = "email"
-	columnRole       = "role"
-	columnPassword   = "password"
-	columnCreatedAt  = "created_at"
-	columnUpdatedAt  = "updated_at"
-	userEntity       = "user"
-	columnEndpoint   = "endpoint"
+	tableUsers          = "users"
+	columnID            = "id"
+	columnUsername      = "username"
+	columnEmail         = "email"
+	columnRole          = "role"
+	columnPassword      = "password"
+	columnCreatedAt     = "created_at"
+	columnUpdatedAt     = "updated_at"
+	userEntity          = "user"
+	columnPrincipalType = "principal_type"
+	columnOwnerID       = "owner_id"
+	columnAuthProvider  = "auth_provider"
+	constraintUsersRole = "users_role_fkey"
+
+	// columnRoles selects roles assigned to the user directly and through groups in addition to the primary role,
+	// direct grants count only within their validity period.
+	columnRoles = `ARRAY(
+    SELECT user_roles.role FROM user_roles
+    WHERE user_roles.user_id = users.id AND user_roles.valid_from <= now()
+      AND (user_roles.valid_until IS NULL OR user_roles.valid_until > now())
+    UNION
+    SELECT group_roles.role FROM group_roles
+    JOIN group_members ON group_members.group_id = group_roles.group_id
+    WHERE group_members.user_id = users.id
+) AS roles`
+
+	// columnTemporaryRoles and columnTemporaryRolesUntil select roles granted for a limited time and their expiry
+	// in the same order, roles also granted through groups are not temporary.
+	columnTemporaryRoles = `ARRAY(
+    SELECT user_roles.role FROM user_roles
+    WHERE user_roles.user_id = users.id AND user_roles.valid_from <= now() AND user_roles.valid_until > now()
+      AND user_roles.role NOT IN (
+        SELECT group_roles.role FROM group_roles
+        JOIN group_members ON group_members.group_id = group_roles.group_id
+        WHERE group_members.user_id = users.id
+      )
+    ORDER BY user_roles.role
+) AS temporary_roles`
+	columnTemporaryRolesUntil = `ARRAY(
+    SELECT user_roles.valid_until FROM user_roles
+    WHERE user_roles.user_id = users.id AND user_roles.valid_from <= now() AND user_roles.valid_until > now()
+      AND user_roles.role NOT IN (
+        SELECT group_roles.role FROM group_roles
+        JOIN group_members ON group_members.group_id = group_roles.group_id
+        WHERE group_members.user_id = users.id
+      )
+    ORDER BY user_roles.role
+) AS temporary_roles_until`
 
 	defaultPageSize = 10
 )
 
+var (
+	// sortColumns maps the sort fields of the list filter to columns, an empty field sorts by ID.
+	sortColumns = map[string]string{
+		"":                     columnID,
+		filter.SortByID:        columnID,
+		filter.SortByUsername:  columnUsername,
+		filter.SortByEmail:     columnEmail,
+		filter.SortByCreatedAt: columnCreatedAt,
+	}
+
+	likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+)
+
 var _ repository.UserRepository = (*repo)(nil)
 
 type repo struct {
@@ -53,6 +106,21 @@ func (r *repo) Create(ctx context.Context, user *model.User) (int64, error) {
 		return 0, err
 	}
 
+	principalType := user.PrincipalType
+	if principalType == "" {
+		principalType = model.PrincipalHuman
+	}
+
+	var ownerID *int64
+	if user.OwnerID != 0 {
+		ownerID = &user.OwnerID
+	}
+
+	authProvider := user.AuthProvider
+	if authProvider == "" {
+		authProvider = model.AuthProviderLocal
+	}
+
 	builder := sq.Insert(tableUsers).
 		PlaceholderFormat(sq.Dollar).
 		Columns(
@@ -60,10 +128,13 @@ func (r *repo) Create(ctx context.Context, user *model.User) (int64, error) {
 			columnEmail,
 			columnRole,
 			columnPassword,
+			columnPrincipalType,
+			columnOwnerID,
+			columnAuthProvider,
 			columnCreatedAt,
 			columnUpdatedAt,
 		).
-		Values(user.Username, user.Email, user.Role, password, now, now).
+		Values(user.Username, user.Email, user.Role, password, principalType, ownerID, authProvider, now, now).
 		Suffix("RETURNING id")
 
 	query, args, err := builder.ToSql()
@@ -79,7 +150,7 @@ func (r *repo) Create(ctx context.Context, user *model.User) (int64, error) {
 	var id int64
 	err = r.db.DB().ScanOneContext(ctx, &id, q, args...)
 	if err != nil {
-		return 0, err
+		return 0, unknownRoleErr(err, user.Role)
 	}
 
 	return id, nil
@@ -100,6 +171,12 @@ func (r *repo) Get(ctx context.Context, f filter.UserFilter) (*model.User, error
 		columnPassword,
 		columnCreatedAt,
 		columnUpdatedAt,
+		columnPrincipalType,
+		columnOwnerID,
+		columnAuthProvider,
+		columnRoles,
+		columnTemporaryRoles,
+		columnTemporaryRolesUntil,
 	).
 		From(tableUsers).
 		PlaceholderFormat(sq.Dollar)
@@ -136,32 +213,6 @@ func (r *repo) Get(ctx context.Context, f filter.UserFilter) (*model.User, error
 	return converter.FromRepoToService(&user), nil
 }
 
-// GetEndpointRoles retrieves roles associated with a specific endpoint from the database.
-func (r *repo) GetEndpointRoles(ctx context.Context, endpoint string) ([]string, error) {
-	builder := sq.Select(columnRole).
-		From(tablePermissions).
-		Where(sq.Eq{columnEndpoint: endpoint}).
-		PlaceholderFormat(sq.Dollar)
-
-	query, args, err := builder.ToSql()
-	if err != nil {
-		return nil, err
-	}
-
-	q := db.Query{
-		Name:     "user_repository.GetEndpointRoles",
-		QueryRaw: query,
-	}
-
-	var roles []string
-	err = r.db.DB().ScanAllContext(ctx, &roles, q, args...)
-	if err != nil {
-		return nil, err
-	}
-
-	return roles, nil
-}
-
 // Delete removes a user from the database by ID.
 func (r *repo) Delete(ctx context.Context, id int64) error {
 	builder := sq.Delete(tableUsers).
@@ -207,7 +258,7 @@ func (r *repo) Update(ctx context.Context, updates *model.User) error {
 
 	result, err := r.db.DB().ExecContext(ctx, q, args...)
 	if err != nil {
-		return err
+		return unknownRoleErr(err, updates.Role)
 	}
 
 	rowsAffected := result.RowsAffected()
@@ -219,7 +270,8 @@ func (r *repo) Update(ctx context.Context, updates *model.User) error {
 }
 
 // List retrieves a list of users from the database.
-func (r *repo) List(ctx context.Context, limit, offset int64) ([]*model.User, error) {
+func (r *repo) List(ctx context.Context, f filter.UserListFilter) ([]*model.User, error) {
+	limit, offset := f.Limit, f.Offset
 	if limit <= 0 {
 		limit = defaultPageSize
 	}
@@ -227,9 +279,25 @@ func (r *repo) List(ctx context.Context, limit, offset int64) ([]*model.User, er
 		offset = 0
 	}
 
-	builder := sq.Select("*").
-		From(tableUsers).
-		OrderBy(columnID).
+	sortColumn, ok := sortColumns[f.SortBy]
+	if !ok {
+		return nil, customerrors.NewErrInvalidArgument(fmt.Sprintf("unsupported sort field %q", f.SortBy))
+	}
+	direction := "ASC"
+	if f.Descending {
+		direction = "DESC"
+	}
+	orderBy := []string{fmt.Sprintf("%s %s", sortColumn, direction)}
+	if sortColumn != columnID {
+		orderBy = append(orderBy, fmt.Sprintf("%s %s", columnID, direction))
+	}
+
+	builder := applyListFilter(sq.Select("*").From(tableUsers), f)
+	if f.After != nil {
+		builder = builder.Where(afterCursor(sortColumn, f.Descending, f.After))
+	}
+	builder = builder.
+		OrderBy(orderBy...).
 		Limit(uint64(limit)).
 		Offset(uint64(offset)).
 		PlaceholderFormat(sq.Dollar)
@@ -253,6 +321,87 @@ func (r *repo) List(ctx context.Context, limit, offset int64) ([]*model.User, er
 	return converter.FromRepoToServiceList(repoUsers), nil
 }
 
+// Count returns the number of users matching the filter, pagination and sorting are ignored.
+func (r *repo) Count(ctx context.Context, f filter.UserListFilter) (int64, error) {
+	builder := applyListFilter(sq.Select("COUNT(*)").From(tableUsers), f).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.Count",
+		QueryRaw: query,
+	}
+
+	var count int64
+	err = r.db.DB().ScanOneContext(ctx, &count, q, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// applyListFilter adds the conditions of the filter shared by List and Count to the builder.
+func applyListFilter(builder sq.SelectBuilder, f filter.UserListFilter) sq.SelectBuilder {
+	if f.PrincipalType != "" {
+		builder = builder.Where(sq.Eq{columnPrincipalType: f.PrincipalType})
+	}
+	if f.Username != "" {
+		builder = builder.Where(sq.ILike{columnUsername: likePattern(f.Username, f.Substring)})
+	}
+	if f.Email != "" {
+		builder = builder.Where(sq.ILike{columnEmail: likePattern(f.Email, f.Substring)})
+	}
+	if f.Role != "" {
+		builder = builder.Where(sq.Eq{columnRole: f.Role})
+	}
+	if !f.CreatedFrom.IsZero() {
+		builder = builder.Where(sq.GtOrEq{columnCreatedAt: f.CreatedFrom})
+	}
+	if !f.CreatedTo.IsZero() {
+		builder = builder.Where(sq.Lt{columnCreatedAt: f.CreatedTo})
+	}
+
+	return builder
+}
+
+// afterCursor selects users following the cursor in the sort order, the row comparison of the sort column and ID
+// matches the order of the list and is served by the same indexes.
+func afterCursor(sortColumn string, descending bool, after *filter.UserCursor) sq.Sqlizer {
+	op := ">"
+	if descending {
+		op = "<"
+	}
+
+	var key any
+	switch sortColumn {
+	case columnUsername:
+		key = after.Username
+	case columnEmail:
+		key = after.Email
+	case columnCreatedAt:
+		key = after.CreatedAt
+	default:
+		return sq.Expr(fmt.Sprintf("%s %s ?", columnID, op), after.ID)
+	}
+
+	return sq.Expr(fmt.Sprintf("(%s, %s) %s (?, ?)", sortColumn, columnID, op), key, after.ID)
+}
+
+// likePattern escapes LIKE wildcards in value and builds a prefix or substring pattern from it.
+func likePattern(value string, substring bool) string {
+	pattern := likeEscaper.Replace(value) + "%"
+	if substring {
+		pattern = "%" + pattern
+	}
+
+	return pattern
+}
+
 // CheckUsersExist checks if all users with the given IDs exist in the database.
 // It returns an error if any of the provided IDs do not exist.
 func (r *repo) CheckUsersExist(ctx context.Context, ids []int64) error {
@@ -290,3 +439,12 @@ func (r *repo) CheckUsersExist(ctx context.Context, ids []int64) error {
 
 	return nil
 }
+
+// unknownRoleErr converts a violation of the users role foreign key into ErrInvalidArgument.
+func unknownRoleErr(err error, role string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == constraintUsersRole {
+		return customerrors.NewErrInvalidArgument(fmt.Sprintf("unknown role %q", role))
+	}
+	return err
+}
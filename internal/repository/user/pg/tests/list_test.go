@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+)
+
+// client is a database client remembering the last query run by the repository, queries return no rows.
+type client struct {
+	recorder recorder
+}
+
+func (c *client) DB() db.DB {
+	return &c.recorder
+}
+
+func (c *client) Close() error {
+	return nil
+}
+
+type recorder struct {
+	db.DB
+
+	query string
+	args  []interface{}
+}
+
+func (r *recorder) ScanAllContext(_ context.Context, _ interface{}, q db.Query, args ...interface{}) error {
+	r.query, r.args = q.QueryRaw, args
+	return nil
+}
+
+func TestListKeysetQuery(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt = time.Date(2024, 11, 22, 10, 0, 0, 0, time.UTC)
+		cursor    = &filter.UserCursor{ID: 42, Username: "kate", Email: "kate@example.com", CreatedAt: createdAt}
+	)
+
+	tests := []struct {
+		name   string
+		filter filter.UserListFilter
+		query  string
+		args   []interface{}
+	}{
+		{
+			name:   "first page",
+			filter: filter.UserListFilter{Limit: 7},
+			query:  "SELECT * FROM users ORDER BY id ASC LIMIT 7 OFFSET 0",
+		},
+		{
+			name:   "id ascending",
+			filter: filter.UserListFilter{Limit: 7, SortBy: filter.SortByID, After: cursor},
+			query:  "SELECT * FROM users WHERE id > $1 ORDER BY id ASC LIMIT 7 OFFSET 0",
+			args:   []interface{}{int64(42)},
+		},
+		{
+			name:   "id descending",
+			filter: filter.UserListFilter{Limit: 7, SortBy: filter.SortByID, Descending: true, After: cursor},
+			query:  "SELECT * FROM users WHERE id < $1 ORDER BY id DESC LIMIT 7 OFFSET 0",
+			args:   []interface{}{int64(42)},
+		},
+		{
+			name:   "username ascending",
+			filter: filter.UserListFilter{Limit: 7, SortBy: filter.SortByUsername, After: cursor},
+			query:  "SELECT * FROM users WHERE (username, id) > ($1, $2) ORDER BY username ASC, id ASC LIMIT 7 OFFSET 0",
+			args:   []interface{}{"kate", int64(42)},
+		},
+		{
+			name:   "username descending",
+			filter: filter.UserListFilter{Limit: 7, SortBy: filter.SortByUsername, Descending: true, After: cursor},
+			query:  "SELECT * FROM users WHERE (username, id) < ($1, $2) ORDER BY username DESC, id DESC LIMIT 7 OFFSET 0",
+			args:   []interface{}{"kate", int64(42)},
+		},
+		{
+			name:   "email ascending",
+			filter: filter.UserListFilter{Limit: 7, SortBy: filter.SortByEmail, After: cursor},
+			query:  "SELECT * FROM users WHERE (email, id) > ($1, $2) ORDER BY email ASC, id ASC LIMIT 7 OFFSET 0",
+			args:   []interface{}{"kate@example.com", int64(42)},
+		},
+		{
+			name:   "email descending",
+			filter: filter.UserListFilter{Limit: 7, SortBy: filter.SortByEmail, Descending: true, After: cursor},
+			query:  "SELECT * FROM users WHERE (email, id) < ($1, $2) ORDER BY email DESC, id DESC LIMIT 7 OFFSET 0",
+			args:   []interface{}{"kate@example.com", int64(42)},
+		},
+		{
+			name:   "created at ascending",
+			filter: filter.UserListFilter{Limit: 7, SortBy: filter.SortByCreatedAt, After: cursor},
+			query:  "SELECT * FROM users WHERE (created_at, id) > ($1, $2) ORDER BY created_at ASC, id ASC LIMIT 7 OFFSET 0",
+			args:   []interface{}{createdAt, int64(42)},
+		},
+		{
+			name:   "created at descending",
+			filter: filter.UserListFilter{Limit: 7, SortBy: filter.SortByCreatedAt, Descending: true, After: cursor},
+			query:  "SELECT * FROM users WHERE (created_at, id) < ($1, $2) ORDER BY created_at DESC, id DESC LIMIT 7 OFFSET 0",
+			args:   []interface{}{createdAt, int64(42)},
+		},
+		{
+			name: "cursor after filters",
+			filter: filter.UserListFilter{
+				Limit:       7,
+				Role:        "ADMIN",
+				CreatedFrom: createdAt.Add(-time.Hour),
+				SortBy:      filter.SortByCreatedAt,
+				Descending:  true,
+				After:       cursor,
+			},
+			query: "SELECT * FROM users WHERE role = $1 AND created_at >= $2 AND (created_at, id) < ($3, $4) " +
+				"ORDER BY created_at DESC, id DESC LIMIT 7 OFFSET 0",
+			args: []interface{}{"ADMIN", createdAt.Add(-time.Hour), createdAt, int64(42)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &client{}
+			_, err := pg.NewRepository(c).List(context.Background(), tt.filter)
+			require.NoError(t, err)
+			require.Equal(t, tt.query, c.recorder.query)
+			require.Equal(t, tt.args, c.recorder.args)
+		})
+	}
+}
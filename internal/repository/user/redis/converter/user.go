@@ -18,13 +18,15 @@ const (
 // FromRepoToService converter from Redis repository User model to service User model.
 func FromRepoToService(user *modelRepo.User) *model.User {
 	return &model.User{
-		ID:        user.ID,
-		Username:  user.Username,
-		Email:     user.Email,
-		Role:      user.Role,
-		Password:  user.Password,
-		CreatedAt: time.Unix(0, user.CreatedAtNs),
-		UpdatedAt: time.Unix(0, user.UpdatedAtNs),
+		ID:            user.ID,
+		Username:      user.Username,
+		Email:         user.Email,
+		Role:          user.Role,
+		Password:      user.Password,
+		PrincipalType: user.PrincipalType,
+		OwnerID:       user.OwnerID,
+		CreatedAt:     time.Unix(0, user.CreatedAtNs),
+		UpdatedAt:     time.Unix(0, user.UpdatedAtNs),
 	}
 }
 
@@ -32,13 +34,15 @@ func FromRepoToService(user *modelRepo.User) *model.User {
 func FromServiceToRepo(user *model.User) *modelRepo.User {
 	now := time.Now().UnixNano()
 	repoUser := &modelRepo.User{
-		ID:          user.ID,
-		Username:    user.Username,
-		Email:       user.Email,
-		Role:        user.Role,
-		Password:    user.Password,
-		CreatedAtNs: now,
-		UpdatedAtNs: now,
+		ID:            user.ID,
+		Username:      user.Username,
+		Email:         user.Email,
+		Role:          user.Role,
+		Password:      user.Password,
+		PrincipalType: user.PrincipalType,
+		OwnerID:       user.OwnerID,
+		CreatedAtNs:   now,
+		UpdatedAtNs:   now,
 	}
 
 	return repoUser
@@ -2,11 +2,13 @@ package model
 
 // User represents a user entity in the Redis database.
 type User struct {
-	ID          int64  `redis:"id"`
-	Username    string `redis:"username"`
-	Email       string `redis:"email"`
-	Role        string `redis:"role"`
-	Password    string `redis:"password"`
-	CreatedAtNs int64  `redis:"created_at"`
-	UpdatedAtNs int64  `redis:"updated_at"`
+	ID            int64  `redis:"id"`
+	Username      string `redis:"username"`
+	Email         string `redis:"email"`
+	Role          string `redis:"role"`
+	Password      string `redis:"password"`
+	PrincipalType string `redis:"principal_type"`
+	OwnerID       int64  `redis:"owner_id"`
+	CreatedAtNs   int64  `redis:"created_at"`
+	UpdatedAtNs   int64  `redis:"updated_at"`
 }
@@ -99,13 +99,13 @@ func (r *repo) Update(ctx context.Context, updates *model.User) error {
 }
 
 // List not implemented.
-func (r *repo) List(_ context.Context, _, _ int64) ([]*model.User, error) {
+func (r *repo) List(_ context.Context, _ filter.UserListFilter) ([]*model.User, error) {
 	return nil, fmt.Errorf("method not implemented")
 }
 
-// GetEndpointRoles not implemented.
-func (r *repo) GetEndpointRoles(_ context.Context, _ string) ([]string, error) {
-	return nil, fmt.Errorf("method not implemented")
+// Count not implemented.
+func (r *repo) Count(_ context.Context, _ filter.UserListFilter) (int64, error) {
+	return 0, fmt.Errorf("method not implemented")
 }
 
 // CheckUsersExist not implemented.
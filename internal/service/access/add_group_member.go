@@ -0,0 +1,28 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// AddGroupMember adds the user to the group, only admins are allowed to manage groups.
+func (a accessService) AddGroupMember(ctx context.Context, groupID, userID int64) error {
+	_, err := a.Check(ctx, pb.AccessV1_AddGroupMember_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.groupRepo.Get(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.userRepo.Get(ctx, filter.UserFilter{ID: &userID})
+	if err != nil {
+		return err
+	}
+
+	return a.groupRepo.AddMember(ctx, groupID, userID)
+}
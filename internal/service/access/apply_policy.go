@@ -0,0 +1,279 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	permissionFilter "github.com/mikhailsoldatkin/auth/internal/repository/permission/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+	pbUser "github.com/mikhailsoldatkin/auth/pkg/user_v1"
+)
+
+// ApplyPolicy brings roles and permissions to the state declared by a policy document in one transaction
+// and returns the planned changes, with dryRun the changes are only planned.
+func (a accessService) ApplyPolicy(ctx context.Context, declared *model.Policy, dryRun bool) (*model.PolicyPlan, error) {
+	_, err := a.Check(ctx, pb.AccessV1_ApplyPolicy_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.validatePolicy(ctx, declared)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &model.PolicyPlan{}
+	err = a.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		roles, errTx := a.roleRepo.List(ctx)
+		if errTx != nil {
+			return errTx
+		}
+
+		plan.Roles, errTx = planRoles(roles, declared.Roles)
+		if errTx != nil {
+			return errTx
+		}
+
+		current, errTx := a.permissionRepo.List(ctx, permissionFilter.PermissionFilter{})
+		if errTx != nil {
+			return errTx
+		}
+
+		plan.Permissions, errTx = planPolicy(current, declared.Permissions)
+		if errTx != nil || dryRun {
+			return errTx
+		}
+
+		errTx = a.applyRoleChanges(ctx, plan.Roles)
+		if errTx != nil {
+			return errTx
+		}
+
+		return a.applyPolicyChanges(ctx, plan.Permissions)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !dryRun && !plan.IsEmpty() {
+		a.invalidatePermissions(ctx)
+	}
+
+	return plan, nil
+}
+
+// validatePolicy validates the declared permissions, their roles must be declared by the policy or exist.
+func (a accessService) validatePolicy(ctx context.Context, declared *model.Policy) error {
+	roles := make(map[string]struct{}, len(declared.Roles))
+	for _, role := range declared.Roles {
+		roles[role.Name] = struct{}{}
+	}
+
+	for _, permission := range declared.Permissions {
+		if permission.Effect == "" {
+			permission.Effect = model.EffectAllow
+		}
+
+		err := validatePermission(permission)
+		if err != nil {
+			return err
+		}
+
+		if permission.HasCondition() {
+			issues := a.policies.Validate(permission.Condition)
+			if len(issues) > 0 {
+				return customerrors.NewErrInvalidArgument(fmt.Sprintf(
+					"invalid condition of %s for role %s: %s",
+					permission.Endpoint, permission.Role, strings.Join(issues, "; "),
+				))
+			}
+		}
+
+		if _, ok := roles[permission.Role]; ok {
+			continue
+		}
+		roles[permission.Role] = struct{}{}
+
+		_, err = a.existingRole(ctx, permission.Role)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// planRoles compares the stored roles with the declared ones and returns creations ordered so that parents
+// are created before their children, followed by updates ordered by name.
+// The hierarchy resulting from the change is checked for unknown parents and inheritance cycles.
+func planRoles(current, declared []*model.Role) ([]*model.RoleChange, error) {
+	stored := make(map[string]*model.Role, len(current))
+	parents := make(map[string]string, len(current)+len(declared))
+	for _, role := range current {
+		stored[role.Name] = role
+		parents[role.Name] = role.Parent
+	}
+	for _, role := range declared {
+		parents[role.Name] = role.Parent
+	}
+
+	depths := make(map[string]int, len(declared))
+	var creates, updates []*model.RoleChange
+	for _, role := range declared {
+		depth, err := roleDepth(parents, role.Name)
+		if err != nil {
+			return nil, err
+		}
+		depths[role.Name] = depth
+
+		existing, ok := stored[role.Name]
+		switch {
+		case !ok:
+			creates = append(creates, &model.RoleChange{Action: model.PolicyCreate, Role: role})
+		case existing.Description != role.Description || existing.Parent != role.Parent:
+			updates = append(updates, &model.RoleChange{Action: model.PolicyUpdate, Role: role, Previous: existing})
+		}
+	}
+
+	sort.Slice(creates, func(i, j int) bool {
+		a, b := creates[i].Role, creates[j].Role
+		if depths[a.Name] != depths[b.Name] {
+			return depths[a.Name] < depths[b.Name]
+		}
+		return a.Name < b.Name
+	})
+	sort.Slice(updates, func(i, j int) bool {
+		return updates[i].Role.Name < updates[j].Role.Name
+	})
+
+	return append(creates, updates...), nil
+}
+
+// roleDepth returns the number of ancestors of the role in the hierarchy given by parents of all known roles,
+// failing on unknown parents and on chains reaching the role itself or longer than maxRoleDepth.
+func roleDepth(parents map[string]string, name string) (int, error) {
+	depth := 0
+	for parent := parents[name]; parent != ""; parent = parents[parent] {
+		if parent == name || depth == maxRoleDepth {
+			return 0, customerrors.NewErrInvalidArgument(fmt.Sprintf("role inheritance cycle of %s", name))
+		}
+		if _, ok := parents[parent]; !ok {
+			return 0, customerrors.NewErrInvalidArgument(fmt.Sprintf("unknown parent role %q of %s", parent, name))
+		}
+		depth++
+	}
+
+	return depth, nil
+}
+
+func (a accessService) applyRoleChanges(ctx context.Context, changes []*model.RoleChange) error {
+	for _, change := range changes {
+		var err error
+		switch change.Action {
+		case model.PolicyCreate:
+			err = a.roleRepo.Create(ctx, change.Role)
+		case model.PolicyUpdate:
+			err = a.roleRepo.Update(ctx, &model.RoleUpdate{
+				Name:        change.Role.Name,
+				Description: &change.Role.Description,
+				Parent:      &change.Role.Parent,
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("failed to %s role %s: %w", change.Action, change.Role.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// planPolicy compares the stored permissions with the declared ones and returns deletions, updates and creations
+// in this order, each ordered by endpoint and role.
+func planPolicy(current, declared []*model.Permission) ([]*model.PolicyChange, error) {
+	stored := make(map[string]*model.Permission, len(current))
+	for _, permission := range current {
+		stored[policyKey(permission)] = permission
+	}
+
+	declaredKeys := make(map[string]struct{}, len(declared))
+	var deletes, updates, creates []*model.PolicyChange
+	for _, permission := range declared {
+		key := policyKey(permission)
+		declaredKeys[key] = struct{}{}
+
+		existing, ok := stored[key]
+		switch {
+		case !ok:
+			creates = append(creates, &model.PolicyChange{Action: model.PolicyCreate, Permission: permission})
+		case !samePermission(existing, permission):
+			if isManagedByAdmins(existing) {
+				return nil, customerrors.NewErrInvalidArgument(fmt.Sprintf(
+					"admin permission for %s can't be changed", existing.Endpoint,
+				))
+			}
+			updated := *permission
+			updated.ID = existing.ID
+			updates = append(updates, &model.PolicyChange{Action: model.PolicyUpdate, Permission: &updated, Previous: existing})
+		}
+	}
+
+	for _, permission := range current {
+		if _, ok := declaredKeys[policyKey(permission)]; ok {
+			continue
+		}
+		if isManagedByAdmins(permission) {
+			return nil, customerrors.NewErrInvalidArgument(fmt.Sprintf(
+				"admin permission for %s can't be deleted", permission.Endpoint,
+			))
+		}
+		deletes = append(deletes, &model.PolicyChange{Action: model.PolicyDelete, Permission: permission})
+	}
+
+	changes := make([]*model.PolicyChange, 0, len(deletes)+len(updates)+len(creates))
+	for _, group := range [][]*model.PolicyChange{deletes, updates, creates} {
+		sort.Slice(group, func(i, j int) bool {
+			return policyKey(group[i].Permission) < policyKey(group[j].Permission)
+		})
+		changes = append(changes, group...)
+	}
+
+	return changes, nil
+}
+
+func (a accessService) applyPolicyChanges(ctx context.Context, changes []*model.PolicyChange) error {
+	for _, change := range changes {
+		var err error
+		switch change.Action {
+		case model.PolicyDelete:
+			err = a.permissionRepo.Delete(ctx, change.Permission.ID)
+		case model.PolicyUpdate:
+			err = a.permissionRepo.Update(ctx, change.Permission)
+		case model.PolicyCreate:
+			change.Permission.ID, err = a.permissionRepo.Create(ctx, change.Permission)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to %s permission of %s for role %s: %w",
+				change.Action, change.Permission.Endpoint, change.Permission.Role, err)
+		}
+	}
+
+	return nil
+}
+
+func policyKey(permission *model.Permission) string {
+	return permission.Endpoint + " " + permission.Role
+}
+
+func samePermission(a, b *model.Permission) bool {
+	return a.Effect == b.Effect && a.MaxAuthAgeMin == b.MaxAuthAgeMin && a.Condition == b.Condition
+}
+
+// isManagedByAdmins reports whether the permission lets admins manage permissions, see managementEndpoints.
+func isManagedByAdmins(permission *model.Permission) bool {
+	_, ok := managementEndpoints[permission.Endpoint]
+	return ok && permission.Role == pbUser.Role_ADMIN.String()
+}
@@ -0,0 +1,56 @@
+package access
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ApproveElevation approves the pending elevation request and grants the requested role to the requester
+// from now for the requested duration. Requesters can't approve their own requests.
+func (a accessService) ApproveElevation(ctx context.Context, id int64) (*model.RoleGrant, error) {
+	grant, err := a.Check(ctx, pb.AccessV1_ApproveElevation_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	approverID := grant.Claims.UserID
+	if approverID == 0 {
+		return nil, customerrors.NewErrInvalidArgument("user ID required")
+	}
+
+	var roleGrant *model.RoleGrant
+	err = a.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		request, errTx := a.elevationRepo.Get(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		if request.UserID == approverID {
+			return customerrors.NewErrForbiddenReason("elevation requests can't be approved by the requester")
+		}
+
+		now := time.Now()
+		errTx = a.elevationRepo.Approve(ctx, id, approverID, now)
+		if errTx != nil {
+			return errTx
+		}
+
+		roleGrant = &model.RoleGrant{
+			UserID:     request.UserID,
+			Role:       request.Role,
+			ValidFrom:  now,
+			ValidUntil: now.Add(request.Duration),
+		}
+
+		return a.roleRepo.AssignToUser(ctx, roleGrant)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return roleGrant, nil
+}
@@ -0,0 +1,27 @@
+package access
+
+import (
+	"context"
+
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// AssignGroupRole assigns the role to all members of the group, only admins are allowed to manage groups.
+func (a accessService) AssignGroupRole(ctx context.Context, groupID int64, role string) error {
+	_, err := a.Check(ctx, pb.AccessV1_AssignGroupRole_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.groupRepo.Get(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.existingRole(ctx, role)
+	if err != nil {
+		return err
+	}
+
+	return a.groupRepo.AssignRole(ctx, groupID, role)
+}
@@ -0,0 +1,36 @@
+package access
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// AssignUserRole assigns the role to the user in addition to the user's primary role, permanently
+// or for the validity period of the grant. Only admins are allowed to manage role assignments.
+func (a accessService) AssignUserRole(ctx context.Context, grant *model.RoleGrant) error {
+	_, err := a.Check(ctx, pb.AccessV1_AssignUserRole_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	if grant.IsTemporary() && (!grant.ValidUntil.After(grant.ValidFrom) || !grant.ValidUntil.After(time.Now())) {
+		return customerrors.NewErrInvalidArgument("valid_until must be in the future and after valid_from")
+	}
+
+	_, err = a.userRepo.Get(ctx, filter.UserFilter{ID: &grant.UserID})
+	if err != nil {
+		return err
+	}
+
+	_, err = a.existingRole(ctx, grant.Role)
+	if err != nil {
+		return err
+	}
+
+	return a.roleRepo.AssignToUser(ctx, grant)
+}
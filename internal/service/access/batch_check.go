@@ -0,0 +1,51 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// BatchCheck authenticates the caller once and decides access to each of the requested endpoints and resources
+// the same way CheckResource does, each decision is passed to the decision recorder.
+// Denied requests don't fail the call, it fails only when the caller can't be authenticated or permissions can't be loaded.
+func (a accessService) BatchCheck(ctx context.Context, requests []*model.AccessRequest) ([]*model.AccessDecision, error) {
+	start := time.Now()
+
+	claims, err := a.principalClaims(ctx)
+	if err != nil {
+		for _, request := range requests {
+			a.recordDecision(ctx, start, request.Endpoint, nil, nil, err)
+		}
+		return nil, err
+	}
+
+	decisions := make([]*model.AccessDecision, len(requests))
+	for i, request := range requests {
+		decision := &model.AccessDecision{Endpoint: request.Endpoint, Resource: request.Resource}
+
+		start = time.Now()
+		permission, err := a.authorize(ctx, claims, request.Endpoint, request.Resource)
+		a.recordDecision(ctx, start, request.Endpoint, claims, permission, err)
+		var forbidden *customerrors.ErrForbidden
+		var stepUp *customerrors.ErrStepUpRequired
+		switch {
+		case err == nil:
+			decision.Allowed = true
+		case errors.As(err, &stepUp):
+			decision.MaxAuthAgeMin = stepUp.MaxAgeMin
+			decision.Reason = stepUp.Error()
+		case errors.As(err, &forbidden):
+			decision.Reason = forbidden.Reason
+		default:
+			return nil, err
+		}
+
+		decisions[i] = decision
+	}
+
+	return decisions, nil
+}
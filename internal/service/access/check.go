@@ -4,50 +4,154 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
 
 	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
 	"github.com/mikhailsoldatkin/auth/internal/utils"
-	"google.golang.org/grpc/metadata"
 )
 
-const headerAuth = "authorization"
-const prefixAuth = "Bearer "
-
 // Check verifies whether the user has the necessary permissions to access a specific endpoint.
-func (a accessService) Check(ctx context.Context, endpoint string) error {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return fmt.Errorf("metadata is not provided")
+func (a accessService) Check(ctx context.Context, endpoint string) (*model.AccessGrant, error) {
+	return a.CheckResource(ctx, endpoint, "")
+}
+
+// CheckResource verifies access to the endpoint the same way Check does for a request narrowed to the resource,
+// conditions see the resource as request.resource.
+func (a accessService) CheckResource(ctx context.Context, endpoint, resource string) (*model.AccessGrant, error) {
+	start := time.Now()
+
+	claims, err := a.principalClaims(ctx)
+	if err != nil {
+		a.recordDecision(ctx, start, endpoint, nil, nil, err)
+		return nil, err
+	}
+
+	permission, err := a.authorize(ctx, claims, endpoint, resource)
+	a.recordDecision(ctx, start, endpoint, claims, permission, err)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.IsImpersonated() {
+		log.Printf("access to endpoint %s granted to %s acting as %s", endpoint, claims.Act.Username, claims.Username)
+	} else {
+		log.Printf("access to endpoint %s granted", endpoint)
+	}
+	return &model.AccessGrant{Claims: claims, Permission: permission}, nil
+}
+
+// authorize decides whether the authenticated principal is allowed to access the endpoint,
+// optionally narrowed to the resource, and returns the permission granting access. Denials by a deny permission or by a permission
+// requiring fresh authentication return the matched permission together with the error.
+func (a accessService) authorize(
+	ctx context.Context,
+	claims *model.UserClaims,
+	endpoint string,
+	resource string,
+) (*model.Permission, error) {
+	permissions, err := a.permissionCache.GetEndpointPermissions(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permissions for endpoint: %w", err)
+	}
+
+	result := evaluate(claims, endpoint, permissions, a.conditions(ctx, claims, endpoint, resource), time.Now())
+
+	return result.Matched, result.Err
+}
+
+// principalClaims authenticates the caller by the bearer token or, when no token is present,
+// by the verified client certificate of the mTLS connection. Missing or malformed credentials fail with ErrInvalidToken.
+func (a accessService) principalClaims(ctx context.Context) (*model.UserClaims, error) {
+	if !utils.HasAuthorizationHeader(ctx) && len(a.principals) > 0 {
+		return a.certificateClaims(ctx)
+	}
+
+	accessToken, err := utils.ExtractBearerToken(ctx)
+	if err != nil {
+		return nil, customerrors.NewErrInvalidToken()
 	}
 
-	authHeader, ok := md[headerAuth]
-	if !ok || len(authHeader) == 0 {
-		return fmt.Errorf("authorization header is not provided")
+	if utils.IsAPIToken(accessToken) {
+		return a.apiTokenClaims(ctx, accessToken)
 	}
 
-	if !strings.HasPrefix(authHeader[0], prefixAuth) {
-		return fmt.Errorf("invalid authorization header format")
+	return a.jwtClaims(accessToken)
+}
+
+// certificateClaims maps the identity of the verified client certificate to a service account
+// and returns claims built from its current state.
+func (a accessService) certificateClaims(ctx context.Context) (*model.UserClaims, error) {
+	for _, identity := range utils.PeerCertificateIdentities(ctx) {
+		username, ok := a.principals[identity]
+		if !ok {
+			continue
+		}
+
+		user, err := a.userRepo.Get(ctx, filter.UserFilter{Username: &username})
+		if err != nil {
+			return nil, err
+		}
+
+		if !user.IsService() {
+			return nil, customerrors.NewErrForbidden()
+		}
+
+		return &model.UserClaims{UserID: user.ID, Username: user.Username, Role: user.Role, Roles: user.EffectiveRoles()}, nil
 	}
 
-	accessToken := strings.TrimPrefix(authHeader[0], prefixAuth)
+	return nil, customerrors.NewErrInvalidToken()
+}
 
+// jwtClaims verifies the JWT access token and returns its claims without the temporary roles expired since it was issued.
+func (a accessService) jwtClaims(accessToken string) (*model.UserClaims, error) {
 	claims, err := utils.VerifyToken(accessToken, []byte(a.config.TokenSecretKey))
 	if err != nil {
-		return customerrors.NewErrInvalidToken()
+		return nil, customerrors.NewErrInvalidToken()
 	}
 
-	roles, err := a.userRepo.GetEndpointRoles(ctx, endpoint)
+	claims.ExpireRoles(time.Now())
+
+	return claims, nil
+}
+
+// apiTokenClaims verifies the personal access token, records its usage and returns claims
+// built from the current state of the token owner restricted to the token scopes.
+func (a accessService) apiTokenClaims(ctx context.Context, token string) (*model.UserClaims, error) {
+	prefix, ok := utils.ParseAPITokenPrefix(token)
+	if !ok {
+		return nil, customerrors.NewErrInvalidToken()
+	}
+
+	apiToken, err := a.apiTokenRepo.GetByPrefix(ctx, prefix)
 	if err != nil {
-		return fmt.Errorf("failed to get roles for endpoint: %w", err)
+		return nil, err
 	}
 
-	for _, role := range roles {
-		if role == claims.Role {
-			log.Printf("access to endpoint %s granted", endpoint)
-			return nil
-		}
+	now := time.Now()
+	if !utils.VerifyAPIToken(apiToken.Hash, token) || !apiToken.IsActive(now) {
+		return nil, customerrors.NewErrInvalidToken()
+	}
+
+	err = a.apiTokenRepo.UpdateLastUsed(ctx, apiToken.ID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update API token last usage: %w", err)
+	}
+
+	user, err := a.userRepo.Get(ctx, filter.UserFilter{ID: &apiToken.UserID})
+	if err != nil {
+		return nil, err
 	}
 
-	return customerrors.NewErrForbidden()
+	return &model.UserClaims{
+		StandardClaims: jwt.StandardClaims{ExpiresAt: apiToken.ExpiresAt.Unix()},
+		UserID:         user.ID,
+		Username:       user.Username,
+		Role:           user.Role,
+		Roles:          user.EffectiveRoles(),
+		Scopes:         apiToken.Scopes,
+	}, nil
 }
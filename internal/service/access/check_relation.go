@@ -0,0 +1,96 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository/relation/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// maxRelationDepth limits the nesting of subject sets followed when checking a relation.
+const maxRelationDepth = 8
+
+// checkRelationAnyUserEndpoint is the permission endpoint authorizing checks of relations of users other
+// than the caller in addition to the CheckRelation endpoint, e.g. for services guarding their resources.
+const checkRelationAnyUserEndpoint = pb.AccessV1_CheckRelation_FullMethodName + "/AnyUser"
+
+// impliedBy maps a relation to the stronger relation implying it, an owner is an editor and an editor is a viewer.
+var impliedBy = map[string]string{
+	model.RelationViewer: model.RelationEditor,
+	model.RelationEditor: model.RelationOwner,
+}
+
+// CheckRelation reports whether the user has the relation to the object, the caller is checked when userID is 0.
+// Checking relations of another user requires access to checkRelationAnyUserEndpoint as well.
+func (a accessService) CheckRelation(ctx context.Context, object, relation string, userID int64) (bool, error) {
+	grant, err := a.Check(ctx, pb.AccessV1_CheckRelation_FullMethodName)
+	if err != nil {
+		return false, err
+	}
+
+	if userID == 0 {
+		userID = grant.Claims.UserID
+	}
+	if userID == 0 {
+		return false, customerrors.NewErrInvalidArgument("user ID required")
+	}
+
+	if userID != grant.Claims.UserID {
+		_, err = a.Check(ctx, checkRelationAnyUserEndpoint)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return a.hasRelation(ctx, object, relation, userID, 0)
+}
+
+func (a accessService) hasRelation(ctx context.Context, object, relation string, userID int64, depth int) (bool, error) {
+	if depth > maxRelationDepth {
+		return false, nil
+	}
+
+	if groupID, ok := model.GroupID(object); ok && relation == model.RelationMember {
+		member, err := a.groupRepo.HasMember(ctx, groupID, userID)
+		if err != nil || member {
+			return member, err
+		}
+	}
+
+	tuples, err := a.relationRepo.List(ctx, filter.RelationFilter{Object: object, Relations: impliedRelations(relation)})
+	if err != nil {
+		return false, err
+	}
+
+	user := model.UserObject(userID)
+	var subjectSets []*model.RelationTuple
+	for _, tuple := range tuples {
+		if tuple.Subject == user {
+			return true, nil
+		}
+		if _, _, ok := tuple.SubjectSet(); ok {
+			subjectSets = append(subjectSets, tuple)
+		}
+	}
+
+	for _, tuple := range subjectSets {
+		setObject, setRelation, _ := tuple.SubjectSet()
+		ok, err := a.hasRelation(ctx, setObject, setRelation, userID, depth+1)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+
+	return false, nil
+}
+
+// impliedRelations returns the relation followed by all relations implying it.
+func impliedRelations(relation string) []string {
+	relations := []string{relation}
+	for implied, ok := impliedBy[relation]; ok; implied, ok = impliedBy[implied] {
+		relations = append(relations, implied)
+	}
+	return relations
+}
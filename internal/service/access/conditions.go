@@ -0,0 +1,132 @@
+package access
+
+import (
+	"context"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/mikhailsoldatkin/auth/internal/policy"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// conditions returns the conditionFunc evaluating conditions for the principal's request to the endpoint
+// narrowed to the resource (empty when the request isn't narrowed), the variables are built when the first condition is evaluated.
+func (a accessService) conditions(
+	ctx context.Context,
+	claims *model.UserClaims,
+	endpoint string,
+	resource string,
+) conditionFunc {
+	var vars *policy.Vars
+	return func(permission *model.Permission) (bool, error) {
+		if vars == nil {
+			vars = a.policyVars(ctx, claims, endpoint, resource)
+		}
+		return a.policies.Evaluate(permission.Condition, vars)
+	}
+}
+
+// policyVars builds the variables conditions are evaluated against,
+// the user record is loaded only if a condition refers to it.
+func (a accessService) policyVars(
+	ctx context.Context,
+	claims *model.UserClaims,
+	endpoint string,
+	resource string,
+) *policy.Vars {
+	var user map[string]any
+	return &policy.Vars{
+		Principal: principalVars(claims),
+		User: func() map[string]any {
+			if user == nil {
+				user = a.userVars(ctx, claims)
+			}
+			return user
+		},
+		Request: requestVars(ctx, endpoint, resource),
+		Now:     time.Now(),
+	}
+}
+
+func principalVars(claims *model.UserClaims) map[string]any {
+	var actor string
+	if claims.IsImpersonated() {
+		actor = claims.Act.Username
+	}
+
+	var authTime time.Time
+	if claims.AuthTime != 0 {
+		authTime = time.Unix(claims.AuthTime, 0)
+	}
+
+	return map[string]any{
+		"user_id":   claims.UserID,
+		"username":  claims.Username,
+		"role":      claims.Role,
+		"roles":     claims.EffectiveRoles(),
+		"scopes":    claims.Scopes,
+		"actor":     actor,
+		"auth_time": authTime,
+		"amr":       claims.AMR,
+	}
+}
+
+// userVars loads the user record of the principal, an empty record is returned on failures
+// so that conditions referring to it fail to evaluate.
+func (a accessService) userVars(ctx context.Context, claims *model.UserClaims) map[string]any {
+	userFilter := filter.UserFilter{ID: &claims.UserID}
+	if claims.UserID == 0 {
+		userFilter = filter.UserFilter{Username: &claims.Username}
+	}
+
+	user, err := a.userRepo.Get(ctx, userFilter)
+	if err != nil {
+		log.Printf("failed to load user %s for conditions: %v", claims.Username, err)
+		return map[string]any{}
+	}
+
+	return map[string]any{
+		"id":             user.ID,
+		"username":       user.Username,
+		"email":          user.Email,
+		"role":           user.Role,
+		"roles":          user.EffectiveRoles(),
+		"principal_type": user.PrincipalType,
+		"auth_provider":  user.AuthProvider,
+		"created_at":     user.CreatedAt,
+	}
+}
+
+// requestVars describes the request: the endpoint, the resource, the incoming metadata except credentials
+// with multiple values joined by commas and the IP address of the gRPC peer.
+func requestVars(ctx context.Context, endpoint, resource string) map[string]any {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := make(map[string]string, len(md))
+	for key, value := range md {
+		if key == "authorization" {
+			continue
+		}
+		values[key] = strings.Join(value, ",")
+	}
+
+	var ip string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+	}
+
+	return map[string]any{
+		"endpoint": endpoint,
+		"resource": resource,
+		"metadata": values,
+		"ip":       ip,
+	}
+}
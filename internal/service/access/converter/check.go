@@ -0,0 +1,58 @@
+package converter
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// FromServiceToProtobufCheckResponse converts service AccessGrant model to protobuf CheckResponse model.
+func FromServiceToProtobufCheckResponse(grant *model.AccessGrant) *pb.CheckResponse {
+	claims := grant.Claims
+	response := &pb.CheckResponse{
+		UserId:     claims.UserID,
+		Username:   claims.Username,
+		Roles:      claims.EffectiveRoles(),
+		Scopes:     claims.Scopes,
+		Permission: FromServiceToProtobufPermission(grant.Permission),
+	}
+
+	if claims.ExpiresAt != 0 {
+		response.ExpiresAt = timestamppb.New(time.Unix(claims.ExpiresAt, 0))
+	}
+	if claims.IsImpersonated() {
+		response.Actor = claims.Act.Username
+	}
+
+	return response
+}
+
+// FromProtobufToServiceAccessRequests converts protobuf CheckItem models to service AccessRequest models.
+func FromProtobufToServiceAccessRequests(items []*pb.CheckItem) []*model.AccessRequest {
+	requests := make([]*model.AccessRequest, len(items))
+	for i, item := range items {
+		requests[i] = &model.AccessRequest{
+			Endpoint: item.GetEndpoint(),
+			Resource: item.GetResource(),
+		}
+	}
+	return requests
+}
+
+// FromServiceToProtobufCheckResults converts service AccessDecision models to protobuf CheckResult models.
+func FromServiceToProtobufCheckResults(decisions []*model.AccessDecision) []*pb.CheckResult {
+	results := make([]*pb.CheckResult, len(decisions))
+	for i, decision := range decisions {
+		results[i] = &pb.CheckResult{
+			Endpoint:      decision.Endpoint,
+			Resource:      decision.Resource,
+			Allowed:       decision.Allowed,
+			MaxAuthAgeMin: int32(decision.MaxAuthAgeMin),
+			Reason:        decision.Reason,
+		}
+	}
+	return results
+}
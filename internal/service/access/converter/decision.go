@@ -0,0 +1,49 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	decisionFilter "github.com/mikhailsoldatkin/auth/internal/repository/decision/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// FromProtobufToServiceDecisionFilter converts protobuf ListDecisionsRequest to the decision filter,
+// unset time bounds are left as zero values.
+func FromProtobufToServiceDecisionFilter(req *pb.ListDecisionsRequest) decisionFilter.DecisionFilter {
+	filter := decisionFilter.DecisionFilter{
+		UserID:   req.GetUserId(),
+		Endpoint: req.GetEndpoint(),
+		Limit:    uint64(req.GetLimit()),
+	}
+	if req.GetFrom() != nil {
+		filter.From = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		filter.To = req.GetTo().AsTime()
+	}
+	return filter
+}
+
+// FromServiceToProtobufDecisionList converts list of service DecisionEvent models to list of protobuf Decision models.
+func FromServiceToProtobufDecisionList(events []*model.DecisionEvent) []*pb.Decision {
+	protobufDecisions := make([]*pb.Decision, len(events))
+	for i, event := range events {
+		protobufDecisions[i] = &pb.Decision{
+			Id:           event.ID,
+			UserId:       event.UserID,
+			Username:     event.Username,
+			Actor:        event.Actor,
+			Endpoint:     event.Endpoint,
+			Decision:     event.Decision,
+			PermissionId: event.PermissionID,
+			MatchedRule:  event.MatchedRule,
+			Reason:       event.Reason,
+			Latency:      durationpb.New(event.Latency),
+			RequestId:    event.RequestID,
+			CreatedAt:    timestamppb.New(event.CreatedAt),
+		}
+	}
+	return protobufDecisions
+}
@@ -0,0 +1,30 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// FromProtobufToServiceGroup converter from protobuf CreateGroupRequest to service Group model.
+func FromProtobufToServiceGroup(req *pb.CreateGroupRequest) *model.Group {
+	return &model.Group{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	}
+}
+
+// FromServiceToProtobufGroupList converts a list of service Group models to a list of protobuf Group models.
+func FromServiceToProtobufGroupList(groups []*model.Group) []*pb.Group {
+	protobufGroups := make([]*pb.Group, len(groups))
+	for i, group := range groups {
+		protobufGroups[i] = &pb.Group{
+			Id:          group.ID,
+			Name:        group.Name,
+			Description: group.Description,
+			CreatedAt:   timestamppb.New(group.CreatedAt),
+		}
+	}
+	return protobufGroups
+}
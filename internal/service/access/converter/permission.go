@@ -0,0 +1,68 @@
+package converter
+
+import (
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// FromProtobufToServicePermission converter from protobuf CreatePermissionRequest to service Permission model.
+func FromProtobufToServicePermission(req *pb.CreatePermissionRequest) *model.Permission {
+	return &model.Permission{
+		Endpoint:      req.GetEndpoint(),
+		Role:          req.GetRole(),
+		MaxAuthAgeMin: int(req.GetMaxAuthAgeMin()),
+		Effect:        req.GetEffect(),
+		Condition:     req.GetCondition(),
+	}
+}
+
+// FromServiceToProtobufPermission converter from service Permission model to protobuf Permission model.
+func FromServiceToProtobufPermission(permission *model.Permission) *pb.Permission {
+	return &pb.Permission{
+		Id:            permission.ID,
+		Endpoint:      permission.Endpoint,
+		Role:          permission.Role,
+		MaxAuthAgeMin: int32(permission.MaxAuthAgeMin),
+		Effect:        permission.Effect,
+		Condition:     permission.Condition,
+	}
+}
+
+// FromServiceToProtobufPermissionList converts a list of service Permission models to a list of protobuf Permission models.
+func FromServiceToProtobufPermissionList(permissions []*model.Permission) []*pb.Permission {
+	protobufPermissions := make([]*pb.Permission, len(permissions))
+	for i, permission := range permissions {
+		protobufPermissions[i] = FromServiceToProtobufPermission(permission)
+	}
+	return protobufPermissions
+}
+
+// FromServiceToProtobufPolicyChangeList converts a list of service PolicyChange models to a list of protobuf PolicyChange models.
+func FromServiceToProtobufPolicyChangeList(changes []*model.PolicyChange) []*pb.PolicyChange {
+	protobufChanges := make([]*pb.PolicyChange, len(changes))
+	for i, change := range changes {
+		protobufChanges[i] = &pb.PolicyChange{
+			Action:     change.Action,
+			Permission: FromServiceToProtobufPermission(change.Permission),
+		}
+		if change.Previous != nil {
+			protobufChanges[i].Previous = FromServiceToProtobufPermission(change.Previous)
+		}
+	}
+	return protobufChanges
+}
+
+// FromServiceToProtobufRoleChangeList converts a list of service RoleChange models to a list of protobuf RoleChange models.
+func FromServiceToProtobufRoleChangeList(changes []*model.RoleChange) []*pb.RoleChange {
+	protobufChanges := make([]*pb.RoleChange, len(changes))
+	for i, change := range changes {
+		protobufChanges[i] = &pb.RoleChange{
+			Action: change.Action,
+			Role:   FromServiceToProtobufRole(change.Role),
+		}
+		if change.Previous != nil {
+			protobufChanges[i].Previous = FromServiceToProtobufRole(change.Previous)
+		}
+	}
+	return protobufChanges
+}
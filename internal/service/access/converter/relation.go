@@ -0,0 +1,31 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// FromProtobufToServiceRelationTuple converter from protobuf RelationTupleRequest to service RelationTuple model.
+func FromProtobufToServiceRelationTuple(req *pb.RelationTupleRequest) *model.RelationTuple {
+	return &model.RelationTuple{
+		Object:   req.GetObject(),
+		Relation: req.GetRelation(),
+		Subject:  req.GetSubject(),
+	}
+}
+
+// FromServiceToProtobufRelationTupleList converts a list of service RelationTuple models to a list of protobuf RelationTuple models.
+func FromServiceToProtobufRelationTupleList(tuples []*model.RelationTuple) []*pb.RelationTuple {
+	protobufTuples := make([]*pb.RelationTuple, len(tuples))
+	for i, tuple := range tuples {
+		protobufTuples[i] = &pb.RelationTuple{
+			Object:    tuple.Object,
+			Relation:  tuple.Relation,
+			Subject:   tuple.Subject,
+			CreatedAt: timestamppb.New(tuple.CreatedAt),
+		}
+	}
+	return protobufTuples
+}
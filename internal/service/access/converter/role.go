@@ -0,0 +1,52 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// FromProtobufToServiceRole converter from protobuf CreateRoleRequest to service Role model.
+func FromProtobufToServiceRole(req *pb.CreateRoleRequest) *model.Role {
+	return &model.Role{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Parent:      req.GetParent(),
+	}
+}
+
+// FromProtobufToServiceRoleUpdate converter from protobuf UpdateRoleRequest to service RoleUpdate model.
+func FromProtobufToServiceRoleUpdate(req *pb.UpdateRoleRequest) *model.RoleUpdate {
+	updates := &model.RoleUpdate{Name: req.GetName()}
+	if req.Description != nil {
+		description := req.GetDescription().GetValue()
+		updates.Description = &description
+	}
+	if req.Parent != nil {
+		parent := req.GetParent().GetValue()
+		updates.Parent = &parent
+	}
+
+	return updates
+}
+
+// FromServiceToProtobufRole converter from service Role model to protobuf Role model.
+func FromServiceToProtobufRole(role *model.Role) *pb.Role {
+	return &pb.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Parent:      role.Parent,
+		CreatedAt:   timestamppb.New(role.CreatedAt),
+		UpdatedAt:   timestamppb.New(role.UpdatedAt),
+	}
+}
+
+// FromServiceToProtobufRoleList converts a list of service Role models to a list of protobuf Role models.
+func FromServiceToProtobufRoleList(roles []*model.Role) []*pb.Role {
+	protobufRoles := make([]*pb.Role, len(roles))
+	for i, role := range roles {
+		protobufRoles[i] = FromServiceToProtobufRole(role)
+	}
+	return protobufRoles
+}
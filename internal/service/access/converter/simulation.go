@@ -0,0 +1,65 @@
+package converter
+
+import (
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// FromProtobufToServiceProposedChanges converts a list of protobuf ProposedChange models to a list of service PolicyChange models.
+func FromProtobufToServiceProposedChanges(changes []*pb.ProposedChange) []*model.PolicyChange {
+	serviceChanges := make([]*model.PolicyChange, len(changes))
+	for i, change := range changes {
+		permission := change.GetPermission()
+		serviceChanges[i] = &model.PolicyChange{
+			Action: change.GetAction(),
+			Permission: &model.Permission{
+				Endpoint:      permission.GetEndpoint(),
+				Role:          permission.GetRole(),
+				MaxAuthAgeMin: int(permission.GetMaxAuthAgeMin()),
+				Effect:        permission.GetEffect(),
+				Condition:     permission.GetCondition(),
+			},
+		}
+	}
+	return serviceChanges
+}
+
+// FromServiceToProtobufSimulationResultList converts a list of service SimulationResult models to a list of protobuf SimulationResult models.
+func FromServiceToProtobufSimulationResultList(results []*model.SimulationResult) []*pb.SimulationResult {
+	protobufResults := make([]*pb.SimulationResult, len(results))
+	for i, result := range results {
+		protobufResults[i] = &pb.SimulationResult{
+			Endpoint:     result.Endpoint,
+			GainedRoles:  result.GainedRoles,
+			LostRoles:    result.LostRoles,
+			UsersGaining: result.UsersGaining,
+			UsersLosing:  result.UsersLosing,
+		}
+	}
+	return protobufResults
+}
+
+// FromServiceToProtobufExplainResponse converter from service Explanation model to protobuf ExplainResponse.
+func FromServiceToProtobufExplainResponse(explanation *model.Explanation) *pb.ExplainResponse {
+	response := &pb.ExplainResponse{
+		UserId:      explanation.UserID,
+		Username:    explanation.Username,
+		Endpoint:    explanation.Endpoint,
+		Roles:       explanation.Roles,
+		Evaluations: make([]*pb.PermissionEvaluation, len(explanation.Evaluations)),
+		Decision:    explanation.Decision,
+		Reason:      explanation.Reason,
+	}
+	for i, evaluation := range explanation.Evaluations {
+		response.Evaluations[i] = &pb.PermissionEvaluation{
+			Permission:      FromServiceToProtobufPermission(evaluation.Permission),
+			RoleHeld:        evaluation.RoleHeld,
+			ConditionResult: evaluation.ConditionResult,
+			Applicable:      evaluation.Applicable,
+		}
+	}
+	if explanation.Matched != nil {
+		response.Matched = FromServiceToProtobufPermission(explanation.Matched)
+	}
+	return response
+}
@@ -0,0 +1,18 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// CreateGroup adds a new user group, only admins are allowed to manage groups.
+func (a accessService) CreateGroup(ctx context.Context, group *model.Group) (int64, error) {
+	_, err := a.Check(ctx, pb.AccessV1_CreateGroup_FullMethodName)
+	if err != nil {
+		return 0, err
+	}
+
+	return a.groupRepo.Create(ctx, group)
+}
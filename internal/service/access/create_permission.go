@@ -0,0 +1,73 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+	pbUser "github.com/mikhailsoldatkin/auth/pkg/user_v1"
+)
+
+// endpointPattern matches full gRPC method names such as /chat_v1.ChatV1/Create
+// and patterns with wildcards such as /chat_v1.ChatV1/* or /admin.*/*,
+// optionally followed by a sub-endpoint such as /user_v1.UserV1/Update/Role.
+var endpointPattern = regexp.MustCompile(`^/[\w.*]+/[\w*]+(/[\w*]+)?$`)
+
+// CreatePermission grants the role access to the endpoint or forbids it, only admins are allowed to manage permissions.
+// Admins can't be denied access to the permission management endpoints, conditions are type-checked before saving.
+func (a accessService) CreatePermission(ctx context.Context, permission *model.Permission) (int64, error) {
+	_, err := a.Check(ctx, pb.AccessV1_CreatePermission_FullMethodName)
+	if err != nil {
+		return 0, err
+	}
+
+	err = validatePermission(permission)
+	if err != nil {
+		return 0, err
+	}
+
+	if permission.HasCondition() {
+		issues := a.policies.Validate(permission.Condition)
+		if len(issues) > 0 {
+			return 0, customerrors.NewErrInvalidArgument(fmt.Sprintf("invalid condition: %s", strings.Join(issues, "; ")))
+		}
+	}
+
+	_, err = a.existingRole(ctx, permission.Role)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := a.permissionRepo.Create(ctx, permission)
+	if err != nil {
+		return 0, err
+	}
+	a.invalidatePermissions(ctx)
+
+	return id, nil
+}
+
+func validatePermission(permission *model.Permission) error {
+	if !endpointPattern.MatchString(permission.Endpoint) {
+		return customerrors.NewErrInvalidArgument(fmt.Sprintf("invalid endpoint %q", permission.Endpoint))
+	}
+
+	if permission.MaxAuthAgeMin < 0 {
+		return customerrors.NewErrInvalidArgument("max auth age can't be negative")
+	}
+
+	if permission.IsDeny() && permission.Role == pbUser.Role_ADMIN.String() {
+		for endpoint := range managementEndpoints {
+			if utils.MatchEndpoint(permission.Endpoint, endpoint) {
+				return customerrors.NewErrInvalidArgument("admins can't be denied permission management")
+			}
+		}
+	}
+
+	return nil
+}
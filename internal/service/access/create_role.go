@@ -0,0 +1,51 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// CreateRole adds a new role inheriting permissions of the optional parent role,
+// only admins are allowed to manage roles.
+func (a accessService) CreateRole(ctx context.Context, role *model.Role) error {
+	_, err := a.Check(ctx, pb.AccessV1_CreateRole_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	if role.Parent != "" {
+		_, err = a.existingRole(ctx, role.Parent)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = a.roleRepo.Create(ctx, role)
+	if err != nil {
+		return err
+	}
+	if role.Parent != "" {
+		a.invalidatePermissions(ctx)
+	}
+
+	return nil
+}
+
+// existingRole retrieves the role referenced by a request, a missing role is reported as an invalid argument.
+func (a accessService) existingRole(ctx context.Context, name string) (*model.Role, error) {
+	role, err := a.roleRepo.Get(ctx, name)
+	if err != nil {
+		var notFound *customerrors.ErrNotFound
+		if errors.As(err, &notFound) {
+			return nil, customerrors.NewErrInvalidArgument(fmt.Sprintf("unknown role %q", name))
+		}
+		return nil, err
+	}
+
+	return role, nil
+}
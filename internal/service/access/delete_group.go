@@ -0,0 +1,18 @@
+package access
+
+import (
+	"context"
+
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// DeleteGroup removes the user group, its members lose the roles assigned to the group.
+// Only admins are allowed to manage groups.
+func (a accessService) DeleteGroup(ctx context.Context, id int64) error {
+	_, err := a.Check(ctx, pb.AccessV1_DeleteGroup_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	return a.groupRepo.Delete(ctx, id)
+}
@@ -0,0 +1,42 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// managementEndpoints are the endpoints admins need to manage permissions, revoking them would lock admins out.
+var managementEndpoints = map[string]struct{}{
+	pb.AccessV1_CreatePermission_FullMethodName: {},
+	pb.AccessV1_ListPermissions_FullMethodName:  {},
+	pb.AccessV1_DeletePermission_FullMethodName: {},
+	pb.AccessV1_ApplyPolicy_FullMethodName:      {},
+}
+
+// DeletePermission revokes the endpoint permission, only admins are allowed to manage permissions.
+// Admin permissions of the permission management endpoints can't be deleted.
+func (a accessService) DeletePermission(ctx context.Context, id int64) error {
+	_, err := a.Check(ctx, pb.AccessV1_DeletePermission_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	permission, err := a.permissionRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if isManagedByAdmins(permission) {
+		return customerrors.NewErrInvalidArgument("admin permissions for permission management can't be deleted")
+	}
+
+	err = a.permissionRepo.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+	a.invalidatePermissions(ctx)
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// DeleteRelation removes the relation tuple and publishes the change.
+func (a accessService) DeleteRelation(ctx context.Context, tuple *model.RelationTuple) error {
+	grant, err := a.Check(ctx, pb.AccessV1_DeleteRelation_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	err = a.relationRepo.Delete(ctx, tuple)
+	if err != nil {
+		return err
+	}
+	a.publishRelationEvent(ctx, model.RelationOperationDelete, tuple, grant.Claims.Username)
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+	pbUser "github.com/mikhailsoldatkin/auth/pkg/user_v1"
+)
+
+// DeleteRole removes the role together with its permissions, only admins are allowed to manage roles.
+// Built-in roles of the user_v1.Role enum can't be deleted.
+func (a accessService) DeleteRole(ctx context.Context, name string) error {
+	_, err := a.Check(ctx, pb.AccessV1_DeleteRole_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := pbUser.Role_value[name]; ok {
+		return customerrors.NewErrInvalidArgument("built-in roles can't be deleted")
+	}
+
+	err = a.roleRepo.Delete(ctx, name)
+	if err != nil {
+		return err
+	}
+	a.invalidatePermissions(ctx)
+
+	return nil
+}
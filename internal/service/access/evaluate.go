@@ -0,0 +1,115 @@
+package access
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+// conditionFunc reports whether the condition of the permission holds.
+type conditionFunc func(permission *model.Permission) (bool, error)
+
+// evaluation is the outcome of deciding access to an endpoint together with the trace of the decision.
+// Err is ErrForbidden or ErrStepUpRequired for denials and nil when access is allowed,
+// Matched is the permission the decision was made by, if any.
+type evaluation struct {
+	Evaluations []*model.PermissionEvaluation
+	Matched     *model.Permission
+	Decision    string
+	Err         error
+}
+
+// evaluate decides access of the principal to the endpoint, a condition failing to evaluate fails closed.
+func evaluate(
+	claims *model.UserClaims,
+	endpoint string,
+	permissions []*model.Permission,
+	conditions conditionFunc,
+	now time.Time,
+) *evaluation {
+	if !claims.HasScope(endpoint) {
+		return &evaluation{
+			Evaluations: []*model.PermissionEvaluation{},
+			Decision:    model.DecisionDeny,
+			Err:         customerrors.NewErrForbiddenReason("endpoint is out of the token scopes"),
+		}
+	}
+
+	result := &evaluation{Evaluations: []*model.PermissionEvaluation{}}
+	applicable := make([]*model.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		if !utils.MatchEndpoint(permission.Endpoint, endpoint) {
+			continue
+		}
+
+		trace := &model.PermissionEvaluation{Permission: permission, RoleHeld: claims.HasRole(permission.Role)}
+		trace.Applicable = trace.RoleHeld
+		if trace.RoleHeld && permission.HasCondition() {
+			holds, err := conditions(permission)
+			if err != nil {
+				log.Printf("failed to evaluate condition of permission %d: %v", permission.ID, err)
+				trace.ConditionResult = err.Error()
+				holds = permission.IsDeny()
+			} else {
+				trace.ConditionResult = strconv.FormatBool(holds)
+			}
+			trace.Applicable = holds
+		}
+
+		result.Evaluations = append(result.Evaluations, trace)
+		if trace.Applicable {
+			applicable = append(applicable, permission)
+		}
+	}
+
+	permission := utils.MatchPermission(applicable, endpoint, claims.HasRole)
+	result.Matched = permission
+	maxAge := time.Duration(0)
+	if permission != nil {
+		maxAge = time.Duration(permission.MaxAuthAgeMin) * time.Minute
+	}
+
+	switch {
+	case permission == nil:
+		result.Decision = model.DecisionDeny
+		result.Err = customerrors.NewErrForbiddenReason(
+			fmt.Sprintf("no permission for roles %s", strings.Join(claims.EffectiveRoles(), ", ")),
+		)
+	case permission.IsDeny():
+		result.Decision = model.DecisionDeny
+		result.Err = customerrors.NewErrForbiddenReason(
+			fmt.Sprintf("denied by permission %d (%s for role %s)", permission.ID, permission.Endpoint, permission.Role),
+		)
+	case permission.RequiresFreshAuth() && !claims.AuthenticatedWithin(maxAge, now):
+		result.Decision = model.DecisionStepUp
+		result.Err = customerrors.NewErrStepUpRequired(permission.MaxAuthAgeMin)
+	default:
+		result.Decision = model.DecisionAllow
+	}
+
+	return result
+}
+
+// Reason explains the denial, it is empty when access is allowed.
+func (e *evaluation) Reason() string {
+	var forbidden *customerrors.ErrForbidden
+	if errors.As(e.Err, &forbidden) {
+		return forbidden.Reason
+	}
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return ""
+}
+
+// assumeConditions is the conditionFunc of evaluations without a request, all conditions are assumed to hold.
+func assumeConditions(*model.Permission) (bool, error) {
+	return true, nil
+}
@@ -0,0 +1,45 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// Explain traces how access of the user to the endpoint is decided for a token issued now.
+func (a accessService) Explain(ctx context.Context, userID int64, endpoint string) (*model.Explanation, error) {
+	_, err := a.Check(ctx, pb.AccessV1_Explain_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := a.userRepo.Get(ctx, filter.UserFilter{ID: &userID})
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := a.permissionCache.GetEndpointPermissions(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permissions for endpoint: %w", err)
+	}
+
+	claims := &model.UserClaims{UserID: user.ID, Username: user.Username, Role: user.Role, Roles: user.EffectiveRoles()}
+	explanation := &model.Explanation{
+		UserID:   user.ID,
+		Username: user.Username,
+		Endpoint: endpoint,
+		Roles:    claims.EffectiveRoles(),
+	}
+
+	result := evaluate(claims, endpoint, permissions, a.conditions(ctx, claims, endpoint, ""), time.Now())
+	explanation.Evaluations = result.Evaluations
+	explanation.Matched = result.Matched
+	explanation.Decision = result.Decision
+	explanation.Reason = result.Reason()
+
+	return explanation, nil
+}
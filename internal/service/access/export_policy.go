@@ -0,0 +1,29 @@
+package access
+
+import (
+	"context"
+
+	permissionFilter "github.com/mikhailsoldatkin/auth/internal/repository/permission/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ExportPolicy returns all stored roles and permissions, the current state of the policy document.
+func (a accessService) ExportPolicy(ctx context.Context) (*model.Policy, error) {
+	_, err := a.Check(ctx, pb.AccessV1_ExportPolicy_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := a.roleRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := a.permissionRepo.List(ctx, permissionFilter.PermissionFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Policy{Roles: roles, Permissions: permissions}, nil
+}
@@ -0,0 +1,18 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// GetRole retrieves the role by its name, only admins are allowed to manage roles.
+func (a accessService) GetRole(ctx context.Context, name string) (*model.Role, error) {
+	_, err := a.Check(ctx, pb.AccessV1_GetRole_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.roleRepo.Get(ctx, name)
+}
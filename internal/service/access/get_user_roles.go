@@ -0,0 +1,23 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// GetUserRoles returns the effective roles of the user, only admins are allowed to manage role assignments.
+func (a accessService) GetUserRoles(ctx context.Context, userID int64) ([]string, error) {
+	_, err := a.Check(ctx, pb.AccessV1_GetUserRoles_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := a.userRepo.Get(ctx, filter.UserFilter{ID: &userID})
+	if err != nil {
+		return nil, err
+	}
+
+	return user.EffectiveRoles(), nil
+}
@@ -0,0 +1,31 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	decisionFilter "github.com/mikhailsoldatkin/auth/internal/repository/decision/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// defaultDecisionsLimit is the number of decisions returned when the filter doesn't limit it.
+const defaultDecisionsLimit = 100
+
+// ListDecisions returns recorded access decisions matching the filter, the most recent first.
+// Only admins are allowed to read the decision log.
+func (a accessService) ListDecisions(ctx context.Context, filter decisionFilter.DecisionFilter) ([]*model.DecisionEvent, error) {
+	_, err := a.Check(ctx, pb.AccessV1_ListDecisions_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, customerrors.NewErrInvalidArgument("from must be before to")
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultDecisionsLimit
+	}
+
+	return a.decisionRepo.List(ctx, filter)
+}
@@ -0,0 +1,18 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ListGroups returns all user groups, only admins are allowed to manage groups.
+func (a accessService) ListGroups(ctx context.Context) ([]*model.Group, error) {
+	_, err := a.Check(ctx, pb.AccessV1_ListGroups_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.groupRepo.List(ctx)
+}
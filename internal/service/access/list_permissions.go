@@ -0,0 +1,24 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/repository/permission/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ListPermissions returns endpoint permissions matching the filter, only admins are allowed to manage permissions.
+func (a accessService) ListPermissions(ctx context.Context, filter filter.PermissionFilter) ([]*model.Permission, error) {
+	_, err := a.Check(ctx, pb.AccessV1_ListPermissions_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := a.permissionRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
@@ -0,0 +1,29 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository/relation/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ListRelations retrieves relation tuples of the object or of the subject, optionally narrowed to the relation.
+func (a accessService) ListRelations(ctx context.Context, object, relation, subject string) ([]*model.RelationTuple, error) {
+	_, err := a.Check(ctx, pb.AccessV1_ListRelations_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	if object == "" && subject == "" {
+		return nil, customerrors.NewErrInvalidArgument("object or subject required")
+	}
+
+	f := filter.RelationFilter{Object: object, Subject: subject}
+	if relation != "" {
+		f.Relations = []string{relation}
+	}
+
+	return a.relationRepo.List(ctx, f)
+}
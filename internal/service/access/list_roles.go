@@ -0,0 +1,18 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ListRoles returns all roles, only admins are allowed to manage roles.
+func (a accessService) ListRoles(ctx context.Context) ([]*model.Role, error) {
+	_, err := a.Check(ctx, pb.AccessV1_ListRoles_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.roleRepo.List(ctx)
+}
@@ -0,0 +1,66 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+// recordDecision passes the outcome of the access check to the decision recorder, internal failures aren't recorded.
+func (a accessService) recordDecision(
+	ctx context.Context,
+	start time.Time,
+	endpoint string,
+	claims *model.UserClaims,
+	permission *model.Permission,
+	err error,
+) {
+	event := &model.DecisionEvent{
+		Endpoint:  endpoint,
+		Latency:   time.Since(start),
+		RequestID: utils.RequestID(ctx),
+		CreatedAt: start,
+	}
+
+	var invalidToken *customerrors.ErrInvalidToken
+	var forbidden *customerrors.ErrForbidden
+	var stepUp *customerrors.ErrStepUpRequired
+	switch {
+	case errors.As(err, &invalidToken):
+		event.Decision = model.DecisionUnauthenticated
+		event.Reason = invalidToken.Error()
+	case err == nil:
+		event.Decision = model.DecisionAllow
+	case errors.As(err, &stepUp):
+		event.Decision = model.DecisionStepUp
+		event.Reason = stepUp.Error()
+	case errors.As(err, &forbidden):
+		event.Decision = model.DecisionDeny
+		event.Reason = forbidden.Reason
+	default:
+		return
+	}
+
+	if claims != nil {
+		event.UserID = claims.UserID
+		event.Username = claims.Username
+		if claims.IsImpersonated() {
+			event.Actor = claims.Act.Username
+		}
+	}
+	if permission != nil {
+		event.PermissionID = permission.ID
+		effect := model.EffectAllow
+		if permission.IsDeny() {
+			effect = model.EffectDeny
+		}
+		event.MatchedRule = fmt.Sprintf("%s %s for role %s", effect, permission.Endpoint, permission.Role)
+	}
+
+	a.decisions.Record(ctx, event)
+}
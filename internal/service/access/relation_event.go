@@ -0,0 +1,30 @@
+package access
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// publishRelationEvent publishes the change of the relation tuple keyed by the object,
+// so that changes of an object are consumed in order. Failures are only logged as the change is already stored.
+func (a accessService) publishRelationEvent(ctx context.Context, operation string, tuple *model.RelationTuple, actor string) {
+	value, err := json.Marshal(&model.RelationEvent{
+		Operation:  operation,
+		Tuple:      tuple,
+		Actor:      actor,
+		OccurredAt: time.Now(),
+	})
+	if err != nil {
+		log.Printf("failed to encode relation event: %v", err)
+		return
+	}
+
+	err = a.producer.Produce(ctx, a.producerConfig.RelationsTopic, []byte(tuple.Object), value)
+	if err != nil {
+		log.Printf("failed to publish relation event: %v", err)
+	}
+}
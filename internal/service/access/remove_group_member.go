@@ -0,0 +1,17 @@
+package access
+
+import (
+	"context"
+
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// RemoveGroupMember removes the user from the group, only admins are allowed to manage groups.
+func (a accessService) RemoveGroupMember(ctx context.Context, groupID, userID int64) error {
+	_, err := a.Check(ctx, pb.AccessV1_RemoveGroupMember_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	return a.groupRepo.RemoveMember(ctx, groupID, userID)
+}
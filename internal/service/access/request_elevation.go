@@ -0,0 +1,42 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// RequestElevation stores the caller's request for a temporary role and returns its ID.
+// The duration is limited by the configured maximum and a role the caller already has can't be requested.
+func (a accessService) RequestElevation(ctx context.Context, request *model.ElevationRequest) (int64, error) {
+	grant, err := a.Check(ctx, pb.AccessV1_RequestElevation_FullMethodName)
+	if err != nil {
+		return 0, err
+	}
+
+	if grant.Claims.UserID == 0 {
+		return 0, customerrors.NewErrInvalidArgument("user ID required")
+	}
+
+	maxDuration := time.Duration(a.grantsConfig.MaxElevationMin) * time.Minute
+	if request.Duration < time.Minute || request.Duration > maxDuration {
+		return 0, customerrors.NewErrInvalidArgument(fmt.Sprintf("duration must be between 1m and %s", maxDuration))
+	}
+
+	_, err = a.existingRole(ctx, request.Role)
+	if err != nil {
+		return 0, err
+	}
+
+	if grant.Claims.HasRole(request.Role) {
+		return 0, customerrors.NewErrInvalidArgument(fmt.Sprintf("role %s is already granted", request.Role))
+	}
+
+	request.UserID = grant.Claims.UserID
+
+	return a.elevationRepo.Create(ctx, request)
+}
@@ -0,0 +1,17 @@
+package access
+
+import (
+	"context"
+
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// RevokeGroupRole removes the role assigned to the group, only admins are allowed to manage groups.
+func (a accessService) RevokeGroupRole(ctx context.Context, groupID int64, role string) error {
+	_, err := a.Check(ctx, pb.AccessV1_RevokeGroupRole_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	return a.groupRepo.RevokeRole(ctx, groupID, role)
+}
@@ -0,0 +1,18 @@
+package access
+
+import (
+	"context"
+
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// RevokeUserRole removes the role assigned to the user, the primary role of the user is changed via UserV1.Update.
+// Only admins are allowed to manage role assignments.
+func (a accessService) RevokeUserRole(ctx context.Context, userID int64, role string) error {
+	_, err := a.Check(ctx, pb.AccessV1_RevokeUserRole_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	return a.roleRepo.RevokeFromUser(ctx, userID, role)
+}
@@ -1,7 +1,14 @@
 package access
 
 import (
+	"context"
+	"log"
+
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+
+	"github.com/mikhailsoldatkin/auth/internal/client/kafka"
 	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/policy"
 	"github.com/mikhailsoldatkin/auth/internal/repository"
 	"github.com/mikhailsoldatkin/auth/internal/service"
 )
@@ -9,17 +16,78 @@ import (
 var _ service.AccessService = (*accessService)(nil)
 
 type accessService struct {
-	userRepo repository.UserRepository
-	config   config.Auth
+	userRepo        repository.UserRepository
+	apiTokenRepo    repository.APITokenRepository
+	permissionRepo  repository.PermissionRepository
+	roleRepo        repository.RoleRepository
+	groupRepo       repository.GroupRepository
+	relationRepo    repository.RelationRepository
+	elevationRepo   repository.ElevationRepository
+	decisionRepo    repository.DecisionRepository
+	permissionCache repository.PermissionCache
+	decisions       service.DecisionRecorder
+	producer        kafka.Producer
+	txManager       db.TxManager
+	config          config.Auth
+	producerConfig  config.KafkaProducer
+	grantsConfig    config.RoleGrants
+	principals      map[string]string
+	policies        *policy.Evaluator
+}
+
+// Deps holds dependencies of the access service.
+type Deps struct {
+	UserRepo        repository.UserRepository
+	APITokenRepo    repository.APITokenRepository
+	PermissionRepo  repository.PermissionRepository
+	RoleRepo        repository.RoleRepository
+	GroupRepo       repository.GroupRepository
+	RelationRepo    repository.RelationRepository
+	ElevationRepo   repository.ElevationRepository
+	DecisionRepo    repository.DecisionRepository
+	PermissionCache repository.PermissionCache
+	Decisions       service.DecisionRecorder
+	Producer        kafka.Producer
+	TxManager       db.TxManager
+	AuthConfig      config.Auth
+	TLSConfig       config.TLS
+	ProducerConfig  config.KafkaProducer
+	GrantsConfig    config.RoleGrants
 }
 
 // NewAccessService creates a new instance of the access service.
-func NewAccessService(
-	userRepo repository.UserRepository,
-	config config.Auth,
-) service.AccessService {
+func NewAccessService(deps Deps) service.AccessService {
+	principals := make(map[string]string, len(deps.TLSConfig.ClientPrincipals))
+	for username, identity := range deps.TLSConfig.ClientPrincipals {
+		principals[identity] = username
+	}
+
 	return &accessService{
-		userRepo: userRepo,
-		config:   config,
+		userRepo:        deps.UserRepo,
+		apiTokenRepo:    deps.APITokenRepo,
+		permissionRepo:  deps.PermissionRepo,
+		roleRepo:        deps.RoleRepo,
+		groupRepo:       deps.GroupRepo,
+		relationRepo:    deps.RelationRepo,
+		elevationRepo:   deps.ElevationRepo,
+		decisionRepo:    deps.DecisionRepo,
+		permissionCache: deps.PermissionCache,
+		decisions:       deps.Decisions,
+		producer:        deps.Producer,
+		txManager:       deps.TxManager,
+		config:          deps.AuthConfig,
+		producerConfig:  deps.ProducerConfig,
+		grantsConfig:    deps.GrantsConfig,
+		principals:      principals,
+		policies:        policy.NewEvaluator(),
+	}
+}
+
+// invalidatePermissions drops cached permissions after a change of permissions or roles.
+// Failures are only logged as the change is already stored, stale copies expire within the cache TTL.
+func (a accessService) invalidatePermissions(ctx context.Context) {
+	err := a.permissionCache.Invalidate(ctx)
+	if err != nil {
+		log.Printf("failed to invalidate permission cache: %v", err)
 	}
 }
@@ -0,0 +1,192 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	permissionFilter "github.com/mikhailsoldatkin/auth/internal/repository/permission/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// Simulate reports who would gain or lose access if the proposed permission changes were applied, nothing is stored.
+// Conditional permissions are assumed to hold and permissions requiring fresh authentication count as granting access.
+func (a accessService) Simulate(ctx context.Context, changes []*model.PolicyChange) ([]*model.SimulationResult, error) {
+	_, err := a.Check(ctx, pb.AccessV1_Simulate_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	declared := make([]*model.Permission, 0, len(changes))
+	for _, change := range changes {
+		if change.Action != model.PolicyDelete {
+			declared = append(declared, change.Permission)
+		}
+	}
+	err = a.validatePolicy(ctx, &model.Policy{Permissions: declared})
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := a.permissionRepo.List(ctx, permissionFilter.PermissionFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	proposed, err := proposePolicy(current, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := a.roleRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roleSets, err := a.roleRepo.CountUsersByRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	before, after := grantedPermissions(current, roles), grantedPermissions(proposed, roles)
+	endpoints := affectedEndpoints(changes, current, proposed)
+	results := make([]*model.SimulationResult, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		result := &model.SimulationResult{Endpoint: endpoint, GainedRoles: []string{}, LostRoles: []string{}}
+
+		for _, role := range roles {
+			held := []string{role.Name}
+			had, has := hasAccess(before, endpoint, held), hasAccess(after, endpoint, held)
+			switch {
+			case !had && has:
+				result.GainedRoles = append(result.GainedRoles, role.Name)
+			case had && !has:
+				result.LostRoles = append(result.LostRoles, role.Name)
+			}
+		}
+
+		for _, set := range roleSets {
+			had, has := hasAccess(before, endpoint, set.Roles), hasAccess(after, endpoint, set.Roles)
+			switch {
+			case !had && has:
+				result.UsersGaining += set.Users
+			case had && !has:
+				result.UsersLosing += set.Users
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// proposePolicy returns the stored permissions with the changes applied.
+func proposePolicy(current []*model.Permission, changes []*model.PolicyChange) ([]*model.Permission, error) {
+	proposed := slices.Clone(current)
+	for _, change := range changes {
+		permission := change.Permission
+		i := slices.IndexFunc(proposed, func(p *model.Permission) bool { return policyKey(p) == policyKey(permission) })
+
+		switch change.Action {
+		case model.PolicyCreate:
+			if i >= 0 {
+				return nil, customerrors.NewErrInvalidArgument(fmt.Sprintf(
+					"permission of %s for role %s already exists", permission.Endpoint, permission.Role,
+				))
+			}
+			proposed = append(proposed, permission)
+		case model.PolicyUpdate, model.PolicyDelete:
+			if i < 0 {
+				return nil, customerrors.NewErrInvalidArgument(fmt.Sprintf(
+					"permission of %s for role %s doesn't exist", permission.Endpoint, permission.Role,
+				))
+			}
+			if isManagedByAdmins(proposed[i]) {
+				return nil, customerrors.NewErrInvalidArgument(fmt.Sprintf(
+					"admin permission for %s can't be %sd", permission.Endpoint, change.Action,
+				))
+			}
+			if change.Action == model.PolicyUpdate {
+				proposed[i] = permission
+			} else {
+				proposed = slices.Delete(proposed, i, i+1)
+			}
+		default:
+			return nil, customerrors.NewErrInvalidArgument(fmt.Sprintf("unknown action %q", change.Action))
+		}
+	}
+
+	return proposed, nil
+}
+
+// grantedPermissions expands the permissions to the roles inheriting them, a role inherits the permissions
+// of its parent, so that the permissions can be matched the same way the cached ones are.
+func grantedPermissions(permissions []*model.Permission, roles []*model.Role) []*model.Permission {
+	children := make(map[string][]string, len(roles))
+	for _, role := range roles {
+		if role.Parent != "" {
+			children[role.Parent] = append(children[role.Parent], role.Name)
+		}
+	}
+
+	granted := make([]*model.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		level := []string{permission.Role}
+		for depth := 0; len(level) > 0 && depth <= maxRoleDepth; depth++ {
+			var next []string
+			for _, role := range level {
+				grant := *permission
+				grant.Role = role
+				granted = append(granted, &grant)
+				next = append(next, children[role]...)
+			}
+			level = next
+		}
+	}
+
+	return granted
+}
+
+// affectedEndpoints returns the sorted endpoints of the changes and the exact endpoints they match.
+func affectedEndpoints(changes []*model.PolicyChange, permissionSets ...[]*model.Permission) []string {
+	var exact []string
+	for _, permissions := range permissionSets {
+		for _, permission := range permissions {
+			if !strings.Contains(permission.Endpoint, "*") {
+				exact = append(exact, permission.Endpoint)
+			}
+		}
+	}
+
+	affected := make(map[string]struct{})
+	for _, change := range changes {
+		affected[change.Permission.Endpoint] = struct{}{}
+		for _, endpoint := range exact {
+			if utils.MatchEndpoint(change.Permission.Endpoint, endpoint) {
+				affected[endpoint] = struct{}{}
+			}
+		}
+	}
+
+	endpoints := make([]string, 0, len(affected))
+	for endpoint := range affected {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+
+	return endpoints
+}
+
+// hasAccess reports whether holders of the roles would access the endpoint, it is decided by the evaluation
+// of Check with conditions assumed to hold and fresh authentication assumed to be provided.
+func hasAccess(permissions []*model.Permission, endpoint string, roles []string) bool {
+	result := evaluate(&model.UserClaims{Roles: roles}, endpoint, permissions, assumeConditions, time.Now())
+	return result.Decision != model.DecisionDeny
+}
@@ -0,0 +1,360 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/policy"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/repository/permission/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service"
+	"github.com/mikhailsoldatkin/auth/internal/service/access"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+func TestApplyPolicy(t *testing.T) {
+	t.Parallel()
+	type permissionRepoMockFunc func(mc *minimock.Controller) repository.PermissionRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+		cfg = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+
+		management = []*model.Permission{
+			{ID: 1, Endpoint: pb.AccessV1_ApplyPolicy_FullMethodName, Role: "ADMIN", Effect: model.EffectAllow},
+			{ID: 2, Endpoint: pb.AccessV1_CreatePermission_FullMethodName, Role: "ADMIN", Effect: model.EffectAllow},
+			{ID: 3, Endpoint: pb.AccessV1_DeletePermission_FullMethodName, Role: "ADMIN", Effect: model.EffectAllow},
+			{ID: 4, Endpoint: pb.AccessV1_ListPermissions_FullMethodName, Role: "ADMIN", Effect: model.EffectAllow},
+		}
+		current = append(management[:4:4],
+			&model.Permission{ID: 5, Endpoint: "/chat_v1.ChatV1/*", Role: "USER", Effect: model.EffectAllow},
+			&model.Permission{ID: 6, Endpoint: "/chat_v1.ChatV1/Delete", Role: "USER", Effect: model.EffectAllow},
+			&model.Permission{ID: 7, Endpoint: "/chat_v1.ChatV1/Old", Role: "USER", Effect: model.EffectAllow},
+		)
+		declared = func() []*model.Permission {
+			permissions := make([]*model.Permission, 0, len(management)+3)
+			for _, permission := range management {
+				permissions = append(permissions, &model.Permission{Endpoint: permission.Endpoint, Role: permission.Role})
+			}
+			return append(permissions,
+				&model.Permission{Endpoint: "/chat_v1.ChatV1/*", Role: "USER"},
+				&model.Permission{Endpoint: "/chat_v1.ChatV1/Delete", Role: "USER", Effect: model.EffectDeny, Condition: `!request.ip.startsWith("10.")`},
+				&model.Permission{Endpoint: "/chat_v1.ChatV1/Create", Role: "AUDITOR"},
+			)
+		}
+		storedPermissions = func(mc *minimock.Controller) *repoMocks.PermissionRepositoryMock {
+			mock := repoMocks.NewPermissionRepositoryMock(mc)
+			mock.ListMock.Expect(minimock.AnyContext, filter.PermissionFilter{}).Return(current, nil)
+			return mock
+		}
+	)
+
+	tests := []struct {
+		name               string
+		permissions        []*model.Permission
+		dryRun             bool
+		actions            []string
+		err                error
+		invalid            bool
+		permissionRepoMock permissionRepoMockFunc
+	}{
+		{
+			name:        "changes applied",
+			permissions: declared(),
+			actions:     []string{model.PolicyDelete, model.PolicyUpdate, model.PolicyCreate},
+			permissionRepoMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := storedPermissions(mc)
+				mock.DeleteMock.Expect(minimock.AnyContext, 7).Return(nil)
+				mock.UpdateMock.Expect(minimock.AnyContext, &model.Permission{
+					ID:        6,
+					Endpoint:  "/chat_v1.ChatV1/Delete",
+					Role:      "USER",
+					Effect:    model.EffectDeny,
+					Condition: `!request.ip.startsWith("10.")`,
+				}).Return(nil)
+				mock.CreateMock.Expect(minimock.AnyContext, &model.Permission{
+					Endpoint: "/chat_v1.ChatV1/Create",
+					Role:     "AUDITOR",
+					Effect:   model.EffectAllow,
+				}).Return(8, nil)
+				return mock
+			},
+		},
+		{
+			name:               "dry run only plans",
+			permissions:        declared(),
+			dryRun:             true,
+			actions:            []string{model.PolicyDelete, model.PolicyUpdate, model.PolicyCreate},
+			permissionRepoMock: func(mc *minimock.Controller) repository.PermissionRepository { return storedPermissions(mc) },
+		},
+		{
+			name:               "admin management permission deleted",
+			permissions:        declared()[1:],
+			actions:            []string{},
+			err:                customerrors.NewErrInvalidArgument("admin permission for /access_v1.AccessV1/ApplyPolicy can't be deleted"),
+			permissionRepoMock: func(mc *minimock.Controller) repository.PermissionRepository { return storedPermissions(mc) },
+		},
+		{
+			name:        "invalid condition",
+			permissions: []*model.Permission{{Endpoint: "/chat_v1.ChatV1/Create", Role: "USER", Condition: "user.email =="}},
+			invalid:     true,
+			permissionRepoMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				return repoMocks.NewPermissionRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			roleRepoMock := repoMocks.NewRoleRepositoryMock(mc)
+			roleRepoMock.GetMock.Optional().Set(func(_ context.Context, name string) (*model.Role, error) {
+				return &model.Role{Name: name}, nil
+			})
+			roleRepoMock.ListMock.Optional().Return([]*model.Role{{Name: "ADMIN"}, {Name: "USER"}, {Name: "AUDITOR"}}, nil)
+
+			permissionCacheMock := adminOnlyMock(mc, pb.AccessV1_ApplyPolicy_FullMethodName)
+			if len(tt.actions) > 0 && !tt.dryRun {
+				permissionCacheMock.InvalidateMock.Return(nil)
+			}
+
+			service := access.NewAccessService(access.Deps{
+				PermissionRepo:  tt.permissionRepoMock(mc),
+				RoleRepo:        roleRepoMock,
+				PermissionCache: permissionCacheMock,
+				Decisions:       decisionRecorderMock(mc),
+				TxManager:       noOpTxManager{},
+				AuthConfig:      cfg,
+			})
+
+			plan, err := service.ApplyPolicy(bearerContext(t, ctx, cfg, "ADMIN"), &model.Policy{Permissions: tt.permissions}, tt.dryRun)
+			if tt.invalid {
+				var invalid *customerrors.ErrInvalidArgument
+				require.ErrorAs(t, err, &invalid)
+				return
+			}
+			require.Equal(t, tt.err, err)
+			if tt.err != nil {
+				return
+			}
+
+			actions := make([]string, 0, len(plan.Permissions))
+			for _, change := range plan.Permissions {
+				actions = append(actions, change.Action)
+			}
+			require.Equal(t, tt.actions, actions)
+			require.Empty(t, plan.Roles)
+		})
+	}
+}
+
+func TestApplyPolicyRoles(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+		cfg = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+
+		management = []*model.Permission{
+			{ID: 1, Endpoint: pb.AccessV1_ApplyPolicy_FullMethodName, Role: "ADMIN", Effect: model.EffectAllow},
+		}
+		builtIn = []*model.Role{{Name: "ADMIN"}, {Name: "USER"}}
+	)
+
+	tests := []struct {
+		name     string
+		stored   []*model.Role
+		declared []*model.Role
+		created  []*model.Role
+		updated  []*model.RoleUpdate
+		err      error
+	}{
+		{
+			name:   "hierarchy created parents first",
+			stored: builtIn,
+			declared: []*model.Role{
+				{Name: "MODERATOR", Description: "Chat moderators", Parent: "SUPPORT"},
+				{Name: "SUPPORT", Parent: "USER"},
+				{Name: "USER"},
+			},
+			created: []*model.Role{
+				{Name: "SUPPORT", Parent: "USER"},
+				{Name: "MODERATOR", Description: "Chat moderators", Parent: "SUPPORT"},
+			},
+		},
+		{
+			name:     "role updated",
+			stored:   append(builtIn[:2:2], &model.Role{Name: "AUDITOR", Description: "old"}),
+			declared: []*model.Role{{Name: "AUDITOR", Description: "new", Parent: "USER"}},
+			updated:  []*model.RoleUpdate{{Name: "AUDITOR", Description: ptr("new"), Parent: ptr("USER")}},
+		},
+		{
+			name:   "cycle among declared roles",
+			stored: builtIn,
+			declared: []*model.Role{
+				{Name: "MODERATOR", Parent: "SUPPORT"},
+				{Name: "SUPPORT", Parent: "MODERATOR"},
+			},
+			err: customerrors.NewErrInvalidArgument("role inheritance cycle of MODERATOR"),
+		},
+		{
+			name: "cycle through stored roles",
+			stored: append(builtIn[:2:2],
+				&model.Role{Name: "SUPPORT", Parent: "USER"},
+				&model.Role{Name: "MODERATOR", Parent: "SUPPORT"},
+			),
+			declared: []*model.Role{{Name: "USER", Parent: "MODERATOR"}},
+			err:      customerrors.NewErrInvalidArgument("role inheritance cycle of USER"),
+		},
+		{
+			name:     "unknown parent",
+			stored:   builtIn,
+			declared: []*model.Role{{Name: "MODERATOR", Parent: "GHOST"}},
+			err:      customerrors.NewErrInvalidArgument(`unknown parent role "GHOST" of MODERATOR`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var created []*model.Role
+			var updated []*model.RoleUpdate
+			roleRepoMock := repoMocks.NewRoleRepositoryMock(mc)
+			roleRepoMock.GetMock.Optional().Return(&model.Role{Name: "ADMIN"}, nil)
+			roleRepoMock.ListMock.Return(tt.stored, nil)
+			roleRepoMock.CreateMock.Optional().Set(func(_ context.Context, role *model.Role) error {
+				created = append(created, role)
+				return nil
+			})
+			roleRepoMock.UpdateMock.Optional().Set(func(_ context.Context, updates *model.RoleUpdate) error {
+				updated = append(updated, updates)
+				return nil
+			})
+
+			permissionRepoMock := repoMocks.NewPermissionRepositoryMock(mc)
+			permissionRepoMock.ListMock.Optional().Return(management, nil)
+
+			permissionCacheMock := adminOnlyMock(mc, pb.AccessV1_ApplyPolicy_FullMethodName)
+			if tt.err == nil {
+				permissionCacheMock.InvalidateMock.Return(nil)
+			}
+
+			service := access.NewAccessService(access.Deps{
+				PermissionRepo:  permissionRepoMock,
+				RoleRepo:        roleRepoMock,
+				PermissionCache: permissionCacheMock,
+				Decisions:       decisionRecorderMock(mc),
+				TxManager:       noOpTxManager{},
+				AuthConfig:      cfg,
+			})
+
+			declared := &model.Policy{
+				Roles:       tt.declared,
+				Permissions: []*model.Permission{{Endpoint: pb.AccessV1_ApplyPolicy_FullMethodName, Role: "ADMIN"}},
+			}
+			plan, err := service.ApplyPolicy(bearerContext(t, ctx, cfg, "ADMIN"), declared, false)
+			require.Equal(t, tt.err, err)
+			if tt.err != nil {
+				require.Empty(t, created)
+				require.Empty(t, updated)
+				return
+			}
+
+			require.Equal(t, tt.created, created)
+			require.Equal(t, tt.updated, updated)
+			require.Len(t, plan.Roles, len(tt.created)+len(tt.updated))
+			require.Empty(t, plan.Permissions)
+		})
+	}
+}
+
+// TestPolicyRoundTrip exports a policy with a role hierarchy, renders and parses the document
+// and applies it to a store knowing only the built-in roles.
+func TestPolicyRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+		cfg = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+
+		permissions = []*model.Permission{
+			{ID: 1, Endpoint: pb.AccessV1_ApplyPolicy_FullMethodName, Role: "ADMIN", Effect: model.EffectAllow},
+			{ID: 2, Endpoint: pb.AccessV1_ExportPolicy_FullMethodName, Role: "ADMIN", Effect: model.EffectAllow},
+			{ID: 3, Endpoint: "/chat_v1.ChatV1/Ban", Role: "MODERATOR", Effect: model.EffectAllow},
+			{ID: 4, Endpoint: "/user_v1.UserV1/Update/Role", Role: "ADMIN", Effect: model.EffectAllow},
+			{ID: 5, Endpoint: "/access_v1.AccessV1/CheckRelation/AnyUser", Role: "ADMIN", Effect: model.EffectAllow},
+		}
+		builtIn   = []*model.Role{{Name: "ADMIN", Description: "Administrators"}, {Name: "USER", Description: "Users"}}
+		hierarchy = append(builtIn[:2:2],
+			&model.Role{Name: "MODERATOR", Description: "Chat moderators", Parent: "SUPPORT", CreatedAt: time.Now()},
+			&model.Role{Name: "SUPPORT", Parent: "USER", CreatedAt: time.Now()},
+		)
+	)
+
+	newService := func(roles []*model.Role, created *[]*model.Role) service.AccessService {
+		roleRepoMock := repoMocks.NewRoleRepositoryMock(mc)
+		roleRepoMock.ListMock.Return(roles, nil)
+		roleRepoMock.CreateMock.Optional().Set(func(_ context.Context, role *model.Role) error {
+			*created = append(*created, role)
+			return nil
+		})
+
+		permissionRepoMock := repoMocks.NewPermissionRepositoryMock(mc)
+		permissionRepoMock.ListMock.Return(permissions, nil)
+
+		permissionCacheMock := adminOnlyMock(mc, "/access_v1.AccessV1/*")
+		permissionCacheMock.InvalidateMock.Optional().Return(nil)
+
+		return access.NewAccessService(access.Deps{
+			PermissionRepo:  permissionRepoMock,
+			RoleRepo:        roleRepoMock,
+			PermissionCache: permissionCacheMock,
+			Decisions:       decisionRecorderMock(mc),
+			TxManager:       noOpTxManager{},
+			AuthConfig:      cfg,
+		})
+	}
+
+	var created []*model.Role
+	source := newService(hierarchy, &created)
+	exported, err := source.ExportPolicy(bearerContext(t, ctx, cfg, "ADMIN"))
+	require.NoError(t, err)
+
+	document, err := policy.MarshalDocument(exported)
+	require.NoError(t, err)
+	parsed, err := policy.ParseDocument(document)
+	require.NoError(t, err)
+
+	// applied to the source the document changes nothing
+	plan, err := source.ApplyPolicy(bearerContext(t, ctx, cfg, "ADMIN"), parsed, false)
+	require.NoError(t, err)
+	require.True(t, plan.IsEmpty())
+
+	// applied to a store with the built-in roles only the hierarchy is recreated parents first
+	target := newService(builtIn, &created)
+	plan, err = target.ApplyPolicy(bearerContext(t, ctx, cfg, "ADMIN"), parsed, false)
+	require.NoError(t, err)
+	require.Empty(t, plan.Permissions)
+	require.Equal(t, []*model.Role{
+		{Name: "SUPPORT", Parent: "USER"},
+		{Name: "MODERATOR", Description: "Chat moderators", Parent: "SUPPORT"},
+	}, created)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
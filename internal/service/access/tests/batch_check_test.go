@@ -0,0 +1,186 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/access"
+	serviceMocks "github.com/mikhailsoldatkin/auth/internal/service/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+func TestBatchCheck(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+		cfg = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+
+		userID   = gofakeit.Int64()
+		user     = &model.User{ID: userID, Username: gofakeit.Username(), Role: "USER"}
+		create   = "/chat_v1.ChatV1/Create"
+		deleting = "/chat_v1.ChatV1/Delete"
+		send     = "/chat_v1.ChatV1/SendMessage"
+		ban      = "/chat_v1.ChatV1/Ban"
+
+		permissions = []*model.Permission{
+			{Endpoint: create, Role: "USER"},
+			{Endpoint: deleting, Role: "USER", MaxAuthAgeMin: 5},
+			{Endpoint: send, Role: "USER"},
+			{Endpoint: ban, Role: "ADMIN"},
+			{ID: 7, Endpoint: "/chat_v1.ChatV1/*", Role: "USER", Effect: model.EffectDeny},
+		}
+	)
+
+	token, prefix, hash, err := utils.GenerateAPIToken()
+	require.NoError(t, err)
+	apiToken := &model.APIToken{
+		ID:        gofakeit.Int64(),
+		UserID:    userID,
+		Prefix:    prefix,
+		Hash:      hash,
+		Scopes:    []string{create, deleting, ban},
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	// the token is verified and its owner is loaded once for the whole batch
+	apiTokenRepoMock := repoMocks.NewAPITokenRepositoryMock(mc)
+	apiTokenRepoMock.GetByPrefixMock.Times(1).Return(apiToken, nil)
+	apiTokenRepoMock.UpdateLastUsedMock.Times(1).Return(nil)
+	userRepoMock := repoMocks.NewUserRepositoryMock(mc)
+	userRepoMock.GetMock.Times(1).Return(user, nil)
+	permissionCacheMock := repoMocks.NewPermissionCacheMock(mc)
+	permissionCacheMock.GetEndpointPermissionsMock.Return(permissions, nil)
+
+	service := access.NewAccessService(access.Deps{
+		UserRepo:        userRepoMock,
+		APITokenRepo:    apiTokenRepoMock,
+		PermissionCache: permissionCacheMock,
+		Decisions:       decisionRecorderMock(mc),
+		AuthConfig:      cfg,
+	})
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	decisions, err := service.BatchCheck(ctx, []*model.AccessRequest{
+		{Endpoint: create, Resource: "chat:1"},
+		{Endpoint: deleting, Resource: "chat:1"},
+		{Endpoint: send, Resource: "chat:1"},
+		{Endpoint: ban},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*model.AccessDecision{
+		{Endpoint: create, Resource: "chat:1", Allowed: true},
+		{Endpoint: deleting, Resource: "chat:1", Allowed: false, MaxAuthAgeMin: 5, Reason: "fresh authentication within 5 minutes required"},
+		{Endpoint: send, Resource: "chat:1", Allowed: false, Reason: "endpoint is out of the token scopes"},
+		{Endpoint: ban, Allowed: false, Reason: "denied by permission 7 (/chat_v1.ChatV1/* for role USER)"},
+	}, decisions)
+}
+
+func TestBatchCheckResourceConditions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		mc       = minimock.NewController(t)
+		cfg      = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		user     = model.User{ID: gofakeit.Int64(), Username: gofakeit.Username(), Role: "USER"}
+		endpoint = "/chat_v1.ChatV1/Delete"
+
+		permissions = []*model.Permission{
+			{ID: 3, Endpoint: endpoint, Role: "USER"},
+			{ID: 4, Endpoint: endpoint, Role: "USER", Effect: model.EffectDeny, Condition: `request.resource != "chat:1"`},
+		}
+		requests = []*model.AccessRequest{
+			{Endpoint: endpoint, Resource: "chat:1"},
+			{Endpoint: endpoint, Resource: "chat:2"},
+			{Endpoint: endpoint},
+		}
+	)
+
+	token, err := utils.GenerateToken(user, []byte(cfg.TokenSecretKey), time.Hour)
+	require.NoError(t, err)
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+
+	permissionCacheMock := repoMocks.NewPermissionCacheMock(mc)
+	permissionCacheMock.GetEndpointPermissionsMock.Return(permissions, nil)
+
+	// every item of the batch is recorded as a decision of its own
+	var recorded []*model.DecisionEvent
+	recorderMock := serviceMocks.NewDecisionRecorderMock(mc)
+	recorderMock.RecordMock.Set(func(_ context.Context, event *model.DecisionEvent) {
+		recorded = append(recorded, event)
+	})
+
+	service := access.NewAccessService(access.Deps{
+		PermissionCache: permissionCacheMock,
+		Decisions:       recorderMock,
+		AuthConfig:      cfg,
+	})
+
+	decisions, err := service.BatchCheck(ctx, requests)
+	require.NoError(t, err)
+	require.Equal(t, []*model.AccessDecision{
+		{Endpoint: endpoint, Resource: "chat:1", Allowed: true},
+		{Endpoint: endpoint, Resource: "chat:2", Reason: "denied by permission 4 (/chat_v1.ChatV1/Delete for role USER)"},
+		{Endpoint: endpoint, Reason: "denied by permission 4 (/chat_v1.ChatV1/Delete for role USER)"},
+	}, decisions)
+
+	require.Len(t, recorded, len(requests))
+	for i, event := range recorded {
+		require.Equal(t, endpoint, event.Endpoint)
+		require.Equal(t, user.ID, event.UserID)
+		if decisions[i].Allowed {
+			require.Equal(t, model.DecisionAllow, event.Decision)
+			require.Equal(t, int64(3), event.PermissionID)
+		} else {
+			require.Equal(t, model.DecisionDeny, event.Decision)
+			require.Equal(t, int64(4), event.PermissionID)
+		}
+	}
+
+	// the batch decides each item the same way CheckResource does
+	for i, request := range requests {
+		_, err = service.CheckResource(ctx, request.Endpoint, request.Resource)
+		require.Equal(t, decisions[i].Allowed, err == nil, request.Resource)
+	}
+}
+
+func TestBatchCheckUnauthenticated(t *testing.T) {
+	t.Parallel()
+
+	var recorded []*model.DecisionEvent
+	mc := minimock.NewController(t)
+	recorderMock := serviceMocks.NewDecisionRecorderMock(mc)
+	recorderMock.RecordMock.Set(func(_ context.Context, event *model.DecisionEvent) {
+		recorded = append(recorded, event)
+	})
+
+	service := access.NewAccessService(access.Deps{
+		Decisions:  recorderMock,
+		AuthConfig: config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)},
+	})
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer invalid"))
+	decisions, err := service.BatchCheck(ctx, []*model.AccessRequest{
+		{Endpoint: "/chat_v1.ChatV1/Create"},
+		{Endpoint: "/chat_v1.ChatV1/Delete"},
+	})
+	require.Equal(t, customerrors.NewErrInvalidToken(), err)
+	require.Nil(t, decisions)
+
+	require.Len(t, recorded, 2)
+	for _, event := range recorded {
+		require.Equal(t, model.DecisionUnauthenticated, event.Decision)
+	}
+}
@@ -0,0 +1,353 @@
+package tests
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/access"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+func TestCheckStepUp(t *testing.T) {
+	t.Parallel()
+	type permissionCacheMockFunc func(mc *minimock.Controller, ctx context.Context) repository.PermissionCache
+
+	var (
+		mc       = minimock.NewController(t)
+		cfg      = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		endpoint = "/user_v1.UserV1/Delete"
+		user     = model.User{Username: gofakeit.Username(), Role: "ADMIN"}
+		amr      = []string{model.AuthMethodPassword}
+
+		permissionsMock = func(permissions ...*model.Permission) permissionCacheMockFunc {
+			return func(mc *minimock.Controller, ctx context.Context) repository.PermissionCache {
+				mock := repoMocks.NewPermissionCacheMock(mc)
+				mock.GetEndpointPermissionsMock.Expect(ctx, endpoint).Return(permissions, nil)
+				return mock
+			}
+		}
+	)
+
+	tests := []struct {
+		name                string
+		authTime            time.Time
+		err                 error
+		permissionCacheMock permissionCacheMockFunc
+	}{
+		{
+			name:                "permission without step-up",
+			authTime:            time.Now().Add(-time.Hour),
+			err:                 nil,
+			permissionCacheMock: permissionsMock(&model.Permission{Endpoint: endpoint, Role: user.Role}),
+		},
+		{
+			name:                "fresh authentication",
+			authTime:            time.Now().Add(-time.Minute),
+			err:                 nil,
+			permissionCacheMock: permissionsMock(&model.Permission{Endpoint: endpoint, Role: user.Role, MaxAuthAgeMin: 5}),
+		},
+		{
+			name:                "stale authentication",
+			authTime:            time.Now().Add(-10 * time.Minute),
+			err:                 customerrors.NewErrStepUpRequired(5),
+			permissionCacheMock: permissionsMock(&model.Permission{Endpoint: endpoint, Role: user.Role, MaxAuthAgeMin: 5}),
+		},
+		{
+			name:                "token without auth time",
+			authTime:            time.Unix(0, 0),
+			err:                 customerrors.NewErrStepUpRequired(5),
+			permissionCacheMock: permissionsMock(&model.Permission{Endpoint: endpoint, Role: user.Role, MaxAuthAgeMin: 5}),
+		},
+		{
+			name:                "role not permitted",
+			authTime:            time.Now(),
+			err:                 customerrors.NewErrForbiddenReason("no permission for roles ADMIN"),
+			permissionCacheMock: permissionsMock(&model.Permission{Endpoint: endpoint, Role: "USER", MaxAuthAgeMin: 5}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := utils.GenerateAuthenticatedToken(user, tt.authTime, amr, []byte(cfg.TokenSecretKey), time.Hour)
+			require.NoError(t, err)
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+			service := access.NewAccessService(access.Deps{
+				PermissionCache: tt.permissionCacheMock(mc, ctx),
+				Decisions:       decisionRecorderMock(mc),
+				AuthConfig:      cfg,
+			})
+
+			grant, err := service.Check(ctx, endpoint)
+			require.Equal(t, tt.err, err)
+			if tt.err == nil {
+				require.Equal(t, user.Username, grant.Claims.Username)
+			}
+		})
+	}
+}
+
+func TestCheckClientCertificate(t *testing.T) {
+	t.Parallel()
+	type userRepoMockFunc func(mc *minimock.Controller, ctx context.Context) repository.UserRepository
+
+	var (
+		mc       = minimock.NewController(t)
+		cfg      = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		endpoint = "/chat_v1.ChatV1/SendMessage"
+		username = gofakeit.Username()
+		spiffeID = &url.URL{Scheme: "spiffe", Host: "corp", Path: "/chat"}
+		tlsCfg   = config.TLS{ClientPrincipals: map[string]string{username: spiffeID.String()}}
+
+		account = &model.User{Username: username, Role: "USER", PrincipalType: model.PrincipalService}
+		human   = &model.User{Username: username, Role: "USER", PrincipalType: model.PrincipalHuman}
+
+		getUserMock = func(user *model.User) userRepoMockFunc {
+			return func(mc *minimock.Controller, ctx context.Context) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, filter.UserFilter{Username: &username}).Return(user, nil)
+				return mock
+			}
+		}
+		noUserRepo = func(mc *minimock.Controller, _ context.Context) repository.UserRepository {
+			return repoMocks.NewUserRepositoryMock(mc)
+		}
+	)
+
+	tests := []struct {
+		name         string
+		cert         *x509.Certificate
+		err          error
+		userRepoMock userRepoMockFunc
+	}{
+		{
+			name:         "service account by URI SAN",
+			cert:         &x509.Certificate{URIs: []*url.URL{spiffeID}},
+			err:          nil,
+			userRepoMock: getUserMock(account),
+		},
+		{
+			name:         "certificate mapped to human",
+			cert:         &x509.Certificate{URIs: []*url.URL{spiffeID}},
+			err:          customerrors.NewErrForbidden(),
+			userRepoMock: getUserMock(human),
+		},
+		{
+			name:         "unmapped certificate",
+			cert:         &x509.Certificate{Subject: pkix.Name{CommonName: gofakeit.DomainName()}},
+			err:          customerrors.NewErrInvalidToken(),
+			userRepoMock: noUserRepo,
+		},
+		{
+			name:         "no client certificate",
+			cert:         nil,
+			err:          customerrors.NewErrInvalidToken(),
+			userRepoMock: noUserRepo,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var state tls.ConnectionState
+			if tt.cert != nil {
+				state.VerifiedChains = [][]*x509.Certificate{{tt.cert}}
+			}
+			ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+
+			permissionCacheMock := repoMocks.NewPermissionCacheMock(mc)
+			if tt.err == nil {
+				permissionCacheMock.GetEndpointPermissionsMock.Expect(ctx, endpoint).Return([]*model.Permission{{Endpoint: endpoint, Role: "USER"}}, nil)
+			}
+
+			service := access.NewAccessService(access.Deps{
+				UserRepo:        tt.userRepoMock(mc, ctx),
+				PermissionCache: permissionCacheMock,
+				Decisions:       decisionRecorderMock(mc),
+				AuthConfig:      cfg,
+				TLSConfig:       tlsCfg,
+			})
+
+			grant, err := service.Check(ctx, endpoint)
+			require.Equal(t, tt.err, err)
+			if tt.err == nil {
+				require.Equal(t, username, grant.Claims.Username)
+			}
+		})
+	}
+}
+
+func TestCheckEffectiveRoles(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		mc       = minimock.NewController(t)
+		cfg      = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		endpoint = "/chat_v1.ChatV1/Delete"
+		user     = model.User{Username: gofakeit.Username(), Role: "USER", Roles: []string{"MODERATOR", "SUPPORT"}}
+	)
+
+	tests := []struct {
+		name string
+		role string
+		err  error
+	}{
+		{
+			name: "primary role permitted",
+			role: "USER",
+			err:  nil,
+		},
+		{
+			name: "additional role permitted",
+			role: "SUPPORT",
+			err:  nil,
+		},
+		{
+			name: "no effective role permitted",
+			role: "ADMIN",
+			err:  customerrors.NewErrForbiddenReason("no permission for roles USER, MODERATOR, SUPPORT"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			permissionCacheMock := repoMocks.NewPermissionCacheMock(mc)
+			permissionCacheMock.GetEndpointPermissionsMock.Return([]*model.Permission{{Endpoint: endpoint, Role: tt.role}}, nil)
+
+			token, err := utils.GenerateToken(user, []byte(cfg.TokenSecretKey), time.Hour)
+			require.NoError(t, err)
+			ctx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+
+			service := access.NewAccessService(access.Deps{
+				PermissionCache: permissionCacheMock,
+				Decisions:       decisionRecorderMock(mc),
+				AuthConfig:      cfg,
+			})
+
+			grant, err := service.Check(ctx, endpoint)
+			require.Equal(t, tt.err, err)
+			if tt.err == nil {
+				require.Equal(t, []string{"USER", "MODERATOR", "SUPPORT"}, grant.Claims.Roles)
+				require.Equal(t, &model.Permission{Endpoint: endpoint, Role: tt.role}, grant.Permission)
+			}
+		})
+	}
+}
+
+func TestCheckConditions(t *testing.T) {
+	t.Parallel()
+	type userRepoMockFunc func(mc *minimock.Controller) repository.UserRepository
+
+	var (
+		ctx      = context.Background()
+		mc       = minimock.NewController(t)
+		cfg      = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		endpoint = "/chat_v1.ChatV1/Create"
+		user     = model.User{ID: gofakeit.Int64(), Username: gofakeit.Username(), Role: "USER"}
+		ourEmail = `user.email.endsWith("@example.com")`
+
+		userRepoMock = func(email string) userRepoMockFunc {
+			return func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, filter.UserFilter{ID: &user.ID}).
+					Return(&model.User{ID: user.ID, Username: user.Username, Email: email, Role: user.Role}, nil)
+				return mock
+			}
+		}
+	)
+
+	tests := []struct {
+		name         string
+		permissions  []*model.Permission
+		err          error
+		userRepoMock userRepoMockFunc
+	}{
+		{
+			name:         "condition holds",
+			permissions:  []*model.Permission{{Endpoint: endpoint, Role: "USER", Condition: ourEmail}},
+			err:          nil,
+			userRepoMock: userRepoMock("alice@example.com"),
+		},
+		{
+			name:         "condition doesn't hold",
+			permissions:  []*model.Permission{{Endpoint: endpoint, Role: "USER", Condition: ourEmail}},
+			err:          customerrors.NewErrForbiddenReason("no permission for roles USER"),
+			userRepoMock: userRepoMock("alice@gmail.com"),
+		},
+		{
+			name: "conditional deny overrides allow",
+			permissions: []*model.Permission{
+				{Endpoint: endpoint, Role: "USER"},
+				{ID: 3, Endpoint: endpoint, Role: "USER", Effect: model.EffectDeny, Condition: `!` + ourEmail},
+			},
+			err:          customerrors.NewErrForbiddenReason("denied by permission 3 (/chat_v1.ChatV1/Create for role USER)"),
+			userRepoMock: userRepoMock("alice@gmail.com"),
+		},
+		{
+			name: "deny failing to evaluate applies",
+			permissions: []*model.Permission{
+				{Endpoint: endpoint, Role: "USER"},
+				{ID: 4, Endpoint: endpoint, Role: "USER", Effect: model.EffectDeny, Condition: `request.metadata["x-tenant"] != "acme"`},
+			},
+			err: customerrors.NewErrForbiddenReason("denied by permission 4 (/chat_v1.ChatV1/Create for role USER)"),
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+		},
+		{
+			name:        "conditions of other roles aren't evaluated",
+			permissions: []*model.Permission{{Endpoint: endpoint, Role: "USER"}, {Endpoint: endpoint, Role: "ADMIN", Condition: ourEmail}},
+			err:         nil,
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			permissionCacheMock := repoMocks.NewPermissionCacheMock(mc)
+			permissionCacheMock.GetEndpointPermissionsMock.Return(tt.permissions, nil)
+
+			token, err := utils.GenerateToken(user, []byte(cfg.TokenSecretKey), time.Hour)
+			require.NoError(t, err)
+			ctx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+
+			service := access.NewAccessService(access.Deps{
+				UserRepo:        tt.userRepoMock(mc),
+				PermissionCache: permissionCacheMock,
+				Decisions:       decisionRecorderMock(mc),
+				AuthConfig:      cfg,
+			})
+
+			_, err = service.Check(ctx, endpoint)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	decisionFilter "github.com/mikhailsoldatkin/auth/internal/repository/decision/filter"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/access"
+	serviceMocks "github.com/mikhailsoldatkin/auth/internal/service/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+func decisionRecorderMock(mc *minimock.Controller) *serviceMocks.DecisionRecorderMock {
+	mock := serviceMocks.NewDecisionRecorderMock(mc)
+	mock.RecordMock.Optional().Return()
+	return mock
+}
+
+func TestCheckRecordsDecision(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		mc       = minimock.NewController(t)
+		cfg      = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		endpoint = "/chat_v1.ChatV1/Delete"
+		user     = model.User{ID: 7, Username: "oncall", Role: "USER"}
+	)
+
+	tests := []struct {
+		name        string
+		permissions []*model.Permission
+		withToken   bool
+		loadErr     error
+		expected    *model.DecisionEvent
+	}{
+		{
+			name:        "allowed",
+			permissions: []*model.Permission{{ID: 3, Endpoint: "/chat_v1.ChatV1/*", Role: "USER", Effect: model.EffectAllow}},
+			withToken:   true,
+			expected: &model.DecisionEvent{
+				UserID:       user.ID,
+				Username:     user.Username,
+				Decision:     model.DecisionAllow,
+				PermissionID: 3,
+				MatchedRule:  "allow /chat_v1.ChatV1/* for role USER",
+			},
+		},
+		{
+			name: "denied by a deny permission",
+			permissions: []*model.Permission{
+				{ID: 3, Endpoint: "/chat_v1.ChatV1/*", Role: "USER", Effect: model.EffectAllow},
+				{ID: 4, Endpoint: endpoint, Role: "USER", Effect: model.EffectDeny},
+			},
+			withToken: true,
+			expected: &model.DecisionEvent{
+				UserID:       user.ID,
+				Username:     user.Username,
+				Decision:     model.DecisionDeny,
+				PermissionID: 4,
+				MatchedRule:  "deny /chat_v1.ChatV1/Delete for role USER",
+				Reason:       "denied by permission 4 (/chat_v1.ChatV1/Delete for role USER)",
+			},
+		},
+		{
+			name: "no token",
+			expected: &model.DecisionEvent{
+				Decision: model.DecisionUnauthenticated,
+				Reason:   "invalid token",
+			},
+		},
+		{
+			name:      "permissions not loaded",
+			loadErr:   errors.New("connection refused"),
+			withToken: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			md := metadata.Pairs("x-request-id", "req-42")
+			if tt.withToken {
+				token, err := utils.GenerateToken(user, []byte(cfg.TokenSecretKey), time.Hour)
+				require.NoError(t, err)
+				md.Set("authorization", "Bearer "+token)
+			}
+			reqCtx := metadata.NewIncomingContext(ctx, md)
+
+			permissionCacheMock := repoMocks.NewPermissionCacheMock(mc)
+			if tt.permissions != nil || tt.loadErr != nil {
+				permissionCacheMock.GetEndpointPermissionsMock.Return(tt.permissions, tt.loadErr)
+			}
+
+			// failures to decide are not recorded, an unexpected call fails the test
+			recorderMock := serviceMocks.NewDecisionRecorderMock(mc)
+			if tt.expected != nil {
+				recorderMock.RecordMock.Set(func(_ context.Context, event *model.DecisionEvent) {
+					require.GreaterOrEqual(t, event.Latency, time.Duration(0))
+					require.False(t, event.CreatedAt.IsZero())
+					event.Latency, event.CreatedAt = 0, time.Time{}
+
+					expected := *tt.expected
+					expected.Endpoint = endpoint
+					expected.RequestID = "req-42"
+					require.Equal(t, &expected, event)
+				})
+			}
+
+			service := access.NewAccessService(access.Deps{
+				PermissionCache: permissionCacheMock,
+				Decisions:       recorderMock,
+				AuthConfig:      cfg,
+			})
+
+			_, err := service.Check(reqCtx, endpoint)
+			if tt.loadErr != nil {
+				require.ErrorIs(t, err, tt.loadErr)
+			}
+		})
+	}
+}
+
+func TestListDecisions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		mc       = minimock.NewController(t)
+		cfg      = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		now      = time.Now()
+		decision = &model.DecisionEvent{ID: 1, UserID: 7, Endpoint: "/chat_v1.ChatV1/Delete", Decision: model.DecisionDeny}
+	)
+
+	tests := []struct {
+		name     string
+		filter   decisionFilter.DecisionFilter
+		expected decisionFilter.DecisionFilter
+		err      error
+	}{
+		{
+			name:     "default limit",
+			filter:   decisionFilter.DecisionFilter{UserID: 7, From: now.Add(-time.Hour), To: now},
+			expected: decisionFilter.DecisionFilter{UserID: 7, From: now.Add(-time.Hour), To: now, Limit: 100},
+		},
+		{
+			name:   "empty time range",
+			filter: decisionFilter.DecisionFilter{From: now, To: now},
+			err:    customerrors.NewErrInvalidArgument("from must be before to"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			decisionRepoMock := repoMocks.NewDecisionRepositoryMock(mc)
+			if tt.err == nil {
+				decisionRepoMock.ListMock.Expect(minimock.AnyContext, tt.expected).Return([]*model.DecisionEvent{decision}, nil)
+			}
+
+			service := access.NewAccessService(access.Deps{
+				DecisionRepo:    decisionRepoMock,
+				PermissionCache: adminOnlyMock(mc, pb.AccessV1_ListDecisions_FullMethodName),
+				Decisions:       decisionRecorderMock(mc),
+				AuthConfig:      cfg,
+			})
+
+			decisions, err := service.ListDecisions(bearerContext(t, ctx, cfg, "ADMIN"), tt.filter)
+			require.Equal(t, tt.err, err)
+			if tt.err == nil {
+				require.Equal(t, []*model.DecisionEvent{decision}, decisions)
+			}
+		})
+	}
+}
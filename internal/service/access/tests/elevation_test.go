@@ -0,0 +1,275 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/access"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+type noOpTxManager struct{}
+
+func (noOpTxManager) ReadCommitted(ctx context.Context, f db.Handler) error {
+	return f(ctx)
+}
+
+func userContext(t *testing.T, ctx context.Context, cfg config.Auth, user model.User) context.Context {
+	t.Helper()
+
+	token, err := utils.GenerateToken(user, []byte(cfg.TokenSecretKey), time.Hour)
+	require.NoError(t, err)
+
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestRequestElevation(t *testing.T) {
+	t.Parallel()
+	type roleRepoMockFunc func(mc *minimock.Controller) repository.RoleRepository
+	type elevationRepoMockFunc func(mc *minimock.Controller) repository.ElevationRepository
+
+	var (
+		ctx       = context.Background()
+		mc        = minimock.NewController(t)
+		cfg       = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		grantsCfg = config.RoleGrants{MaxElevationMin: 240}
+		user      = model.User{ID: 7, Username: "oncall", Role: "USER"}
+
+		existingRole = func(mc *minimock.Controller) repository.RoleRepository {
+			mock := repoMocks.NewRoleRepositoryMock(mc)
+			mock.GetMock.Set(func(_ context.Context, name string) (*model.Role, error) {
+				return &model.Role{Name: name}, nil
+			})
+			return mock
+		}
+		noElevations = func(mc *minimock.Controller) repository.ElevationRepository {
+			return repoMocks.NewElevationRepositoryMock(mc)
+		}
+	)
+
+	tests := []struct {
+		name              string
+		request           *model.ElevationRequest
+		id                int64
+		err               error
+		roleRepoMock      roleRepoMockFunc
+		elevationRepoMock elevationRepoMockFunc
+	}{
+		{
+			name:         "request stored for the caller",
+			request:      &model.ElevationRequest{Role: "ADMIN", Reason: "incident 42", Duration: 2 * time.Hour},
+			id:           3,
+			roleRepoMock: existingRole,
+			elevationRepoMock: func(mc *minimock.Controller) repository.ElevationRepository {
+				mock := repoMocks.NewElevationRepositoryMock(mc)
+				mock.CreateMock.Expect(minimock.AnyContext, &model.ElevationRequest{
+					UserID:   user.ID,
+					Role:     "ADMIN",
+					Reason:   "incident 42",
+					Duration: 2 * time.Hour,
+				}).Return(3, nil)
+				return mock
+			},
+		},
+		{
+			name:    "duration over the maximum",
+			request: &model.ElevationRequest{Role: "ADMIN", Reason: "incident 42", Duration: 5 * time.Hour},
+			err:     customerrors.NewErrInvalidArgument("duration must be between 1m and 4h0m0s"),
+			roleRepoMock: func(mc *minimock.Controller) repository.RoleRepository {
+				return repoMocks.NewRoleRepositoryMock(mc)
+			},
+			elevationRepoMock: noElevations,
+		},
+		{
+			name:              "role already granted",
+			request:           &model.ElevationRequest{Role: "USER", Reason: "incident 42", Duration: time.Hour},
+			err:               customerrors.NewErrInvalidArgument("role USER is already granted"),
+			roleRepoMock:      existingRole,
+			elevationRepoMock: noElevations,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			permissionCacheMock := repoMocks.NewPermissionCacheMock(mc)
+			permissionCacheMock.GetEndpointPermissionsMock.Return([]*model.Permission{
+				{Endpoint: pb.AccessV1_RequestElevation_FullMethodName, Role: "USER"},
+			}, nil)
+
+			service := access.NewAccessService(access.Deps{
+				RoleRepo:        tt.roleRepoMock(mc),
+				ElevationRepo:   tt.elevationRepoMock(mc),
+				PermissionCache: permissionCacheMock,
+				Decisions:       decisionRecorderMock(mc),
+				TxManager:       noOpTxManager{},
+				AuthConfig:      cfg,
+				GrantsConfig:    grantsCfg,
+			})
+
+			id, err := service.RequestElevation(userContext(t, ctx, cfg, user), tt.request)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.id, id)
+		})
+	}
+}
+
+func TestApproveElevation(t *testing.T) {
+	t.Parallel()
+	type roleRepoMockFunc func(mc *minimock.Controller) repository.RoleRepository
+	type elevationRepoMockFunc func(mc *minimock.Controller) repository.ElevationRepository
+
+	var (
+		ctx      = context.Background()
+		mc       = minimock.NewController(t)
+		cfg      = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		approver = model.User{ID: 1, Username: "lead", Role: "ADMIN"}
+		request  = &model.ElevationRequest{ID: 3, UserID: 7, Role: "ADMIN", Duration: 2 * time.Hour, Status: model.ElevationPending}
+
+		pendingRequest = func(request *model.ElevationRequest, approveErr error) elevationRepoMockFunc {
+			return func(mc *minimock.Controller) repository.ElevationRepository {
+				mock := repoMocks.NewElevationRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, request.ID).Return(request, nil)
+				if request.UserID != approver.ID {
+					mock.ApproveMock.Set(func(_ context.Context, id, approverID int64, _ time.Time) error {
+						require.Equal(t, request.ID, id)
+						require.Equal(t, approver.ID, approverID)
+						return approveErr
+					})
+				}
+				return mock
+			}
+		}
+		noGrants = func(mc *minimock.Controller) repository.RoleRepository {
+			return repoMocks.NewRoleRepositoryMock(mc)
+		}
+	)
+
+	tests := []struct {
+		name              string
+		id                int64
+		err               error
+		roleRepoMock      roleRepoMockFunc
+		elevationRepoMock elevationRepoMockFunc
+	}{
+		{
+			name: "role granted for the requested duration",
+			id:   request.ID,
+			roleRepoMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.AssignToUserMock.Set(func(_ context.Context, grant *model.RoleGrant) error {
+					require.Equal(t, request.UserID, grant.UserID)
+					require.Equal(t, request.Role, grant.Role)
+					require.Equal(t, request.Duration, grant.ValidUntil.Sub(grant.ValidFrom))
+					return nil
+				})
+				return mock
+			},
+			elevationRepoMock: pendingRequest(request, nil),
+		},
+		{
+			name:              "request approved by the requester",
+			id:                4,
+			err:               customerrors.NewErrForbiddenReason("elevation requests can't be approved by the requester"),
+			roleRepoMock:      noGrants,
+			elevationRepoMock: pendingRequest(&model.ElevationRequest{ID: 4, UserID: approver.ID, Role: "ADMIN", Duration: time.Hour}, nil),
+		},
+		{
+			name:              "request already approved",
+			id:                request.ID,
+			err:               customerrors.NewErrInvalidArgument("elevation request is not pending"),
+			roleRepoMock:      noGrants,
+			elevationRepoMock: pendingRequest(request, customerrors.NewErrInvalidArgument("elevation request is not pending")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := access.NewAccessService(access.Deps{
+				RoleRepo:        tt.roleRepoMock(mc),
+				ElevationRepo:   tt.elevationRepoMock(mc),
+				PermissionCache: adminOnlyMock(mc, pb.AccessV1_ApproveElevation_FullMethodName),
+				Decisions:       decisionRecorderMock(mc),
+				TxManager:       noOpTxManager{},
+				AuthConfig:      cfg,
+			})
+
+			grant, err := service.ApproveElevation(userContext(t, ctx, cfg, approver), tt.id)
+			require.Equal(t, tt.err, err)
+			if tt.err == nil {
+				require.Equal(t, request.Role, grant.Role)
+			}
+		})
+	}
+}
+
+func TestCheckTemporaryRole(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		mc       = minimock.NewController(t)
+		cfg      = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		endpoint = pb.AccessV1_ListRoles_FullMethodName
+	)
+
+	tests := []struct {
+		name       string
+		validUntil time.Time
+		allowed    bool
+	}{
+		{
+			name:       "grant still valid",
+			validUntil: time.Now().Add(time.Hour),
+			allowed:    true,
+		},
+		{
+			name:       "grant expired after the token was issued",
+			validUntil: time.Now().Add(-time.Minute),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := access.NewAccessService(access.Deps{
+				PermissionCache: adminOnlyMock(mc, endpoint),
+				Decisions:       decisionRecorderMock(mc),
+				TxManager:       noOpTxManager{},
+				AuthConfig:      cfg,
+			})
+
+			user := model.User{
+				ID:         7,
+				Username:   "oncall",
+				Role:       "USER",
+				Roles:      []string{"ADMIN"},
+				RolesUntil: map[string]time.Time{"ADMIN": tt.validUntil},
+			}
+
+			_, err := service.Check(userContext(t, ctx, cfg, user), endpoint)
+			if tt.allowed {
+				require.NoError(t, err)
+				return
+			}
+			require.Equal(t, customerrors.NewErrForbiddenReason("no permission for roles USER"), err)
+		})
+	}
+}
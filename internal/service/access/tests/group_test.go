@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/access"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+func TestAssignGroupRole(t *testing.T) {
+	t.Parallel()
+	type groupRepoMockFunc func(mc *minimock.Controller) repository.GroupRepository
+	type roleRepoMockFunc func(mc *minimock.Controller) repository.RoleRepository
+
+	var (
+		ctx     = context.Background()
+		mc      = minimock.NewController(t)
+		cfg     = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		groupID = gofakeit.Int64()
+		role    = "MODERATOR"
+	)
+
+	tests := []struct {
+		name          string
+		err           error
+		groupRepoMock groupRepoMockFunc
+		roleRepoMock  roleRepoMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			groupRepoMock: func(mc *minimock.Controller) repository.GroupRepository {
+				mock := repoMocks.NewGroupRepositoryMock(mc)
+				mock.GetMock.Return(&model.Group{ID: groupID}, nil)
+				mock.AssignRoleMock.Expect(minimock.AnyContext, groupID, role).Return(nil)
+				return mock
+			},
+			roleRepoMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.GetMock.Return(&model.Role{Name: role}, nil)
+				return mock
+			},
+		},
+		{
+			name: "group not found",
+			err:  customerrors.NewErrNotFound("group", groupID),
+			groupRepoMock: func(mc *minimock.Controller) repository.GroupRepository {
+				mock := repoMocks.NewGroupRepositoryMock(mc)
+				mock.GetMock.Return(nil, customerrors.NewErrNotFound("group", groupID))
+				return mock
+			},
+			roleRepoMock: func(mc *minimock.Controller) repository.RoleRepository {
+				return repoMocks.NewRoleRepositoryMock(mc)
+			},
+		},
+		{
+			name: "unknown role",
+			err:  customerrors.NewErrInvalidArgument(`unknown role "MODERATOR"`),
+			groupRepoMock: func(mc *minimock.Controller) repository.GroupRepository {
+				mock := repoMocks.NewGroupRepositoryMock(mc)
+				mock.GetMock.Return(&model.Group{ID: groupID}, nil)
+				return mock
+			},
+			roleRepoMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.GetMock.Return(nil, customerrors.NewErrNotFound("role", role))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := access.NewAccessService(access.Deps{
+				RoleRepo:        tt.roleRepoMock(mc),
+				GroupRepo:       tt.groupRepoMock(mc),
+				PermissionCache: adminOnlyMock(mc, pb.AccessV1_AssignGroupRole_FullMethodName),
+				Decisions:       decisionRecorderMock(mc),
+				AuthConfig:      cfg,
+			})
+
+			err := service.AssignGroupRole(bearerContext(t, ctx, cfg, "ADMIN"), groupID, role)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/access"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+func TestCreatePermission(t *testing.T) {
+	t.Parallel()
+	type permissionRepoMockFunc func(mc *minimock.Controller) repository.PermissionRepository
+	type roleRepoMockFunc func(mc *minimock.Controller) repository.RoleRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+		cfg = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		id  = gofakeit.Int64()
+
+		noCallsMock = func(mc *minimock.Controller) repository.PermissionRepository {
+			return repoMocks.NewPermissionRepositoryMock(mc)
+		}
+		noRoleCallsMock = func(mc *minimock.Controller) repository.RoleRepository {
+			return repoMocks.NewRoleRepositoryMock(mc)
+		}
+	)
+
+	tests := []struct {
+		name               string
+		role               string
+		permission         *model.Permission
+		want               int64
+		err                error
+		permissionRepoMock permissionRepoMockFunc
+		roleRepoMock       roleRepoMockFunc
+	}{
+		{
+			name:       "success case",
+			role:       "ADMIN",
+			permission: &model.Permission{Endpoint: "/chat_v1.ChatV1/Get", Role: "USER"},
+			want:       id,
+			err:        nil,
+			permissionRepoMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repoMocks.NewPermissionRepositoryMock(mc)
+				mock.CreateMock.Return(id, nil)
+				return mock
+			},
+			roleRepoMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.GetMock.Return(&model.Role{Name: "USER"}, nil)
+				return mock
+			},
+		},
+		{
+			name:       "sub-endpoint",
+			role:       "ADMIN",
+			permission: &model.Permission{Endpoint: "/user_v1.UserV1/Update/Role", Role: "ADMIN"},
+			want:       id,
+			err:        nil,
+			permissionRepoMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repoMocks.NewPermissionRepositoryMock(mc)
+				mock.CreateMock.Return(id, nil)
+				return mock
+			},
+			roleRepoMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.GetMock.Return(&model.Role{Name: "ADMIN"}, nil)
+				return mock
+			},
+		},
+		{
+			name:               "caller is not admin",
+			role:               "USER",
+			permission:         &model.Permission{Endpoint: "/chat_v1.ChatV1/Get", Role: "USER"},
+			want:               0,
+			err:                customerrors.NewErrForbiddenReason("no permission for roles USER"),
+			permissionRepoMock: noCallsMock,
+			roleRepoMock:       noRoleCallsMock,
+		},
+		{
+			name:               "invalid endpoint",
+			role:               "ADMIN",
+			permission:         &model.Permission{Endpoint: "/chat_v1.ChatV1", Role: "USER"},
+			want:               0,
+			err:                customerrors.NewErrInvalidArgument(`invalid endpoint "/chat_v1.ChatV1"`),
+			permissionRepoMock: noCallsMock,
+			roleRepoMock:       noRoleCallsMock,
+		},
+		{
+			name:       "relation checks of any user",
+			role:       "ADMIN",
+			permission: &model.Permission{Endpoint: "/access_v1.AccessV1/CheckRelation/AnyUser", Role: "AUDITOR"},
+			want:       id,
+			err:        nil,
+			permissionRepoMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repoMocks.NewPermissionRepositoryMock(mc)
+				mock.CreateMock.Return(id, nil)
+				return mock
+			},
+			roleRepoMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.GetMock.Return(&model.Role{Name: "AUDITOR"}, nil)
+				return mock
+			},
+		},
+		{
+			name:               "nested sub-endpoint",
+			role:               "ADMIN",
+			permission:         &model.Permission{Endpoint: "/user_v1.UserV1/Update/Role/Name", Role: "USER"},
+			want:               0,
+			err:                customerrors.NewErrInvalidArgument(`invalid endpoint "/user_v1.UserV1/Update/Role/Name"`),
+			permissionRepoMock: noCallsMock,
+			roleRepoMock:       noRoleCallsMock,
+		},
+		{
+			name:               "admin denied permission management",
+			role:               "ADMIN",
+			permission:         &model.Permission{Endpoint: "/access_v1.AccessV1/*", Role: "ADMIN", Effect: model.EffectDeny},
+			want:               0,
+			err:                customerrors.NewErrInvalidArgument("admins can't be denied permission management"),
+			permissionRepoMock: noCallsMock,
+			roleRepoMock:       noRoleCallsMock,
+		},
+		{
+			name:               "condition not a bool",
+			role:               "ADMIN",
+			permission:         &model.Permission{Endpoint: "/chat_v1.ChatV1/Get", Role: "USER", Condition: "now + duration('1h')"},
+			want:               0,
+			err:                customerrors.NewErrInvalidArgument("invalid condition: condition must evaluate to bool, not google.protobuf.Timestamp"),
+			permissionRepoMock: noCallsMock,
+			roleRepoMock:       noRoleCallsMock,
+		},
+		{
+			name:               "unknown role",
+			role:               "ADMIN",
+			permission:         &model.Permission{Endpoint: "/chat_v1.ChatV1/Get", Role: "GHOST"},
+			want:               0,
+			err:                customerrors.NewErrInvalidArgument(`unknown role "GHOST"`),
+			permissionRepoMock: noCallsMock,
+			roleRepoMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.GetMock.Return(nil, customerrors.NewErrNotFound("role", "GHOST"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			permissionCacheMock := adminOnlyMock(mc, pb.AccessV1_CreatePermission_FullMethodName)
+			if tt.err == nil {
+				permissionCacheMock.InvalidateMock.Return(nil)
+			}
+			service := access.NewAccessService(access.Deps{
+				PermissionRepo:  tt.permissionRepoMock(mc),
+				RoleRepo:        tt.roleRepoMock(mc),
+				PermissionCache: permissionCacheMock,
+				Decisions:       decisionRecorderMock(mc),
+				AuthConfig:      cfg,
+			})
+
+			got, err := service.CreatePermission(bearerContext(t, ctx, cfg, tt.role), tt.permission)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDeletePermission(t *testing.T) {
+	t.Parallel()
+	type permissionRepoMockFunc func(mc *minimock.Controller) repository.PermissionRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+		cfg = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		id  = gofakeit.Int64()
+	)
+
+	tests := []struct {
+		name               string
+		err                error
+		permissionRepoMock permissionRepoMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			permissionRepoMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repoMocks.NewPermissionRepositoryMock(mc)
+				mock.GetMock.Return(&model.Permission{ID: id, Endpoint: "/chat_v1.ChatV1/Create", Role: "ADMIN"}, nil)
+				mock.DeleteMock.Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "permission not found",
+			err:  customerrors.NewErrNotFound("permission", id),
+			permissionRepoMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repoMocks.NewPermissionRepositoryMock(mc)
+				mock.GetMock.Return(nil, customerrors.NewErrNotFound("permission", id))
+				return mock
+			},
+		},
+		{
+			name: "admin permission management lockout",
+			err:  customerrors.NewErrInvalidArgument("admin permissions for permission management can't be deleted"),
+			permissionRepoMock: func(mc *minimock.Controller) repository.PermissionRepository {
+				mock := repoMocks.NewPermissionRepositoryMock(mc)
+				mock.GetMock.Return(&model.Permission{ID: id, Endpoint: pb.AccessV1_DeletePermission_FullMethodName, Role: "ADMIN"}, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			permissionCacheMock := adminOnlyMock(mc, pb.AccessV1_DeletePermission_FullMethodName)
+			if tt.err == nil {
+				permissionCacheMock.InvalidateMock.Return(nil)
+			}
+			service := access.NewAccessService(access.Deps{
+				PermissionRepo:  tt.permissionRepoMock(mc),
+				PermissionCache: permissionCacheMock,
+				Decisions:       decisionRecorderMock(mc),
+				AuthConfig:      cfg,
+			})
+
+			err := service.DeletePermission(bearerContext(t, ctx, cfg, "ADMIN"), id)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func adminOnlyMock(mc *minimock.Controller, endpoint string) *repoMocks.PermissionCacheMock {
+	mock := repoMocks.NewPermissionCacheMock(mc)
+	mock.GetEndpointPermissionsMock.Return([]*model.Permission{{Endpoint: endpoint, Role: "ADMIN"}}, nil)
+	return mock
+}
+
+func bearerContext(t *testing.T, ctx context.Context, cfg config.Auth, role string) context.Context {
+	t.Helper()
+
+	token, err := utils.GenerateToken(model.User{Username: gofakeit.Username(), Role: role}, []byte(cfg.TokenSecretKey), time.Hour)
+	require.NoError(t, err)
+
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+}
@@ -0,0 +1,309 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mikhailsoldatkin/auth/internal/client/kafka"
+	kafkaMocks "github.com/mikhailsoldatkin/auth/internal/client/kafka/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/repository/relation/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/access"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// relationCheckers lets users check their own relations and admins check relations of any user.
+var relationCheckers = []*model.Permission{
+	{Endpoint: pb.AccessV1_CheckRelation_FullMethodName, Role: "USER"},
+	{Endpoint: pb.AccessV1_CheckRelation_FullMethodName, Role: "ADMIN"},
+	{Endpoint: pb.AccessV1_CheckRelation_FullMethodName + "/AnyUser", Role: "ADMIN"},
+}
+
+func TestCheckRelation(t *testing.T) {
+	t.Parallel()
+	type relationRepoMockFunc func(mc *minimock.Controller) repository.RelationRepository
+	type groupRepoMockFunc func(mc *minimock.Controller) repository.GroupRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+		cfg = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+
+		userID  = int64(42)
+		user    = model.UserObject(userID)
+		chat    = "chat:1"
+		group   = "group:3"
+		viewers = []string{model.RelationViewer, model.RelationEditor, model.RelationOwner}
+	)
+
+	tests := []struct {
+		name             string
+		relation         string
+		userID           int64
+		allowed          bool
+		err              error
+		relationRepoMock relationRepoMockFunc
+		groupRepoMock    groupRepoMockFunc
+	}{
+		{
+			name:     "direct tuple",
+			relation: model.RelationEditor,
+			userID:   userID,
+			allowed:  true,
+			relationRepoMock: func(mc *minimock.Controller) repository.RelationRepository {
+				mock := repoMocks.NewRelationRepositoryMock(mc)
+				mock.ListMock.Expect(minimock.AnyContext, filter.RelationFilter{
+					Object:    chat,
+					Relations: []string{model.RelationEditor, model.RelationOwner},
+				}).Return([]*model.RelationTuple{{Object: chat, Relation: model.RelationEditor, Subject: user}}, nil)
+				return mock
+			},
+			groupRepoMock: func(mc *minimock.Controller) repository.GroupRepository {
+				return repoMocks.NewGroupRepositoryMock(mc)
+			},
+		},
+		{
+			name:     "owner is a viewer",
+			relation: model.RelationViewer,
+			userID:   userID,
+			allowed:  true,
+			relationRepoMock: func(mc *minimock.Controller) repository.RelationRepository {
+				mock := repoMocks.NewRelationRepositoryMock(mc)
+				mock.ListMock.Expect(minimock.AnyContext, filter.RelationFilter{Object: chat, Relations: viewers}).
+					Return([]*model.RelationTuple{{Object: chat, Relation: model.RelationOwner, Subject: user}}, nil)
+				return mock
+			},
+			groupRepoMock: func(mc *minimock.Controller) repository.GroupRepository {
+				return repoMocks.NewGroupRepositoryMock(mc)
+			},
+		},
+		{
+			name:     "member of a group subject set",
+			relation: model.RelationViewer,
+			userID:   userID,
+			allowed:  true,
+			relationRepoMock: func(mc *minimock.Controller) repository.RelationRepository {
+				mock := repoMocks.NewRelationRepositoryMock(mc)
+				mock.ListMock.Expect(minimock.AnyContext, filter.RelationFilter{Object: chat, Relations: viewers}).
+					Return([]*model.RelationTuple{
+						{Object: chat, Relation: model.RelationViewer, Subject: "user:7"},
+						{Object: chat, Relation: model.RelationViewer, Subject: group + "#" + model.RelationMember},
+					}, nil)
+				return mock
+			},
+			groupRepoMock: func(mc *minimock.Controller) repository.GroupRepository {
+				mock := repoMocks.NewGroupRepositoryMock(mc)
+				mock.HasMemberMock.Expect(minimock.AnyContext, 3, userID).Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name:     "nested subject set",
+			relation: model.RelationViewer,
+			userID:   userID,
+			allowed:  true,
+			relationRepoMock: func(mc *minimock.Controller) repository.RelationRepository {
+				mock := repoMocks.NewRelationRepositoryMock(mc)
+				mock.ListMock.When(minimock.AnyContext, filter.RelationFilter{Object: chat, Relations: viewers}).
+					Then([]*model.RelationTuple{{Object: chat, Relation: model.RelationOwner, Subject: "team:5#" + model.RelationMember}}, nil)
+				mock.ListMock.When(minimock.AnyContext, filter.RelationFilter{Object: "team:5", Relations: []string{model.RelationMember}}).
+					Then([]*model.RelationTuple{{Object: "team:5", Relation: model.RelationMember, Subject: user}}, nil)
+				return mock
+			},
+			groupRepoMock: func(mc *minimock.Controller) repository.GroupRepository {
+				return repoMocks.NewGroupRepositoryMock(mc)
+			},
+		},
+		{
+			name:     "no relation",
+			relation: model.RelationViewer,
+			userID:   userID,
+			allowed:  false,
+			relationRepoMock: func(mc *minimock.Controller) repository.RelationRepository {
+				mock := repoMocks.NewRelationRepositoryMock(mc)
+				mock.ListMock.When(minimock.AnyContext, filter.RelationFilter{Object: chat, Relations: viewers}).
+					Then([]*model.RelationTuple{{Object: chat, Relation: model.RelationViewer, Subject: group + "#" + model.RelationMember}}, nil)
+				mock.ListMock.When(minimock.AnyContext, filter.RelationFilter{Object: group, Relations: []string{model.RelationMember}}).
+					Then(nil, nil)
+				return mock
+			},
+			groupRepoMock: func(mc *minimock.Controller) repository.GroupRepository {
+				mock := repoMocks.NewGroupRepositoryMock(mc)
+				mock.HasMemberMock.Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name:     "caller without user ID",
+			relation: model.RelationViewer,
+			err:      customerrors.NewErrInvalidArgument("user ID required"),
+			relationRepoMock: func(mc *minimock.Controller) repository.RelationRepository {
+				return repoMocks.NewRelationRepositoryMock(mc)
+			},
+			groupRepoMock: func(mc *minimock.Controller) repository.GroupRepository {
+				return repoMocks.NewGroupRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			permissionCacheMock := repoMocks.NewPermissionCacheMock(mc)
+			permissionCacheMock.GetEndpointPermissionsMock.Return(relationCheckers, nil)
+
+			service := access.NewAccessService(access.Deps{
+				GroupRepo:       tt.groupRepoMock(mc),
+				RelationRepo:    tt.relationRepoMock(mc),
+				PermissionCache: permissionCacheMock,
+				Decisions:       decisionRecorderMock(mc),
+				AuthConfig:      cfg,
+			})
+
+			allowed, err := service.CheckRelation(bearerContext(t, ctx, cfg, "ADMIN"), chat, tt.relation, tt.userID)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.allowed, allowed)
+		})
+	}
+}
+
+func TestCheckRelationOfOtherUsers(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc     = minimock.NewController(t)
+		cfg    = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		caller = model.User{ID: 7, Username: gofakeit.Username(), Role: "USER"}
+		chat   = "chat:1"
+	)
+
+	token, err := utils.GenerateToken(caller, []byte(cfg.TokenSecretKey), time.Hour)
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+	tests := []struct {
+		name      string
+		userID    int64
+		allowed   bool
+		forbidden bool
+	}{
+		{
+			name:    "caller",
+			allowed: true,
+		},
+		{
+			name:    "caller by ID",
+			userID:  caller.ID,
+			allowed: true,
+		},
+		{
+			name:      "other user",
+			userID:    42,
+			forbidden: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			relationRepoMock := repoMocks.NewRelationRepositoryMock(mc)
+			relationRepoMock.ListMock.Optional().
+				Return([]*model.RelationTuple{{Object: chat, Relation: model.RelationOwner, Subject: model.UserObject(caller.ID)}}, nil)
+
+			permissionCacheMock := repoMocks.NewPermissionCacheMock(mc)
+			permissionCacheMock.GetEndpointPermissionsMock.Return(relationCheckers, nil)
+
+			service := access.NewAccessService(access.Deps{
+				RelationRepo:    relationRepoMock,
+				PermissionCache: permissionCacheMock,
+				Decisions:       decisionRecorderMock(mc),
+				AuthConfig:      cfg,
+			})
+
+			allowed, err := service.CheckRelation(ctx, chat, model.RelationViewer, tt.userID)
+			if tt.forbidden {
+				var forbidden *customerrors.ErrForbidden
+				require.ErrorAs(t, err, &forbidden)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.allowed, allowed)
+		})
+	}
+}
+
+func TestWriteRelation(t *testing.T) {
+	t.Parallel()
+	type relationRepoMockFunc func(mc *minimock.Controller) repository.RelationRepository
+	type producerMockFunc func(mc *minimock.Controller) kafka.Producer
+
+	var (
+		ctx      = context.Background()
+		mc       = minimock.NewController(t)
+		cfg      = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		kafkaCfg = config.KafkaProducer{RelationsTopic: "relations"}
+		tuple    = &model.RelationTuple{Object: "chat:1", Relation: model.RelationOwner, Subject: "user:42"}
+	)
+
+	tests := []struct {
+		name             string
+		relationRepoMock relationRepoMockFunc
+		producerMock     producerMockFunc
+	}{
+		{
+			name: "new tuple is published",
+			relationRepoMock: func(mc *minimock.Controller) repository.RelationRepository {
+				mock := repoMocks.NewRelationRepositoryMock(mc)
+				mock.CreateMock.Expect(minimock.AnyContext, tuple).Return(true, nil)
+				return mock
+			},
+			producerMock: func(mc *minimock.Controller) kafka.Producer {
+				mock := kafkaMocks.NewProducerMock(mc)
+				mock.ProduceMock.ExpectTopicNameParam2(kafkaCfg.RelationsTopic).ExpectKeyParam3([]byte(tuple.Object)).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "existing tuple is not published",
+			relationRepoMock: func(mc *minimock.Controller) repository.RelationRepository {
+				mock := repoMocks.NewRelationRepositoryMock(mc)
+				mock.CreateMock.Expect(minimock.AnyContext, tuple).Return(false, nil)
+				return mock
+			},
+			producerMock: func(mc *minimock.Controller) kafka.Producer {
+				return kafkaMocks.NewProducerMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := access.NewAccessService(access.Deps{
+				RelationRepo:    tt.relationRepoMock(mc),
+				PermissionCache: adminOnlyMock(mc, pb.AccessV1_WriteRelation_FullMethodName),
+				Decisions:       decisionRecorderMock(mc),
+				Producer:        tt.producerMock(mc),
+				AuthConfig:      cfg,
+				ProducerConfig:  kafkaCfg,
+			})
+
+			err := service.WriteRelation(bearerContext(t, ctx, cfg, "ADMIN"), tuple)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/access"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+func TestUpdateRole(t *testing.T) {
+	t.Parallel()
+	type roleRepoMockFunc func(mc *minimock.Controller) repository.RoleRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+		cfg = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+
+		// SUPPORT -> MODERATOR -> USER
+		roles = map[string]*model.Role{
+			"USER":      {Name: "USER"},
+			"MODERATOR": {Name: "MODERATOR", Parent: "USER"},
+			"SUPPORT":   {Name: "SUPPORT", Parent: "MODERATOR"},
+		}
+		getRole = func(_ context.Context, name string) (*model.Role, error) {
+			role, ok := roles[name]
+			if !ok {
+				return nil, customerrors.NewErrNotFound("role", name)
+			}
+			return role, nil
+		}
+		parent = func(name string) *string { return &name }
+	)
+
+	tests := []struct {
+		name         string
+		updates      *model.RoleUpdate
+		err          error
+		roleRepoMock roleRepoMockFunc
+	}{
+		{
+			name:    "success case",
+			updates: &model.RoleUpdate{Name: "SUPPORT", Parent: parent("USER")},
+			err:     nil,
+			roleRepoMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.GetMock.Set(getRole)
+				mock.UpdateMock.Return(nil)
+				return mock
+			},
+		},
+		{
+			name:    "detach from parent",
+			updates: &model.RoleUpdate{Name: "SUPPORT", Parent: parent("")},
+			err:     nil,
+			roleRepoMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.UpdateMock.Return(nil)
+				return mock
+			},
+		},
+		{
+			name:    "inheritance cycle",
+			updates: &model.RoleUpdate{Name: "USER", Parent: parent("SUPPORT")},
+			err:     customerrors.NewErrInvalidArgument("role inheritance cycle"),
+			roleRepoMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.GetMock.Set(getRole)
+				return mock
+			},
+		},
+		{
+			name:    "unknown parent",
+			updates: &model.RoleUpdate{Name: "SUPPORT", Parent: parent("GHOST")},
+			err:     customerrors.NewErrInvalidArgument(`unknown role "GHOST"`),
+			roleRepoMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.GetMock.Set(getRole)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			permissionCacheMock := adminOnlyMock(mc, pb.AccessV1_UpdateRole_FullMethodName)
+			if tt.err == nil {
+				permissionCacheMock.InvalidateMock.Return(nil)
+			}
+			service := access.NewAccessService(access.Deps{
+				RoleRepo:        tt.roleRepoMock(mc),
+				PermissionCache: permissionCacheMock,
+				Decisions:       decisionRecorderMock(mc),
+				AuthConfig:      cfg,
+			})
+
+			err := service.UpdateRole(bearerContext(t, ctx, cfg, "ADMIN"), tt.updates)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestDeleteRole(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+		cfg = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+	)
+
+	tests := []struct {
+		name     string
+		roleName string
+		err      error
+	}{
+		{
+			name:     "success case",
+			roleName: "MODERATOR",
+			err:      nil,
+		},
+		{
+			name:     "built-in role",
+			roleName: "ADMIN",
+			err:      customerrors.NewErrInvalidArgument("built-in roles can't be deleted"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			roleRepoMock := repoMocks.NewRoleRepositoryMock(mc)
+			permissionCacheMock := adminOnlyMock(mc, pb.AccessV1_DeleteRole_FullMethodName)
+			if tt.err == nil {
+				roleRepoMock.DeleteMock.Expect(minimock.AnyContext, tt.roleName).Return(nil)
+				permissionCacheMock.InvalidateMock.Return(nil)
+			}
+
+			service := access.NewAccessService(access.Deps{
+				RoleRepo:        roleRepoMock,
+				PermissionCache: permissionCacheMock,
+				Decisions:       decisionRecorderMock(mc),
+				AuthConfig:      cfg,
+			})
+
+			err := service.DeleteRole(bearerContext(t, ctx, cfg, "ADMIN"), tt.roleName)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,282 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/access"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+func TestSimulate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		mc      = minimock.NewController(t)
+		cfg     = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		pattern = "/chat_v1.ChatV1/*"
+		exact   = "/chat_v1.ChatV1/Delete"
+		current = []*model.Permission{
+			{ID: 1, Endpoint: pattern, Role: "USER", Effect: model.EffectAllow},
+			{ID: 2, Endpoint: exact, Role: "ADMIN", Effect: model.EffectAllow},
+		}
+		roles = []*model.Role{{Name: "ADMIN"}, {Name: "SUPPORT", Parent: "USER"}, {Name: "USER"}}
+		sets  = []*model.RoleSet{
+			{Roles: []string{"USER"}, Users: 5},
+			{Roles: []string{"SUPPORT", "USER"}, Users: 2},
+			{Roles: []string{"ADMIN", "USER"}, Users: 1},
+			{Roles: []string{"ADMIN"}, Users: 1},
+		}
+	)
+
+	tests := []struct {
+		name     string
+		changes  []*model.PolicyChange
+		expected []*model.SimulationResult
+		err      error
+	}{
+		{
+			name: "pattern revoked from a parent role",
+			changes: []*model.PolicyChange{
+				{Action: model.PolicyDelete, Permission: &model.Permission{Endpoint: pattern, Role: "USER"}},
+			},
+			expected: []*model.SimulationResult{
+				{Endpoint: pattern, GainedRoles: []string{}, LostRoles: []string{"SUPPORT", "USER"}, UsersLosing: 8},
+				{Endpoint: exact, GainedRoles: []string{}, LostRoles: []string{"SUPPORT", "USER"}, UsersLosing: 7},
+			},
+		},
+		{
+			name: "endpoint denied to a child role",
+			changes: []*model.PolicyChange{
+				{Action: model.PolicyCreate, Permission: &model.Permission{Endpoint: exact, Role: "SUPPORT", Effect: model.EffectDeny}},
+			},
+			expected: []*model.SimulationResult{
+				{Endpoint: exact, GainedRoles: []string{}, LostRoles: []string{"SUPPORT"}, UsersLosing: 2},
+			},
+		},
+		{
+			name: "endpoint granted to a role",
+			changes: []*model.PolicyChange{
+				{Action: model.PolicyCreate, Permission: &model.Permission{Endpoint: "/chat_v1.ChatV1/*", Role: "ADMIN"}},
+			},
+			expected: []*model.SimulationResult{
+				{Endpoint: pattern, GainedRoles: []string{"ADMIN"}, LostRoles: []string{}, UsersGaining: 1},
+				{Endpoint: exact, GainedRoles: []string{}, LostRoles: []string{}},
+			},
+		},
+		{
+			name: "sub-endpoint granted to a role",
+			changes: []*model.PolicyChange{
+				{Action: model.PolicyCreate, Permission: &model.Permission{Endpoint: "/user_v1.UserV1/Update/Role", Role: "SUPPORT"}},
+			},
+			expected: []*model.SimulationResult{
+				{Endpoint: "/user_v1.UserV1/Update/Role", GainedRoles: []string{"SUPPORT"}, LostRoles: []string{}, UsersGaining: 2},
+			},
+		},
+		{
+			name: "missing permission updated",
+			changes: []*model.PolicyChange{
+				{Action: model.PolicyUpdate, Permission: &model.Permission{Endpoint: exact, Role: "USER", MaxAuthAgeMin: 5}},
+			},
+			err: customerrors.NewErrInvalidArgument("permission of /chat_v1.ChatV1/Delete for role USER doesn't exist"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			permissionRepoMock := repoMocks.NewPermissionRepositoryMock(mc)
+			permissionRepoMock.ListMock.Return(current, nil)
+
+			roleRepoMock := repoMocks.NewRoleRepositoryMock(mc)
+			roleRepoMock.GetMock.Optional().Set(func(_ context.Context, name string) (*model.Role, error) {
+				return &model.Role{Name: name}, nil
+			})
+			if tt.err == nil {
+				roleRepoMock.ListMock.Return(roles, nil)
+				roleRepoMock.CountUsersByRolesMock.Return(sets, nil)
+			}
+
+			service := access.NewAccessService(access.Deps{
+				PermissionRepo:  permissionRepoMock,
+				RoleRepo:        roleRepoMock,
+				PermissionCache: adminOnlyMock(mc, pb.AccessV1_Simulate_FullMethodName),
+				Decisions:       decisionRecorderMock(mc),
+				AuthConfig:      cfg,
+			})
+
+			results, err := service.Simulate(bearerContext(t, ctx, cfg, "ADMIN"), tt.changes)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.expected, results)
+		})
+	}
+}
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		mc       = minimock.NewController(t)
+		cfg      = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		endpoint = "/chat_v1.ChatV1/Delete"
+		user     = &model.User{ID: 7, Username: "oncall", Role: "USER", Roles: []string{"SUPPORT"}}
+
+		allowUsers   = &model.Permission{ID: 1, Endpoint: "/chat_v1.ChatV1/*", Role: "USER", Effect: model.EffectAllow}
+		denyOncall   = &model.Permission{ID: 3, Endpoint: endpoint, Role: "SUPPORT", Effect: model.EffectDeny, Condition: `principal.username == "oncall"`}
+		allowAdmins  = &model.Permission{ID: 2, Endpoint: endpoint, Role: "ADMIN", Effect: model.EffectAllow}
+		otherService = &model.Permission{ID: 4, Endpoint: "/user_v1.UserV1/*", Role: "USER", Effect: model.EffectAllow}
+	)
+
+	userRepoMock := repoMocks.NewUserRepositoryMock(mc)
+	userRepoMock.GetMock.Expect(minimock.AnyContext, filter.UserFilter{ID: &user.ID}).Return(user, nil)
+
+	permissionCacheMock := repoMocks.NewPermissionCacheMock(mc)
+	permissionCacheMock.GetEndpointPermissionsMock.Set(func(_ context.Context, e string) ([]*model.Permission, error) {
+		if e == pb.AccessV1_Explain_FullMethodName {
+			return []*model.Permission{{Endpoint: e, Role: "ADMIN"}}, nil
+		}
+		require.Equal(t, endpoint, e)
+		return []*model.Permission{allowUsers, denyOncall, allowAdmins, otherService}, nil
+	})
+
+	service := access.NewAccessService(access.Deps{
+		UserRepo:        userRepoMock,
+		PermissionCache: permissionCacheMock,
+		Decisions:       decisionRecorderMock(mc),
+		AuthConfig:      cfg,
+	})
+
+	explanation, err := service.Explain(bearerContext(t, ctx, cfg, "ADMIN"), user.ID, endpoint)
+	require.NoError(t, err)
+	require.Equal(t, &model.Explanation{
+		UserID:   user.ID,
+		Username: user.Username,
+		Endpoint: endpoint,
+		Roles:    []string{"USER", "SUPPORT"},
+		Evaluations: []*model.PermissionEvaluation{
+			{Permission: allowUsers, RoleHeld: true, Applicable: true},
+			{Permission: denyOncall, RoleHeld: true, ConditionResult: "true", Applicable: true},
+			{Permission: allowAdmins},
+		},
+		Matched:  denyOncall,
+		Decision: model.DecisionDeny,
+		Reason:   "denied by permission 3 (/chat_v1.ChatV1/Delete for role SUPPORT)",
+	}, explanation)
+}
+
+func TestExplainAgreesWithCheck(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		cfg      = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		endpoint = "/chat_v1.ChatV1/Delete"
+		user     = &model.User{ID: 7, Username: "oncall", Role: "USER", Roles: []string{"SUPPORT"}}
+		allow    = &model.Permission{ID: 1, Endpoint: "/chat_v1.ChatV1/*", Role: "USER", Effect: model.EffectAllow}
+	)
+
+	tests := []struct {
+		name        string
+		permissions []*model.Permission
+		decision    string
+	}{
+		{
+			name:        "allowed by a pattern",
+			permissions: []*model.Permission{allow},
+			decision:    model.DecisionAllow,
+		},
+		{
+			name:        "no permission of the user's roles",
+			permissions: []*model.Permission{{ID: 2, Endpoint: endpoint, Role: "ADMIN", Effect: model.EffectAllow}},
+			decision:    model.DecisionDeny,
+		},
+		{
+			name: "denied by a holding condition",
+			permissions: []*model.Permission{
+				allow,
+				{ID: 3, Endpoint: endpoint, Role: "SUPPORT", Effect: model.EffectDeny, Condition: `principal.username == "oncall"`},
+			},
+			decision: model.DecisionDeny,
+		},
+		{
+			name:        "conditional allow not holding",
+			permissions: []*model.Permission{{ID: 4, Endpoint: endpoint, Role: "USER", Effect: model.EffectAllow, Condition: `principal.username == "other"`}},
+			decision:    model.DecisionDeny,
+		},
+		{
+			name:        "conditional allow failing to evaluate",
+			permissions: []*model.Permission{{ID: 5, Endpoint: endpoint, Role: "USER", Effect: model.EffectAllow, Condition: `principal.missing == 1`}},
+			decision:    model.DecisionDeny,
+		},
+		{
+			name:        "fresh authentication required",
+			permissions: []*model.Permission{{ID: 6, Endpoint: endpoint, Role: "SUPPORT", Effect: model.EffectAllow, MaxAuthAgeMin: 5}},
+			decision:    model.DecisionStepUp,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mc := minimock.NewController(t)
+
+			userRepoMock := repoMocks.NewUserRepositoryMock(mc)
+			userRepoMock.GetMock.Optional().Return(user, nil)
+
+			permissionCacheMock := repoMocks.NewPermissionCacheMock(mc)
+			permissionCacheMock.GetEndpointPermissionsMock.Set(func(_ context.Context, e string) ([]*model.Permission, error) {
+				if e == pb.AccessV1_Explain_FullMethodName {
+					return []*model.Permission{{Endpoint: e, Role: "ADMIN"}}, nil
+				}
+				return tt.permissions, nil
+			})
+
+			service := access.NewAccessService(access.Deps{
+				UserRepo:        userRepoMock,
+				PermissionCache: permissionCacheMock,
+				Decisions:       decisionRecorderMock(mc),
+				AuthConfig:      cfg,
+			})
+
+			explanation, err := service.Explain(bearerContext(t, ctx, cfg, "ADMIN"), user.ID, endpoint)
+			require.NoError(t, err)
+
+			token, err := utils.GenerateToken(*user, []byte(cfg.TokenSecretKey), time.Hour)
+			require.NoError(t, err)
+			grant, err := service.Check(metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token)), endpoint)
+
+			var forbidden *customerrors.ErrForbidden
+			var stepUp *customerrors.ErrStepUpRequired
+			switch {
+			case err == nil:
+				require.Equal(t, model.DecisionAllow, explanation.Decision)
+				require.Equal(t, grant.Permission, explanation.Matched)
+			case errors.As(err, &stepUp):
+				require.Equal(t, model.DecisionStepUp, explanation.Decision)
+				require.Equal(t, stepUp.Error(), explanation.Reason)
+			case errors.As(err, &forbidden):
+				require.Equal(t, model.DecisionDeny, explanation.Decision)
+				require.Equal(t, forbidden.Reason, explanation.Reason)
+			default:
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.decision, explanation.Decision)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// maxRoleDepth limits the length of the inheritance chain walked when looking for cycles.
+const maxRoleDepth = 16
+
+// UpdateRole changes the description or the parent of the role, only admins are allowed to manage roles.
+// A role can't become an ancestor of itself.
+func (a accessService) UpdateRole(ctx context.Context, updates *model.RoleUpdate) error {
+	_, err := a.Check(ctx, pb.AccessV1_UpdateRole_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	if updates.Parent != nil && *updates.Parent != "" {
+		err = a.checkInheritanceCycle(ctx, updates.Name, *updates.Parent)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = a.roleRepo.Update(ctx, updates)
+	if err != nil {
+		return err
+	}
+	if updates.Parent != nil {
+		a.invalidatePermissions(ctx)
+	}
+
+	return nil
+}
+
+// checkInheritanceCycle walks up from the new parent and fails if the chain reaches the role itself.
+func (a accessService) checkInheritanceCycle(ctx context.Context, name, parent string) error {
+	for depth := 0; parent != ""; depth++ {
+		if parent == name || depth == maxRoleDepth {
+			return customerrors.NewErrInvalidArgument("role inheritance cycle")
+		}
+
+		role, err := a.existingRole(ctx, parent)
+		if err != nil {
+			return err
+		}
+		parent = role.Parent
+	}
+
+	return nil
+}
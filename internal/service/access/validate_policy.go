@@ -0,0 +1,18 @@
+package access
+
+import (
+	"context"
+
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// ValidatePolicy parses and type-checks the permission condition and returns the issues found,
+// nothing is returned for a valid condition.
+func (a accessService) ValidatePolicy(ctx context.Context, condition string) ([]string, error) {
+	_, err := a.Check(ctx, pb.AccessV1_ValidatePolicy_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.policies.Validate(condition), nil
+}
@@ -0,0 +1,27 @@
+package access
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+)
+
+// WriteRelation adds the relation tuple and publishes the change, writing an existing tuple is a no-op.
+func (a accessService) WriteRelation(ctx context.Context, tuple *model.RelationTuple) error {
+	grant, err := a.Check(ctx, pb.AccessV1_WriteRelation_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	created, err := a.relationRepo.Create(ctx, tuple)
+	if err != nil {
+		return err
+	}
+
+	if created {
+		a.publishRelationEvent(ctx, model.RelationOperationWrite, tuple, grant.Claims.Username)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+// CreateAPIToken issues a new personal access token for the current user.
+// Scopes must be a subset of endpoints allowed for the user's role.
+// The plain token is returned only once, the service keeps its hash and prefix.
+func (a authService) CreateAPIToken(
+	ctx context.Context,
+	name string,
+	expiresAt time.Time,
+	scopes []string,
+) (string, *model.APIToken, error) {
+	if !expiresAt.After(time.Now()) {
+		return "", nil, customerrors.NewErrInvalidArgument("expiration time must be in the future")
+	}
+
+	user, err := a.currentUser(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for _, scope := range scopes {
+		allowed, errScope := a.roleAllowed(ctx, scope, user)
+		if errScope != nil {
+			return "", nil, errScope
+		}
+		if !allowed {
+			return "", nil, customerrors.NewErrInvalidArgument(fmt.Sprintf("scope %s is not allowed for role %s", scope, user.Role))
+		}
+	}
+
+	plain, prefix, hash, err := utils.GenerateAPIToken()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate API token: %w", err)
+	}
+
+	token := &model.APIToken{
+		UserID:    user.ID,
+		Name:      name,
+		Prefix:    prefix,
+		Hash:      hash,
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+
+	token.ID, err = a.apiTokenRepo.Create(ctx, token)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return plain, token, nil
+}
+
+// ListAPITokens returns personal access tokens of the current user.
+func (a authService) ListAPITokens(ctx context.Context) ([]*model.APIToken, error) {
+	user, err := a.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.apiTokenRepo.List(ctx, user.ID)
+}
+
+// RevokeAPIToken revokes the current user's personal access token by ID.
+func (a authService) RevokeAPIToken(ctx context.Context, id int64) error {
+	user, err := a.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	return a.apiTokenRepo.Revoke(ctx, id, user.ID)
+}
+
+// roleAllowed reports whether any effective role of the user is permitted to access the endpoint,
+// permissions are read through the permission cache the same way the access service does.
+func (a authService) roleAllowed(ctx context.Context, endpoint string, user *model.User) (bool, error) {
+	permissions, err := a.permissionCache.GetEndpointPermissions(ctx, endpoint)
+	if err != nil {
+		return false, fmt.Errorf("failed to get permissions for endpoint: %w", err)
+	}
+
+	permission := utils.MatchPermission(permissions, endpoint, user.HasRole)
+
+	return permission != nil && !permission.IsDeny(), nil
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// authenticate tries the configured providers in order and returns the user accepted by the first of them.
+// Unknown user and wrong password errors let the next provider try, the first such error
+// is returned when no provider accepts the credentials.
+func (a authService) authenticate(ctx context.Context, username, password string) (*model.User, error) {
+	var firstErr error
+	for _, provider := range a.providers {
+		user, err := provider.Authenticate(ctx, username, password)
+		if err == nil {
+			return user, nil
+		}
+
+		if !isCredentialsError(err) {
+			return nil, err
+		}
+
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	if firstErr == nil {
+		return nil, customerrors.NewErrInvalidPassword()
+	}
+
+	return nil, firstErr
+}
+
+// provider returns the configured provider with the given name.
+func (a authService) provider(name string) (service.AuthProvider, bool) {
+	for _, provider := range a.providers {
+		if provider.Name() == name {
+			return provider, true
+		}
+	}
+
+	return nil, false
+}
+
+func isCredentialsError(err error) bool {
+	var errNotFound *customerrors.ErrNotFound
+	var errInvalidPassword *customerrors.ErrInvalidPassword
+
+	return errors.As(err, &errNotFound) || errors.As(err, &errInvalidPassword)
+}
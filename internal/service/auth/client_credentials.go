@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+// ClientCredentials authenticates a service account by its username (client ID) and client secret.
+// If successful, returns an access token.
+func (a authService) ClientCredentials(ctx context.Context, clientID, clientSecret string) (string, error) {
+	account, err := a.userPGRepo.Get(ctx, filter.UserFilter{Username: &clientID})
+	if err != nil {
+		return "", err
+	}
+
+	if !account.IsService() {
+		return "", customerrors.NewErrForbidden()
+	}
+
+	if !utils.VerifyPassword(account.Password, clientSecret) {
+		return "", customerrors.NewErrInvalidPassword()
+	}
+
+	accessToken, err := utils.GenerateToken(
+		model.User{
+			ID:         account.ID,
+			Username:   account.Username,
+			Role:       account.Role,
+			Roles:      account.Roles,
+			RolesUntil: account.RolesUntil,
+		},
+		[]byte(a.config.TokenSecretKey),
+		time.Duration(a.config.AccessTokenExpirationMin)*time.Minute,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate token")
+	}
+
+	return accessToken, nil
+}
@@ -0,0 +1,40 @@
+package converter
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	pb "github.com/mikhailsoldatkin/auth/pkg/auth_v1"
+)
+
+// FromServiceToProtobufAPIToken converter from service APIToken model to protobuf APIToken model.
+func FromServiceToProtobufAPIToken(token *model.APIToken) *pb.APIToken {
+	return &pb.APIToken{
+		Id:         token.ID,
+		Name:       token.Name,
+		Prefix:     token.Prefix,
+		Scopes:     token.Scopes,
+		ExpiresAt:  timestamppb.New(token.ExpiresAt),
+		LastUsedAt: toTimestamp(token.LastUsedAt),
+		RevokedAt:  toTimestamp(token.RevokedAt),
+		CreatedAt:  timestamppb.New(token.CreatedAt),
+	}
+}
+
+// FromServiceToProtobufAPITokenList converts a list of service APIToken models to a list of protobuf APIToken models.
+func FromServiceToProtobufAPITokenList(tokens []*model.APIToken) []*pb.APIToken {
+	protobufTokens := make([]*pb.APIToken, len(tokens))
+	for i, token := range tokens {
+		protobufTokens[i] = FromServiceToProtobufAPIToken(token)
+	}
+	return protobufTokens
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
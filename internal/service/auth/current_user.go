@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+// currentClaims returns verified claims of the JWT access token from incoming metadata.
+func (a authService) currentClaims(ctx context.Context) (*model.UserClaims, error) {
+	accessToken, err := utils.ExtractBearerToken(ctx)
+	if err != nil {
+		return nil, customerrors.NewErrInvalidToken()
+	}
+
+	claims, err := utils.VerifyToken(accessToken, []byte(a.config.TokenSecretKey))
+	if err != nil {
+		return nil, customerrors.NewErrInvalidToken()
+	}
+
+	return claims, nil
+}
+
+// currentUser returns the user identified by the JWT access token from incoming metadata.
+// Impersonation tokens are rejected so that an admin can't act persistently on the user's behalf.
+func (a authService) currentUser(ctx context.Context) (*model.User, error) {
+	claims, err := a.currentClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.IsImpersonated() {
+		return nil, customerrors.NewErrForbidden()
+	}
+
+	return a.userPGRepo.Get(ctx, filter.UserFilter{Username: &claims.Username})
+}
+
+// exchangeToken issues a token valid for duration in exchange for the refresh token.
+// The original authentication time is kept so that step-up checks can't be bypassed by exchanging tokens,
+// impersonation tokens are short-lived on purpose and can't be exchanged.
+func (a authService) exchangeToken(refreshToken string, duration time.Duration) (string, error) {
+	claims, err := utils.VerifyToken(refreshToken, []byte(a.config.TokenSecretKey))
+	if err != nil || claims.IsImpersonated() {
+		return "", customerrors.NewErrInvalidToken()
+	}
+
+	return utils.GenerateAuthenticatedToken(
+		claimsUser(claims, time.Now()),
+		time.Unix(claims.AuthTime, 0),
+		claims.AMR,
+		[]byte(a.config.TokenSecretKey),
+		duration,
+	)
+}
+
+// claimsUser returns the token subject with the roles still granted at now, expired temporary roles are dropped.
+func claimsUser(claims *model.UserClaims, now time.Time) model.User {
+	claims.ExpireRoles(now)
+
+	user := model.User{
+		ID:       claims.UserID,
+		Username: claims.Username,
+		Role:     claims.Role,
+		Roles:    claims.Roles,
+	}
+
+	for _, role := range claims.Roles {
+		until, ok := claims.RolesUntil[role]
+		if !ok {
+			continue
+		}
+		if user.RolesUntil == nil {
+			user.RolesUntil = make(map[string]time.Time, len(claims.RolesUntil))
+		}
+		user.RolesUntil[role] = time.Unix(until, 0)
+	}
+
+	return user
+}
@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+	pbAuth "github.com/mikhailsoldatkin/auth/pkg/auth_v1"
+	pbUser "github.com/mikhailsoldatkin/auth/pkg/user_v1"
+)
+
+// Impersonate issues a short-lived access token for the target user on behalf of the current admin.
+// The token carries an act claim naming the admin, admins can't be impersonated
+// and the start of impersonation is written to the users log.
+func (a authService) Impersonate(ctx context.Context, userID int64, reason string) (string, time.Time, error) {
+	admin, err := a.currentUser(ctx)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	allowed, err := a.roleAllowed(ctx, pbAuth.AuthV1_Impersonate_FullMethodName, admin)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if !allowed {
+		return "", time.Time{}, customerrors.NewErrForbidden()
+	}
+
+	target, err := a.userPGRepo.Get(ctx, filter.UserFilter{ID: &userID})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	if target.HasRole(pbUser.Role_ADMIN.String()) || target.ID == admin.ID {
+		return "", time.Time{}, customerrors.NewErrForbidden()
+	}
+
+	expiresAt := time.Now().Add(time.Duration(a.config.ImpersonationTokenExpirationMin) * time.Minute)
+
+	accessToken, err := utils.GenerateImpersonationToken(*target, admin.Username, []byte(a.config.TokenSecretKey), expiresAt)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate token")
+	}
+
+	details := fmt.Sprintf("impersonation of user %d started by admin %s", target.ID, admin.Username)
+	if reason != "" {
+		details = fmt.Sprintf("%s, reason: %s", details, reason)
+	}
+
+	err = a.logRepo.Log(ctx, target.ID, details)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return accessToken, expiresAt, nil
+}
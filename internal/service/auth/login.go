@@ -5,29 +5,34 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/mikhailsoldatkin/auth/internal/customerrors"
-	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
 	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
 	"github.com/mikhailsoldatkin/auth/internal/utils"
 )
 
-// Login authenticates a user with the provided username and password.
-// Validates the credentials and, if successful, returns an access token.
+// Login authenticates a user against the configured providers and returns a refresh token.
 func (a authService) Login(ctx context.Context, username, password string) (string, error) {
-	user, err := a.userPGRepo.Get(ctx, filter.UserFilter{Username: &username})
+	user, err := a.authenticate(ctx, username, password)
 	if err != nil {
 		return "", err
 	}
 
-	if !utils.VerifyPassword(user.Password, password) {
-		return "", customerrors.NewErrInvalidPassword()
+	if user.AuthProvider != model.AuthProviderLocal {
+		user, err = a.provision(ctx, user)
+		if err != nil {
+			return "", err
+		}
 	}
 
-	accessToken, err := utils.GenerateToken(
+	accessToken, err := utils.GenerateAuthenticatedToken(
 		model.User{
-			Username: username,
-			Role:     user.Role,
+			ID:         user.ID,
+			Username:   user.Username,
+			Role:       user.Role,
+			Roles:      user.Roles,
+			RolesUntil: user.RolesUntil,
 		},
+		time.Now(),
+		[]string{model.AuthMethodPassword},
 		[]byte(a.config.TokenSecretKey),
 		time.Duration(a.config.RefreshTokenExpirationMin)*time.Minute,
 	)
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+const attributeDN = "dn"
+
+var _ service.AuthProvider = (*ldapProvider)(nil)
+
+// LDAPConn is the subset of an LDAP connection used by the LDAP provider.
+type LDAPConn interface {
+	Bind(username, password string) error
+	Search(request *ldap.SearchRequest) (*ldap.SearchResult, error)
+	Close() error
+}
+
+// LDAPDialer opens a connection to the LDAP server.
+type LDAPDialer func(ctx context.Context, url string) (LDAPConn, error)
+
+type ldapProvider struct {
+	config config.LDAP
+	dial   LDAPDialer
+}
+
+// NewLDAPProvider creates an authentication provider binding to an LDAP / Active Directory server.
+// A nil dialer connects to config.URL over the network.
+func NewLDAPProvider(config config.LDAP, dial LDAPDialer) service.AuthProvider {
+	if dial == nil {
+		dial = func(_ context.Context, url string) (LDAPConn, error) {
+			timeout := time.Duration(config.TimeoutSec) * time.Second
+			return ldap.DialURL(url, ldap.DialWithDialer(&net.Dialer{Timeout: timeout}))
+		}
+	}
+
+	return &ldapProvider{
+		config: config,
+		dial:   dial,
+	}
+}
+
+// Name returns the provider name stored in users.auth_provider.
+func (p *ldapProvider) Name() string {
+	return model.AuthProviderLDAP
+}
+
+// Authenticate searches the user DN with the service account, binds as the user to verify the password
+// and maps the user's groups to a role. The returned user is not stored yet and has no ID.
+func (p *ldapProvider) Authenticate(ctx context.Context, username, password string) (*model.User, error) {
+	// an empty password results in an unauthenticated bind which always succeeds
+	if password == "" {
+		return nil, customerrors.NewErrInvalidPassword()
+	}
+
+	conn, err := p.dial(ctx, p.config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to LDAP server: %w", err)
+	}
+	defer func(conn LDAPConn) {
+		_ = conn.Close()
+	}(conn)
+
+	err = conn.Bind(p.config.BindDN, p.config.BindPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind LDAP service account: %w", err)
+	}
+
+	result, err := conn.Search(ldap.NewSearchRequest(
+		p.config.BaseDN,
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		2,
+		p.config.TimeoutSec,
+		false,
+		fmt.Sprintf(p.config.UserFilter, ldap.EscapeFilter(username)),
+		[]string{attributeDN, p.config.EmailAttribute, p.config.GroupAttribute},
+		nil,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search LDAP user: %w", err)
+	}
+
+	if len(result.Entries) == 0 {
+		return nil, customerrors.NewErrNotFound("user", username)
+	}
+	if len(result.Entries) > 1 {
+		return nil, customerrors.NewErrInvalidPassword()
+	}
+
+	entry := result.Entries[0]
+	err = conn.Bind(entry.DN, password)
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, customerrors.NewErrInvalidPassword()
+		}
+		return nil, fmt.Errorf("failed to bind LDAP user: %w", err)
+	}
+
+	return &model.User{
+		Username:      username,
+		Email:         entry.GetAttributeValue(p.config.EmailAttribute),
+		Role:          utils.MapRole(entry.GetAttributeValues(p.config.GroupAttribute), p.config.GroupRoles, p.config.DefaultRole),
+		PrincipalType: model.PrincipalHuman,
+		AuthProvider:  model.AuthProviderLDAP,
+	}, nil
+}
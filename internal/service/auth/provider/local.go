@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+var _ service.AuthProvider = (*localProvider)(nil)
+
+type localProvider struct {
+	userRepo repository.UserRepository
+}
+
+// NewLocalProvider creates an authentication provider verifying bcrypt password hashes stored in the users table.
+func NewLocalProvider(userRepo repository.UserRepository) service.AuthProvider {
+	return &localProvider{userRepo: userRepo}
+}
+
+// Name returns the provider name stored in users.auth_provider.
+func (p *localProvider) Name() string {
+	return model.AuthProviderLocal
+}
+
+// Authenticate verifies the password of a local user.
+// Service accounts and users provisioned by other providers can't log in with a local password.
+func (p *localProvider) Authenticate(ctx context.Context, username, password string) (*model.User, error) {
+	user, err := p.userRepo.Get(ctx, filter.UserFilter{Username: &username})
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsService() {
+		return nil, customerrors.NewErrForbidden()
+	}
+
+	if user.AuthProvider != model.AuthProviderLocal || !utils.VerifyPassword(user.Password, password) {
+		return nil, customerrors.NewErrInvalidPassword()
+	}
+
+	return user, nil
+}
@@ -0,0 +1,192 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/auth/provider"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+const (
+	baseDN      = "ou=people,dc=example,dc=com"
+	adminsGroup = "cn=admins,ou=groups,dc=example,dc=com"
+	staffGroup  = "cn=staff,ou=groups,dc=example,dc=com"
+)
+
+// directoryEntry is a user stored in the in-process LDAP directory.
+type directoryEntry struct {
+	uid      string
+	password string
+	mail     string
+	groups   []string
+}
+
+// directory is an in-process LDAP server stand-in serving bind and search requests.
+type directory struct {
+	bindDN       string
+	bindPassword string
+	entries      []directoryEntry
+}
+
+func (d *directory) dn(uid string) string {
+	return fmt.Sprintf("uid=%s,%s", uid, baseDN)
+}
+
+func (d *directory) Dial(_ context.Context, _ string) (provider.LDAPConn, error) {
+	return &directoryConn{directory: d}, nil
+}
+
+type directoryConn struct {
+	directory *directory
+}
+
+func (c *directoryConn) Bind(username, password string) error {
+	if username == c.directory.bindDN && password == c.directory.bindPassword {
+		return nil
+	}
+
+	for _, entry := range c.directory.entries {
+		if username == c.directory.dn(entry.uid) && password == entry.password {
+			return nil
+		}
+	}
+
+	return ldap.NewError(ldap.LDAPResultInvalidCredentials, errors.New("invalid credentials"))
+}
+
+func (c *directoryConn) Search(request *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	result := &ldap.SearchResult{}
+	for _, entry := range c.directory.entries {
+		if request.BaseDN != baseDN || request.Filter != fmt.Sprintf("(uid=%s)", ldap.EscapeFilter(entry.uid)) {
+			continue
+		}
+
+		result.Entries = append(result.Entries, ldap.NewEntry(c.directory.dn(entry.uid), map[string][]string{
+			"mail":     {entry.mail},
+			"memberOf": entry.groups,
+		}))
+	}
+
+	return result, nil
+}
+
+func (c *directoryConn) Close() error {
+	return nil
+}
+
+func TestLDAPAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		admin = directoryEntry{
+			uid:      gofakeit.Username(),
+			password: gofakeit.Password(true, true, true, false, false, 12),
+			mail:     gofakeit.Email(),
+			groups:   []string{staffGroup, adminsGroup},
+		}
+		user = directoryEntry{
+			uid:      gofakeit.Username(),
+			password: gofakeit.Password(true, true, true, false, false, 12),
+			mail:     gofakeit.Email(),
+			groups:   []string{staffGroup},
+		}
+		dir = &directory{
+			bindDN:       "cn=readonly,dc=example,dc=com",
+			bindPassword: gofakeit.Password(true, true, true, false, false, 12),
+			entries:      []directoryEntry{admin, user},
+		}
+		cfg = config.LDAP{
+			BindDN:         dir.bindDN,
+			BindPassword:   dir.bindPassword,
+			BaseDN:         baseDN,
+			UserFilter:     "(uid=%s)",
+			EmailAttribute: "mail",
+			GroupAttribute: "memberOf",
+			GroupRoles:     map[string]string{adminsGroup: "ADMIN"},
+			DefaultRole:    "USER",
+		}
+	)
+
+	tests := []struct {
+		name     string
+		username string
+		password string
+		want     *model.User
+		err      error
+	}{
+		{
+			name:     "admin group mapped to role",
+			username: admin.uid,
+			password: admin.password,
+			want: &model.User{
+				Username:      admin.uid,
+				Email:         admin.mail,
+				Role:          "ADMIN",
+				PrincipalType: model.PrincipalHuman,
+				AuthProvider:  model.AuthProviderLDAP,
+			},
+		},
+		{
+			name:     "default role",
+			username: user.uid,
+			password: user.password,
+			want: &model.User{
+				Username:      user.uid,
+				Email:         user.mail,
+				Role:          "USER",
+				PrincipalType: model.PrincipalHuman,
+				AuthProvider:  model.AuthProviderLDAP,
+			},
+		},
+		{
+			name:     "wrong password",
+			username: user.uid,
+			password: admin.password,
+			err:      customerrors.NewErrInvalidPassword(),
+		},
+		{
+			name:     "empty password",
+			username: user.uid,
+			password: "",
+			err:      customerrors.NewErrInvalidPassword(),
+		},
+		{
+			name:     "unknown user",
+			username: gofakeit.Username() + "_unknown",
+			password: user.password,
+			err:      &customerrors.ErrNotFound{},
+		},
+		{
+			name:     "filter injection",
+			username: "*",
+			password: user.password,
+			err:      &customerrors.ErrNotFound{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ldapProvider := provider.NewLDAPProvider(cfg, dir.Dial)
+
+			got, err := ldapProvider.Authenticate(context.Background(), tt.username, tt.password)
+			if tt.err != nil {
+				require.IsType(t, tt.err, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+// provision stores a user authenticated by an external provider on first login
+// and keeps the role in sync with the provider on subsequent logins.
+// A local user with the same username is never taken over by an external identity.
+func (a authService) provision(ctx context.Context, identity *model.User) (*model.User, error) {
+	user, err := a.userPGRepo.Get(ctx, filter.UserFilter{Username: &identity.Username})
+
+	var errNotFound *customerrors.ErrNotFound
+	if errors.As(err, &errNotFound) {
+		// the password is never used, external users authenticate against their provider
+		identity.Password, err = utils.GenerateClientSecret()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate password: %w", err)
+		}
+
+		identity.ID, err = a.userService.Create(ctx, identity)
+		if err != nil {
+			return nil, err
+		}
+
+		return identity, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AuthProvider != identity.AuthProvider || user.IsService() {
+		return nil, customerrors.NewErrForbidden()
+	}
+
+	if user.Role != identity.Role {
+		err = a.userService.Update(ctx, &model.User{ID: user.ID, Role: identity.Role})
+		if err != nil {
+			return nil, err
+		}
+		user.Role = identity.Role
+	}
+
+	return user, nil
+}
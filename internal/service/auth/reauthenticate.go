@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+// Reauthenticate confirms the password of the current user with the provider the user belongs to and issues a short-lived access token
+// with a fresh auth_time claim required by endpoints protected with step-up authentication.
+func (a authService) Reauthenticate(ctx context.Context, password string) (string, time.Time, error) {
+	user, err := a.currentUser(ctx)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	provider, ok := a.provider(user.AuthProvider)
+	if !ok {
+		return "", time.Time{}, customerrors.NewErrForbidden()
+	}
+
+	_, err = provider.Authenticate(ctx, user.Username, password)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	now := time.Now()
+	duration := time.Duration(a.config.StepUpTokenExpirationMin) * time.Minute
+
+	accessToken, err := utils.GenerateAuthenticatedToken(
+		model.User{
+			ID:         user.ID,
+			Username:   user.Username,
+			Role:       user.Role,
+			Roles:      user.Roles,
+			RolesUntil: user.RolesUntil,
+		},
+		now,
+		[]string{model.AuthMethodPassword},
+		[]byte(a.config.TokenSecretKey),
+		duration,
+	)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate token")
+	}
+
+	return accessToken, now.Add(duration), nil
+}
@@ -9,17 +9,33 @@ import (
 var _ service.AuthService = (*authService)(nil)
 
 type authService struct {
-	userPGRepo repository.UserRepository
-	config     config.Auth
+	userPGRepo      repository.UserRepository
+	apiTokenRepo    repository.APITokenRepository
+	logRepo         repository.LogRepository
+	permissionCache repository.PermissionCache
+	userService     service.UserService
+	providers       []service.AuthProvider
+	config          config.Auth
 }
 
 // NewAuthService creates a new instance of the authentication service.
+// Providers are tried in the given order on login, users of external providers are provisioned via the user service.
 func NewAuthService(
 	userPGRepo repository.UserRepository,
+	apiTokenRepo repository.APITokenRepository,
+	logRepo repository.LogRepository,
+	permissionCache repository.PermissionCache,
+	userService service.UserService,
+	providers []service.AuthProvider,
 	config config.Auth,
 ) service.AuthService {
 	return &authService{
-		userPGRepo: userPGRepo,
-		config:     config,
+		userPGRepo:      userPGRepo,
+		apiTokenRepo:    apiTokenRepo,
+		logRepo:         logRepo,
+		permissionCache: permissionCache,
+		userService:     userService,
+		providers:       providers,
+		config:          config,
 	}
 }
@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+)
+
+// StopImpersonation writes the end of impersonation session to the users log.
+// It must be called with the impersonation token itself.
+func (a authService) StopImpersonation(ctx context.Context) error {
+	claims, err := a.currentClaims(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !claims.IsImpersonated() {
+		return customerrors.NewErrInvalidArgument("token is not an impersonation token")
+	}
+
+	target, err := a.userPGRepo.Get(ctx, filter.UserFilter{Username: &claims.Username})
+	if err != nil {
+		return err
+	}
+
+	return a.logRepo.Log(ctx, target.ID, fmt.Sprintf("impersonation of user %d stopped by admin %s", target.ID, claims.Act.Username))
+}
@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/auth"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+func TestCreateAPIToken(t *testing.T) {
+	t.Parallel()
+	type userRepoMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type tokenRepoMockFunc func(mc *minimock.Controller) repository.APITokenRepository
+
+	type args struct {
+		expiresAt time.Time
+		scopes    []string
+	}
+
+	var (
+		mc        = minimock.NewController(t)
+		cfg       = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32)}
+		id        = gofakeit.Int64()
+		tokenID   = gofakeit.Int64()
+		username  = gofakeit.Username()
+		name      = gofakeit.Word()
+		endpoint  = "/chat_v1.ChatV1/SendMessage"
+		expiresAt = time.Now().Add(24 * time.Hour)
+		user      = &model.User{ID: id, Username: username, Role: "USER"}
+		repoErr   = fmt.Errorf("repository error")
+	)
+
+	jwt, err := utils.GenerateToken(*user, []byte(cfg.TokenSecretKey), time.Hour)
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+jwt))
+
+	currentUser := func(mc *minimock.Controller) repository.UserRepository {
+		mock := repoMocks.NewUserRepositoryMock(mc)
+		mock.GetMock.Expect(ctx, filter.UserFilter{Username: &username}).Return(user, nil)
+		return mock
+	}
+
+	tests := []struct {
+		name          string
+		args          args
+		err           error
+		grantedRoles  []string
+		userRepoMock  userRepoMockFunc
+		tokenRepoMock tokenRepoMockFunc
+	}{
+		{
+			name:         "success case",
+			args:         args{expiresAt: expiresAt, scopes: []string{endpoint}},
+			err:          nil,
+			grantedRoles: []string{"ADMIN", "USER"},
+			userRepoMock: currentUser,
+			tokenRepoMock: func(mc *minimock.Controller) repository.APITokenRepository {
+				mock := repoMocks.NewAPITokenRepositoryMock(mc)
+				mock.CreateMock.Set(func(_ context.Context, token *model.APIToken) (int64, error) {
+					require.Equal(t, id, token.UserID)
+					require.Equal(t, name, token.Name)
+					require.Equal(t, []string{endpoint}, token.Scopes)
+					return tokenID, nil
+				})
+				return mock
+			},
+		},
+		{
+			name:         "scope not allowed for role",
+			args:         args{expiresAt: expiresAt, scopes: []string{endpoint}},
+			err:          customerrors.NewErrInvalidArgument(fmt.Sprintf("scope %s is not allowed for role USER", endpoint)),
+			grantedRoles: []string{"ADMIN"},
+			userRepoMock: currentUser,
+			tokenRepoMock: func(mc *minimock.Controller) repository.APITokenRepository {
+				return repoMocks.NewAPITokenRepositoryMock(mc)
+			},
+		},
+		{
+			name: "expiration time in the past",
+			args: args{expiresAt: time.Now().Add(-time.Minute)},
+			err:  customerrors.NewErrInvalidArgument("expiration time must be in the future"),
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			tokenRepoMock: func(mc *minimock.Controller) repository.APITokenRepository {
+				return repoMocks.NewAPITokenRepositoryMock(mc)
+			},
+		},
+		{
+			name:         "repository error",
+			args:         args{expiresAt: expiresAt},
+			err:          repoErr,
+			userRepoMock: currentUser,
+			tokenRepoMock: func(mc *minimock.Controller) repository.APITokenRepository {
+				mock := repoMocks.NewAPITokenRepositoryMock(mc)
+				mock.CreateMock.Return(0, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			permissionCacheMock := repoMocks.NewPermissionCacheMock(mc)
+			permissionCacheMock.GetEndpointPermissionsMock.Optional().
+				Expect(ctx, endpoint).
+				Return(endpointPermissions(endpoint, tt.grantedRoles...), nil)
+
+			service := auth.NewAuthService(
+				tt.userRepoMock(mc),
+				tt.tokenRepoMock(mc),
+				repoMocks.NewLogRepositoryMock(mc),
+				permissionCacheMock,
+				nil,
+				nil,
+				cfg,
+			)
+
+			plain, token, err := service.CreateAPIToken(ctx, name, tt.args.expiresAt, tt.args.scopes)
+			require.Equal(t, tt.err, err)
+			if tt.err != nil {
+				require.Empty(t, plain)
+				require.Nil(t, token)
+				return
+			}
+
+			require.Equal(t, tokenID, token.ID)
+			require.True(t, utils.IsAPIToken(plain))
+			require.True(t, utils.VerifyAPIToken(token.Hash, plain))
+
+			prefix, ok := utils.ParseAPITokenPrefix(plain)
+			require.True(t, ok)
+			require.Equal(t, token.Prefix, prefix)
+		})
+	}
+}
+
+func endpointPermissions(endpoint string, roles ...string) []*model.Permission {
+	permissions := make([]*model.Permission, len(roles))
+	for i, role := range roles {
+		permissions[i] = &model.Permission{Endpoint: endpoint, Role: role, Effect: model.EffectAllow}
+	}
+	return permissions
+}
@@ -0,0 +1,176 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/auth"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+	pb "github.com/mikhailsoldatkin/auth/pkg/auth_v1"
+)
+
+func TestImpersonate(t *testing.T) {
+	t.Parallel()
+	type userRepoMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type logRepoMockFunc func(mc *minimock.Controller) repository.LogRepository
+	type permissionCacheMockFunc func(mc *minimock.Controller) repository.PermissionCache
+
+	var (
+		mc       = minimock.NewController(t)
+		cfg      = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32), ImpersonationTokenExpirationMin: 15}
+		endpoint = pb.AuthV1_Impersonate_FullMethodName
+		reason   = gofakeit.Sentence(5)
+
+		admin    = &model.User{ID: gofakeit.Int64(), Username: gofakeit.Username(), Role: "ADMIN"}
+		user     = &model.User{ID: gofakeit.Int64(), Username: gofakeit.Username(), Role: "USER"}
+		another  = &model.User{ID: gofakeit.Int64(), Username: gofakeit.Username(), Role: "ADMIN"}
+		adminCtx = contextWithToken(t, cfg, *admin, "")
+
+		grantedTo = func(roles ...string) permissionCacheMockFunc {
+			return func(mc *minimock.Controller) repository.PermissionCache {
+				mock := repoMocks.NewPermissionCacheMock(mc)
+				mock.GetEndpointPermissionsMock.Expect(adminCtx, endpoint).Return(endpointPermissions(endpoint, roles...), nil)
+				return mock
+			}
+		}
+	)
+
+	tests := []struct {
+		name                string
+		ctx                 context.Context
+		targetID            int64
+		err                 error
+		userRepoMock        userRepoMockFunc
+		logRepoMock         logRepoMockFunc
+		permissionCacheMock permissionCacheMockFunc
+	}{
+		{
+			name:     "success case",
+			ctx:      adminCtx,
+			targetID: user.ID,
+			err:      nil,
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.When(adminCtx, filter.UserFilter{Username: &admin.Username}).Then(admin, nil)
+				mock.GetMock.When(adminCtx, filter.UserFilter{ID: &user.ID}).Then(user, nil)
+				return mock
+			},
+			logRepoMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repoMocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Set(func(_ context.Context, id int64, details string) error {
+					require.Equal(t, user.ID, id)
+					require.Contains(t, details, admin.Username)
+					require.Contains(t, details, reason)
+					return nil
+				})
+				return mock
+			},
+			permissionCacheMock: grantedTo("ADMIN"),
+		},
+		{
+			name:     "admin can't be impersonated",
+			ctx:      adminCtx,
+			targetID: another.ID,
+			err:      customerrors.NewErrForbidden(),
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.When(adminCtx, filter.UserFilter{Username: &admin.Username}).Then(admin, nil)
+				mock.GetMock.When(adminCtx, filter.UserFilter{ID: &another.ID}).Then(another, nil)
+				return mock
+			},
+			logRepoMock: func(mc *minimock.Controller) repository.LogRepository {
+				return repoMocks.NewLogRepositoryMock(mc)
+			},
+			permissionCacheMock: grantedTo("ADMIN"),
+		},
+		{
+			name:     "role without permission",
+			ctx:      adminCtx,
+			targetID: user.ID,
+			err:      customerrors.NewErrForbidden(),
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(adminCtx, filter.UserFilter{Username: &admin.Username}).Return(admin, nil)
+				return mock
+			},
+			logRepoMock: func(mc *minimock.Controller) repository.LogRepository {
+				return repoMocks.NewLogRepositoryMock(mc)
+			},
+			permissionCacheMock: grantedTo(),
+		},
+		{
+			name:     "nested impersonation",
+			ctx:      contextWithToken(t, cfg, *user, admin.Username),
+			targetID: user.ID,
+			err:      customerrors.NewErrForbidden(),
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			logRepoMock: func(mc *minimock.Controller) repository.LogRepository {
+				return repoMocks.NewLogRepositoryMock(mc)
+			},
+			permissionCacheMock: func(mc *minimock.Controller) repository.PermissionCache {
+				return repoMocks.NewPermissionCacheMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := auth.NewAuthService(
+				tt.userRepoMock(mc),
+				repoMocks.NewAPITokenRepositoryMock(mc),
+				tt.logRepoMock(mc),
+				tt.permissionCacheMock(mc),
+				nil,
+				nil,
+				cfg,
+			)
+
+			token, expiresAt, err := service.Impersonate(tt.ctx, tt.targetID, reason)
+			require.Equal(t, tt.err, err)
+			if tt.err != nil {
+				return
+			}
+
+			require.WithinDuration(t, time.Now().Add(15*time.Minute), expiresAt, time.Minute)
+
+			claims, err := utils.VerifyToken(token, []byte(cfg.TokenSecretKey))
+			require.NoError(t, err)
+			require.Equal(t, user.Username, claims.Username)
+			require.True(t, claims.IsImpersonated())
+			require.Equal(t, admin.Username, claims.Act.Username)
+		})
+	}
+}
+
+// contextWithToken returns a context with incoming metadata carrying a JWT for the user.
+// A non-empty actor produces an impersonation token.
+func contextWithToken(t *testing.T, cfg config.Auth, user model.User, actor string) context.Context {
+	var (
+		token string
+		err   error
+	)
+	if actor == "" {
+		token, err = utils.GenerateToken(user, []byte(cfg.TokenSecretKey), time.Hour)
+	} else {
+		token, err = utils.GenerateImpersonationToken(user, actor, []byte(cfg.TokenSecretKey), time.Now().Add(time.Hour))
+	}
+	require.NoError(t, err)
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
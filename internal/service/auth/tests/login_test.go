@@ -0,0 +1,194 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service"
+	"github.com/mikhailsoldatkin/auth/internal/service/auth"
+	serviceMocks "github.com/mikhailsoldatkin/auth/internal/service/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+func TestLogin(t *testing.T) {
+	t.Parallel()
+	type userRepoMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type userServiceMockFunc func(mc *minimock.Controller) service.UserService
+	type providersMockFunc func(mc *minimock.Controller) []service.AuthProvider
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+		cfg = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32), RefreshTokenExpirationMin: 60}
+
+		id       = gofakeit.Int64()
+		username = gofakeit.Username()
+		password = gofakeit.Password(true, true, true, false, false, 12)
+
+		localUser = &model.User{ID: id, Username: username, Role: "USER", AuthProvider: model.AuthProviderLocal}
+		ldapUser  = &model.User{ID: id, Username: username, Role: "USER", AuthProvider: model.AuthProviderLDAP}
+		identity  = model.User{Username: username, Role: "ADMIN", AuthProvider: model.AuthProviderLDAP}
+
+		localProvider = func(mc *minimock.Controller, user *model.User, err error) service.AuthProvider {
+			mock := serviceMocks.NewAuthProviderMock(mc)
+			mock.AuthenticateMock.Expect(ctx, username, password).Return(user, err)
+			return mock
+		}
+		ldapProvider = func(mc *minimock.Controller) service.AuthProvider {
+			mock := serviceMocks.NewAuthProviderMock(mc)
+			mock.AuthenticateMock.Set(func(_ context.Context, _, _ string) (*model.User, error) {
+				ldapIdentity := identity
+				return &ldapIdentity, nil
+			})
+			return mock
+		}
+		noUserRepo = func(mc *minimock.Controller) repository.UserRepository {
+			return repoMocks.NewUserRepositoryMock(mc)
+		}
+		noUserService = func(mc *minimock.Controller) service.UserService {
+			return serviceMocks.NewUserServiceMock(mc)
+		}
+	)
+
+	tests := []struct {
+		name            string
+		role            string
+		err             error
+		providersMock   providersMockFunc
+		userRepoMock    userRepoMockFunc
+		userServiceMock userServiceMockFunc
+	}{
+		{
+			name: "local user",
+			role: "USER",
+			err:  nil,
+			providersMock: func(mc *minimock.Controller) []service.AuthProvider {
+				return []service.AuthProvider{localProvider(mc, localUser, nil), serviceMocks.NewAuthProviderMock(mc)}
+			},
+			userRepoMock:    noUserRepo,
+			userServiceMock: noUserService,
+		},
+		{
+			name: "ldap user provisioned on first login",
+			role: "ADMIN",
+			err:  nil,
+			providersMock: func(mc *minimock.Controller) []service.AuthProvider {
+				return []service.AuthProvider{
+					localProvider(mc, nil, customerrors.NewErrNotFound("user", username)),
+					ldapProvider(mc),
+				}
+			},
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, filter.UserFilter{Username: &username}).Return(nil, customerrors.NewErrNotFound("user", username))
+				return mock
+			},
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateMock.Set(func(_ context.Context, user *model.User) (int64, error) {
+					require.Equal(t, model.AuthProviderLDAP, user.AuthProvider)
+					require.Equal(t, "ADMIN", user.Role)
+					require.NotEmpty(t, user.Password)
+					return id, nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "ldap user role synced",
+			role: "ADMIN",
+			err:  nil,
+			providersMock: func(mc *minimock.Controller) []service.AuthProvider {
+				return []service.AuthProvider{
+					localProvider(mc, nil, customerrors.NewErrInvalidPassword()),
+					ldapProvider(mc),
+				}
+			},
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				user := *ldapUser
+				mock.GetMock.Expect(ctx, filter.UserFilter{Username: &username}).Return(&user, nil)
+				return mock
+			},
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.UpdateMock.Expect(ctx, &model.User{ID: id, Role: "ADMIN"}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "local account not taken over",
+			err:  customerrors.NewErrForbidden(),
+			providersMock: func(mc *minimock.Controller) []service.AuthProvider {
+				return []service.AuthProvider{
+					localProvider(mc, nil, customerrors.NewErrInvalidPassword()),
+					ldapProvider(mc),
+				}
+			},
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, filter.UserFilter{Username: &username}).Return(localUser, nil)
+				return mock
+			},
+			userServiceMock: noUserService,
+		},
+		{
+			name: "no provider accepts credentials",
+			err:  customerrors.NewErrInvalidPassword(),
+			providersMock: func(mc *minimock.Controller) []service.AuthProvider {
+				return []service.AuthProvider{
+					localProvider(mc, nil, customerrors.NewErrInvalidPassword()),
+					localProvider(mc, nil, customerrors.NewErrNotFound("user", username)),
+				}
+			},
+			userRepoMock:    noUserRepo,
+			userServiceMock: noUserService,
+		},
+		{
+			name: "service account",
+			err:  customerrors.NewErrForbidden(),
+			providersMock: func(mc *minimock.Controller) []service.AuthProvider {
+				return []service.AuthProvider{localProvider(mc, nil, customerrors.NewErrForbidden()), serviceMocks.NewAuthProviderMock(mc)}
+			},
+			userRepoMock:    noUserRepo,
+			userServiceMock: noUserService,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			authService := auth.NewAuthService(
+				tt.userRepoMock(mc),
+				repoMocks.NewAPITokenRepositoryMock(mc),
+				repoMocks.NewLogRepositoryMock(mc),
+				repoMocks.NewPermissionCacheMock(mc),
+				tt.userServiceMock(mc),
+				tt.providersMock(mc),
+				cfg,
+			)
+
+			token, err := authService.Login(ctx, username, password)
+			require.Equal(t, tt.err, err)
+			if tt.err != nil {
+				return
+			}
+
+			claims, err := utils.VerifyToken(token, []byte(cfg.TokenSecretKey))
+			require.NoError(t, err)
+			require.Equal(t, username, claims.Username)
+			require.Equal(t, tt.role, claims.Role)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service"
+	"github.com/mikhailsoldatkin/auth/internal/service/auth"
+	"github.com/mikhailsoldatkin/auth/internal/service/auth/provider"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+func TestReauthenticate(t *testing.T) {
+	t.Parallel()
+	type userRepoMockFunc func(mc *minimock.Controller) repository.UserRepository
+
+	var (
+		mc       = minimock.NewController(t)
+		cfg      = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32), StepUpTokenExpirationMin: 5}
+		password = gofakeit.Password(true, true, true, false, false, 12)
+		user     = &model.User{ID: gofakeit.Int64(), Username: gofakeit.Username(), Role: "ADMIN", PrincipalType: model.PrincipalHuman, AuthProvider: model.AuthProviderLocal}
+		ctx      = contextWithToken(t, cfg, *user, "")
+	)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	require.NoError(t, err)
+	user.Password = string(hash)
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		password     string
+		err          error
+		userRepoMock userRepoMockFunc
+	}{
+		{
+			name:     "success case",
+			ctx:      ctx,
+			password: password,
+			err:      nil,
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, filter.UserFilter{Username: &user.Username}).Return(user, nil)
+				return mock
+			},
+		},
+		{
+			name:     "wrong password",
+			ctx:      ctx,
+			password: gofakeit.Password(true, true, true, false, false, 12),
+			err:      customerrors.NewErrInvalidPassword(),
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, filter.UserFilter{Username: &user.Username}).Return(user, nil)
+				return mock
+			},
+		},
+		{
+			name:     "impersonation token",
+			ctx:      contextWithToken(t, cfg, *user, gofakeit.Username()),
+			password: password,
+			err:      customerrors.NewErrForbidden(),
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userRepoMock := tt.userRepoMock(mc)
+			providers := []service.AuthProvider{provider.NewLocalProvider(userRepoMock)}
+			authService := auth.NewAuthService(
+				userRepoMock,
+				repoMocks.NewAPITokenRepositoryMock(mc),
+				repoMocks.NewLogRepositoryMock(mc),
+				repoMocks.NewPermissionCacheMock(mc),
+				nil,
+				providers,
+				cfg,
+			)
+
+			token, expiresAt, err := authService.Reauthenticate(tt.ctx, tt.password)
+			require.Equal(t, tt.err, err)
+			if tt.err != nil {
+				return
+			}
+
+			require.WithinDuration(t, time.Now().Add(5*time.Minute), expiresAt, time.Minute)
+
+			claims, err := utils.VerifyToken(token, []byte(cfg.TokenSecretKey))
+			require.NoError(t, err)
+			require.True(t, claims.AuthenticatedWithin(time.Minute, time.Now()))
+			require.Equal(t, []string{model.AuthMethodPassword}, claims.AMR)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/auth"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+func TestRefreshExpiresTemporaryRoles(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+		cfg = config.Auth{
+			TokenSecretKey:            gofakeit.Password(true, true, true, false, false, 32),
+			AccessTokenExpirationMin:  5,
+			RefreshTokenExpirationMin: 60,
+		}
+	)
+
+	tests := []struct {
+		name  string
+		until time.Time
+		roles []string
+	}{
+		{
+			name:  "grant still valid",
+			until: time.Now().Add(time.Hour),
+			roles: []string{"USER", "AUDITOR"},
+		},
+		{
+			name:  "grant expired",
+			until: time.Now().Add(-time.Minute),
+			roles: []string{"USER"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			user := model.User{
+				ID:         gofakeit.Int64(),
+				Username:   gofakeit.Username(),
+				Role:       "USER",
+				Roles:      []string{"AUDITOR"},
+				RolesUntil: map[string]time.Time{"AUDITOR": tt.until},
+			}
+			refreshToken, err := utils.GenerateAuthenticatedToken(
+				user,
+				time.Now(),
+				[]string{model.AuthMethodPassword},
+				[]byte(cfg.TokenSecretKey),
+				time.Hour,
+			)
+			require.NoError(t, err)
+
+			authService := auth.NewAuthService(
+				repoMocks.NewUserRepositoryMock(mc),
+				repoMocks.NewAPITokenRepositoryMock(mc),
+				repoMocks.NewLogRepositoryMock(mc),
+				repoMocks.NewPermissionCacheMock(mc),
+				nil,
+				nil,
+				cfg,
+			)
+
+			newRefreshToken, err := authService.GetRefreshToken(ctx, refreshToken)
+			require.NoError(t, err)
+			refreshClaims, err := utils.VerifyToken(newRefreshToken, []byte(cfg.TokenSecretKey))
+			require.NoError(t, err)
+			require.Equal(t, tt.roles, refreshClaims.Roles)
+
+			accessToken, err := authService.GetAccessToken(ctx, refreshToken)
+			require.NoError(t, err)
+			accessClaims, err := utils.VerifyToken(accessToken, []byte(cfg.TokenSecretKey))
+			require.NoError(t, err)
+			require.Equal(t, tt.roles, accessClaims.Roles)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package decision
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/logger"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	"github.com/mikhailsoldatkin/auth/internal/service"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// flushTimeout limits writing of the decisions left in the buffer on shutdown.
+const flushTimeout = 5 * time.Second
+
+var _ service.DecisionRecorder = (*recorderService)(nil)
+
+// recorderService implements the DecisionRecorder interface writing sampled decisions to the decision repository.
+type recorderService struct {
+	decisionRepo repository.DecisionRepository
+	config       config.DecisionLog
+	events       chan *model.DecisionEvent
+	dropped      atomic.Int64
+	droppedDeny  atomic.Int64
+	stopped      chan struct{}
+	stopOnce     sync.Once
+}
+
+// NewRecorderService creates a new instance of the access decision recorder.
+func NewRecorderService(decisionRepo repository.DecisionRepository, config config.DecisionLog) service.DecisionRecorder {
+	return &recorderService{
+		decisionRepo: decisionRepo,
+		config:       config,
+		events:       make(chan *model.DecisionEvent, max(config.BufferSize, 1)),
+		stopped:      make(chan struct{}),
+	}
+}
+
+// Record queues the decision for writing. Allowed decisions are queued with the configured sample rate
+// without blocking the access check, they are dropped when the buffer is full. Denials wait for space in the buffer
+// until the context of the check is done, only then or after the recorder stopped they are dropped.
+func (s *recorderService) Record(ctx context.Context, event *model.DecisionEvent) {
+	if !s.config.Enabled {
+		return
+	}
+
+	if event.IsAllow() {
+		if rand.Float64() >= s.config.AllowSampleRate {
+			return
+		}
+
+		select {
+		case s.events <- event:
+		default:
+			s.dropped.Add(1)
+		}
+		return
+	}
+
+	select {
+	case s.events <- event:
+	case <-s.stopped:
+	case <-ctx.Done():
+		s.droppedDeny.Add(1)
+	}
+}
+
+// RunRecorder writes queued decisions in batches until the context is cancelled,
+// the decisions left in the buffer are written before it returns.
+// Failed writes are logged and the batch is discarded so that the buffer keeps draining.
+func (s *recorderService) RunRecorder(ctx context.Context) error {
+	logger.Info("decision recorder is running")
+	defer s.stopOnce.Do(func() { close(s.stopped) })
+
+	ticker := time.NewTicker(time.Duration(s.config.FlushIntervalMs) * time.Millisecond)
+	defer ticker.Stop()
+
+	batchSize := max(s.config.BatchSize, 1)
+	batch := make([]*model.DecisionEvent, 0, batchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			batch = s.drain(batch)
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), flushTimeout)
+			s.flush(flushCtx, batch)
+			cancel()
+			return nil
+		case event := <-s.events:
+			batch = append(batch, event)
+			if len(batch) >= batchSize {
+				batch = s.flush(ctx, batch)
+			}
+		case <-ticker.C:
+			batch = s.flush(ctx, batch)
+		}
+	}
+}
+
+// drain appends the decisions waiting in the buffer to the batch.
+func (s *recorderService) drain(batch []*model.DecisionEvent) []*model.DecisionEvent {
+	for {
+		select {
+		case event := <-s.events:
+			batch = append(batch, event)
+		default:
+			return batch
+		}
+	}
+}
+
+// flush writes the batch and returns it emptied for reuse.
+func (s *recorderService) flush(ctx context.Context, batch []*model.DecisionEvent) []*model.DecisionEvent {
+	if dropped := s.dropped.Swap(0); dropped > 0 {
+		logger.Warnf("dropped %d allowed access decisions: decision log buffer is full", dropped)
+	}
+	if dropped := s.droppedDeny.Swap(0); dropped > 0 {
+		logger.Warnf("dropped %d access denials: requests ended while decision log buffer was full", dropped)
+	}
+	if len(batch) == 0 {
+		return batch
+	}
+
+	err := s.decisionRepo.CreateBatch(ctx, batch)
+	if err != nil {
+		logger.Errorf("failed to write %d access decisions: %v", len(batch), err)
+	}
+
+	return batch[:0]
+}
@@ -0,0 +1,195 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/logger"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/decision"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init(zapcore.NewNopCore())
+	os.Exit(m.Run())
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		allow = &model.DecisionEvent{Endpoint: "/chat_v1.ChatV1/Create", Decision: model.DecisionAllow}
+		deny  = &model.DecisionEvent{Endpoint: "/chat_v1.ChatV1/Delete", Decision: model.DecisionDeny}
+		stale = &model.DecisionEvent{Endpoint: "/chat_v1.ChatV1/Delete", Decision: model.DecisionStepUp}
+	)
+
+	tests := []struct {
+		name     string
+		config   config.DecisionLog
+		events   []*model.DecisionEvent
+		expected []*model.DecisionEvent
+	}{
+		{
+			name:     "allowed decisions sampled out",
+			config:   config.DecisionLog{Enabled: true, AllowSampleRate: 0, BufferSize: 10, BatchSize: 10, FlushIntervalMs: 60000},
+			events:   []*model.DecisionEvent{allow, deny, allow, stale},
+			expected: []*model.DecisionEvent{deny, stale},
+		},
+		{
+			name:     "all allowed decisions recorded",
+			config:   config.DecisionLog{Enabled: true, AllowSampleRate: 1, BufferSize: 10, BatchSize: 10, FlushIntervalMs: 60000},
+			events:   []*model.DecisionEvent{allow, deny},
+			expected: []*model.DecisionEvent{allow, deny},
+		},
+		{
+			name:     "allowed decisions over the buffer dropped",
+			config:   config.DecisionLog{Enabled: true, AllowSampleRate: 1, BufferSize: 2, BatchSize: 10, FlushIntervalMs: 60000},
+			events:   []*model.DecisionEvent{deny, stale, allow},
+			expected: []*model.DecisionEvent{deny, stale},
+		},
+		{
+			name:   "log disabled",
+			config: config.DecisionLog{AllowSampleRate: 1, BufferSize: 10, BatchSize: 10, FlushIntervalMs: 60000},
+			events: []*model.DecisionEvent{allow, deny},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mc := minimock.NewController(t)
+
+			var (
+				mu      sync.Mutex
+				written []*model.DecisionEvent
+			)
+			decisionRepoMock := repoMocks.NewDecisionRepositoryMock(mc)
+			decisionRepoMock.CreateBatchMock.Optional().Set(func(_ context.Context, events []*model.DecisionEvent) error {
+				mu.Lock()
+				defer mu.Unlock()
+				written = append(written, events...)
+				return nil
+			})
+
+			recorder := decision.NewRecorderService(decisionRepoMock, tt.config)
+			for _, event := range tt.events {
+				recorder.Record(context.Background(), event)
+			}
+
+			// the recorder is stopped right away, queued decisions are written on shutdown
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			require.NoError(t, recorder.RunRecorder(ctx))
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.Equal(t, tt.expected, written)
+		})
+	}
+}
+
+func TestRecorderKeepsDenialsWhenBufferFull(t *testing.T) {
+	t.Parallel()
+	mc := minimock.NewController(t)
+
+	var (
+		allow = &model.DecisionEvent{Endpoint: "/chat_v1.ChatV1/Create", Decision: model.DecisionAllow}
+		deny  = &model.DecisionEvent{Endpoint: "/chat_v1.ChatV1/Delete", Decision: model.DecisionDeny}
+
+		mu      sync.Mutex
+		written []*model.DecisionEvent
+	)
+	decisionRepoMock := repoMocks.NewDecisionRepositoryMock(mc)
+	decisionRepoMock.CreateBatchMock.Optional().Set(func(_ context.Context, events []*model.DecisionEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		written = append(written, events...)
+		return nil
+	})
+
+	recorder := decision.NewRecorderService(decisionRepoMock, config.DecisionLog{
+		Enabled:         true,
+		AllowSampleRate: 1,
+		BufferSize:      2,
+		BatchSize:       1,
+		FlushIntervalMs: 60000,
+	})
+
+	// the buffer is full, the third allowed decision is dropped and the denial waits for the recorder
+	recorder.Record(context.Background(), allow)
+	recorder.Record(context.Background(), allow)
+	recorder.Record(context.Background(), allow)
+
+	recorded := make(chan struct{})
+	go func() {
+		recorder.Record(context.Background(), deny)
+		close(recorded)
+	}()
+
+	select {
+	case <-recorded:
+		t.Fatal("denial was dropped instead of waiting for space in the buffer")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error)
+	go func() {
+		stopped <- recorder.RunRecorder(ctx)
+	}()
+
+	<-recorded
+	cancel()
+	require.NoError(t, <-stopped)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []*model.DecisionEvent{allow, allow, deny}, written)
+}
+
+func TestRecorderDropsDenialWhenRequestEnds(t *testing.T) {
+	t.Parallel()
+	mc := minimock.NewController(t)
+
+	var (
+		queued  = &model.DecisionEvent{Endpoint: "/chat_v1.ChatV1/Delete", Decision: model.DecisionDeny}
+		dropped = &model.DecisionEvent{Endpoint: "/chat_v1.ChatV1/Ban", Decision: model.DecisionDeny}
+
+		written []*model.DecisionEvent
+	)
+	decisionRepoMock := repoMocks.NewDecisionRepositoryMock(mc)
+	decisionRepoMock.CreateBatchMock.Set(func(_ context.Context, events []*model.DecisionEvent) error {
+		written = append(written, events...)
+		return nil
+	})
+
+	recorder := decision.NewRecorderService(decisionRepoMock, config.DecisionLog{
+		Enabled:         true,
+		AllowSampleRate: 1,
+		BufferSize:      1,
+		BatchSize:       1,
+		FlushIntervalMs: 60000,
+	})
+
+	// the buffer is full and the recorder isn't running, the denial is dropped once the request ends
+	recorder.Record(context.Background(), queued)
+
+	requestCtx, cancelRequest := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelRequest()
+	recorder.Record(requestCtx, dropped)
+	require.ErrorIs(t, requestCtx.Err(), context.DeadlineExceeded)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, recorder.RunRecorder(ctx))
+	require.Equal(t, []*model.DecisionEvent{queued}, written)
+}
@@ -0,0 +1,28 @@
+package federation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+// AuthCodeURL returns the upstream authorization URL to redirect the user to
+// and the signed state which must come back with the callback.
+func (s *federationService) AuthCodeURL(ctx context.Context) (string, string, error) {
+	state, nonce, err := utils.GenerateState(
+		[]byte(s.authConfig.TokenSecretKey),
+		time.Duration(s.config.StateTTLMin)*time.Minute,
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate state: %w", err)
+	}
+
+	authURL, err := s.oidcClient.AuthCodeURL(ctx, state, nonce)
+	if err != nil {
+		return "", "", err
+	}
+
+	return authURL, state, nil
+}
@@ -0,0 +1,167 @@
+package federation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mikhailsoldatkin/auth/internal/client/oidc/upstream"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+// Callback completes the federated login: it verifies the state, exchanges the authorization code,
+// validates the upstream ID token and returns a refresh token for the linked user.
+// Users are provisioned and linked on first login, their role follows the configured mapping rules.
+func (s *federationService) Callback(ctx context.Context, code, state string) (string, error) {
+	nonce, err := utils.VerifyState(state, []byte(s.authConfig.TokenSecretKey))
+	if err != nil {
+		return "", customerrors.NewErrInvalidToken()
+	}
+
+	rawIDToken, err := s.oidcClient.Exchange(ctx, code)
+	if err != nil {
+		return "", err
+	}
+
+	idToken, err := s.oidcClient.VerifyIDToken(ctx, rawIDToken, nonce)
+	if err != nil {
+		log.Printf("federated login rejected: %v", err)
+		return "", customerrors.NewErrInvalidToken()
+	}
+
+	user, err := s.linkedUser(ctx, idToken)
+	if err != nil {
+		return "", err
+	}
+
+	authTime := time.Now()
+	if upstreamAuthTime, ok := idToken.Claims["auth_time"].(float64); ok {
+		authTime = time.Unix(int64(upstreamAuthTime), 0)
+	}
+
+	refreshToken, err := utils.GenerateAuthenticatedToken(
+		model.User{
+			ID:         user.ID,
+			Username:   user.Username,
+			Role:       user.Role,
+			Roles:      user.Roles,
+			RolesUntil: user.RolesUntil,
+		},
+		authTime,
+		stringsClaim(idToken.Claims["amr"]),
+		[]byte(s.authConfig.TokenSecretKey),
+		time.Duration(s.authConfig.RefreshTokenExpirationMin)*time.Minute,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate token")
+	}
+
+	return refreshToken, nil
+}
+
+// linkedUser returns the user linked to the upstream subject, keeping the role in sync with the mapping rules,
+// or provisions and links a new user. An existing user with the same username is never linked automatically.
+func (s *federationService) linkedUser(ctx context.Context, idToken *upstream.IDToken) (*model.User, error) {
+	role := utils.MapRole(stringsClaim(idToken.Claims[s.config.RoleClaim]), s.config.RoleMapping, s.config.DefaultRole)
+
+	identity, err := s.identityRepo.Get(ctx, s.config.Provider, idToken.Subject)
+
+	var errNotFound *customerrors.ErrNotFound
+	if errors.As(err, &errNotFound) {
+		return s.provision(ctx, idToken, role)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userPGRepo.Get(ctx, filter.UserFilter{ID: &identity.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role != role {
+		err = s.userService.Update(ctx, &model.User{ID: user.ID, Role: role})
+		if err != nil {
+			return nil, err
+		}
+		user.Role = role
+	}
+
+	return user, nil
+}
+
+// provision creates a user for the upstream subject and links them in one transaction.
+func (s *federationService) provision(ctx context.Context, idToken *upstream.IDToken, role string) (*model.User, error) {
+	username, _ := idToken.Claims[s.config.UsernameClaim].(string)
+	if username == "" {
+		return nil, customerrors.NewErrInvalidArgument(fmt.Sprintf("id token has no %s claim", s.config.UsernameClaim))
+	}
+
+	_, err := s.userPGRepo.Get(ctx, filter.UserFilter{Username: &username})
+	if err == nil {
+		return nil, customerrors.NewErrForbidden()
+	}
+
+	var errNotFound *customerrors.ErrNotFound
+	if !errors.As(err, &errNotFound) {
+		return nil, err
+	}
+
+	// the password is never used, federated users authenticate against the upstream provider
+	password, err := utils.GenerateClientSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate password: %w", err)
+	}
+
+	email, _ := idToken.Claims[s.config.EmailClaim].(string)
+	user := &model.User{
+		Username:      username,
+		Email:         email,
+		Role:          role,
+		Password:      password,
+		PrincipalType: model.PrincipalHuman,
+		AuthProvider:  model.AuthProviderOIDC,
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		user.ID, errTx = s.userService.Create(ctx, user)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.identityRepo.Create(ctx, &model.FederatedIdentity{
+			Provider: s.config.Provider,
+			Subject:  idToken.Subject,
+			UserID:   user.ID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// stringsClaim converts a string or an array of strings claim to a slice.
+func stringsClaim(claim any) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, value := range v {
+			if s, ok := value.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package federation
+
+import (
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+
+	"github.com/mikhailsoldatkin/auth/internal/client/oidc"
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	"github.com/mikhailsoldatkin/auth/internal/service"
+)
+
+var _ service.FederationService = (*federationService)(nil)
+
+type federationService struct {
+	oidcClient   oidc.Client
+	identityRepo repository.IdentityRepository
+	userPGRepo   repository.UserRepository
+	userService  service.UserService
+	txManager    db.TxManager
+	config       config.OIDC
+	authConfig   config.Auth
+}
+
+// NewFederationService creates a new instance of the federated login service.
+func NewFederationService(
+	oidcClient oidc.Client,
+	identityRepo repository.IdentityRepository,
+	userPGRepo repository.UserRepository,
+	userService service.UserService,
+	txManager db.TxManager,
+	config config.OIDC,
+	authConfig config.Auth,
+) service.FederationService {
+	return &federationService{
+		oidcClient:   oidcClient,
+		identityRepo: identityRepo,
+		userPGRepo:   userPGRepo,
+		userService:  userService,
+		txManager:    txManager,
+		config:       config,
+		authConfig:   authConfig,
+	}
+}
@@ -0,0 +1,362 @@
+package tests
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikhailsoldatkin/auth/internal/client/oidc/upstream"
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service"
+	"github.com/mikhailsoldatkin/auth/internal/service/federation"
+	serviceMocks "github.com/mikhailsoldatkin/auth/internal/service/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+const (
+	clientID = "auth"
+	keyID    = "test-key"
+)
+
+// mockIdP is a local OpenID Connect provider issuing ID tokens for registered authorization codes.
+type mockIdP struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]jwt.MapClaims
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	idp := &mockIdP{key: key, codes: map[string]jwt.MapClaims{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.server.URL,
+			"authorization_endpoint": idp.server.URL + "/authorize",
+			"token_endpoint":         idp.server.URL + "/token",
+			"jwks_uri":               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kid": keyID,
+				"kty": "RSA",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		user, _, ok := r.BasicAuth()
+		if !ok || user != clientID || r.FormValue("grant_type") != "authorization_code" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		idp.mu.Lock()
+		claims, ok := idp.codes[r.FormValue("code")]
+		idp.mu.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]string{"id_token": idp.sign(t, claims, key)})
+	})
+
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	return idp
+}
+
+// issue registers an authorization code for which the token endpoint returns an ID token with the claims.
+func (idp *mockIdP) issue(claims jwt.MapClaims) string {
+	code := gofakeit.UUID()
+
+	idp.mu.Lock()
+	idp.codes[code] = claims
+	idp.mu.Unlock()
+
+	return code
+}
+
+func (idp *mockIdP) claims(subject, username, nonce string, groups ...string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":                idp.server.URL,
+		"aud":                []string{clientID},
+		"sub":                subject,
+		"exp":                time.Now().Add(time.Minute).Unix(),
+		"nonce":              nonce,
+		"preferred_username": username,
+		"email":              username + "@example.com",
+		"groups":             groups,
+		"amr":                []string{"pwd", "mfa"},
+	}
+}
+
+func (idp *mockIdP) sign(t *testing.T, claims jwt.MapClaims, key *rsa.PrivateKey) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = keyID
+
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	return signed
+}
+
+type noOpTxManager struct{}
+
+func (noOpTxManager) ReadCommitted(ctx context.Context, f db.Handler) error {
+	return f(ctx)
+}
+
+func TestCallback(t *testing.T) {
+	t.Parallel()
+	type identityRepoMockFunc func(mc *minimock.Controller) repository.IdentityRepository
+	type userRepoMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type userServiceMockFunc func(mc *minimock.Controller) service.UserService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+		idp = newMockIdP(t)
+
+		authCfg = config.Auth{TokenSecretKey: gofakeit.Password(true, true, true, false, false, 32), RefreshTokenExpirationMin: 60}
+		oidcCfg = config.OIDC{
+			Provider:      "sso",
+			IssuerURL:     idp.server.URL,
+			ClientID:      clientID,
+			ClientSecret:  gofakeit.Password(true, true, true, false, false, 16),
+			RedirectURL:   "http://localhost/auth/v1/oidc/callback",
+			Scopes:        []string{"openid", "email", "profile"},
+			UsernameClaim: "preferred_username",
+			EmailClaim:    "email",
+			RoleClaim:     "groups",
+			RoleMapping:   map[string]string{"auth-admins": "ADMIN"},
+			DefaultRole:   "USER",
+			StateTTLMin:   10,
+			TimeoutSec:    5,
+		}
+
+		id       = gofakeit.Int64()
+		subject  = gofakeit.UUID()
+		username = gofakeit.Username()
+		linked   = &model.FederatedIdentity{Provider: oidcCfg.Provider, Subject: subject, UserID: id}
+
+		otherKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+
+		noUserService = func(mc *minimock.Controller) service.UserService {
+			return serviceMocks.NewUserServiceMock(mc)
+		}
+		noUserRepo = func(mc *minimock.Controller) repository.UserRepository {
+			return repoMocks.NewUserRepositoryMock(mc)
+		}
+		noIdentityRepo = func(mc *minimock.Controller) repository.IdentityRepository {
+			return repoMocks.NewIdentityRepositoryMock(mc)
+		}
+	)
+
+	tests := []struct {
+		name             string
+		role             string
+		code             func(nonce string) string
+		tamperState      bool
+		err              error
+		identityRepoMock identityRepoMockFunc
+		userRepoMock     userRepoMockFunc
+		userServiceMock  userServiceMockFunc
+	}{
+		{
+			name: "first login provisions and links user",
+			role: "ADMIN",
+			code: func(nonce string) string {
+				return idp.issue(idp.claims(subject, username, nonce, "staff", "auth-admins"))
+			},
+			identityRepoMock: func(mc *minimock.Controller) repository.IdentityRepository {
+				mock := repoMocks.NewIdentityRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, oidcCfg.Provider, subject).Return(nil, customerrors.NewErrNotFound("federated identity", subject))
+				mock.CreateMock.Expect(ctx, linked).Return(nil)
+				return mock
+			},
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, filter.UserFilter{Username: &username}).Return(nil, customerrors.NewErrNotFound("user", username))
+				return mock
+			},
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateMock.Set(func(_ context.Context, user *model.User) (int64, error) {
+					require.Equal(t, username, user.Username)
+					require.Equal(t, username+"@example.com", user.Email)
+					require.Equal(t, "ADMIN", user.Role)
+					require.Equal(t, model.AuthProviderOIDC, user.AuthProvider)
+					return id, nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "linked user role synced",
+			role: "USER",
+			code: func(nonce string) string {
+				return idp.issue(idp.claims(subject, username, nonce, "staff"))
+			},
+			identityRepoMock: func(mc *minimock.Controller) repository.IdentityRepository {
+				mock := repoMocks.NewIdentityRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, oidcCfg.Provider, subject).Return(linked, nil)
+				return mock
+			},
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, filter.UserFilter{ID: &id}).Return(&model.User{ID: id, Username: username, Role: "ADMIN"}, nil)
+				return mock
+			},
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.UpdateMock.Expect(ctx, &model.User{ID: id, Role: "USER"}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "existing username is not linked",
+			err:  customerrors.NewErrForbidden(),
+			code: func(nonce string) string {
+				return idp.issue(idp.claims(subject, username, nonce))
+			},
+			identityRepoMock: func(mc *minimock.Controller) repository.IdentityRepository {
+				mock := repoMocks.NewIdentityRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, oidcCfg.Provider, subject).Return(nil, customerrors.NewErrNotFound("federated identity", subject))
+				return mock
+			},
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, filter.UserFilter{Username: &username}).Return(&model.User{ID: id, Username: username}, nil)
+				return mock
+			},
+			userServiceMock: noUserService,
+		},
+		{
+			name: "nonce mismatch",
+			err:  customerrors.NewErrInvalidToken(),
+			code: func(_ string) string {
+				return idp.issue(idp.claims(subject, username, gofakeit.UUID()))
+			},
+			identityRepoMock: noIdentityRepo,
+			userRepoMock:     noUserRepo,
+			userServiceMock:  noUserService,
+		},
+		{
+			name: "wrong audience",
+			err:  customerrors.NewErrInvalidToken(),
+			code: func(nonce string) string {
+				claims := idp.claims(subject, username, nonce)
+				claims["aud"] = "another-client"
+				return idp.issue(claims)
+			},
+			identityRepoMock: noIdentityRepo,
+			userRepoMock:     noUserRepo,
+			userServiceMock:  noUserService,
+		},
+		{
+			name: "expired id token",
+			err:  customerrors.NewErrInvalidToken(),
+			code: func(nonce string) string {
+				claims := idp.claims(subject, username, nonce)
+				claims["exp"] = time.Now().Add(-time.Minute).Unix()
+				return idp.issue(claims)
+			},
+			identityRepoMock: noIdentityRepo,
+			userRepoMock:     noUserRepo,
+			userServiceMock:  noUserService,
+		},
+		{
+			name:        "tampered state",
+			err:         customerrors.NewErrInvalidToken(),
+			tamperState: true,
+			code: func(nonce string) string {
+				return idp.issue(idp.claims(subject, username, nonce))
+			},
+			identityRepoMock: noIdentityRepo,
+			userRepoMock:     noUserRepo,
+			userServiceMock:  noUserService,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			federationService := federation.NewFederationService(
+				upstream.NewClient(oidcCfg),
+				tt.identityRepoMock(mc),
+				tt.userRepoMock(mc),
+				tt.userServiceMock(mc),
+				noOpTxManager{},
+				oidcCfg,
+				authCfg,
+			)
+
+			authURL, state, err := federationService.AuthCodeURL(ctx)
+			require.NoError(t, err)
+
+			parsed, err := url.Parse(authURL)
+			require.NoError(t, err)
+			require.Equal(t, idp.server.URL+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+			require.Equal(t, state, parsed.Query().Get("state"))
+
+			code := tt.code(parsed.Query().Get("nonce"))
+			if tt.tamperState {
+				state = state[:len(state)-1] + "x"
+			}
+
+			token, err := federationService.Callback(ctx, code, state)
+			require.Equal(t, tt.err, err)
+			if tt.err != nil {
+				return
+			}
+
+			claims, err := utils.VerifyToken(token, []byte(authCfg.TokenSecretKey))
+			require.NoError(t, err)
+			require.Equal(t, username, claims.Username)
+			require.Equal(t, tt.role, claims.Role)
+			require.Equal(t, []string{"pwd", "mfa"}, claims.AMR)
+		})
+	}
+
+	t.Run("foreign signing key", func(t *testing.T) {
+		t.Parallel()
+
+		client := upstream.NewClient(oidcCfg)
+		nonce := gofakeit.UUID()
+
+		_, err := client.VerifyIDToken(ctx, idp.sign(t, idp.claims(subject, username, nonce), otherKey), nonce)
+		require.Error(t, err)
+	})
+}
@@ -2,3 +2,6 @@ package service
 
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate minimock -i UserService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i AuthProvider -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i AccessService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i DecisionRecorder -o ./mocks/ -s "_minimock.go"
@@ -0,0 +1,11292 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/auth/internal/service.AccessService -o access_service_minimock.go -n AccessServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	decisionFilter "github.com/mikhailsoldatkin/auth/internal/repository/decision/filter"
+	permissionFilter "github.com/mikhailsoldatkin/auth/internal/repository/permission/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// AccessServiceMock implements service.AccessService
+type AccessServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddGroupMember          func(ctx context.Context, groupID int64, userID int64) (err error)
+	inspectFuncAddGroupMember   func(ctx context.Context, groupID int64, userID int64)
+	afterAddGroupMemberCounter  uint64
+	beforeAddGroupMemberCounter uint64
+	AddGroupMemberMock          mAccessServiceMockAddGroupMember
+
+	funcApplyPolicy          func(ctx context.Context, policy *model.Policy, dryRun bool) (pp1 *model.PolicyPlan, err error)
+	inspectFuncApplyPolicy   func(ctx context.Context, policy *model.Policy, dryRun bool)
+	afterApplyPolicyCounter  uint64
+	beforeApplyPolicyCounter uint64
+	ApplyPolicyMock          mAccessServiceMockApplyPolicy
+
+	funcApproveElevation          func(ctx context.Context, id int64) (rp1 *model.RoleGrant, err error)
+	inspectFuncApproveElevation   func(ctx context.Context, id int64)
+	afterApproveElevationCounter  uint64
+	beforeApproveElevationCounter uint64
+	ApproveElevationMock          mAccessServiceMockApproveElevation
+
+	funcAssignGroupRole          func(ctx context.Context, groupID int64, role string) (err error)
+	inspectFuncAssignGroupRole   func(ctx context.Context, groupID int64, role string)
+	afterAssignGroupRoleCounter  uint64
+	beforeAssignGroupRoleCounter uint64
+	AssignGroupRoleMock          mAccessServiceMockAssignGroupRole
+
+	funcAssignUserRole          func(ctx context.Context, grant *model.RoleGrant) (err error)
+	inspectFuncAssignUserRole   func(ctx context.Context, grant *model.RoleGrant)
+	afterAssignUserRoleCounter  uint64
+	beforeAssignUserRoleCounter uint64
+	AssignUserRoleMock          mAccessServiceMockAssignUserRole
+
+	funcBatchCheck          func(ctx context.Context, requests []*model.AccessRequest) (apa1 []*model.AccessDecision, err error)
+	inspectFuncBatchCheck   func(ctx context.Context, requests []*model.AccessRequest)
+	afterBatchCheckCounter  uint64
+	beforeBatchCheckCounter uint64
+	BatchCheckMock          mAccessServiceMockBatchCheck
+
+	funcCheck          func(ctx context.Context, endpoint string) (ap1 *model.AccessGrant, err error)
+	inspectFuncCheck   func(ctx context.Context, endpoint string)
+	afterCheckCounter  uint64
+	beforeCheckCounter uint64
+	CheckMock          mAccessServiceMockCheck
+
+	funcCheckRelation          func(ctx context.Context, object string, relation string, userID int64) (b1 bool, err error)
+	inspectFuncCheckRelation   func(ctx context.Context, object string, relation string, userID int64)
+	afterCheckRelationCounter  uint64
+	beforeCheckRelationCounter uint64
+	CheckRelationMock          mAccessServiceMockCheckRelation
+
+	funcCheckResource          func(ctx context.Context, endpoint string, resource string) (ap1 *model.AccessGrant, err error)
+	inspectFuncCheckResource   func(ctx context.Context, endpoint string, resource string)
+	afterCheckResourceCounter  uint64
+	beforeCheckResourceCounter uint64
+	CheckResourceMock          mAccessServiceMockCheckResource
+
+	funcCreateGroup          func(ctx context.Context, group *model.Group) (i1 int64, err error)
+	inspectFuncCreateGroup   func(ctx context.Context, group *model.Group)
+	afterCreateGroupCounter  uint64
+	beforeCreateGroupCounter uint64
+	CreateGroupMock          mAccessServiceMockCreateGroup
+
+	funcCreatePermission          func(ctx context.Context, permission *model.Permission) (i1 int64, err error)
+	inspectFuncCreatePermission   func(ctx context.Context, permission *model.Permission)
+	afterCreatePermissionCounter  uint64
+	beforeCreatePermissionCounter uint64
+	CreatePermissionMock          mAccessServiceMockCreatePermission
+
+	funcCreateRole          func(ctx context.Context, role *model.Role) (err error)
+	inspectFuncCreateRole   func(ctx context.Context, role *model.Role)
+	afterCreateRoleCounter  uint64
+	beforeCreateRoleCounter uint64
+	CreateRoleMock          mAccessServiceMockCreateRole
+
+	funcDeleteGroup          func(ctx context.Context, id int64) (err error)
+	inspectFuncDeleteGroup   func(ctx context.Context, id int64)
+	afterDeleteGroupCounter  uint64
+	beforeDeleteGroupCounter uint64
+	DeleteGroupMock          mAccessServiceMockDeleteGroup
+
+	funcDeletePermission          func(ctx context.Context, id int64) (err error)
+	inspectFuncDeletePermission   func(ctx context.Context, id int64)
+	afterDeletePermissionCounter  uint64
+	beforeDeletePermissionCounter uint64
+	DeletePermissionMock          mAccessServiceMockDeletePermission
+
+	funcDeleteRelation          func(ctx context.Context, tuple *model.RelationTuple) (err error)
+	inspectFuncDeleteRelation   func(ctx context.Context, tuple *model.RelationTuple)
+	afterDeleteRelationCounter  uint64
+	beforeDeleteRelationCounter uint64
+	DeleteRelationMock          mAccessServiceMockDeleteRelation
+
+	funcDeleteRole          func(ctx context.Context, name string) (err error)
+	inspectFuncDeleteRole   func(ctx context.Context, name string)
+	afterDeleteRoleCounter  uint64
+	beforeDeleteRoleCounter uint64
+	DeleteRoleMock          mAccessServiceMockDeleteRole
+
+	funcExplain          func(ctx context.Context, userID int64, endpoint string) (ep1 *model.Explanation, err error)
+	inspectFuncExplain   func(ctx context.Context, userID int64, endpoint string)
+	afterExplainCounter  uint64
+	beforeExplainCounter uint64
+	ExplainMock          mAccessServiceMockExplain
+
+	funcExportPolicy          func(ctx context.Context) (pp1 *model.Policy, err error)
+	inspectFuncExportPolicy   func(ctx context.Context)
+	afterExportPolicyCounter  uint64
+	beforeExportPolicyCounter uint64
+	ExportPolicyMock          mAccessServiceMockExportPolicy
+
+	funcGetRole          func(ctx context.Context, name string) (rp1 *model.Role, err error)
+	inspectFuncGetRole   func(ctx context.Context, name string)
+	afterGetRoleCounter  uint64
+	beforeGetRoleCounter uint64
+	GetRoleMock          mAccessServiceMockGetRole
+
+	funcGetUserRoles          func(ctx context.Context, userID int64) (sa1 []string, err error)
+	inspectFuncGetUserRoles   func(ctx context.Context, userID int64)
+	afterGetUserRolesCounter  uint64
+	beforeGetUserRolesCounter uint64
+	GetUserRolesMock          mAccessServiceMockGetUserRoles
+
+	funcListDecisions          func(ctx context.Context, filter decisionFilter.DecisionFilter) (dpa1 []*model.DecisionEvent, err error)
+	inspectFuncListDecisions   func(ctx context.Context, filter decisionFilter.DecisionFilter)
+	afterListDecisionsCounter  uint64
+	beforeListDecisionsCounter uint64
+	ListDecisionsMock          mAccessServiceMockListDecisions
+
+	funcListGroups          func(ctx context.Context) (gpa1 []*model.Group, err error)
+	inspectFuncListGroups   func(ctx context.Context)
+	afterListGroupsCounter  uint64
+	beforeListGroupsCounter uint64
+	ListGroupsMock          mAccessServiceMockListGroups
+
+	funcListPermissions          func(ctx context.Context, filter permissionFilter.PermissionFilter) (ppa1 []*model.Permission, err error)
+	inspectFuncListPermissions   func(ctx context.Context, filter permissionFilter.PermissionFilter)
+	afterListPermissionsCounter  uint64
+	beforeListPermissionsCounter uint64
+	ListPermissionsMock          mAccessServiceMockListPermissions
+
+	funcListRelations          func(ctx context.Context, object string, relation string, subject string) (rpa1 []*model.RelationTuple, err error)
+	inspectFuncListRelations   func(ctx context.Context, object string, relation string, subject string)
+	afterListRelationsCounter  uint64
+	beforeListRelationsCounter uint64
+	ListRelationsMock          mAccessServiceMockListRelations
+
+	funcListRoles          func(ctx context.Context) (rpa1 []*model.Role, err error)
+	inspectFuncListRoles   func(ctx context.Context)
+	afterListRolesCounter  uint64
+	beforeListRolesCounter uint64
+	ListRolesMock          mAccessServiceMockListRoles
+
+	funcRemoveGroupMember          func(ctx context.Context, groupID int64, userID int64) (err error)
+	inspectFuncRemoveGroupMember   func(ctx context.Context, groupID int64, userID int64)
+	afterRemoveGroupMemberCounter  uint64
+	beforeRemoveGroupMemberCounter uint64
+	RemoveGroupMemberMock          mAccessServiceMockRemoveGroupMember
+
+	funcRequestElevation          func(ctx context.Context, request *model.ElevationRequest) (i1 int64, err error)
+	inspectFuncRequestElevation   func(ctx context.Context, request *model.ElevationRequest)
+	afterRequestElevationCounter  uint64
+	beforeRequestElevationCounter uint64
+	RequestElevationMock          mAccessServiceMockRequestElevation
+
+	funcRevokeGroupRole          func(ctx context.Context, groupID int64, role string) (err error)
+	inspectFuncRevokeGroupRole   func(ctx context.Context, groupID int64, role string)
+	afterRevokeGroupRoleCounter  uint64
+	beforeRevokeGroupRoleCounter uint64
+	RevokeGroupRoleMock          mAccessServiceMockRevokeGroupRole
+
+	funcRevokeUserRole          func(ctx context.Context, userID int64, role string) (err error)
+	inspectFuncRevokeUserRole   func(ctx context.Context, userID int64, role string)
+	afterRevokeUserRoleCounter  uint64
+	beforeRevokeUserRoleCounter uint64
+	RevokeUserRoleMock          mAccessServiceMockRevokeUserRole
+
+	funcSimulate          func(ctx context.Context, changes []*model.PolicyChange) (spa1 []*model.SimulationResult, err error)
+	inspectFuncSimulate   func(ctx context.Context, changes []*model.PolicyChange)
+	afterSimulateCounter  uint64
+	beforeSimulateCounter uint64
+	SimulateMock          mAccessServiceMockSimulate
+
+	funcUpdateRole          func(ctx context.Context, updates *model.RoleUpdate) (err error)
+	inspectFuncUpdateRole   func(ctx context.Context, updates *model.RoleUpdate)
+	afterUpdateRoleCounter  uint64
+	beforeUpdateRoleCounter uint64
+	UpdateRoleMock          mAccessServiceMockUpdateRole
+
+	funcValidatePolicy          func(ctx context.Context, condition string) (sa1 []string, err error)
+	inspectFuncValidatePolicy   func(ctx context.Context, condition string)
+	afterValidatePolicyCounter  uint64
+	beforeValidatePolicyCounter uint64
+	ValidatePolicyMock          mAccessServiceMockValidatePolicy
+
+	funcWriteRelation          func(ctx context.Context, tuple *model.RelationTuple) (err error)
+	inspectFuncWriteRelation   func(ctx context.Context, tuple *model.RelationTuple)
+	afterWriteRelationCounter  uint64
+	beforeWriteRelationCounter uint64
+	WriteRelationMock          mAccessServiceMockWriteRelation
+}
+
+// NewAccessServiceMock returns a mock for service.AccessService
+func NewAccessServiceMock(t minimock.Tester) *AccessServiceMock {
+	m := &AccessServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddGroupMemberMock = mAccessServiceMockAddGroupMember{mock: m}
+	m.AddGroupMemberMock.callArgs = []*AccessServiceMockAddGroupMemberParams{}
+
+	m.ApplyPolicyMock = mAccessServiceMockApplyPolicy{mock: m}
+	m.ApplyPolicyMock.callArgs = []*AccessServiceMockApplyPolicyParams{}
+
+	m.ApproveElevationMock = mAccessServiceMockApproveElevation{mock: m}
+	m.ApproveElevationMock.callArgs = []*AccessServiceMockApproveElevationParams{}
+
+	m.AssignGroupRoleMock = mAccessServiceMockAssignGroupRole{mock: m}
+	m.AssignGroupRoleMock.callArgs = []*AccessServiceMockAssignGroupRoleParams{}
+
+	m.AssignUserRoleMock = mAccessServiceMockAssignUserRole{mock: m}
+	m.AssignUserRoleMock.callArgs = []*AccessServiceMockAssignUserRoleParams{}
+
+	m.BatchCheckMock = mAccessServiceMockBatchCheck{mock: m}
+	m.BatchCheckMock.callArgs = []*AccessServiceMockBatchCheckParams{}
+
+	m.CheckMock = mAccessServiceMockCheck{mock: m}
+	m.CheckMock.callArgs = []*AccessServiceMockCheckParams{}
+
+	m.CheckRelationMock = mAccessServiceMockCheckRelation{mock: m}
+	m.CheckRelationMock.callArgs = []*AccessServiceMockCheckRelationParams{}
+
+	m.CheckResourceMock = mAccessServiceMockCheckResource{mock: m}
+	m.CheckResourceMock.callArgs = []*AccessServiceMockCheckResourceParams{}
+
+	m.CreateGroupMock = mAccessServiceMockCreateGroup{mock: m}
+	m.CreateGroupMock.callArgs = []*AccessServiceMockCreateGroupParams{}
+
+	m.CreatePermissionMock = mAccessServiceMockCreatePermission{mock: m}
+	m.CreatePermissionMock.callArgs = []*AccessServiceMockCreatePermissionParams{}
+
+	m.CreateRoleMock = mAccessServiceMockCreateRole{mock: m}
+	m.CreateRoleMock.callArgs = []*AccessServiceMockCreateRoleParams{}
+
+	m.DeleteGroupMock = mAccessServiceMockDeleteGroup{mock: m}
+	m.DeleteGroupMock.callArgs = []*AccessServiceMockDeleteGroupParams{}
+
+	m.DeletePermissionMock = mAccessServiceMockDeletePermission{mock: m}
+	m.DeletePermissionMock.callArgs = []*AccessServiceMockDeletePermissionParams{}
+
+	m.DeleteRelationMock = mAccessServiceMockDeleteRelation{mock: m}
+	m.DeleteRelationMock.callArgs = []*AccessServiceMockDeleteRelationParams{}
+
+	m.DeleteRoleMock = mAccessServiceMockDeleteRole{mock: m}
+	m.DeleteRoleMock.callArgs = []*AccessServiceMockDeleteRoleParams{}
+
+	m.ExplainMock = mAccessServiceMockExplain{mock: m}
+	m.ExplainMock.callArgs = []*AccessServiceMockExplainParams{}
+
+	m.ExportPolicyMock = mAccessServiceMockExportPolicy{mock: m}
+	m.ExportPolicyMock.callArgs = []*AccessServiceMockExportPolicyParams{}
+
+	m.GetRoleMock = mAccessServiceMockGetRole{mock: m}
+	m.GetRoleMock.callArgs = []*AccessServiceMockGetRoleParams{}
+
+	m.GetUserRolesMock = mAccessServiceMockGetUserRoles{mock: m}
+	m.GetUserRolesMock.callArgs = []*AccessServiceMockGetUserRolesParams{}
+
+	m.ListDecisionsMock = mAccessServiceMockListDecisions{mock: m}
+	m.ListDecisionsMock.callArgs = []*AccessServiceMockListDecisionsParams{}
+
+	m.ListGroupsMock = mAccessServiceMockListGroups{mock: m}
+	m.ListGroupsMock.callArgs = []*AccessServiceMockListGroupsParams{}
+
+	m.ListPermissionsMock = mAccessServiceMockListPermissions{mock: m}
+	m.ListPermissionsMock.callArgs = []*AccessServiceMockListPermissionsParams{}
+
+	m.ListRelationsMock = mAccessServiceMockListRelations{mock: m}
+	m.ListRelationsMock.callArgs = []*AccessServiceMockListRelationsParams{}
+
+	m.ListRolesMock = mAccessServiceMockListRoles{mock: m}
+	m.ListRolesMock.callArgs = []*AccessServiceMockListRolesParams{}
+
+	m.RemoveGroupMemberMock = mAccessServiceMockRemoveGroupMember{mock: m}
+	m.RemoveGroupMemberMock.callArgs = []*AccessServiceMockRemoveGroupMemberParams{}
+
+	m.RequestElevationMock = mAccessServiceMockRequestElevation{mock: m}
+	m.RequestElevationMock.callArgs = []*AccessServiceMockRequestElevationParams{}
+
+	m.RevokeGroupRoleMock = mAccessServiceMockRevokeGroupRole{mock: m}
+	m.RevokeGroupRoleMock.callArgs = []*AccessServiceMockRevokeGroupRoleParams{}
+
+	m.RevokeUserRoleMock = mAccessServiceMockRevokeUserRole{mock: m}
+	m.RevokeUserRoleMock.callArgs = []*AccessServiceMockRevokeUserRoleParams{}
+
+	m.SimulateMock = mAccessServiceMockSimulate{mock: m}
+	m.SimulateMock.callArgs = []*AccessServiceMockSimulateParams{}
+
+	m.UpdateRoleMock = mAccessServiceMockUpdateRole{mock: m}
+	m.UpdateRoleMock.callArgs = []*AccessServiceMockUpdateRoleParams{}
+
+	m.ValidatePolicyMock = mAccessServiceMockValidatePolicy{mock: m}
+	m.ValidatePolicyMock.callArgs = []*AccessServiceMockValidatePolicyParams{}
+
+	m.WriteRelationMock = mAccessServiceMockWriteRelation{mock: m}
+	m.WriteRelationMock.callArgs = []*AccessServiceMockWriteRelationParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAccessServiceMockAddGroupMember struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockAddGroupMemberExpectation
+	expectations       []*AccessServiceMockAddGroupMemberExpectation
+
+	callArgs []*AccessServiceMockAddGroupMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockAddGroupMemberExpectation specifies expectation struct of the AccessService.AddGroupMember
+type AccessServiceMockAddGroupMemberExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockAddGroupMemberParams
+	paramPtrs *AccessServiceMockAddGroupMemberParamPtrs
+	results   *AccessServiceMockAddGroupMemberResults
+	Counter   uint64
+}
+
+// AccessServiceMockAddGroupMemberParams contains parameters of the AccessService.AddGroupMember
+type AccessServiceMockAddGroupMemberParams struct {
+	ctx     context.Context
+	groupID int64
+	userID  int64
+}
+
+// AccessServiceMockAddGroupMemberParamPtrs contains pointers to parameters of the AccessService.AddGroupMember
+type AccessServiceMockAddGroupMemberParamPtrs struct {
+	ctx     *context.Context
+	groupID *int64
+	userID  *int64
+}
+
+// AccessServiceMockAddGroupMemberResults contains results of the AccessService.AddGroupMember
+type AccessServiceMockAddGroupMemberResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddGroupMember *mAccessServiceMockAddGroupMember) Optional() *mAccessServiceMockAddGroupMember {
+	mmAddGroupMember.optional = true
+	return mmAddGroupMember
+}
+
+// Expect sets up expected params for AccessService.AddGroupMember
+func (mmAddGroupMember *mAccessServiceMockAddGroupMember) Expect(ctx context.Context, groupID int64, userID int64) *mAccessServiceMockAddGroupMember {
+	if mmAddGroupMember.mock.funcAddGroupMember != nil {
+		mmAddGroupMember.mock.t.Fatalf("AccessServiceMock.AddGroupMember mock is already set by Set")
+	}
+
+	if mmAddGroupMember.defaultExpectation == nil {
+		mmAddGroupMember.defaultExpectation = &AccessServiceMockAddGroupMemberExpectation{}
+	}
+
+	if mmAddGroupMember.defaultExpectation.paramPtrs != nil {
+		mmAddGroupMember.mock.t.Fatalf("AccessServiceMock.AddGroupMember mock is already set by ExpectParams functions")
+	}
+
+	mmAddGroupMember.defaultExpectation.params = &AccessServiceMockAddGroupMemberParams{ctx, groupID, userID}
+	for _, e := range mmAddGroupMember.expectations {
+		if minimock.Equal(e.params, mmAddGroupMember.defaultExpectation.params) {
+			mmAddGroupMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddGroupMember.defaultExpectation.params)
+		}
+	}
+
+	return mmAddGroupMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.AddGroupMember
+func (mmAddGroupMember *mAccessServiceMockAddGroupMember) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockAddGroupMember {
+	if mmAddGroupMember.mock.funcAddGroupMember != nil {
+		mmAddGroupMember.mock.t.Fatalf("AccessServiceMock.AddGroupMember mock is already set by Set")
+	}
+
+	if mmAddGroupMember.defaultExpectation == nil {
+		mmAddGroupMember.defaultExpectation = &AccessServiceMockAddGroupMemberExpectation{}
+	}
+
+	if mmAddGroupMember.defaultExpectation.params != nil {
+		mmAddGroupMember.mock.t.Fatalf("AccessServiceMock.AddGroupMember mock is already set by Expect")
+	}
+
+	if mmAddGroupMember.defaultExpectation.paramPtrs == nil {
+		mmAddGroupMember.defaultExpectation.paramPtrs = &AccessServiceMockAddGroupMemberParamPtrs{}
+	}
+	mmAddGroupMember.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAddGroupMember
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for AccessService.AddGroupMember
+func (mmAddGroupMember *mAccessServiceMockAddGroupMember) ExpectGroupIDParam2(groupID int64) *mAccessServiceMockAddGroupMember {
+	if mmAddGroupMember.mock.funcAddGroupMember != nil {
+		mmAddGroupMember.mock.t.Fatalf("AccessServiceMock.AddGroupMember mock is already set by Set")
+	}
+
+	if mmAddGroupMember.defaultExpectation == nil {
+		mmAddGroupMember.defaultExpectation = &AccessServiceMockAddGroupMemberExpectation{}
+	}
+
+	if mmAddGroupMember.defaultExpectation.params != nil {
+		mmAddGroupMember.mock.t.Fatalf("AccessServiceMock.AddGroupMember mock is already set by Expect")
+	}
+
+	if mmAddGroupMember.defaultExpectation.paramPtrs == nil {
+		mmAddGroupMember.defaultExpectation.paramPtrs = &AccessServiceMockAddGroupMemberParamPtrs{}
+	}
+	mmAddGroupMember.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmAddGroupMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for AccessService.AddGroupMember
+func (mmAddGroupMember *mAccessServiceMockAddGroupMember) ExpectUserIDParam3(userID int64) *mAccessServiceMockAddGroupMember {
+	if mmAddGroupMember.mock.funcAddGroupMember != nil {
+		mmAddGroupMember.mock.t.Fatalf("AccessServiceMock.AddGroupMember mock is already set by Set")
+	}
+
+	if mmAddGroupMember.defaultExpectation == nil {
+		mmAddGroupMember.defaultExpectation = &AccessServiceMockAddGroupMemberExpectation{}
+	}
+
+	if mmAddGroupMember.defaultExpectation.params != nil {
+		mmAddGroupMember.mock.t.Fatalf("AccessServiceMock.AddGroupMember mock is already set by Expect")
+	}
+
+	if mmAddGroupMember.defaultExpectation.paramPtrs == nil {
+		mmAddGroupMember.defaultExpectation.paramPtrs = &AccessServiceMockAddGroupMemberParamPtrs{}
+	}
+	mmAddGroupMember.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmAddGroupMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.AddGroupMember
+func (mmAddGroupMember *mAccessServiceMockAddGroupMember) Inspect(f func(ctx context.Context, groupID int64, userID int64)) *mAccessServiceMockAddGroupMember {
+	if mmAddGroupMember.mock.inspectFuncAddGroupMember != nil {
+		mmAddGroupMember.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.AddGroupMember")
+	}
+
+	mmAddGroupMember.mock.inspectFuncAddGroupMember = f
+
+	return mmAddGroupMember
+}
+
+// Return sets up results that will be returned by AccessService.AddGroupMember
+func (mmAddGroupMember *mAccessServiceMockAddGroupMember) Return(err error) *AccessServiceMock {
+	if mmAddGroupMember.mock.funcAddGroupMember != nil {
+		mmAddGroupMember.mock.t.Fatalf("AccessServiceMock.AddGroupMember mock is already set by Set")
+	}
+
+	if mmAddGroupMember.defaultExpectation == nil {
+		mmAddGroupMember.defaultExpectation = &AccessServiceMockAddGroupMemberExpectation{mock: mmAddGroupMember.mock}
+	}
+	mmAddGroupMember.defaultExpectation.results = &AccessServiceMockAddGroupMemberResults{err}
+	return mmAddGroupMember.mock
+}
+
+// Set uses given function f to mock the AccessService.AddGroupMember method
+func (mmAddGroupMember *mAccessServiceMockAddGroupMember) Set(f func(ctx context.Context, groupID int64, userID int64) (err error)) *AccessServiceMock {
+	if mmAddGroupMember.defaultExpectation != nil {
+		mmAddGroupMember.mock.t.Fatalf("Default expectation is already set for the AccessService.AddGroupMember method")
+	}
+
+	if len(mmAddGroupMember.expectations) > 0 {
+		mmAddGroupMember.mock.t.Fatalf("Some expectations are already set for the AccessService.AddGroupMember method")
+	}
+
+	mmAddGroupMember.mock.funcAddGroupMember = f
+	return mmAddGroupMember.mock
+}
+
+// When sets expectation for the AccessService.AddGroupMember which will trigger the result defined by the following
+// Then helper
+func (mmAddGroupMember *mAccessServiceMockAddGroupMember) When(ctx context.Context, groupID int64, userID int64) *AccessServiceMockAddGroupMemberExpectation {
+	if mmAddGroupMember.mock.funcAddGroupMember != nil {
+		mmAddGroupMember.mock.t.Fatalf("AccessServiceMock.AddGroupMember mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockAddGroupMemberExpectation{
+		mock:   mmAddGroupMember.mock,
+		params: &AccessServiceMockAddGroupMemberParams{ctx, groupID, userID},
+	}
+	mmAddGroupMember.expectations = append(mmAddGroupMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.AddGroupMember return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockAddGroupMemberExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockAddGroupMemberResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.AddGroupMember should be invoked
+func (mmAddGroupMember *mAccessServiceMockAddGroupMember) Times(n uint64) *mAccessServiceMockAddGroupMember {
+	if n == 0 {
+		mmAddGroupMember.mock.t.Fatalf("Times of AccessServiceMock.AddGroupMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddGroupMember.expectedInvocations, n)
+	return mmAddGroupMember
+}
+
+func (mmAddGroupMember *mAccessServiceMockAddGroupMember) invocationsDone() bool {
+	if len(mmAddGroupMember.expectations) == 0 && mmAddGroupMember.defaultExpectation == nil && mmAddGroupMember.mock.funcAddGroupMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddGroupMember.mock.afterAddGroupMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddGroupMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddGroupMember implements service.AccessService
+func (mmAddGroupMember *AccessServiceMock) AddGroupMember(ctx context.Context, groupID int64, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmAddGroupMember.beforeAddGroupMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddGroupMember.afterAddGroupMemberCounter, 1)
+
+	if mmAddGroupMember.inspectFuncAddGroupMember != nil {
+		mmAddGroupMember.inspectFuncAddGroupMember(ctx, groupID, userID)
+	}
+
+	mm_params := AccessServiceMockAddGroupMemberParams{ctx, groupID, userID}
+
+	// Record call args
+	mmAddGroupMember.AddGroupMemberMock.mutex.Lock()
+	mmAddGroupMember.AddGroupMemberMock.callArgs = append(mmAddGroupMember.AddGroupMemberMock.callArgs, &mm_params)
+	mmAddGroupMember.AddGroupMemberMock.mutex.Unlock()
+
+	for _, e := range mmAddGroupMember.AddGroupMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddGroupMember.AddGroupMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddGroupMember.AddGroupMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddGroupMember.AddGroupMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmAddGroupMember.AddGroupMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockAddGroupMemberParams{ctx, groupID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddGroupMember.t.Errorf("AccessServiceMock.AddGroupMember got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmAddGroupMember.t.Errorf("AccessServiceMock.AddGroupMember got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddGroupMember.t.Errorf("AccessServiceMock.AddGroupMember got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddGroupMember.t.Errorf("AccessServiceMock.AddGroupMember got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddGroupMember.AddGroupMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddGroupMember.t.Fatal("No results are set for the AccessServiceMock.AddGroupMember")
+		}
+		return (*mm_results).err
+	}
+	if mmAddGroupMember.funcAddGroupMember != nil {
+		return mmAddGroupMember.funcAddGroupMember(ctx, groupID, userID)
+	}
+	mmAddGroupMember.t.Fatalf("Unexpected call to AccessServiceMock.AddGroupMember. %v %v %v", ctx, groupID, userID)
+	return
+}
+
+// AddGroupMemberAfterCounter returns a count of finished AccessServiceMock.AddGroupMember invocations
+func (mmAddGroupMember *AccessServiceMock) AddGroupMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddGroupMember.afterAddGroupMemberCounter)
+}
+
+// AddGroupMemberBeforeCounter returns a count of AccessServiceMock.AddGroupMember invocations
+func (mmAddGroupMember *AccessServiceMock) AddGroupMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddGroupMember.beforeAddGroupMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.AddGroupMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddGroupMember *mAccessServiceMockAddGroupMember) Calls() []*AccessServiceMockAddGroupMemberParams {
+	mmAddGroupMember.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockAddGroupMemberParams, len(mmAddGroupMember.callArgs))
+	copy(argCopy, mmAddGroupMember.callArgs)
+
+	mmAddGroupMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddGroupMemberDone returns true if the count of the AddGroupMember invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockAddGroupMemberDone() bool {
+	if m.AddGroupMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddGroupMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddGroupMemberMock.invocationsDone()
+}
+
+// MinimockAddGroupMemberInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockAddGroupMemberInspect() {
+	for _, e := range m.AddGroupMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.AddGroupMember with params: %#v", *e.params)
+		}
+	}
+
+	afterAddGroupMemberCounter := mm_atomic.LoadUint64(&m.afterAddGroupMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddGroupMemberMock.defaultExpectation != nil && afterAddGroupMemberCounter < 1 {
+		if m.AddGroupMemberMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.AddGroupMember")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.AddGroupMember with params: %#v", *m.AddGroupMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddGroupMember != nil && afterAddGroupMemberCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.AddGroupMember")
+	}
+
+	if !m.AddGroupMemberMock.invocationsDone() && afterAddGroupMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.AddGroupMember but found %d calls",
+			mm_atomic.LoadUint64(&m.AddGroupMemberMock.expectedInvocations), afterAddGroupMemberCounter)
+	}
+}
+
+type mAccessServiceMockApplyPolicy struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockApplyPolicyExpectation
+	expectations       []*AccessServiceMockApplyPolicyExpectation
+
+	callArgs []*AccessServiceMockApplyPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockApplyPolicyExpectation specifies expectation struct of the AccessService.ApplyPolicy
+type AccessServiceMockApplyPolicyExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockApplyPolicyParams
+	paramPtrs *AccessServiceMockApplyPolicyParamPtrs
+	results   *AccessServiceMockApplyPolicyResults
+	Counter   uint64
+}
+
+// AccessServiceMockApplyPolicyParams contains parameters of the AccessService.ApplyPolicy
+type AccessServiceMockApplyPolicyParams struct {
+	ctx    context.Context
+	policy *model.Policy
+	dryRun bool
+}
+
+// AccessServiceMockApplyPolicyParamPtrs contains pointers to parameters of the AccessService.ApplyPolicy
+type AccessServiceMockApplyPolicyParamPtrs struct {
+	ctx    *context.Context
+	policy **model.Policy
+	dryRun *bool
+}
+
+// AccessServiceMockApplyPolicyResults contains results of the AccessService.ApplyPolicy
+type AccessServiceMockApplyPolicyResults struct {
+	pp1 *model.PolicyPlan
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmApplyPolicy *mAccessServiceMockApplyPolicy) Optional() *mAccessServiceMockApplyPolicy {
+	mmApplyPolicy.optional = true
+	return mmApplyPolicy
+}
+
+// Expect sets up expected params for AccessService.ApplyPolicy
+func (mmApplyPolicy *mAccessServiceMockApplyPolicy) Expect(ctx context.Context, policy *model.Policy, dryRun bool) *mAccessServiceMockApplyPolicy {
+	if mmApplyPolicy.mock.funcApplyPolicy != nil {
+		mmApplyPolicy.mock.t.Fatalf("AccessServiceMock.ApplyPolicy mock is already set by Set")
+	}
+
+	if mmApplyPolicy.defaultExpectation == nil {
+		mmApplyPolicy.defaultExpectation = &AccessServiceMockApplyPolicyExpectation{}
+	}
+
+	if mmApplyPolicy.defaultExpectation.paramPtrs != nil {
+		mmApplyPolicy.mock.t.Fatalf("AccessServiceMock.ApplyPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmApplyPolicy.defaultExpectation.params = &AccessServiceMockApplyPolicyParams{ctx, policy, dryRun}
+	for _, e := range mmApplyPolicy.expectations {
+		if minimock.Equal(e.params, mmApplyPolicy.defaultExpectation.params) {
+			mmApplyPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApplyPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmApplyPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.ApplyPolicy
+func (mmApplyPolicy *mAccessServiceMockApplyPolicy) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockApplyPolicy {
+	if mmApplyPolicy.mock.funcApplyPolicy != nil {
+		mmApplyPolicy.mock.t.Fatalf("AccessServiceMock.ApplyPolicy mock is already set by Set")
+	}
+
+	if mmApplyPolicy.defaultExpectation == nil {
+		mmApplyPolicy.defaultExpectation = &AccessServiceMockApplyPolicyExpectation{}
+	}
+
+	if mmApplyPolicy.defaultExpectation.params != nil {
+		mmApplyPolicy.mock.t.Fatalf("AccessServiceMock.ApplyPolicy mock is already set by Expect")
+	}
+
+	if mmApplyPolicy.defaultExpectation.paramPtrs == nil {
+		mmApplyPolicy.defaultExpectation.paramPtrs = &AccessServiceMockApplyPolicyParamPtrs{}
+	}
+	mmApplyPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmApplyPolicy
+}
+
+// ExpectPolicyParam2 sets up expected param policy for AccessService.ApplyPolicy
+func (mmApplyPolicy *mAccessServiceMockApplyPolicy) ExpectPolicyParam2(policy *model.Policy) *mAccessServiceMockApplyPolicy {
+	if mmApplyPolicy.mock.funcApplyPolicy != nil {
+		mmApplyPolicy.mock.t.Fatalf("AccessServiceMock.ApplyPolicy mock is already set by Set")
+	}
+
+	if mmApplyPolicy.defaultExpectation == nil {
+		mmApplyPolicy.defaultExpectation = &AccessServiceMockApplyPolicyExpectation{}
+	}
+
+	if mmApplyPolicy.defaultExpectation.params != nil {
+		mmApplyPolicy.mock.t.Fatalf("AccessServiceMock.ApplyPolicy mock is already set by Expect")
+	}
+
+	if mmApplyPolicy.defaultExpectation.paramPtrs == nil {
+		mmApplyPolicy.defaultExpectation.paramPtrs = &AccessServiceMockApplyPolicyParamPtrs{}
+	}
+	mmApplyPolicy.defaultExpectation.paramPtrs.policy = &policy
+
+	return mmApplyPolicy
+}
+
+// ExpectDryRunParam3 sets up expected param dryRun for AccessService.ApplyPolicy
+func (mmApplyPolicy *mAccessServiceMockApplyPolicy) ExpectDryRunParam3(dryRun bool) *mAccessServiceMockApplyPolicy {
+	if mmApplyPolicy.mock.funcApplyPolicy != nil {
+		mmApplyPolicy.mock.t.Fatalf("AccessServiceMock.ApplyPolicy mock is already set by Set")
+	}
+
+	if mmApplyPolicy.defaultExpectation == nil {
+		mmApplyPolicy.defaultExpectation = &AccessServiceMockApplyPolicyExpectation{}
+	}
+
+	if mmApplyPolicy.defaultExpectation.params != nil {
+		mmApplyPolicy.mock.t.Fatalf("AccessServiceMock.ApplyPolicy mock is already set by Expect")
+	}
+
+	if mmApplyPolicy.defaultExpectation.paramPtrs == nil {
+		mmApplyPolicy.defaultExpectation.paramPtrs = &AccessServiceMockApplyPolicyParamPtrs{}
+	}
+	mmApplyPolicy.defaultExpectation.paramPtrs.dryRun = &dryRun
+
+	return mmApplyPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.ApplyPolicy
+func (mmApplyPolicy *mAccessServiceMockApplyPolicy) Inspect(f func(ctx context.Context, policy *model.Policy, dryRun bool)) *mAccessServiceMockApplyPolicy {
+	if mmApplyPolicy.mock.inspectFuncApplyPolicy != nil {
+		mmApplyPolicy.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.ApplyPolicy")
+	}
+
+	mmApplyPolicy.mock.inspectFuncApplyPolicy = f
+
+	return mmApplyPolicy
+}
+
+// Return sets up results that will be returned by AccessService.ApplyPolicy
+func (mmApplyPolicy *mAccessServiceMockApplyPolicy) Return(pp1 *model.PolicyPlan, err error) *AccessServiceMock {
+	if mmApplyPolicy.mock.funcApplyPolicy != nil {
+		mmApplyPolicy.mock.t.Fatalf("AccessServiceMock.ApplyPolicy mock is already set by Set")
+	}
+
+	if mmApplyPolicy.defaultExpectation == nil {
+		mmApplyPolicy.defaultExpectation = &AccessServiceMockApplyPolicyExpectation{mock: mmApplyPolicy.mock}
+	}
+	mmApplyPolicy.defaultExpectation.results = &AccessServiceMockApplyPolicyResults{pp1, err}
+	return mmApplyPolicy.mock
+}
+
+// Set uses given function f to mock the AccessService.ApplyPolicy method
+func (mmApplyPolicy *mAccessServiceMockApplyPolicy) Set(f func(ctx context.Context, policy *model.Policy, dryRun bool) (pp1 *model.PolicyPlan, err error)) *AccessServiceMock {
+	if mmApplyPolicy.defaultExpectation != nil {
+		mmApplyPolicy.mock.t.Fatalf("Default expectation is already set for the AccessService.ApplyPolicy method")
+	}
+
+	if len(mmApplyPolicy.expectations) > 0 {
+		mmApplyPolicy.mock.t.Fatalf("Some expectations are already set for the AccessService.ApplyPolicy method")
+	}
+
+	mmApplyPolicy.mock.funcApplyPolicy = f
+	return mmApplyPolicy.mock
+}
+
+// When sets expectation for the AccessService.ApplyPolicy which will trigger the result defined by the following
+// Then helper
+func (mmApplyPolicy *mAccessServiceMockApplyPolicy) When(ctx context.Context, policy *model.Policy, dryRun bool) *AccessServiceMockApplyPolicyExpectation {
+	if mmApplyPolicy.mock.funcApplyPolicy != nil {
+		mmApplyPolicy.mock.t.Fatalf("AccessServiceMock.ApplyPolicy mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockApplyPolicyExpectation{
+		mock:   mmApplyPolicy.mock,
+		params: &AccessServiceMockApplyPolicyParams{ctx, policy, dryRun},
+	}
+	mmApplyPolicy.expectations = append(mmApplyPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.ApplyPolicy return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockApplyPolicyExpectation) Then(pp1 *model.PolicyPlan, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockApplyPolicyResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.ApplyPolicy should be invoked
+func (mmApplyPolicy *mAccessServiceMockApplyPolicy) Times(n uint64) *mAccessServiceMockApplyPolicy {
+	if n == 0 {
+		mmApplyPolicy.mock.t.Fatalf("Times of AccessServiceMock.ApplyPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmApplyPolicy.expectedInvocations, n)
+	return mmApplyPolicy
+}
+
+func (mmApplyPolicy *mAccessServiceMockApplyPolicy) invocationsDone() bool {
+	if len(mmApplyPolicy.expectations) == 0 && mmApplyPolicy.defaultExpectation == nil && mmApplyPolicy.mock.funcApplyPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmApplyPolicy.mock.afterApplyPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApplyPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ApplyPolicy implements service.AccessService
+func (mmApplyPolicy *AccessServiceMock) ApplyPolicy(ctx context.Context, policy *model.Policy, dryRun bool) (pp1 *model.PolicyPlan, err error) {
+	mm_atomic.AddUint64(&mmApplyPolicy.beforeApplyPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmApplyPolicy.afterApplyPolicyCounter, 1)
+
+	if mmApplyPolicy.inspectFuncApplyPolicy != nil {
+		mmApplyPolicy.inspectFuncApplyPolicy(ctx, policy, dryRun)
+	}
+
+	mm_params := AccessServiceMockApplyPolicyParams{ctx, policy, dryRun}
+
+	// Record call args
+	mmApplyPolicy.ApplyPolicyMock.mutex.Lock()
+	mmApplyPolicy.ApplyPolicyMock.callArgs = append(mmApplyPolicy.ApplyPolicyMock.callArgs, &mm_params)
+	mmApplyPolicy.ApplyPolicyMock.mutex.Unlock()
+
+	for _, e := range mmApplyPolicy.ApplyPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmApplyPolicy.ApplyPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApplyPolicy.ApplyPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmApplyPolicy.ApplyPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmApplyPolicy.ApplyPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockApplyPolicyParams{ctx, policy, dryRun}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmApplyPolicy.t.Errorf("AccessServiceMock.ApplyPolicy got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.policy != nil && !minimock.Equal(*mm_want_ptrs.policy, mm_got.policy) {
+				mmApplyPolicy.t.Errorf("AccessServiceMock.ApplyPolicy got unexpected parameter policy, want: %#v, got: %#v%s\n", *mm_want_ptrs.policy, mm_got.policy, minimock.Diff(*mm_want_ptrs.policy, mm_got.policy))
+			}
+
+			if mm_want_ptrs.dryRun != nil && !minimock.Equal(*mm_want_ptrs.dryRun, mm_got.dryRun) {
+				mmApplyPolicy.t.Errorf("AccessServiceMock.ApplyPolicy got unexpected parameter dryRun, want: %#v, got: %#v%s\n", *mm_want_ptrs.dryRun, mm_got.dryRun, minimock.Diff(*mm_want_ptrs.dryRun, mm_got.dryRun))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmApplyPolicy.t.Errorf("AccessServiceMock.ApplyPolicy got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmApplyPolicy.ApplyPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmApplyPolicy.t.Fatal("No results are set for the AccessServiceMock.ApplyPolicy")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmApplyPolicy.funcApplyPolicy != nil {
+		return mmApplyPolicy.funcApplyPolicy(ctx, policy, dryRun)
+	}
+	mmApplyPolicy.t.Fatalf("Unexpected call to AccessServiceMock.ApplyPolicy. %v %v %v", ctx, policy, dryRun)
+	return
+}
+
+// ApplyPolicyAfterCounter returns a count of finished AccessServiceMock.ApplyPolicy invocations
+func (mmApplyPolicy *AccessServiceMock) ApplyPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPolicy.afterApplyPolicyCounter)
+}
+
+// ApplyPolicyBeforeCounter returns a count of AccessServiceMock.ApplyPolicy invocations
+func (mmApplyPolicy *AccessServiceMock) ApplyPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPolicy.beforeApplyPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.ApplyPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmApplyPolicy *mAccessServiceMockApplyPolicy) Calls() []*AccessServiceMockApplyPolicyParams {
+	mmApplyPolicy.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockApplyPolicyParams, len(mmApplyPolicy.callArgs))
+	copy(argCopy, mmApplyPolicy.callArgs)
+
+	mmApplyPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockApplyPolicyDone returns true if the count of the ApplyPolicy invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockApplyPolicyDone() bool {
+	if m.ApplyPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ApplyPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ApplyPolicyMock.invocationsDone()
+}
+
+// MinimockApplyPolicyInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockApplyPolicyInspect() {
+	for _, e := range m.ApplyPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.ApplyPolicy with params: %#v", *e.params)
+		}
+	}
+
+	afterApplyPolicyCounter := mm_atomic.LoadUint64(&m.afterApplyPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApplyPolicyMock.defaultExpectation != nil && afterApplyPolicyCounter < 1 {
+		if m.ApplyPolicyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.ApplyPolicy")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.ApplyPolicy with params: %#v", *m.ApplyPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApplyPolicy != nil && afterApplyPolicyCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.ApplyPolicy")
+	}
+
+	if !m.ApplyPolicyMock.invocationsDone() && afterApplyPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.ApplyPolicy but found %d calls",
+			mm_atomic.LoadUint64(&m.ApplyPolicyMock.expectedInvocations), afterApplyPolicyCounter)
+	}
+}
+
+type mAccessServiceMockApproveElevation struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockApproveElevationExpectation
+	expectations       []*AccessServiceMockApproveElevationExpectation
+
+	callArgs []*AccessServiceMockApproveElevationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockApproveElevationExpectation specifies expectation struct of the AccessService.ApproveElevation
+type AccessServiceMockApproveElevationExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockApproveElevationParams
+	paramPtrs *AccessServiceMockApproveElevationParamPtrs
+	results   *AccessServiceMockApproveElevationResults
+	Counter   uint64
+}
+
+// AccessServiceMockApproveElevationParams contains parameters of the AccessService.ApproveElevation
+type AccessServiceMockApproveElevationParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// AccessServiceMockApproveElevationParamPtrs contains pointers to parameters of the AccessService.ApproveElevation
+type AccessServiceMockApproveElevationParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// AccessServiceMockApproveElevationResults contains results of the AccessService.ApproveElevation
+type AccessServiceMockApproveElevationResults struct {
+	rp1 *model.RoleGrant
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmApproveElevation *mAccessServiceMockApproveElevation) Optional() *mAccessServiceMockApproveElevation {
+	mmApproveElevation.optional = true
+	return mmApproveElevation
+}
+
+// Expect sets up expected params for AccessService.ApproveElevation
+func (mmApproveElevation *mAccessServiceMockApproveElevation) Expect(ctx context.Context, id int64) *mAccessServiceMockApproveElevation {
+	if mmApproveElevation.mock.funcApproveElevation != nil {
+		mmApproveElevation.mock.t.Fatalf("AccessServiceMock.ApproveElevation mock is already set by Set")
+	}
+
+	if mmApproveElevation.defaultExpectation == nil {
+		mmApproveElevation.defaultExpectation = &AccessServiceMockApproveElevationExpectation{}
+	}
+
+	if mmApproveElevation.defaultExpectation.paramPtrs != nil {
+		mmApproveElevation.mock.t.Fatalf("AccessServiceMock.ApproveElevation mock is already set by ExpectParams functions")
+	}
+
+	mmApproveElevation.defaultExpectation.params = &AccessServiceMockApproveElevationParams{ctx, id}
+	for _, e := range mmApproveElevation.expectations {
+		if minimock.Equal(e.params, mmApproveElevation.defaultExpectation.params) {
+			mmApproveElevation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApproveElevation.defaultExpectation.params)
+		}
+	}
+
+	return mmApproveElevation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.ApproveElevation
+func (mmApproveElevation *mAccessServiceMockApproveElevation) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockApproveElevation {
+	if mmApproveElevation.mock.funcApproveElevation != nil {
+		mmApproveElevation.mock.t.Fatalf("AccessServiceMock.ApproveElevation mock is already set by Set")
+	}
+
+	if mmApproveElevation.defaultExpectation == nil {
+		mmApproveElevation.defaultExpectation = &AccessServiceMockApproveElevationExpectation{}
+	}
+
+	if mmApproveElevation.defaultExpectation.params != nil {
+		mmApproveElevation.mock.t.Fatalf("AccessServiceMock.ApproveElevation mock is already set by Expect")
+	}
+
+	if mmApproveElevation.defaultExpectation.paramPtrs == nil {
+		mmApproveElevation.defaultExpectation.paramPtrs = &AccessServiceMockApproveElevationParamPtrs{}
+	}
+	mmApproveElevation.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmApproveElevation
+}
+
+// ExpectIdParam2 sets up expected param id for AccessService.ApproveElevation
+func (mmApproveElevation *mAccessServiceMockApproveElevation) ExpectIdParam2(id int64) *mAccessServiceMockApproveElevation {
+	if mmApproveElevation.mock.funcApproveElevation != nil {
+		mmApproveElevation.mock.t.Fatalf("AccessServiceMock.ApproveElevation mock is already set by Set")
+	}
+
+	if mmApproveElevation.defaultExpectation == nil {
+		mmApproveElevation.defaultExpectation = &AccessServiceMockApproveElevationExpectation{}
+	}
+
+	if mmApproveElevation.defaultExpectation.params != nil {
+		mmApproveElevation.mock.t.Fatalf("AccessServiceMock.ApproveElevation mock is already set by Expect")
+	}
+
+	if mmApproveElevation.defaultExpectation.paramPtrs == nil {
+		mmApproveElevation.defaultExpectation.paramPtrs = &AccessServiceMockApproveElevationParamPtrs{}
+	}
+	mmApproveElevation.defaultExpectation.paramPtrs.id = &id
+
+	return mmApproveElevation
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.ApproveElevation
+func (mmApproveElevation *mAccessServiceMockApproveElevation) Inspect(f func(ctx context.Context, id int64)) *mAccessServiceMockApproveElevation {
+	if mmApproveElevation.mock.inspectFuncApproveElevation != nil {
+		mmApproveElevation.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.ApproveElevation")
+	}
+
+	mmApproveElevation.mock.inspectFuncApproveElevation = f
+
+	return mmApproveElevation
+}
+
+// Return sets up results that will be returned by AccessService.ApproveElevation
+func (mmApproveElevation *mAccessServiceMockApproveElevation) Return(rp1 *model.RoleGrant, err error) *AccessServiceMock {
+	if mmApproveElevation.mock.funcApproveElevation != nil {
+		mmApproveElevation.mock.t.Fatalf("AccessServiceMock.ApproveElevation mock is already set by Set")
+	}
+
+	if mmApproveElevation.defaultExpectation == nil {
+		mmApproveElevation.defaultExpectation = &AccessServiceMockApproveElevationExpectation{mock: mmApproveElevation.mock}
+	}
+	mmApproveElevation.defaultExpectation.results = &AccessServiceMockApproveElevationResults{rp1, err}
+	return mmApproveElevation.mock
+}
+
+// Set uses given function f to mock the AccessService.ApproveElevation method
+func (mmApproveElevation *mAccessServiceMockApproveElevation) Set(f func(ctx context.Context, id int64) (rp1 *model.RoleGrant, err error)) *AccessServiceMock {
+	if mmApproveElevation.defaultExpectation != nil {
+		mmApproveElevation.mock.t.Fatalf("Default expectation is already set for the AccessService.ApproveElevation method")
+	}
+
+	if len(mmApproveElevation.expectations) > 0 {
+		mmApproveElevation.mock.t.Fatalf("Some expectations are already set for the AccessService.ApproveElevation method")
+	}
+
+	mmApproveElevation.mock.funcApproveElevation = f
+	return mmApproveElevation.mock
+}
+
+// When sets expectation for the AccessService.ApproveElevation which will trigger the result defined by the following
+// Then helper
+func (mmApproveElevation *mAccessServiceMockApproveElevation) When(ctx context.Context, id int64) *AccessServiceMockApproveElevationExpectation {
+	if mmApproveElevation.mock.funcApproveElevation != nil {
+		mmApproveElevation.mock.t.Fatalf("AccessServiceMock.ApproveElevation mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockApproveElevationExpectation{
+		mock:   mmApproveElevation.mock,
+		params: &AccessServiceMockApproveElevationParams{ctx, id},
+	}
+	mmApproveElevation.expectations = append(mmApproveElevation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.ApproveElevation return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockApproveElevationExpectation) Then(rp1 *model.RoleGrant, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockApproveElevationResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.ApproveElevation should be invoked
+func (mmApproveElevation *mAccessServiceMockApproveElevation) Times(n uint64) *mAccessServiceMockApproveElevation {
+	if n == 0 {
+		mmApproveElevation.mock.t.Fatalf("Times of AccessServiceMock.ApproveElevation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmApproveElevation.expectedInvocations, n)
+	return mmApproveElevation
+}
+
+func (mmApproveElevation *mAccessServiceMockApproveElevation) invocationsDone() bool {
+	if len(mmApproveElevation.expectations) == 0 && mmApproveElevation.defaultExpectation == nil && mmApproveElevation.mock.funcApproveElevation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmApproveElevation.mock.afterApproveElevationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApproveElevation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ApproveElevation implements service.AccessService
+func (mmApproveElevation *AccessServiceMock) ApproveElevation(ctx context.Context, id int64) (rp1 *model.RoleGrant, err error) {
+	mm_atomic.AddUint64(&mmApproveElevation.beforeApproveElevationCounter, 1)
+	defer mm_atomic.AddUint64(&mmApproveElevation.afterApproveElevationCounter, 1)
+
+	if mmApproveElevation.inspectFuncApproveElevation != nil {
+		mmApproveElevation.inspectFuncApproveElevation(ctx, id)
+	}
+
+	mm_params := AccessServiceMockApproveElevationParams{ctx, id}
+
+	// Record call args
+	mmApproveElevation.ApproveElevationMock.mutex.Lock()
+	mmApproveElevation.ApproveElevationMock.callArgs = append(mmApproveElevation.ApproveElevationMock.callArgs, &mm_params)
+	mmApproveElevation.ApproveElevationMock.mutex.Unlock()
+
+	for _, e := range mmApproveElevation.ApproveElevationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmApproveElevation.ApproveElevationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApproveElevation.ApproveElevationMock.defaultExpectation.Counter, 1)
+		mm_want := mmApproveElevation.ApproveElevationMock.defaultExpectation.params
+		mm_want_ptrs := mmApproveElevation.ApproveElevationMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockApproveElevationParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmApproveElevation.t.Errorf("AccessServiceMock.ApproveElevation got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmApproveElevation.t.Errorf("AccessServiceMock.ApproveElevation got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmApproveElevation.t.Errorf("AccessServiceMock.ApproveElevation got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmApproveElevation.ApproveElevationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmApproveElevation.t.Fatal("No results are set for the AccessServiceMock.ApproveElevation")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmApproveElevation.funcApproveElevation != nil {
+		return mmApproveElevation.funcApproveElevation(ctx, id)
+	}
+	mmApproveElevation.t.Fatalf("Unexpected call to AccessServiceMock.ApproveElevation. %v %v", ctx, id)
+	return
+}
+
+// ApproveElevationAfterCounter returns a count of finished AccessServiceMock.ApproveElevation invocations
+func (mmApproveElevation *AccessServiceMock) ApproveElevationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApproveElevation.afterApproveElevationCounter)
+}
+
+// ApproveElevationBeforeCounter returns a count of AccessServiceMock.ApproveElevation invocations
+func (mmApproveElevation *AccessServiceMock) ApproveElevationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApproveElevation.beforeApproveElevationCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.ApproveElevation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmApproveElevation *mAccessServiceMockApproveElevation) Calls() []*AccessServiceMockApproveElevationParams {
+	mmApproveElevation.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockApproveElevationParams, len(mmApproveElevation.callArgs))
+	copy(argCopy, mmApproveElevation.callArgs)
+
+	mmApproveElevation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockApproveElevationDone returns true if the count of the ApproveElevation invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockApproveElevationDone() bool {
+	if m.ApproveElevationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ApproveElevationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ApproveElevationMock.invocationsDone()
+}
+
+// MinimockApproveElevationInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockApproveElevationInspect() {
+	for _, e := range m.ApproveElevationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.ApproveElevation with params: %#v", *e.params)
+		}
+	}
+
+	afterApproveElevationCounter := mm_atomic.LoadUint64(&m.afterApproveElevationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApproveElevationMock.defaultExpectation != nil && afterApproveElevationCounter < 1 {
+		if m.ApproveElevationMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.ApproveElevation")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.ApproveElevation with params: %#v", *m.ApproveElevationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApproveElevation != nil && afterApproveElevationCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.ApproveElevation")
+	}
+
+	if !m.ApproveElevationMock.invocationsDone() && afterApproveElevationCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.ApproveElevation but found %d calls",
+			mm_atomic.LoadUint64(&m.ApproveElevationMock.expectedInvocations), afterApproveElevationCounter)
+	}
+}
+
+type mAccessServiceMockAssignGroupRole struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockAssignGroupRoleExpectation
+	expectations       []*AccessServiceMockAssignGroupRoleExpectation
+
+	callArgs []*AccessServiceMockAssignGroupRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockAssignGroupRoleExpectation specifies expectation struct of the AccessService.AssignGroupRole
+type AccessServiceMockAssignGroupRoleExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockAssignGroupRoleParams
+	paramPtrs *AccessServiceMockAssignGroupRoleParamPtrs
+	results   *AccessServiceMockAssignGroupRoleResults
+	Counter   uint64
+}
+
+// AccessServiceMockAssignGroupRoleParams contains parameters of the AccessService.AssignGroupRole
+type AccessServiceMockAssignGroupRoleParams struct {
+	ctx     context.Context
+	groupID int64
+	role    string
+}
+
+// AccessServiceMockAssignGroupRoleParamPtrs contains pointers to parameters of the AccessService.AssignGroupRole
+type AccessServiceMockAssignGroupRoleParamPtrs struct {
+	ctx     *context.Context
+	groupID *int64
+	role    *string
+}
+
+// AccessServiceMockAssignGroupRoleResults contains results of the AccessService.AssignGroupRole
+type AccessServiceMockAssignGroupRoleResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAssignGroupRole *mAccessServiceMockAssignGroupRole) Optional() *mAccessServiceMockAssignGroupRole {
+	mmAssignGroupRole.optional = true
+	return mmAssignGroupRole
+}
+
+// Expect sets up expected params for AccessService.AssignGroupRole
+func (mmAssignGroupRole *mAccessServiceMockAssignGroupRole) Expect(ctx context.Context, groupID int64, role string) *mAccessServiceMockAssignGroupRole {
+	if mmAssignGroupRole.mock.funcAssignGroupRole != nil {
+		mmAssignGroupRole.mock.t.Fatalf("AccessServiceMock.AssignGroupRole mock is already set by Set")
+	}
+
+	if mmAssignGroupRole.defaultExpectation == nil {
+		mmAssignGroupRole.defaultExpectation = &AccessServiceMockAssignGroupRoleExpectation{}
+	}
+
+	if mmAssignGroupRole.defaultExpectation.paramPtrs != nil {
+		mmAssignGroupRole.mock.t.Fatalf("AccessServiceMock.AssignGroupRole mock is already set by ExpectParams functions")
+	}
+
+	mmAssignGroupRole.defaultExpectation.params = &AccessServiceMockAssignGroupRoleParams{ctx, groupID, role}
+	for _, e := range mmAssignGroupRole.expectations {
+		if minimock.Equal(e.params, mmAssignGroupRole.defaultExpectation.params) {
+			mmAssignGroupRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAssignGroupRole.defaultExpectation.params)
+		}
+	}
+
+	return mmAssignGroupRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.AssignGroupRole
+func (mmAssignGroupRole *mAccessServiceMockAssignGroupRole) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockAssignGroupRole {
+	if mmAssignGroupRole.mock.funcAssignGroupRole != nil {
+		mmAssignGroupRole.mock.t.Fatalf("AccessServiceMock.AssignGroupRole mock is already set by Set")
+	}
+
+	if mmAssignGroupRole.defaultExpectation == nil {
+		mmAssignGroupRole.defaultExpectation = &AccessServiceMockAssignGroupRoleExpectation{}
+	}
+
+	if mmAssignGroupRole.defaultExpectation.params != nil {
+		mmAssignGroupRole.mock.t.Fatalf("AccessServiceMock.AssignGroupRole mock is already set by Expect")
+	}
+
+	if mmAssignGroupRole.defaultExpectation.paramPtrs == nil {
+		mmAssignGroupRole.defaultExpectation.paramPtrs = &AccessServiceMockAssignGroupRoleParamPtrs{}
+	}
+	mmAssignGroupRole.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAssignGroupRole
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for AccessService.AssignGroupRole
+func (mmAssignGroupRole *mAccessServiceMockAssignGroupRole) ExpectGroupIDParam2(groupID int64) *mAccessServiceMockAssignGroupRole {
+	if mmAssignGroupRole.mock.funcAssignGroupRole != nil {
+		mmAssignGroupRole.mock.t.Fatalf("AccessServiceMock.AssignGroupRole mock is already set by Set")
+	}
+
+	if mmAssignGroupRole.defaultExpectation == nil {
+		mmAssignGroupRole.defaultExpectation = &AccessServiceMockAssignGroupRoleExpectation{}
+	}
+
+	if mmAssignGroupRole.defaultExpectation.params != nil {
+		mmAssignGroupRole.mock.t.Fatalf("AccessServiceMock.AssignGroupRole mock is already set by Expect")
+	}
+
+	if mmAssignGroupRole.defaultExpectation.paramPtrs == nil {
+		mmAssignGroupRole.defaultExpectation.paramPtrs = &AccessServiceMockAssignGroupRoleParamPtrs{}
+	}
+	mmAssignGroupRole.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmAssignGroupRole
+}
+
+// ExpectRoleParam3 sets up expected param role for AccessService.AssignGroupRole
+func (mmAssignGroupRole *mAccessServiceMockAssignGroupRole) ExpectRoleParam3(role string) *mAccessServiceMockAssignGroupRole {
+	if mmAssignGroupRole.mock.funcAssignGroupRole != nil {
+		mmAssignGroupRole.mock.t.Fatalf("AccessServiceMock.AssignGroupRole mock is already set by Set")
+	}
+
+	if mmAssignGroupRole.defaultExpectation == nil {
+		mmAssignGroupRole.defaultExpectation = &AccessServiceMockAssignGroupRoleExpectation{}
+	}
+
+	if mmAssignGroupRole.defaultExpectation.params != nil {
+		mmAssignGroupRole.mock.t.Fatalf("AccessServiceMock.AssignGroupRole mock is already set by Expect")
+	}
+
+	if mmAssignGroupRole.defaultExpectation.paramPtrs == nil {
+		mmAssignGroupRole.defaultExpectation.paramPtrs = &AccessServiceMockAssignGroupRoleParamPtrs{}
+	}
+	mmAssignGroupRole.defaultExpectation.paramPtrs.role = &role
+
+	return mmAssignGroupRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.AssignGroupRole
+func (mmAssignGroupRole *mAccessServiceMockAssignGroupRole) Inspect(f func(ctx context.Context, groupID int64, role string)) *mAccessServiceMockAssignGroupRole {
+	if mmAssignGroupRole.mock.inspectFuncAssignGroupRole != nil {
+		mmAssignGroupRole.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.AssignGroupRole")
+	}
+
+	mmAssignGroupRole.mock.inspectFuncAssignGroupRole = f
+
+	return mmAssignGroupRole
+}
+
+// Return sets up results that will be returned by AccessService.AssignGroupRole
+func (mmAssignGroupRole *mAccessServiceMockAssignGroupRole) Return(err error) *AccessServiceMock {
+	if mmAssignGroupRole.mock.funcAssignGroupRole != nil {
+		mmAssignGroupRole.mock.t.Fatalf("AccessServiceMock.AssignGroupRole mock is already set by Set")
+	}
+
+	if mmAssignGroupRole.defaultExpectation == nil {
+		mmAssignGroupRole.defaultExpectation = &AccessServiceMockAssignGroupRoleExpectation{mock: mmAssignGroupRole.mock}
+	}
+	mmAssignGroupRole.defaultExpectation.results = &AccessServiceMockAssignGroupRoleResults{err}
+	return mmAssignGroupRole.mock
+}
+
+// Set uses given function f to mock the AccessService.AssignGroupRole method
+func (mmAssignGroupRole *mAccessServiceMockAssignGroupRole) Set(f func(ctx context.Context, groupID int64, role string) (err error)) *AccessServiceMock {
+	if mmAssignGroupRole.defaultExpectation != nil {
+		mmAssignGroupRole.mock.t.Fatalf("Default expectation is already set for the AccessService.AssignGroupRole method")
+	}
+
+	if len(mmAssignGroupRole.expectations) > 0 {
+		mmAssignGroupRole.mock.t.Fatalf("Some expectations are already set for the AccessService.AssignGroupRole method")
+	}
+
+	mmAssignGroupRole.mock.funcAssignGroupRole = f
+	return mmAssignGroupRole.mock
+}
+
+// When sets expectation for the AccessService.AssignGroupRole which will trigger the result defined by the following
+// Then helper
+func (mmAssignGroupRole *mAccessServiceMockAssignGroupRole) When(ctx context.Context, groupID int64, role string) *AccessServiceMockAssignGroupRoleExpectation {
+	if mmAssignGroupRole.mock.funcAssignGroupRole != nil {
+		mmAssignGroupRole.mock.t.Fatalf("AccessServiceMock.AssignGroupRole mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockAssignGroupRoleExpectation{
+		mock:   mmAssignGroupRole.mock,
+		params: &AccessServiceMockAssignGroupRoleParams{ctx, groupID, role},
+	}
+	mmAssignGroupRole.expectations = append(mmAssignGroupRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.AssignGroupRole return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockAssignGroupRoleExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockAssignGroupRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.AssignGroupRole should be invoked
+func (mmAssignGroupRole *mAccessServiceMockAssignGroupRole) Times(n uint64) *mAccessServiceMockAssignGroupRole {
+	if n == 0 {
+		mmAssignGroupRole.mock.t.Fatalf("Times of AccessServiceMock.AssignGroupRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAssignGroupRole.expectedInvocations, n)
+	return mmAssignGroupRole
+}
+
+func (mmAssignGroupRole *mAccessServiceMockAssignGroupRole) invocationsDone() bool {
+	if len(mmAssignGroupRole.expectations) == 0 && mmAssignGroupRole.defaultExpectation == nil && mmAssignGroupRole.mock.funcAssignGroupRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAssignGroupRole.mock.afterAssignGroupRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAssignGroupRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AssignGroupRole implements service.AccessService
+func (mmAssignGroupRole *AccessServiceMock) AssignGroupRole(ctx context.Context, groupID int64, role string) (err error) {
+	mm_atomic.AddUint64(&mmAssignGroupRole.beforeAssignGroupRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmAssignGroupRole.afterAssignGroupRoleCounter, 1)
+
+	if mmAssignGroupRole.inspectFuncAssignGroupRole != nil {
+		mmAssignGroupRole.inspectFuncAssignGroupRole(ctx, groupID, role)
+	}
+
+	mm_params := AccessServiceMockAssignGroupRoleParams{ctx, groupID, role}
+
+	// Record call args
+	mmAssignGroupRole.AssignGroupRoleMock.mutex.Lock()
+	mmAssignGroupRole.AssignGroupRoleMock.callArgs = append(mmAssignGroupRole.AssignGroupRoleMock.callArgs, &mm_params)
+	mmAssignGroupRole.AssignGroupRoleMock.mutex.Unlock()
+
+	for _, e := range mmAssignGroupRole.AssignGroupRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAssignGroupRole.AssignGroupRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAssignGroupRole.AssignGroupRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmAssignGroupRole.AssignGroupRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmAssignGroupRole.AssignGroupRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockAssignGroupRoleParams{ctx, groupID, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAssignGroupRole.t.Errorf("AccessServiceMock.AssignGroupRole got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmAssignGroupRole.t.Errorf("AccessServiceMock.AssignGroupRole got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmAssignGroupRole.t.Errorf("AccessServiceMock.AssignGroupRole got unexpected parameter role, want: %#v, got: %#v%s\n", *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAssignGroupRole.t.Errorf("AccessServiceMock.AssignGroupRole got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAssignGroupRole.AssignGroupRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAssignGroupRole.t.Fatal("No results are set for the AccessServiceMock.AssignGroupRole")
+		}
+		return (*mm_results).err
+	}
+	if mmAssignGroupRole.funcAssignGroupRole != nil {
+		return mmAssignGroupRole.funcAssignGroupRole(ctx, groupID, role)
+	}
+	mmAssignGroupRole.t.Fatalf("Unexpected call to AccessServiceMock.AssignGroupRole. %v %v %v", ctx, groupID, role)
+	return
+}
+
+// AssignGroupRoleAfterCounter returns a count of finished AccessServiceMock.AssignGroupRole invocations
+func (mmAssignGroupRole *AccessServiceMock) AssignGroupRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAssignGroupRole.afterAssignGroupRoleCounter)
+}
+
+// AssignGroupRoleBeforeCounter returns a count of AccessServiceMock.AssignGroupRole invocations
+func (mmAssignGroupRole *AccessServiceMock) AssignGroupRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAssignGroupRole.beforeAssignGroupRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.AssignGroupRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAssignGroupRole *mAccessServiceMockAssignGroupRole) Calls() []*AccessServiceMockAssignGroupRoleParams {
+	mmAssignGroupRole.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockAssignGroupRoleParams, len(mmAssignGroupRole.callArgs))
+	copy(argCopy, mmAssignGroupRole.callArgs)
+
+	mmAssignGroupRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAssignGroupRoleDone returns true if the count of the AssignGroupRole invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockAssignGroupRoleDone() bool {
+	if m.AssignGroupRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AssignGroupRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AssignGroupRoleMock.invocationsDone()
+}
+
+// MinimockAssignGroupRoleInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockAssignGroupRoleInspect() {
+	for _, e := range m.AssignGroupRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.AssignGroupRole with params: %#v", *e.params)
+		}
+	}
+
+	afterAssignGroupRoleCounter := mm_atomic.LoadUint64(&m.afterAssignGroupRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AssignGroupRoleMock.defaultExpectation != nil && afterAssignGroupRoleCounter < 1 {
+		if m.AssignGroupRoleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.AssignGroupRole")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.AssignGroupRole with params: %#v", *m.AssignGroupRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAssignGroupRole != nil && afterAssignGroupRoleCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.AssignGroupRole")
+	}
+
+	if !m.AssignGroupRoleMock.invocationsDone() && afterAssignGroupRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.AssignGroupRole but found %d calls",
+			mm_atomic.LoadUint64(&m.AssignGroupRoleMock.expectedInvocations), afterAssignGroupRoleCounter)
+	}
+}
+
+type mAccessServiceMockAssignUserRole struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockAssignUserRoleExpectation
+	expectations       []*AccessServiceMockAssignUserRoleExpectation
+
+	callArgs []*AccessServiceMockAssignUserRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockAssignUserRoleExpectation specifies expectation struct of the AccessService.AssignUserRole
+type AccessServiceMockAssignUserRoleExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockAssignUserRoleParams
+	paramPtrs *AccessServiceMockAssignUserRoleParamPtrs
+	results   *AccessServiceMockAssignUserRoleResults
+	Counter   uint64
+}
+
+// AccessServiceMockAssignUserRoleParams contains parameters of the AccessService.AssignUserRole
+type AccessServiceMockAssignUserRoleParams struct {
+	ctx   context.Context
+	grant *model.RoleGrant
+}
+
+// AccessServiceMockAssignUserRoleParamPtrs contains pointers to parameters of the AccessService.AssignUserRole
+type AccessServiceMockAssignUserRoleParamPtrs struct {
+	ctx   *context.Context
+	grant **model.RoleGrant
+}
+
+// AccessServiceMockAssignUserRoleResults contains results of the AccessService.AssignUserRole
+type AccessServiceMockAssignUserRoleResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAssignUserRole *mAccessServiceMockAssignUserRole) Optional() *mAccessServiceMockAssignUserRole {
+	mmAssignUserRole.optional = true
+	return mmAssignUserRole
+}
+
+// Expect sets up expected params for AccessService.AssignUserRole
+func (mmAssignUserRole *mAccessServiceMockAssignUserRole) Expect(ctx context.Context, grant *model.RoleGrant) *mAccessServiceMockAssignUserRole {
+	if mmAssignUserRole.mock.funcAssignUserRole != nil {
+		mmAssignUserRole.mock.t.Fatalf("AccessServiceMock.AssignUserRole mock is already set by Set")
+	}
+
+	if mmAssignUserRole.defaultExpectation == nil {
+		mmAssignUserRole.defaultExpectation = &AccessServiceMockAssignUserRoleExpectation{}
+	}
+
+	if mmAssignUserRole.defaultExpectation.paramPtrs != nil {
+		mmAssignUserRole.mock.t.Fatalf("AccessServiceMock.AssignUserRole mock is already set by ExpectParams functions")
+	}
+
+	mmAssignUserRole.defaultExpectation.params = &AccessServiceMockAssignUserRoleParams{ctx, grant}
+	for _, e := range mmAssignUserRole.expectations {
+		if minimock.Equal(e.params, mmAssignUserRole.defaultExpectation.params) {
+			mmAssignUserRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAssignUserRole.defaultExpectation.params)
+		}
+	}
+
+	return mmAssignUserRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.AssignUserRole
+func (mmAssignUserRole *mAccessServiceMockAssignUserRole) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockAssignUserRole {
+	if mmAssignUserRole.mock.funcAssignUserRole != nil {
+		mmAssignUserRole.mock.t.Fatalf("AccessServiceMock.AssignUserRole mock is already set by Set")
+	}
+
+	if mmAssignUserRole.defaultExpectation == nil {
+		mmAssignUserRole.defaultExpectation = &AccessServiceMockAssignUserRoleExpectation{}
+	}
+
+	if mmAssignUserRole.defaultExpectation.params != nil {
+		mmAssignUserRole.mock.t.Fatalf("AccessServiceMock.AssignUserRole mock is already set by Expect")
+	}
+
+	if mmAssignUserRole.defaultExpectation.paramPtrs == nil {
+		mmAssignUserRole.defaultExpectation.paramPtrs = &AccessServiceMockAssignUserRoleParamPtrs{}
+	}
+	mmAssignUserRole.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAssignUserRole
+}
+
+// ExpectGrantParam2 sets up expected param grant for AccessService.AssignUserRole
+func (mmAssignUserRole *mAccessServiceMockAssignUserRole) ExpectGrantParam2(grant *model.RoleGrant) *mAccessServiceMockAssignUserRole {
+	if mmAssignUserRole.mock.funcAssignUserRole != nil {
+		mmAssignUserRole.mock.t.Fatalf("AccessServiceMock.AssignUserRole mock is already set by Set")
+	}
+
+	if mmAssignUserRole.defaultExpectation == nil {
+		mmAssignUserRole.defaultExpectation = &AccessServiceMockAssignUserRoleExpectation{}
+	}
+
+	if mmAssignUserRole.defaultExpectation.params != nil {
+		mmAssignUserRole.mock.t.Fatalf("AccessServiceMock.AssignUserRole mock is already set by Expect")
+	}
+
+	if mmAssignUserRole.defaultExpectation.paramPtrs == nil {
+		mmAssignUserRole.defaultExpectation.paramPtrs = &AccessServiceMockAssignUserRoleParamPtrs{}
+	}
+	mmAssignUserRole.defaultExpectation.paramPtrs.grant = &grant
+
+	return mmAssignUserRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.AssignUserRole
+func (mmAssignUserRole *mAccessServiceMockAssignUserRole) Inspect(f func(ctx context.Context, grant *model.RoleGrant)) *mAccessServiceMockAssignUserRole {
+	if mmAssignUserRole.mock.inspectFuncAssignUserRole != nil {
+		mmAssignUserRole.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.AssignUserRole")
+	}
+
+	mmAssignUserRole.mock.inspectFuncAssignUserRole = f
+
+	return mmAssignUserRole
+}
+
+// Return sets up results that will be returned by AccessService.AssignUserRole
+func (mmAssignUserRole *mAccessServiceMockAssignUserRole) Return(err error) *AccessServiceMock {
+	if mmAssignUserRole.mock.funcAssignUserRole != nil {
+		mmAssignUserRole.mock.t.Fatalf("AccessServiceMock.AssignUserRole mock is already set by Set")
+	}
+
+	if mmAssignUserRole.defaultExpectation == nil {
+		mmAssignUserRole.defaultExpectation = &AccessServiceMockAssignUserRoleExpectation{mock: mmAssignUserRole.mock}
+	}
+	mmAssignUserRole.defaultExpectation.results = &AccessServiceMockAssignUserRoleResults{err}
+	return mmAssignUserRole.mock
+}
+
+// Set uses given function f to mock the AccessService.AssignUserRole method
+func (mmAssignUserRole *mAccessServiceMockAssignUserRole) Set(f func(ctx context.Context, grant *model.RoleGrant) (err error)) *AccessServiceMock {
+	if mmAssignUserRole.defaultExpectation != nil {
+		mmAssignUserRole.mock.t.Fatalf("Default expectation is already set for the AccessService.AssignUserRole method")
+	}
+
+	if len(mmAssignUserRole.expectations) > 0 {
+		mmAssignUserRole.mock.t.Fatalf("Some expectations are already set for the AccessService.AssignUserRole method")
+	}
+
+	mmAssignUserRole.mock.funcAssignUserRole = f
+	return mmAssignUserRole.mock
+}
+
+// When sets expectation for the AccessService.AssignUserRole which will trigger the result defined by the following
+// Then helper
+func (mmAssignUserRole *mAccessServiceMockAssignUserRole) When(ctx context.Context, grant *model.RoleGrant) *AccessServiceMockAssignUserRoleExpectation {
+	if mmAssignUserRole.mock.funcAssignUserRole != nil {
+		mmAssignUserRole.mock.t.Fatalf("AccessServiceMock.AssignUserRole mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockAssignUserRoleExpectation{
+		mock:   mmAssignUserRole.mock,
+		params: &AccessServiceMockAssignUserRoleParams{ctx, grant},
+	}
+	mmAssignUserRole.expectations = append(mmAssignUserRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.AssignUserRole return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockAssignUserRoleExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockAssignUserRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.AssignUserRole should be invoked
+func (mmAssignUserRole *mAccessServiceMockAssignUserRole) Times(n uint64) *mAccessServiceMockAssignUserRole {
+	if n == 0 {
+		mmAssignUserRole.mock.t.Fatalf("Times of AccessServiceMock.AssignUserRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAssignUserRole.expectedInvocations, n)
+	return mmAssignUserRole
+}
+
+func (mmAssignUserRole *mAccessServiceMockAssignUserRole) invocationsDone() bool {
+	if len(mmAssignUserRole.expectations) == 0 && mmAssignUserRole.defaultExpectation == nil && mmAssignUserRole.mock.funcAssignUserRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAssignUserRole.mock.afterAssignUserRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAssignUserRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AssignUserRole implements service.AccessService
+func (mmAssignUserRole *AccessServiceMock) AssignUserRole(ctx context.Context, grant *model.RoleGrant) (err error) {
+	mm_atomic.AddUint64(&mmAssignUserRole.beforeAssignUserRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmAssignUserRole.afterAssignUserRoleCounter, 1)
+
+	if mmAssignUserRole.inspectFuncAssignUserRole != nil {
+		mmAssignUserRole.inspectFuncAssignUserRole(ctx, grant)
+	}
+
+	mm_params := AccessServiceMockAssignUserRoleParams{ctx, grant}
+
+	// Record call args
+	mmAssignUserRole.AssignUserRoleMock.mutex.Lock()
+	mmAssignUserRole.AssignUserRoleMock.callArgs = append(mmAssignUserRole.AssignUserRoleMock.callArgs, &mm_params)
+	mmAssignUserRole.AssignUserRoleMock.mutex.Unlock()
+
+	for _, e := range mmAssignUserRole.AssignUserRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAssignUserRole.AssignUserRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAssignUserRole.AssignUserRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmAssignUserRole.AssignUserRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmAssignUserRole.AssignUserRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockAssignUserRoleParams{ctx, grant}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAssignUserRole.t.Errorf("AccessServiceMock.AssignUserRole got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.grant != nil && !minimock.Equal(*mm_want_ptrs.grant, mm_got.grant) {
+				mmAssignUserRole.t.Errorf("AccessServiceMock.AssignUserRole got unexpected parameter grant, want: %#v, got: %#v%s\n", *mm_want_ptrs.grant, mm_got.grant, minimock.Diff(*mm_want_ptrs.grant, mm_got.grant))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAssignUserRole.t.Errorf("AccessServiceMock.AssignUserRole got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAssignUserRole.AssignUserRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAssignUserRole.t.Fatal("No results are set for the AccessServiceMock.AssignUserRole")
+		}
+		return (*mm_results).err
+	}
+	if mmAssignUserRole.funcAssignUserRole != nil {
+		return mmAssignUserRole.funcAssignUserRole(ctx, grant)
+	}
+	mmAssignUserRole.t.Fatalf("Unexpected call to AccessServiceMock.AssignUserRole. %v %v", ctx, grant)
+	return
+}
+
+// AssignUserRoleAfterCounter returns a count of finished AccessServiceMock.AssignUserRole invocations
+func (mmAssignUserRole *AccessServiceMock) AssignUserRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAssignUserRole.afterAssignUserRoleCounter)
+}
+
+// AssignUserRoleBeforeCounter returns a count of AccessServiceMock.AssignUserRole invocations
+func (mmAssignUserRole *AccessServiceMock) AssignUserRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAssignUserRole.beforeAssignUserRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.AssignUserRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAssignUserRole *mAccessServiceMockAssignUserRole) Calls() []*AccessServiceMockAssignUserRoleParams {
+	mmAssignUserRole.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockAssignUserRoleParams, len(mmAssignUserRole.callArgs))
+	copy(argCopy, mmAssignUserRole.callArgs)
+
+	mmAssignUserRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAssignUserRoleDone returns true if the count of the AssignUserRole invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockAssignUserRoleDone() bool {
+	if m.AssignUserRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AssignUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AssignUserRoleMock.invocationsDone()
+}
+
+// MinimockAssignUserRoleInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockAssignUserRoleInspect() {
+	for _, e := range m.AssignUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.AssignUserRole with params: %#v", *e.params)
+		}
+	}
+
+	afterAssignUserRoleCounter := mm_atomic.LoadUint64(&m.afterAssignUserRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AssignUserRoleMock.defaultExpectation != nil && afterAssignUserRoleCounter < 1 {
+		if m.AssignUserRoleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.AssignUserRole")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.AssignUserRole with params: %#v", *m.AssignUserRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAssignUserRole != nil && afterAssignUserRoleCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.AssignUserRole")
+	}
+
+	if !m.AssignUserRoleMock.invocationsDone() && afterAssignUserRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.AssignUserRole but found %d calls",
+			mm_atomic.LoadUint64(&m.AssignUserRoleMock.expectedInvocations), afterAssignUserRoleCounter)
+	}
+}
+
+type mAccessServiceMockBatchCheck struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockBatchCheckExpectation
+	expectations       []*AccessServiceMockBatchCheckExpectation
+
+	callArgs []*AccessServiceMockBatchCheckParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockBatchCheckExpectation specifies expectation struct of the AccessService.BatchCheck
+type AccessServiceMockBatchCheckExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockBatchCheckParams
+	paramPtrs *AccessServiceMockBatchCheckParamPtrs
+	results   *AccessServiceMockBatchCheckResults
+	Counter   uint64
+}
+
+// AccessServiceMockBatchCheckParams contains parameters of the AccessService.BatchCheck
+type AccessServiceMockBatchCheckParams struct {
+	ctx      context.Context
+	requests []*model.AccessRequest
+}
+
+// AccessServiceMockBatchCheckParamPtrs contains pointers to parameters of the AccessService.BatchCheck
+type AccessServiceMockBatchCheckParamPtrs struct {
+	ctx      *context.Context
+	requests *[]*model.AccessRequest
+}
+
+// AccessServiceMockBatchCheckResults contains results of the AccessService.BatchCheck
+type AccessServiceMockBatchCheckResults struct {
+	apa1 []*model.AccessDecision
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Optional() *mAccessServiceMockBatchCheck {
+	mmBatchCheck.optional = true
+	return mmBatchCheck
+}
+
+// Expect sets up expected params for AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Expect(ctx context.Context, requests []*model.AccessRequest) *mAccessServiceMockBatchCheck {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
+	}
+
+	if mmBatchCheck.defaultExpectation == nil {
+		mmBatchCheck.defaultExpectation = &AccessServiceMockBatchCheckExpectation{}
+	}
+
+	if mmBatchCheck.defaultExpectation.paramPtrs != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by ExpectParams functions")
+	}
+
+	mmBatchCheck.defaultExpectation.params = &AccessServiceMockBatchCheckParams{ctx, requests}
+	for _, e := range mmBatchCheck.expectations {
+		if minimock.Equal(e.params, mmBatchCheck.defaultExpectation.params) {
+			mmBatchCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBatchCheck.defaultExpectation.params)
+		}
+	}
+
+	return mmBatchCheck
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockBatchCheck {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
+	}
+
+	if mmBatchCheck.defaultExpectation == nil {
+		mmBatchCheck.defaultExpectation = &AccessServiceMockBatchCheckExpectation{}
+	}
+
+	if mmBatchCheck.defaultExpectation.params != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Expect")
+	}
+
+	if mmBatchCheck.defaultExpectation.paramPtrs == nil {
+		mmBatchCheck.defaultExpectation.paramPtrs = &AccessServiceMockBatchCheckParamPtrs{}
+	}
+	mmBatchCheck.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmBatchCheck
+}
+
+// ExpectRequestsParam2 sets up expected param requests for AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) ExpectRequestsParam2(requests []*model.AccessRequest) *mAccessServiceMockBatchCheck {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
+	}
+
+	if mmBatchCheck.defaultExpectation == nil {
+		mmBatchCheck.defaultExpectation = &AccessServiceMockBatchCheckExpectation{}
+	}
+
+	if mmBatchCheck.defaultExpectation.params != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Expect")
+	}
+
+	if mmBatchCheck.defaultExpectation.paramPtrs == nil {
+		mmBatchCheck.defaultExpectation.paramPtrs = &AccessServiceMockBatchCheckParamPtrs{}
+	}
+	mmBatchCheck.defaultExpectation.paramPtrs.requests = &requests
+
+	return mmBatchCheck
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Inspect(f func(ctx context.Context, requests []*model.AccessRequest)) *mAccessServiceMockBatchCheck {
+	if mmBatchCheck.mock.inspectFuncBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.BatchCheck")
+	}
+
+	mmBatchCheck.mock.inspectFuncBatchCheck = f
+
+	return mmBatchCheck
+}
+
+// Return sets up results that will be returned by AccessService.BatchCheck
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Return(apa1 []*model.AccessDecision, err error) *AccessServiceMock {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
+	}
+
+	if mmBatchCheck.defaultExpectation == nil {
+		mmBatchCheck.defaultExpectation = &AccessServiceMockBatchCheckExpectation{mock: mmBatchCheck.mock}
+	}
+	mmBatchCheck.defaultExpectation.results = &AccessServiceMockBatchCheckResults{apa1, err}
+	return mmBatchCheck.mock
+}
+
+// Set uses given function f to mock the AccessService.BatchCheck method
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Set(f func(ctx context.Context, requests []*model.AccessRequest) (apa1 []*model.AccessDecision, err error)) *AccessServiceMock {
+	if mmBatchCheck.defaultExpectation != nil {
+		mmBatchCheck.mock.t.Fatalf("Default expectation is already set for the AccessService.BatchCheck method")
+	}
+
+	if len(mmBatchCheck.expectations) > 0 {
+		mmBatchCheck.mock.t.Fatalf("Some expectations are already set for the AccessService.BatchCheck method")
+	}
+
+	mmBatchCheck.mock.funcBatchCheck = f
+	return mmBatchCheck.mock
+}
+
+// When sets expectation for the AccessService.BatchCheck which will trigger the result defined by the following
+// Then helper
+func (mmBatchCheck *mAccessServiceMockBatchCheck) When(ctx context.Context, requests []*model.AccessRequest) *AccessServiceMockBatchCheckExpectation {
+	if mmBatchCheck.mock.funcBatchCheck != nil {
+		mmBatchCheck.mock.t.Fatalf("AccessServiceMock.BatchCheck mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockBatchCheckExpectation{
+		mock:   mmBatchCheck.mock,
+		params: &AccessServiceMockBatchCheckParams{ctx, requests},
+	}
+	mmBatchCheck.expectations = append(mmBatchCheck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.BatchCheck return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockBatchCheckExpectation) Then(apa1 []*model.AccessDecision, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockBatchCheckResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.BatchCheck should be invoked
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Times(n uint64) *mAccessServiceMockBatchCheck {
+	if n == 0 {
+		mmBatchCheck.mock.t.Fatalf("Times of AccessServiceMock.BatchCheck mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmBatchCheck.expectedInvocations, n)
+	return mmBatchCheck
+}
+
+func (mmBatchCheck *mAccessServiceMockBatchCheck) invocationsDone() bool {
+	if len(mmBatchCheck.expectations) == 0 && mmBatchCheck.defaultExpectation == nil && mmBatchCheck.mock.funcBatchCheck == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmBatchCheck.mock.afterBatchCheckCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBatchCheck.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// BatchCheck implements service.AccessService
+func (mmBatchCheck *AccessServiceMock) BatchCheck(ctx context.Context, requests []*model.AccessRequest) (apa1 []*model.AccessDecision, err error) {
+	mm_atomic.AddUint64(&mmBatchCheck.beforeBatchCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmBatchCheck.afterBatchCheckCounter, 1)
+
+	if mmBatchCheck.inspectFuncBatchCheck != nil {
+		mmBatchCheck.inspectFuncBatchCheck(ctx, requests)
+	}
+
+	mm_params := AccessServiceMockBatchCheckParams{ctx, requests}
+
+	// Record call args
+	mmBatchCheck.BatchCheckMock.mutex.Lock()
+	mmBatchCheck.BatchCheckMock.callArgs = append(mmBatchCheck.BatchCheckMock.callArgs, &mm_params)
+	mmBatchCheck.BatchCheckMock.mutex.Unlock()
+
+	for _, e := range mmBatchCheck.BatchCheckMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmBatchCheck.BatchCheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBatchCheck.BatchCheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmBatchCheck.BatchCheckMock.defaultExpectation.params
+		mm_want_ptrs := mmBatchCheck.BatchCheckMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockBatchCheckParams{ctx, requests}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBatchCheck.t.Errorf("AccessServiceMock.BatchCheck got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.requests != nil && !minimock.Equal(*mm_want_ptrs.requests, mm_got.requests) {
+				mmBatchCheck.t.Errorf("AccessServiceMock.BatchCheck got unexpected parameter requests, want: %#v, got: %#v%s\n", *mm_want_ptrs.requests, mm_got.requests, minimock.Diff(*mm_want_ptrs.requests, mm_got.requests))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBatchCheck.t.Errorf("AccessServiceMock.BatchCheck got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBatchCheck.BatchCheckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBatchCheck.t.Fatal("No results are set for the AccessServiceMock.BatchCheck")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmBatchCheck.funcBatchCheck != nil {
+		return mmBatchCheck.funcBatchCheck(ctx, requests)
+	}
+	mmBatchCheck.t.Fatalf("Unexpected call to AccessServiceMock.BatchCheck. %v %v", ctx, requests)
+	return
+}
+
+// BatchCheckAfterCounter returns a count of finished AccessServiceMock.BatchCheck invocations
+func (mmBatchCheck *AccessServiceMock) BatchCheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchCheck.afterBatchCheckCounter)
+}
+
+// BatchCheckBeforeCounter returns a count of AccessServiceMock.BatchCheck invocations
+func (mmBatchCheck *AccessServiceMock) BatchCheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchCheck.beforeBatchCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.BatchCheck.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBatchCheck *mAccessServiceMockBatchCheck) Calls() []*AccessServiceMockBatchCheckParams {
+	mmBatchCheck.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockBatchCheckParams, len(mmBatchCheck.callArgs))
+	copy(argCopy, mmBatchCheck.callArgs)
+
+	mmBatchCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBatchCheckDone returns true if the count of the BatchCheck invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockBatchCheckDone() bool {
+	if m.BatchCheckMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BatchCheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BatchCheckMock.invocationsDone()
+}
+
+// MinimockBatchCheckInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockBatchCheckInspect() {
+	for _, e := range m.BatchCheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.BatchCheck with params: %#v", *e.params)
+		}
+	}
+
+	afterBatchCheckCounter := mm_atomic.LoadUint64(&m.afterBatchCheckCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BatchCheckMock.defaultExpectation != nil && afterBatchCheckCounter < 1 {
+		if m.BatchCheckMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.BatchCheck")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.BatchCheck with params: %#v", *m.BatchCheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBatchCheck != nil && afterBatchCheckCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.BatchCheck")
+	}
+
+	if !m.BatchCheckMock.invocationsDone() && afterBatchCheckCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.BatchCheck but found %d calls",
+			mm_atomic.LoadUint64(&m.BatchCheckMock.expectedInvocations), afterBatchCheckCounter)
+	}
+}
+
+type mAccessServiceMockCheck struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockCheckExpectation
+	expectations       []*AccessServiceMockCheckExpectation
+
+	callArgs []*AccessServiceMockCheckParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockCheckExpectation specifies expectation struct of the AccessService.Check
+type AccessServiceMockCheckExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockCheckParams
+	paramPtrs *AccessServiceMockCheckParamPtrs
+	results   *AccessServiceMockCheckResults
+	Counter   uint64
+}
+
+// AccessServiceMockCheckParams contains parameters of the AccessService.Check
+type AccessServiceMockCheckParams struct {
+	ctx      context.Context
+	endpoint string
+}
+
+// AccessServiceMockCheckParamPtrs contains pointers to parameters of the AccessService.Check
+type AccessServiceMockCheckParamPtrs struct {
+	ctx      *context.Context
+	endpoint *string
+}
+
+// AccessServiceMockCheckResults contains results of the AccessService.Check
+type AccessServiceMockCheckResults struct {
+	ap1 *model.AccessGrant
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheck *mAccessServiceMockCheck) Optional() *mAccessServiceMockCheck {
+	mmCheck.optional = true
+	return mmCheck
+}
+
+// Expect sets up expected params for AccessService.Check
+func (mmCheck *mAccessServiceMockCheck) Expect(ctx context.Context, endpoint string) *mAccessServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by ExpectParams functions")
+	}
+
+	mmCheck.defaultExpectation.params = &AccessServiceMockCheckParams{ctx, endpoint}
+	for _, e := range mmCheck.expectations {
+		if minimock.Equal(e.params, mmCheck.defaultExpectation.params) {
+			mmCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheck.defaultExpectation.params)
+		}
+	}
+
+	return mmCheck
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.Check
+func (mmCheck *mAccessServiceMockCheck) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &AccessServiceMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCheck
+}
+
+// ExpectEndpointParam2 sets up expected param endpoint for AccessService.Check
+func (mmCheck *mAccessServiceMockCheck) ExpectEndpointParam2(endpoint string) *mAccessServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &AccessServiceMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.endpoint = &endpoint
+
+	return mmCheck
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.Check
+func (mmCheck *mAccessServiceMockCheck) Inspect(f func(ctx context.Context, endpoint string)) *mAccessServiceMockCheck {
+	if mmCheck.mock.inspectFuncCheck != nil {
+		mmCheck.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.Check")
+	}
+
+	mmCheck.mock.inspectFuncCheck = f
+
+	return mmCheck
+}
+
+// Return sets up results that will be returned by AccessService.Check
+func (mmCheck *mAccessServiceMockCheck) Return(ap1 *model.AccessGrant, err error) *AccessServiceMock {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceMockCheckExpectation{mock: mmCheck.mock}
+	}
+	mmCheck.defaultExpectation.results = &AccessServiceMockCheckResults{ap1, err}
+	return mmCheck.mock
+}
+
+// Set uses given function f to mock the AccessService.Check method
+func (mmCheck *mAccessServiceMockCheck) Set(f func(ctx context.Context, endpoint string) (ap1 *model.AccessGrant, err error)) *AccessServiceMock {
+	if mmCheck.defaultExpectation != nil {
+		mmCheck.mock.t.Fatalf("Default expectation is already set for the AccessService.Check method")
+	}
+
+	if len(mmCheck.expectations) > 0 {
+		mmCheck.mock.t.Fatalf("Some expectations are already set for the AccessService.Check method")
+	}
+
+	mmCheck.mock.funcCheck = f
+	return mmCheck.mock
+}
+
+// When sets expectation for the AccessService.Check which will trigger the result defined by the following
+// Then helper
+func (mmCheck *mAccessServiceMockCheck) When(ctx context.Context, endpoint string) *AccessServiceMockCheckExpectation {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceMock.Check mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockCheckExpectation{
+		mock:   mmCheck.mock,
+		params: &AccessServiceMockCheckParams{ctx, endpoint},
+	}
+	mmCheck.expectations = append(mmCheck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.Check return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockCheckExpectation) Then(ap1 *model.AccessGrant, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockCheckResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.Check should be invoked
+func (mmCheck *mAccessServiceMockCheck) Times(n uint64) *mAccessServiceMockCheck {
+	if n == 0 {
+		mmCheck.mock.t.Fatalf("Times of AccessServiceMock.Check mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheck.expectedInvocations, n)
+	return mmCheck
+}
+
+func (mmCheck *mAccessServiceMockCheck) invocationsDone() bool {
+	if len(mmCheck.expectations) == 0 && mmCheck.defaultExpectation == nil && mmCheck.mock.funcCheck == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheck.mock.afterCheckCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheck.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Check implements service.AccessService
+func (mmCheck *AccessServiceMock) Check(ctx context.Context, endpoint string) (ap1 *model.AccessGrant, err error) {
+	mm_atomic.AddUint64(&mmCheck.beforeCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheck.afterCheckCounter, 1)
+
+	if mmCheck.inspectFuncCheck != nil {
+		mmCheck.inspectFuncCheck(ctx, endpoint)
+	}
+
+	mm_params := AccessServiceMockCheckParams{ctx, endpoint}
+
+	// Record call args
+	mmCheck.CheckMock.mutex.Lock()
+	mmCheck.CheckMock.callArgs = append(mmCheck.CheckMock.callArgs, &mm_params)
+	mmCheck.CheckMock.mutex.Unlock()
+
+	for _, e := range mmCheck.CheckMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmCheck.CheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheck.CheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheck.CheckMock.defaultExpectation.params
+		mm_want_ptrs := mmCheck.CheckMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockCheckParams{ctx, endpoint}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheck.t.Errorf("AccessServiceMock.Check got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.endpoint != nil && !minimock.Equal(*mm_want_ptrs.endpoint, mm_got.endpoint) {
+				mmCheck.t.Errorf("AccessServiceMock.Check got unexpected parameter endpoint, want: %#v, got: %#v%s\n", *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheck.t.Errorf("AccessServiceMock.Check got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheck.CheckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheck.t.Fatal("No results are set for the AccessServiceMock.Check")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmCheck.funcCheck != nil {
+		return mmCheck.funcCheck(ctx, endpoint)
+	}
+	mmCheck.t.Fatalf("Unexpected call to AccessServiceMock.Check. %v %v", ctx, endpoint)
+	return
+}
+
+// CheckAfterCounter returns a count of finished AccessServiceMock.Check invocations
+func (mmCheck *AccessServiceMock) CheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.afterCheckCounter)
+}
+
+// CheckBeforeCounter returns a count of AccessServiceMock.Check invocations
+func (mmCheck *AccessServiceMock) CheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.beforeCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.Check.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheck *mAccessServiceMockCheck) Calls() []*AccessServiceMockCheckParams {
+	mmCheck.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockCheckParams, len(mmCheck.callArgs))
+	copy(argCopy, mmCheck.callArgs)
+
+	mmCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckDone returns true if the count of the Check invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockCheckDone() bool {
+	if m.CheckMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckMock.invocationsDone()
+}
+
+// MinimockCheckInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockCheckInspect() {
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.Check with params: %#v", *e.params)
+		}
+	}
+
+	afterCheckCounter := mm_atomic.LoadUint64(&m.afterCheckCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckMock.defaultExpectation != nil && afterCheckCounter < 1 {
+		if m.CheckMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.Check")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.Check with params: %#v", *m.CheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheck != nil && afterCheckCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.Check")
+	}
+
+	if !m.CheckMock.invocationsDone() && afterCheckCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.Check but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckMock.expectedInvocations), afterCheckCounter)
+	}
+}
+
+type mAccessServiceMockCheckRelation struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockCheckRelationExpectation
+	expectations       []*AccessServiceMockCheckRelationExpectation
+
+	callArgs []*AccessServiceMockCheckRelationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockCheckRelationExpectation specifies expectation struct of the AccessService.CheckRelation
+type AccessServiceMockCheckRelationExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockCheckRelationParams
+	paramPtrs *AccessServiceMockCheckRelationParamPtrs
+	results   *AccessServiceMockCheckRelationResults
+	Counter   uint64
+}
+
+// AccessServiceMockCheckRelationParams contains parameters of the AccessService.CheckRelation
+type AccessServiceMockCheckRelationParams struct {
+	ctx      context.Context
+	object   string
+	relation string
+	userID   int64
+}
+
+// AccessServiceMockCheckRelationParamPtrs contains pointers to parameters of the AccessService.CheckRelation
+type AccessServiceMockCheckRelationParamPtrs struct {
+	ctx      *context.Context
+	object   *string
+	relation *string
+	userID   *int64
+}
+
+// AccessServiceMockCheckRelationResults contains results of the AccessService.CheckRelation
+type AccessServiceMockCheckRelationResults struct {
+	b1  bool
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckRelation *mAccessServiceMockCheckRelation) Optional() *mAccessServiceMockCheckRelation {
+	mmCheckRelation.optional = true
+	return mmCheckRelation
+}
+
+// Expect sets up expected params for AccessService.CheckRelation
+func (mmCheckRelation *mAccessServiceMockCheckRelation) Expect(ctx context.Context, object string, relation string, userID int64) *mAccessServiceMockCheckRelation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("AccessServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &AccessServiceMockCheckRelationExpectation{}
+	}
+
+	if mmCheckRelation.defaultExpectation.paramPtrs != nil {
+		mmCheckRelation.mock.t.Fatalf("AccessServiceMock.CheckRelation mock is already set by ExpectParams functions")
+	}
+
+	mmCheckRelation.defaultExpectation.params = &AccessServiceMockCheckRelationParams{ctx, object, relation, userID}
+	for _, e := range mmCheckRelation.expectations {
+		if minimock.Equal(e.params, mmCheckRelation.defaultExpectation.params) {
+			mmCheckRelation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckRelation.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckRelation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.CheckRelation
+func (mmCheckRelation *mAccessServiceMockCheckRelation) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockCheckRelation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("AccessServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &AccessServiceMockCheckRelationExpectation{}
+	}
+
+	if mmCheckRelation.defaultExpectation.params != nil {
+		mmCheckRelation.mock.t.Fatalf("AccessServiceMock.CheckRelation mock is already set by Expect")
+	}
+
+	if mmCheckRelation.defaultExpectation.paramPtrs == nil {
+		mmCheckRelation.defaultExpectation.paramPtrs = &AccessServiceMockCheckRelationParamPtrs{}
+	}
+	mmCheckRelation.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCheckRelation
+}
+
+// ExpectObjectParam2 sets up expected param object for AccessService.CheckRelation
+func (mmCheckRelation *mAccessServiceMockCheckRelation) ExpectObjectParam2(object string) *mAccessServiceMockCheckRelation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("AccessServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &AccessServiceMockCheckRelationExpectation{}
+	}
+
+	if mmCheckRelation.defaultExpectation.params != nil {
+		mmCheckRelation.mock.t.Fatalf("AccessServiceMock.CheckRelation mock is already set by Expect")
+	}
+
+	if mmCheckRelation.defaultExpectation.paramPtrs == nil {
+		mmCheckRelation.defaultExpectation.paramPtrs = &AccessServiceMockCheckRelationParamPtrs{}
+	}
+	mmCheckRelation.defaultExpectation.paramPtrs.object = &object
+
+	return mmCheckRelation
+}
+
+// ExpectRelationParam3 sets up expected param relation for AccessService.CheckRelation
+func (mmCheckRelation *mAccessServiceMockCheckRelation) ExpectRelationParam3(relation string) *mAccessServiceMockCheckRelation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("AccessServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &AccessServiceMockCheckRelationExpectation{}
+	}
+
+	if mmCheckRelation.defaultExpectation.params != nil {
+		mmCheckRelation.mock.t.Fatalf("AccessServiceMock.CheckRelation mock is already set by Expect")
+	}
+
+	if mmCheckRelation.defaultExpectation.paramPtrs == nil {
+		mmCheckRelation.defaultExpectation.paramPtrs = &AccessServiceMockCheckRelationParamPtrs{}
+	}
+	mmCheckRelation.defaultExpectation.paramPtrs.relation = &relation
+
+	return mmCheckRelation
+}
+
+// ExpectUserIDParam4 sets up expected param userID for AccessService.CheckRelation
+func (mmCheckRelation *mAccessServiceMockCheckRelation) ExpectUserIDParam4(userID int64) *mAccessServiceMockCheckRelation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("AccessServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &AccessServiceMockCheckRelationExpectation{}
+	}
+
+	if mmCheckRelation.defaultExpectation.params != nil {
+		mmCheckRelation.mock.t.Fatalf("AccessServiceMock.CheckRelation mock is already set by Expect")
+	}
+
+	if mmCheckRelation.defaultExpectation.paramPtrs == nil {
+		mmCheckRelation.defaultExpectation.paramPtrs = &AccessServiceMockCheckRelationParamPtrs{}
+	}
+	mmCheckRelation.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmCheckRelation
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.CheckRelation
+func (mmCheckRelation *mAccessServiceMockCheckRelation) Inspect(f func(ctx context.Context, object string, relation string, userID int64)) *mAccessServiceMockCheckRelation {
+	if mmCheckRelation.mock.inspectFuncCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.CheckRelation")
+	}
+
+	mmCheckRelation.mock.inspectFuncCheckRelation = f
+
+	return mmCheckRelation
+}
+
+// Return sets up results that will be returned by AccessService.CheckRelation
+func (mmCheckRelation *mAccessServiceMockCheckRelation) Return(b1 bool, err error) *AccessServiceMock {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("AccessServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	if mmCheckRelation.defaultExpectation == nil {
+		mmCheckRelation.defaultExpectation = &AccessServiceMockCheckRelationExpectation{mock: mmCheckRelation.mock}
+	}
+	mmCheckRelation.defaultExpectation.results = &AccessServiceMockCheckRelationResults{b1, err}
+	return mmCheckRelation.mock
+}
+
+// Set uses given function f to mock the AccessService.CheckRelation method
+func (mmCheckRelation *mAccessServiceMockCheckRelation) Set(f func(ctx context.Context, object string, relation string, userID int64) (b1 bool, err error)) *AccessServiceMock {
+	if mmCheckRelation.defaultExpectation != nil {
+		mmCheckRelation.mock.t.Fatalf("Default expectation is already set for the AccessService.CheckRelation method")
+	}
+
+	if len(mmCheckRelation.expectations) > 0 {
+		mmCheckRelation.mock.t.Fatalf("Some expectations are already set for the AccessService.CheckRelation method")
+	}
+
+	mmCheckRelation.mock.funcCheckRelation = f
+	return mmCheckRelation.mock
+}
+
+// When sets expectation for the AccessService.CheckRelation which will trigger the result defined by the following
+// Then helper
+func (mmCheckRelation *mAccessServiceMockCheckRelation) When(ctx context.Context, object string, relation string, userID int64) *AccessServiceMockCheckRelationExpectation {
+	if mmCheckRelation.mock.funcCheckRelation != nil {
+		mmCheckRelation.mock.t.Fatalf("AccessServiceMock.CheckRelation mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockCheckRelationExpectation{
+		mock:   mmCheckRelation.mock,
+		params: &AccessServiceMockCheckRelationParams{ctx, object, relation, userID},
+	}
+	mmCheckRelation.expectations = append(mmCheckRelation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.CheckRelation return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockCheckRelationExpectation) Then(b1 bool, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockCheckRelationResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.CheckRelation should be invoked
+func (mmCheckRelation *mAccessServiceMockCheckRelation) Times(n uint64) *mAccessServiceMockCheckRelation {
+	if n == 0 {
+		mmCheckRelation.mock.t.Fatalf("Times of AccessServiceMock.CheckRelation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckRelation.expectedInvocations, n)
+	return mmCheckRelation
+}
+
+func (mmCheckRelation *mAccessServiceMockCheckRelation) invocationsDone() bool {
+	if len(mmCheckRelation.expectations) == 0 && mmCheckRelation.defaultExpectation == nil && mmCheckRelation.mock.funcCheckRelation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckRelation.mock.afterCheckRelationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckRelation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckRelation implements service.AccessService
+func (mmCheckRelation *AccessServiceMock) CheckRelation(ctx context.Context, object string, relation string, userID int64) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmCheckRelation.beforeCheckRelationCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckRelation.afterCheckRelationCounter, 1)
+
+	if mmCheckRelation.inspectFuncCheckRelation != nil {
+		mmCheckRelation.inspectFuncCheckRelation(ctx, object, relation, userID)
+	}
+
+	mm_params := AccessServiceMockCheckRelationParams{ctx, object, relation, userID}
+
+	// Record call args
+	mmCheckRelation.CheckRelationMock.mutex.Lock()
+	mmCheckRelation.CheckRelationMock.callArgs = append(mmCheckRelation.CheckRelationMock.callArgs, &mm_params)
+	mmCheckRelation.CheckRelationMock.mutex.Unlock()
+
+	for _, e := range mmCheckRelation.CheckRelationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmCheckRelation.CheckRelationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckRelation.CheckRelationMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckRelation.CheckRelationMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckRelation.CheckRelationMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockCheckRelationParams{ctx, object, relation, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckRelation.t.Errorf("AccessServiceMock.CheckRelation got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.object != nil && !minimock.Equal(*mm_want_ptrs.object, mm_got.object) {
+				mmCheckRelation.t.Errorf("AccessServiceMock.CheckRelation got unexpected parameter object, want: %#v, got: %#v%s\n", *mm_want_ptrs.object, mm_got.object, minimock.Diff(*mm_want_ptrs.object, mm_got.object))
+			}
+
+			if mm_want_ptrs.relation != nil && !minimock.Equal(*mm_want_ptrs.relation, mm_got.relation) {
+				mmCheckRelation.t.Errorf("AccessServiceMock.CheckRelation got unexpected parameter relation, want: %#v, got: %#v%s\n", *mm_want_ptrs.relation, mm_got.relation, minimock.Diff(*mm_want_ptrs.relation, mm_got.relation))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCheckRelation.t.Errorf("AccessServiceMock.CheckRelation got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckRelation.t.Errorf("AccessServiceMock.CheckRelation got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckRelation.CheckRelationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckRelation.t.Fatal("No results are set for the AccessServiceMock.CheckRelation")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmCheckRelation.funcCheckRelation != nil {
+		return mmCheckRelation.funcCheckRelation(ctx, object, relation, userID)
+	}
+	mmCheckRelation.t.Fatalf("Unexpected call to AccessServiceMock.CheckRelation. %v %v %v %v", ctx, object, relation, userID)
+	return
+}
+
+// CheckRelationAfterCounter returns a count of finished AccessServiceMock.CheckRelation invocations
+func (mmCheckRelation *AccessServiceMock) CheckRelationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckRelation.afterCheckRelationCounter)
+}
+
+// CheckRelationBeforeCounter returns a count of AccessServiceMock.CheckRelation invocations
+func (mmCheckRelation *AccessServiceMock) CheckRelationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckRelation.beforeCheckRelationCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.CheckRelation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckRelation *mAccessServiceMockCheckRelation) Calls() []*AccessServiceMockCheckRelationParams {
+	mmCheckRelation.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockCheckRelationParams, len(mmCheckRelation.callArgs))
+	copy(argCopy, mmCheckRelation.callArgs)
+
+	mmCheckRelation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckRelationDone returns true if the count of the CheckRelation invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockCheckRelationDone() bool {
+	if m.CheckRelationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckRelationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckRelationMock.invocationsDone()
+}
+
+// MinimockCheckRelationInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockCheckRelationInspect() {
+	for _, e := range m.CheckRelationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.CheckRelation with params: %#v", *e.params)
+		}
+	}
+
+	afterCheckRelationCounter := mm_atomic.LoadUint64(&m.afterCheckRelationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckRelationMock.defaultExpectation != nil && afterCheckRelationCounter < 1 {
+		if m.CheckRelationMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.CheckRelation")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.CheckRelation with params: %#v", *m.CheckRelationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckRelation != nil && afterCheckRelationCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.CheckRelation")
+	}
+
+	if !m.CheckRelationMock.invocationsDone() && afterCheckRelationCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.CheckRelation but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckRelationMock.expectedInvocations), afterCheckRelationCounter)
+	}
+}
+
+type mAccessServiceMockCheckResource struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockCheckResourceExpectation
+	expectations       []*AccessServiceMockCheckResourceExpectation
+
+	callArgs []*AccessServiceMockCheckResourceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockCheckResourceExpectation specifies expectation struct of the AccessService.CheckResource
+type AccessServiceMockCheckResourceExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockCheckResourceParams
+	paramPtrs *AccessServiceMockCheckResourceParamPtrs
+	results   *AccessServiceMockCheckResourceResults
+	Counter   uint64
+}
+
+// AccessServiceMockCheckResourceParams contains parameters of the AccessService.CheckResource
+type AccessServiceMockCheckResourceParams struct {
+	ctx      context.Context
+	endpoint string
+	resource string
+}
+
+// AccessServiceMockCheckResourceParamPtrs contains pointers to parameters of the AccessService.CheckResource
+type AccessServiceMockCheckResourceParamPtrs struct {
+	ctx      *context.Context
+	endpoint *string
+	resource *string
+}
+
+// AccessServiceMockCheckResourceResults contains results of the AccessService.CheckResource
+type AccessServiceMockCheckResourceResults struct {
+	ap1 *model.AccessGrant
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckResource *mAccessServiceMockCheckResource) Optional() *mAccessServiceMockCheckResource {
+	mmCheckResource.optional = true
+	return mmCheckResource
+}
+
+// Expect sets up expected params for AccessService.CheckResource
+func (mmCheckResource *mAccessServiceMockCheckResource) Expect(ctx context.Context, endpoint string, resource string) *mAccessServiceMockCheckResource {
+	if mmCheckResource.mock.funcCheckResource != nil {
+		mmCheckResource.mock.t.Fatalf("AccessServiceMock.CheckResource mock is already set by Set")
+	}
+
+	if mmCheckResource.defaultExpectation == nil {
+		mmCheckResource.defaultExpectation = &AccessServiceMockCheckResourceExpectation{}
+	}
+
+	if mmCheckResource.defaultExpectation.paramPtrs != nil {
+		mmCheckResource.mock.t.Fatalf("AccessServiceMock.CheckResource mock is already set by ExpectParams functions")
+	}
+
+	mmCheckResource.defaultExpectation.params = &AccessServiceMockCheckResourceParams{ctx, endpoint, resource}
+	for _, e := range mmCheckResource.expectations {
+		if minimock.Equal(e.params, mmCheckResource.defaultExpectation.params) {
+			mmCheckResource.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckResource.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckResource
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.CheckResource
+func (mmCheckResource *mAccessServiceMockCheckResource) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockCheckResource {
+	if mmCheckResource.mock.funcCheckResource != nil {
+		mmCheckResource.mock.t.Fatalf("AccessServiceMock.CheckResource mock is already set by Set")
+	}
+
+	if mmCheckResource.defaultExpectation == nil {
+		mmCheckResource.defaultExpectation = &AccessServiceMockCheckResourceExpectation{}
+	}
+
+	if mmCheckResource.defaultExpectation.params != nil {
+		mmCheckResource.mock.t.Fatalf("AccessServiceMock.CheckResource mock is already set by Expect")
+	}
+
+	if mmCheckResource.defaultExpectation.paramPtrs == nil {
+		mmCheckResource.defaultExpectation.paramPtrs = &AccessServiceMockCheckResourceParamPtrs{}
+	}
+	mmCheckResource.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCheckResource
+}
+
+// ExpectEndpointParam2 sets up expected param endpoint for AccessService.CheckResource
+func (mmCheckResource *mAccessServiceMockCheckResource) ExpectEndpointParam2(endpoint string) *mAccessServiceMockCheckResource {
+	if mmCheckResource.mock.funcCheckResource != nil {
+		mmCheckResource.mock.t.Fatalf("AccessServiceMock.CheckResource mock is already set by Set")
+	}
+
+	if mmCheckResource.defaultExpectation == nil {
+		mmCheckResource.defaultExpectation = &AccessServiceMockCheckResourceExpectation{}
+	}
+
+	if mmCheckResource.defaultExpectation.params != nil {
+		mmCheckResource.mock.t.Fatalf("AccessServiceMock.CheckResource mock is already set by Expect")
+	}
+
+	if mmCheckResource.defaultExpectation.paramPtrs == nil {
+		mmCheckResource.defaultExpectation.paramPtrs = &AccessServiceMockCheckResourceParamPtrs{}
+	}
+	mmCheckResource.defaultExpectation.paramPtrs.endpoint = &endpoint
+
+	return mmCheckResource
+}
+
+// ExpectResourceParam3 sets up expected param resource for AccessService.CheckResource
+func (mmCheckResource *mAccessServiceMockCheckResource) ExpectResourceParam3(resource string) *mAccessServiceMockCheckResource {
+	if mmCheckResource.mock.funcCheckResource != nil {
+		mmCheckResource.mock.t.Fatalf("AccessServiceMock.CheckResource mock is already set by Set")
+	}
+
+	if mmCheckResource.defaultExpectation == nil {
+		mmCheckResource.defaultExpectation = &AccessServiceMockCheckResourceExpectation{}
+	}
+
+	if mmCheckResource.defaultExpectation.params != nil {
+		mmCheckResource.mock.t.Fatalf("AccessServiceMock.CheckResource mock is already set by Expect")
+	}
+
+	if mmCheckResource.defaultExpectation.paramPtrs == nil {
+		mmCheckResource.defaultExpectation.paramPtrs = &AccessServiceMockCheckResourceParamPtrs{}
+	}
+	mmCheckResource.defaultExpectation.paramPtrs.resource = &resource
+
+	return mmCheckResource
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.CheckResource
+func (mmCheckResource *mAccessServiceMockCheckResource) Inspect(f func(ctx context.Context, endpoint string, resource string)) *mAccessServiceMockCheckResource {
+	if mmCheckResource.mock.inspectFuncCheckResource != nil {
+		mmCheckResource.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.CheckResource")
+	}
+
+	mmCheckResource.mock.inspectFuncCheckResource = f
+
+	return mmCheckResource
+}
+
+// Return sets up results that will be returned by AccessService.CheckResource
+func (mmCheckResource *mAccessServiceMockCheckResource) Return(ap1 *model.AccessGrant, err error) *AccessServiceMock {
+	if mmCheckResource.mock.funcCheckResource != nil {
+		mmCheckResource.mock.t.Fatalf("AccessServiceMock.CheckResource mock is already set by Set")
+	}
+
+	if mmCheckResource.defaultExpectation == nil {
+		mmCheckResource.defaultExpectation = &AccessServiceMockCheckResourceExpectation{mock: mmCheckResource.mock}
+	}
+	mmCheckResource.defaultExpectation.results = &AccessServiceMockCheckResourceResults{ap1, err}
+	return mmCheckResource.mock
+}
+
+// Set uses given function f to mock the AccessService.CheckResource method
+func (mmCheckResource *mAccessServiceMockCheckResource) Set(f func(ctx context.Context, endpoint string, resource string) (ap1 *model.AccessGrant, err error)) *AccessServiceMock {
+	if mmCheckResource.defaultExpectation != nil {
+		mmCheckResource.mock.t.Fatalf("Default expectation is already set for the AccessService.CheckResource method")
+	}
+
+	if len(mmCheckResource.expectations) > 0 {
+		mmCheckResource.mock.t.Fatalf("Some expectations are already set for the AccessService.CheckResource method")
+	}
+
+	mmCheckResource.mock.funcCheckResource = f
+	return mmCheckResource.mock
+}
+
+// When sets expectation for the AccessService.CheckResource which will trigger the result defined by the following
+// Then helper
+func (mmCheckResource *mAccessServiceMockCheckResource) When(ctx context.Context, endpoint string, resource string) *AccessServiceMockCheckResourceExpectation {
+	if mmCheckResource.mock.funcCheckResource != nil {
+		mmCheckResource.mock.t.Fatalf("AccessServiceMock.CheckResource mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockCheckResourceExpectation{
+		mock:   mmCheckResource.mock,
+		params: &AccessServiceMockCheckResourceParams{ctx, endpoint, resource},
+	}
+	mmCheckResource.expectations = append(mmCheckResource.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.CheckResource return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockCheckResourceExpectation) Then(ap1 *model.AccessGrant, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockCheckResourceResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.CheckResource should be invoked
+func (mmCheckResource *mAccessServiceMockCheckResource) Times(n uint64) *mAccessServiceMockCheckResource {
+	if n == 0 {
+		mmCheckResource.mock.t.Fatalf("Times of AccessServiceMock.CheckResource mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckResource.expectedInvocations, n)
+	return mmCheckResource
+}
+
+func (mmCheckResource *mAccessServiceMockCheckResource) invocationsDone() bool {
+	if len(mmCheckResource.expectations) == 0 && mmCheckResource.defaultExpectation == nil && mmCheckResource.mock.funcCheckResource == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckResource.mock.afterCheckResourceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckResource.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckResource implements service.AccessService
+func (mmCheckResource *AccessServiceMock) CheckResource(ctx context.Context, endpoint string, resource string) (ap1 *model.AccessGrant, err error) {
+	mm_atomic.AddUint64(&mmCheckResource.beforeCheckResourceCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckResource.afterCheckResourceCounter, 1)
+
+	if mmCheckResource.inspectFuncCheckResource != nil {
+		mmCheckResource.inspectFuncCheckResource(ctx, endpoint, resource)
+	}
+
+	mm_params := AccessServiceMockCheckResourceParams{ctx, endpoint, resource}
+
+	// Record call args
+	mmCheckResource.CheckResourceMock.mutex.Lock()
+	mmCheckResource.CheckResourceMock.callArgs = append(mmCheckResource.CheckResourceMock.callArgs, &mm_params)
+	mmCheckResource.CheckResourceMock.mutex.Unlock()
+
+	for _, e := range mmCheckResource.CheckResourceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmCheckResource.CheckResourceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckResource.CheckResourceMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckResource.CheckResourceMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckResource.CheckResourceMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockCheckResourceParams{ctx, endpoint, resource}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckResource.t.Errorf("AccessServiceMock.CheckResource got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.endpoint != nil && !minimock.Equal(*mm_want_ptrs.endpoint, mm_got.endpoint) {
+				mmCheckResource.t.Errorf("AccessServiceMock.CheckResource got unexpected parameter endpoint, want: %#v, got: %#v%s\n", *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
+			}
+
+			if mm_want_ptrs.resource != nil && !minimock.Equal(*mm_want_ptrs.resource, mm_got.resource) {
+				mmCheckResource.t.Errorf("AccessServiceMock.CheckResource got unexpected parameter resource, want: %#v, got: %#v%s\n", *mm_want_ptrs.resource, mm_got.resource, minimock.Diff(*mm_want_ptrs.resource, mm_got.resource))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckResource.t.Errorf("AccessServiceMock.CheckResource got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckResource.CheckResourceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckResource.t.Fatal("No results are set for the AccessServiceMock.CheckResource")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmCheckResource.funcCheckResource != nil {
+		return mmCheckResource.funcCheckResource(ctx, endpoint, resource)
+	}
+	mmCheckResource.t.Fatalf("Unexpected call to AccessServiceMock.CheckResource. %v %v %v", ctx, endpoint, resource)
+	return
+}
+
+// CheckResourceAfterCounter returns a count of finished AccessServiceMock.CheckResource invocations
+func (mmCheckResource *AccessServiceMock) CheckResourceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckResource.afterCheckResourceCounter)
+}
+
+// CheckResourceBeforeCounter returns a count of AccessServiceMock.CheckResource invocations
+func (mmCheckResource *AccessServiceMock) CheckResourceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckResource.beforeCheckResourceCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.CheckResource.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckResource *mAccessServiceMockCheckResource) Calls() []*AccessServiceMockCheckResourceParams {
+	mmCheckResource.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockCheckResourceParams, len(mmCheckResource.callArgs))
+	copy(argCopy, mmCheckResource.callArgs)
+
+	mmCheckResource.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckResourceDone returns true if the count of the CheckResource invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockCheckResourceDone() bool {
+	if m.CheckResourceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckResourceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckResourceMock.invocationsDone()
+}
+
+// MinimockCheckResourceInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockCheckResourceInspect() {
+	for _, e := range m.CheckResourceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.CheckResource with params: %#v", *e.params)
+		}
+	}
+
+	afterCheckResourceCounter := mm_atomic.LoadUint64(&m.afterCheckResourceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckResourceMock.defaultExpectation != nil && afterCheckResourceCounter < 1 {
+		if m.CheckResourceMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.CheckResource")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.CheckResource with params: %#v", *m.CheckResourceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckResource != nil && afterCheckResourceCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.CheckResource")
+	}
+
+	if !m.CheckResourceMock.invocationsDone() && afterCheckResourceCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.CheckResource but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckResourceMock.expectedInvocations), afterCheckResourceCounter)
+	}
+}
+
+type mAccessServiceMockCreateGroup struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockCreateGroupExpectation
+	expectations       []*AccessServiceMockCreateGroupExpectation
+
+	callArgs []*AccessServiceMockCreateGroupParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockCreateGroupExpectation specifies expectation struct of the AccessService.CreateGroup
+type AccessServiceMockCreateGroupExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockCreateGroupParams
+	paramPtrs *AccessServiceMockCreateGroupParamPtrs
+	results   *AccessServiceMockCreateGroupResults
+	Counter   uint64
+}
+
+// AccessServiceMockCreateGroupParams contains parameters of the AccessService.CreateGroup
+type AccessServiceMockCreateGroupParams struct {
+	ctx   context.Context
+	group *model.Group
+}
+
+// AccessServiceMockCreateGroupParamPtrs contains pointers to parameters of the AccessService.CreateGroup
+type AccessServiceMockCreateGroupParamPtrs struct {
+	ctx   *context.Context
+	group **model.Group
+}
+
+// AccessServiceMockCreateGroupResults contains results of the AccessService.CreateGroup
+type AccessServiceMockCreateGroupResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateGroup *mAccessServiceMockCreateGroup) Optional() *mAccessServiceMockCreateGroup {
+	mmCreateGroup.optional = true
+	return mmCreateGroup
+}
+
+// Expect sets up expected params for AccessService.CreateGroup
+func (mmCreateGroup *mAccessServiceMockCreateGroup) Expect(ctx context.Context, group *model.Group) *mAccessServiceMockCreateGroup {
+	if mmCreateGroup.mock.funcCreateGroup != nil {
+		mmCreateGroup.mock.t.Fatalf("AccessServiceMock.CreateGroup mock is already set by Set")
+	}
+
+	if mmCreateGroup.defaultExpectation == nil {
+		mmCreateGroup.defaultExpectation = &AccessServiceMockCreateGroupExpectation{}
+	}
+
+	if mmCreateGroup.defaultExpectation.paramPtrs != nil {
+		mmCreateGroup.mock.t.Fatalf("AccessServiceMock.CreateGroup mock is already set by ExpectParams functions")
+	}
+
+	mmCreateGroup.defaultExpectation.params = &AccessServiceMockCreateGroupParams{ctx, group}
+	for _, e := range mmCreateGroup.expectations {
+		if minimock.Equal(e.params, mmCreateGroup.defaultExpectation.params) {
+			mmCreateGroup.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateGroup.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateGroup
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.CreateGroup
+func (mmCreateGroup *mAccessServiceMockCreateGroup) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockCreateGroup {
+	if mmCreateGroup.mock.funcCreateGroup != nil {
+		mmCreateGroup.mock.t.Fatalf("AccessServiceMock.CreateGroup mock is already set by Set")
+	}
+
+	if mmCreateGroup.defaultExpectation == nil {
+		mmCreateGroup.defaultExpectation = &AccessServiceMockCreateGroupExpectation{}
+	}
+
+	if mmCreateGroup.defaultExpectation.params != nil {
+		mmCreateGroup.mock.t.Fatalf("AccessServiceMock.CreateGroup mock is already set by Expect")
+	}
+
+	if mmCreateGroup.defaultExpectation.paramPtrs == nil {
+		mmCreateGroup.defaultExpectation.paramPtrs = &AccessServiceMockCreateGroupParamPtrs{}
+	}
+	mmCreateGroup.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateGroup
+}
+
+// ExpectGroupParam2 sets up expected param group for AccessService.CreateGroup
+func (mmCreateGroup *mAccessServiceMockCreateGroup) ExpectGroupParam2(group *model.Group) *mAccessServiceMockCreateGroup {
+	if mmCreateGroup.mock.funcCreateGroup != nil {
+		mmCreateGroup.mock.t.Fatalf("AccessServiceMock.CreateGroup mock is already set by Set")
+	}
+
+	if mmCreateGroup.defaultExpectation == nil {
+		mmCreateGroup.defaultExpectation = &AccessServiceMockCreateGroupExpectation{}
+	}
+
+	if mmCreateGroup.defaultExpectation.params != nil {
+		mmCreateGroup.mock.t.Fatalf("AccessServiceMock.CreateGroup mock is already set by Expect")
+	}
+
+	if mmCreateGroup.defaultExpectation.paramPtrs == nil {
+		mmCreateGroup.defaultExpectation.paramPtrs = &AccessServiceMockCreateGroupParamPtrs{}
+	}
+	mmCreateGroup.defaultExpectation.paramPtrs.group = &group
+
+	return mmCreateGroup
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.CreateGroup
+func (mmCreateGroup *mAccessServiceMockCreateGroup) Inspect(f func(ctx context.Context, group *model.Group)) *mAccessServiceMockCreateGroup {
+	if mmCreateGroup.mock.inspectFuncCreateGroup != nil {
+		mmCreateGroup.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.CreateGroup")
+	}
+
+	mmCreateGroup.mock.inspectFuncCreateGroup = f
+
+	return mmCreateGroup
+}
+
+// Return sets up results that will be returned by AccessService.CreateGroup
+func (mmCreateGroup *mAccessServiceMockCreateGroup) Return(i1 int64, err error) *AccessServiceMock {
+	if mmCreateGroup.mock.funcCreateGroup != nil {
+		mmCreateGroup.mock.t.Fatalf("AccessServiceMock.CreateGroup mock is already set by Set")
+	}
+
+	if mmCreateGroup.defaultExpectation == nil {
+		mmCreateGroup.defaultExpectation = &AccessServiceMockCreateGroupExpectation{mock: mmCreateGroup.mock}
+	}
+	mmCreateGroup.defaultExpectation.results = &AccessServiceMockCreateGroupResults{i1, err}
+	return mmCreateGroup.mock
+}
+
+// Set uses given function f to mock the AccessService.CreateGroup method
+func (mmCreateGroup *mAccessServiceMockCreateGroup) Set(f func(ctx context.Context, group *model.Group) (i1 int64, err error)) *AccessServiceMock {
+	if mmCreateGroup.defaultExpectation != nil {
+		mmCreateGroup.mock.t.Fatalf("Default expectation is already set for the AccessService.CreateGroup method")
+	}
+
+	if len(mmCreateGroup.expectations) > 0 {
+		mmCreateGroup.mock.t.Fatalf("Some expectations are already set for the AccessService.CreateGroup method")
+	}
+
+	mmCreateGroup.mock.funcCreateGroup = f
+	return mmCreateGroup.mock
+}
+
+// When sets expectation for the AccessService.CreateGroup which will trigger the result defined by the following
+// Then helper
+func (mmCreateGroup *mAccessServiceMockCreateGroup) When(ctx context.Context, group *model.Group) *AccessServiceMockCreateGroupExpectation {
+	if mmCreateGroup.mock.funcCreateGroup != nil {
+		mmCreateGroup.mock.t.Fatalf("AccessServiceMock.CreateGroup mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockCreateGroupExpectation{
+		mock:   mmCreateGroup.mock,
+		params: &AccessServiceMockCreateGroupParams{ctx, group},
+	}
+	mmCreateGroup.expectations = append(mmCreateGroup.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.CreateGroup return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockCreateGroupExpectation) Then(i1 int64, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockCreateGroupResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.CreateGroup should be invoked
+func (mmCreateGroup *mAccessServiceMockCreateGroup) Times(n uint64) *mAccessServiceMockCreateGroup {
+	if n == 0 {
+		mmCreateGroup.mock.t.Fatalf("Times of AccessServiceMock.CreateGroup mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateGroup.expectedInvocations, n)
+	return mmCreateGroup
+}
+
+func (mmCreateGroup *mAccessServiceMockCreateGroup) invocationsDone() bool {
+	if len(mmCreateGroup.expectations) == 0 && mmCreateGroup.defaultExpectation == nil && mmCreateGroup.mock.funcCreateGroup == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateGroup.mock.afterCreateGroupCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateGroup.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateGroup implements service.AccessService
+func (mmCreateGroup *AccessServiceMock) CreateGroup(ctx context.Context, group *model.Group) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateGroup.beforeCreateGroupCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateGroup.afterCreateGroupCounter, 1)
+
+	if mmCreateGroup.inspectFuncCreateGroup != nil {
+		mmCreateGroup.inspectFuncCreateGroup(ctx, group)
+	}
+
+	mm_params := AccessServiceMockCreateGroupParams{ctx, group}
+
+	// Record call args
+	mmCreateGroup.CreateGroupMock.mutex.Lock()
+	mmCreateGroup.CreateGroupMock.callArgs = append(mmCreateGroup.CreateGroupMock.callArgs, &mm_params)
+	mmCreateGroup.CreateGroupMock.mutex.Unlock()
+
+	for _, e := range mmCreateGroup.CreateGroupMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateGroup.CreateGroupMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateGroup.CreateGroupMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateGroup.CreateGroupMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateGroup.CreateGroupMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockCreateGroupParams{ctx, group}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateGroup.t.Errorf("AccessServiceMock.CreateGroup got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.group != nil && !minimock.Equal(*mm_want_ptrs.group, mm_got.group) {
+				mmCreateGroup.t.Errorf("AccessServiceMock.CreateGroup got unexpected parameter group, want: %#v, got: %#v%s\n", *mm_want_ptrs.group, mm_got.group, minimock.Diff(*mm_want_ptrs.group, mm_got.group))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateGroup.t.Errorf("AccessServiceMock.CreateGroup got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateGroup.CreateGroupMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateGroup.t.Fatal("No results are set for the AccessServiceMock.CreateGroup")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateGroup.funcCreateGroup != nil {
+		return mmCreateGroup.funcCreateGroup(ctx, group)
+	}
+	mmCreateGroup.t.Fatalf("Unexpected call to AccessServiceMock.CreateGroup. %v %v", ctx, group)
+	return
+}
+
+// CreateGroupAfterCounter returns a count of finished AccessServiceMock.CreateGroup invocations
+func (mmCreateGroup *AccessServiceMock) CreateGroupAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateGroup.afterCreateGroupCounter)
+}
+
+// CreateGroupBeforeCounter returns a count of AccessServiceMock.CreateGroup invocations
+func (mmCreateGroup *AccessServiceMock) CreateGroupBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateGroup.beforeCreateGroupCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.CreateGroup.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateGroup *mAccessServiceMockCreateGroup) Calls() []*AccessServiceMockCreateGroupParams {
+	mmCreateGroup.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockCreateGroupParams, len(mmCreateGroup.callArgs))
+	copy(argCopy, mmCreateGroup.callArgs)
+
+	mmCreateGroup.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateGroupDone returns true if the count of the CreateGroup invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockCreateGroupDone() bool {
+	if m.CreateGroupMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateGroupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateGroupMock.invocationsDone()
+}
+
+// MinimockCreateGroupInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockCreateGroupInspect() {
+	for _, e := range m.CreateGroupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.CreateGroup with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateGroupCounter := mm_atomic.LoadUint64(&m.afterCreateGroupCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateGroupMock.defaultExpectation != nil && afterCreateGroupCounter < 1 {
+		if m.CreateGroupMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.CreateGroup")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.CreateGroup with params: %#v", *m.CreateGroupMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateGroup != nil && afterCreateGroupCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.CreateGroup")
+	}
+
+	if !m.CreateGroupMock.invocationsDone() && afterCreateGroupCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.CreateGroup but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateGroupMock.expectedInvocations), afterCreateGroupCounter)
+	}
+}
+
+type mAccessServiceMockCreatePermission struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockCreatePermissionExpectation
+	expectations       []*AccessServiceMockCreatePermissionExpectation
+
+	callArgs []*AccessServiceMockCreatePermissionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockCreatePermissionExpectation specifies expectation struct of the AccessService.CreatePermission
+type AccessServiceMockCreatePermissionExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockCreatePermissionParams
+	paramPtrs *AccessServiceMockCreatePermissionParamPtrs
+	results   *AccessServiceMockCreatePermissionResults
+	Counter   uint64
+}
+
+// AccessServiceMockCreatePermissionParams contains parameters of the AccessService.CreatePermission
+type AccessServiceMockCreatePermissionParams struct {
+	ctx        context.Context
+	permission *model.Permission
+}
+
+// AccessServiceMockCreatePermissionParamPtrs contains pointers to parameters of the AccessService.CreatePermission
+type AccessServiceMockCreatePermissionParamPtrs struct {
+	ctx        *context.Context
+	permission **model.Permission
+}
+
+// AccessServiceMockCreatePermissionResults contains results of the AccessService.CreatePermission
+type AccessServiceMockCreatePermissionResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePermission *mAccessServiceMockCreatePermission) Optional() *mAccessServiceMockCreatePermission {
+	mmCreatePermission.optional = true
+	return mmCreatePermission
+}
+
+// Expect sets up expected params for AccessService.CreatePermission
+func (mmCreatePermission *mAccessServiceMockCreatePermission) Expect(ctx context.Context, permission *model.Permission) *mAccessServiceMockCreatePermission {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("AccessServiceMock.CreatePermission mock is already set by Set")
+	}
+
+	if mmCreatePermission.defaultExpectation == nil {
+		mmCreatePermission.defaultExpectation = &AccessServiceMockCreatePermissionExpectation{}
+	}
+
+	if mmCreatePermission.defaultExpectation.paramPtrs != nil {
+		mmCreatePermission.mock.t.Fatalf("AccessServiceMock.CreatePermission mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePermission.defaultExpectation.params = &AccessServiceMockCreatePermissionParams{ctx, permission}
+	for _, e := range mmCreatePermission.expectations {
+		if minimock.Equal(e.params, mmCreatePermission.defaultExpectation.params) {
+			mmCreatePermission.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePermission.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePermission
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.CreatePermission
+func (mmCreatePermission *mAccessServiceMockCreatePermission) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockCreatePermission {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("AccessServiceMock.CreatePermission mock is already set by Set")
+	}
+
+	if mmCreatePermission.defaultExpectation == nil {
+		mmCreatePermission.defaultExpectation = &AccessServiceMockCreatePermissionExpectation{}
+	}
+
+	if mmCreatePermission.defaultExpectation.params != nil {
+		mmCreatePermission.mock.t.Fatalf("AccessServiceMock.CreatePermission mock is already set by Expect")
+	}
+
+	if mmCreatePermission.defaultExpectation.paramPtrs == nil {
+		mmCreatePermission.defaultExpectation.paramPtrs = &AccessServiceMockCreatePermissionParamPtrs{}
+	}
+	mmCreatePermission.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreatePermission
+}
+
+// ExpectPermissionParam2 sets up expected param permission for AccessService.CreatePermission
+func (mmCreatePermission *mAccessServiceMockCreatePermission) ExpectPermissionParam2(permission *model.Permission) *mAccessServiceMockCreatePermission {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("AccessServiceMock.CreatePermission mock is already set by Set")
+	}
+
+	if mmCreatePermission.defaultExpectation == nil {
+		mmCreatePermission.defaultExpectation = &AccessServiceMockCreatePermissionExpectation{}
+	}
+
+	if mmCreatePermission.defaultExpectation.params != nil {
+		mmCreatePermission.mock.t.Fatalf("AccessServiceMock.CreatePermission mock is already set by Expect")
+	}
+
+	if mmCreatePermission.defaultExpectation.paramPtrs == nil {
+		mmCreatePermission.defaultExpectation.paramPtrs = &AccessServiceMockCreatePermissionParamPtrs{}
+	}
+	mmCreatePermission.defaultExpectation.paramPtrs.permission = &permission
+
+	return mmCreatePermission
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.CreatePermission
+func (mmCreatePermission *mAccessServiceMockCreatePermission) Inspect(f func(ctx context.Context, permission *model.Permission)) *mAccessServiceMockCreatePermission {
+	if mmCreatePermission.mock.inspectFuncCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.CreatePermission")
+	}
+
+	mmCreatePermission.mock.inspectFuncCreatePermission = f
+
+	return mmCreatePermission
+}
+
+// Return sets up results that will be returned by AccessService.CreatePermission
+func (mmCreatePermission *mAccessServiceMockCreatePermission) Return(i1 int64, err error) *AccessServiceMock {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("AccessServiceMock.CreatePermission mock is already set by Set")
+	}
+
+	if mmCreatePermission.defaultExpectation == nil {
+		mmCreatePermission.defaultExpectation = &AccessServiceMockCreatePermissionExpectation{mock: mmCreatePermission.mock}
+	}
+	mmCreatePermission.defaultExpectation.results = &AccessServiceMockCreatePermissionResults{i1, err}
+	return mmCreatePermission.mock
+}
+
+// Set uses given function f to mock the AccessService.CreatePermission method
+func (mmCreatePermission *mAccessServiceMockCreatePermission) Set(f func(ctx context.Context, permission *model.Permission) (i1 int64, err error)) *AccessServiceMock {
+	if mmCreatePermission.defaultExpectation != nil {
+		mmCreatePermission.mock.t.Fatalf("Default expectation is already set for the AccessService.CreatePermission method")
+	}
+
+	if len(mmCreatePermission.expectations) > 0 {
+		mmCreatePermission.mock.t.Fatalf("Some expectations are already set for the AccessService.CreatePermission method")
+	}
+
+	mmCreatePermission.mock.funcCreatePermission = f
+	return mmCreatePermission.mock
+}
+
+// When sets expectation for the AccessService.CreatePermission which will trigger the result defined by the following
+// Then helper
+func (mmCreatePermission *mAccessServiceMockCreatePermission) When(ctx context.Context, permission *model.Permission) *AccessServiceMockCreatePermissionExpectation {
+	if mmCreatePermission.mock.funcCreatePermission != nil {
+		mmCreatePermission.mock.t.Fatalf("AccessServiceMock.CreatePermission mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockCreatePermissionExpectation{
+		mock:   mmCreatePermission.mock,
+		params: &AccessServiceMockCreatePermissionParams{ctx, permission},
+	}
+	mmCreatePermission.expectations = append(mmCreatePermission.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.CreatePermission return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockCreatePermissionExpectation) Then(i1 int64, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockCreatePermissionResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.CreatePermission should be invoked
+func (mmCreatePermission *mAccessServiceMockCreatePermission) Times(n uint64) *mAccessServiceMockCreatePermission {
+	if n == 0 {
+		mmCreatePermission.mock.t.Fatalf("Times of AccessServiceMock.CreatePermission mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePermission.expectedInvocations, n)
+	return mmCreatePermission
+}
+
+func (mmCreatePermission *mAccessServiceMockCreatePermission) invocationsDone() bool {
+	if len(mmCreatePermission.expectations) == 0 && mmCreatePermission.defaultExpectation == nil && mmCreatePermission.mock.funcCreatePermission == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePermission.mock.afterCreatePermissionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePermission.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePermission implements service.AccessService
+func (mmCreatePermission *AccessServiceMock) CreatePermission(ctx context.Context, permission *model.Permission) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreatePermission.beforeCreatePermissionCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePermission.afterCreatePermissionCounter, 1)
+
+	if mmCreatePermission.inspectFuncCreatePermission != nil {
+		mmCreatePermission.inspectFuncCreatePermission(ctx, permission)
+	}
+
+	mm_params := AccessServiceMockCreatePermissionParams{ctx, permission}
+
+	// Record call args
+	mmCreatePermission.CreatePermissionMock.mutex.Lock()
+	mmCreatePermission.CreatePermissionMock.callArgs = append(mmCreatePermission.CreatePermissionMock.callArgs, &mm_params)
+	mmCreatePermission.CreatePermissionMock.mutex.Unlock()
+
+	for _, e := range mmCreatePermission.CreatePermissionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreatePermission.CreatePermissionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePermission.CreatePermissionMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePermission.CreatePermissionMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePermission.CreatePermissionMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockCreatePermissionParams{ctx, permission}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePermission.t.Errorf("AccessServiceMock.CreatePermission got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.permission != nil && !minimock.Equal(*mm_want_ptrs.permission, mm_got.permission) {
+				mmCreatePermission.t.Errorf("AccessServiceMock.CreatePermission got unexpected parameter permission, want: %#v, got: %#v%s\n", *mm_want_ptrs.permission, mm_got.permission, minimock.Diff(*mm_want_ptrs.permission, mm_got.permission))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePermission.t.Errorf("AccessServiceMock.CreatePermission got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePermission.CreatePermissionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePermission.t.Fatal("No results are set for the AccessServiceMock.CreatePermission")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreatePermission.funcCreatePermission != nil {
+		return mmCreatePermission.funcCreatePermission(ctx, permission)
+	}
+	mmCreatePermission.t.Fatalf("Unexpected call to AccessServiceMock.CreatePermission. %v %v", ctx, permission)
+	return
+}
+
+// CreatePermissionAfterCounter returns a count of finished AccessServiceMock.CreatePermission invocations
+func (mmCreatePermission *AccessServiceMock) CreatePermissionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePermission.afterCreatePermissionCounter)
+}
+
+// CreatePermissionBeforeCounter returns a count of AccessServiceMock.CreatePermission invocations
+func (mmCreatePermission *AccessServiceMock) CreatePermissionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePermission.beforeCreatePermissionCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.CreatePermission.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePermission *mAccessServiceMockCreatePermission) Calls() []*AccessServiceMockCreatePermissionParams {
+	mmCreatePermission.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockCreatePermissionParams, len(mmCreatePermission.callArgs))
+	copy(argCopy, mmCreatePermission.callArgs)
+
+	mmCreatePermission.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePermissionDone returns true if the count of the CreatePermission invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockCreatePermissionDone() bool {
+	if m.CreatePermissionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePermissionMock.invocationsDone()
+}
+
+// MinimockCreatePermissionInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockCreatePermissionInspect() {
+	for _, e := range m.CreatePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.CreatePermission with params: %#v", *e.params)
+		}
+	}
+
+	afterCreatePermissionCounter := mm_atomic.LoadUint64(&m.afterCreatePermissionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePermissionMock.defaultExpectation != nil && afterCreatePermissionCounter < 1 {
+		if m.CreatePermissionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.CreatePermission")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.CreatePermission with params: %#v", *m.CreatePermissionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePermission != nil && afterCreatePermissionCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.CreatePermission")
+	}
+
+	if !m.CreatePermissionMock.invocationsDone() && afterCreatePermissionCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.CreatePermission but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePermissionMock.expectedInvocations), afterCreatePermissionCounter)
+	}
+}
+
+type mAccessServiceMockCreateRole struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockCreateRoleExpectation
+	expectations       []*AccessServiceMockCreateRoleExpectation
+
+	callArgs []*AccessServiceMockCreateRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockCreateRoleExpectation specifies expectation struct of the AccessService.CreateRole
+type AccessServiceMockCreateRoleExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockCreateRoleParams
+	paramPtrs *AccessServiceMockCreateRoleParamPtrs
+	results   *AccessServiceMockCreateRoleResults
+	Counter   uint64
+}
+
+// AccessServiceMockCreateRoleParams contains parameters of the AccessService.CreateRole
+type AccessServiceMockCreateRoleParams struct {
+	ctx  context.Context
+	role *model.Role
+}
+
+// AccessServiceMockCreateRoleParamPtrs contains pointers to parameters of the AccessService.CreateRole
+type AccessServiceMockCreateRoleParamPtrs struct {
+	ctx  *context.Context
+	role **model.Role
+}
+
+// AccessServiceMockCreateRoleResults contains results of the AccessService.CreateRole
+type AccessServiceMockCreateRoleResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRole *mAccessServiceMockCreateRole) Optional() *mAccessServiceMockCreateRole {
+	mmCreateRole.optional = true
+	return mmCreateRole
+}
+
+// Expect sets up expected params for AccessService.CreateRole
+func (mmCreateRole *mAccessServiceMockCreateRole) Expect(ctx context.Context, role *model.Role) *mAccessServiceMockCreateRole {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("AccessServiceMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &AccessServiceMockCreateRoleExpectation{}
+	}
+
+	if mmCreateRole.defaultExpectation.paramPtrs != nil {
+		mmCreateRole.mock.t.Fatalf("AccessServiceMock.CreateRole mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRole.defaultExpectation.params = &AccessServiceMockCreateRoleParams{ctx, role}
+	for _, e := range mmCreateRole.expectations {
+		if minimock.Equal(e.params, mmCreateRole.defaultExpectation.params) {
+			mmCreateRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRole.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.CreateRole
+func (mmCreateRole *mAccessServiceMockCreateRole) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockCreateRole {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("AccessServiceMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &AccessServiceMockCreateRoleExpectation{}
+	}
+
+	if mmCreateRole.defaultExpectation.params != nil {
+		mmCreateRole.mock.t.Fatalf("AccessServiceMock.CreateRole mock is already set by Expect")
+	}
+
+	if mmCreateRole.defaultExpectation.paramPtrs == nil {
+		mmCreateRole.defaultExpectation.paramPtrs = &AccessServiceMockCreateRoleParamPtrs{}
+	}
+	mmCreateRole.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateRole
+}
+
+// ExpectRoleParam2 sets up expected param role for AccessService.CreateRole
+func (mmCreateRole *mAccessServiceMockCreateRole) ExpectRoleParam2(role *model.Role) *mAccessServiceMockCreateRole {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("AccessServiceMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &AccessServiceMockCreateRoleExpectation{}
+	}
+
+	if mmCreateRole.defaultExpectation.params != nil {
+		mmCreateRole.mock.t.Fatalf("AccessServiceMock.CreateRole mock is already set by Expect")
+	}
+
+	if mmCreateRole.defaultExpectation.paramPtrs == nil {
+		mmCreateRole.defaultExpectation.paramPtrs = &AccessServiceMockCreateRoleParamPtrs{}
+	}
+	mmCreateRole.defaultExpectation.paramPtrs.role = &role
+
+	return mmCreateRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.CreateRole
+func (mmCreateRole *mAccessServiceMockCreateRole) Inspect(f func(ctx context.Context, role *model.Role)) *mAccessServiceMockCreateRole {
+	if mmCreateRole.mock.inspectFuncCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.CreateRole")
+	}
+
+	mmCreateRole.mock.inspectFuncCreateRole = f
+
+	return mmCreateRole
+}
+
+// Return sets up results that will be returned by AccessService.CreateRole
+func (mmCreateRole *mAccessServiceMockCreateRole) Return(err error) *AccessServiceMock {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("AccessServiceMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &AccessServiceMockCreateRoleExpectation{mock: mmCreateRole.mock}
+	}
+	mmCreateRole.defaultExpectation.results = &AccessServiceMockCreateRoleResults{err}
+	return mmCreateRole.mock
+}
+
+// Set uses given function f to mock the AccessService.CreateRole method
+func (mmCreateRole *mAccessServiceMockCreateRole) Set(f func(ctx context.Context, role *model.Role) (err error)) *AccessServiceMock {
+	if mmCreateRole.defaultExpectation != nil {
+		mmCreateRole.mock.t.Fatalf("Default expectation is already set for the AccessService.CreateRole method")
+	}
+
+	if len(mmCreateRole.expectations) > 0 {
+		mmCreateRole.mock.t.Fatalf("Some expectations are already set for the AccessService.CreateRole method")
+	}
+
+	mmCreateRole.mock.funcCreateRole = f
+	return mmCreateRole.mock
+}
+
+// When sets expectation for the AccessService.CreateRole which will trigger the result defined by the following
+// Then helper
+func (mmCreateRole *mAccessServiceMockCreateRole) When(ctx context.Context, role *model.Role) *AccessServiceMockCreateRoleExpectation {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("AccessServiceMock.CreateRole mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockCreateRoleExpectation{
+		mock:   mmCreateRole.mock,
+		params: &AccessServiceMockCreateRoleParams{ctx, role},
+	}
+	mmCreateRole.expectations = append(mmCreateRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.CreateRole return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockCreateRoleExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockCreateRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.CreateRole should be invoked
+func (mmCreateRole *mAccessServiceMockCreateRole) Times(n uint64) *mAccessServiceMockCreateRole {
+	if n == 0 {
+		mmCreateRole.mock.t.Fatalf("Times of AccessServiceMock.CreateRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRole.expectedInvocations, n)
+	return mmCreateRole
+}
+
+func (mmCreateRole *mAccessServiceMockCreateRole) invocationsDone() bool {
+	if len(mmCreateRole.expectations) == 0 && mmCreateRole.defaultExpectation == nil && mmCreateRole.mock.funcCreateRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRole.mock.afterCreateRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRole implements service.AccessService
+func (mmCreateRole *AccessServiceMock) CreateRole(ctx context.Context, role *model.Role) (err error) {
+	mm_atomic.AddUint64(&mmCreateRole.beforeCreateRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRole.afterCreateRoleCounter, 1)
+
+	if mmCreateRole.inspectFuncCreateRole != nil {
+		mmCreateRole.inspectFuncCreateRole(ctx, role)
+	}
+
+	mm_params := AccessServiceMockCreateRoleParams{ctx, role}
+
+	// Record call args
+	mmCreateRole.CreateRoleMock.mutex.Lock()
+	mmCreateRole.CreateRoleMock.callArgs = append(mmCreateRole.CreateRoleMock.callArgs, &mm_params)
+	mmCreateRole.CreateRoleMock.mutex.Unlock()
+
+	for _, e := range mmCreateRole.CreateRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateRole.CreateRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRole.CreateRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRole.CreateRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRole.CreateRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockCreateRoleParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRole.t.Errorf("AccessServiceMock.CreateRole got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmCreateRole.t.Errorf("AccessServiceMock.CreateRole got unexpected parameter role, want: %#v, got: %#v%s\n", *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRole.t.Errorf("AccessServiceMock.CreateRole got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRole.CreateRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRole.t.Fatal("No results are set for the AccessServiceMock.CreateRole")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateRole.funcCreateRole != nil {
+		return mmCreateRole.funcCreateRole(ctx, role)
+	}
+	mmCreateRole.t.Fatalf("Unexpected call to AccessServiceMock.CreateRole. %v %v", ctx, role)
+	return
+}
+
+// CreateRoleAfterCounter returns a count of finished AccessServiceMock.CreateRole invocations
+func (mmCreateRole *AccessServiceMock) CreateRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRole.afterCreateRoleCounter)
+}
+
+// CreateRoleBeforeCounter returns a count of AccessServiceMock.CreateRole invocations
+func (mmCreateRole *AccessServiceMock) CreateRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRole.beforeCreateRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.CreateRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRole *mAccessServiceMockCreateRole) Calls() []*AccessServiceMockCreateRoleParams {
+	mmCreateRole.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockCreateRoleParams, len(mmCreateRole.callArgs))
+	copy(argCopy, mmCreateRole.callArgs)
+
+	mmCreateRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRoleDone returns true if the count of the CreateRole invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockCreateRoleDone() bool {
+	if m.CreateRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRoleMock.invocationsDone()
+}
+
+// MinimockCreateRoleInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockCreateRoleInspect() {
+	for _, e := range m.CreateRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.CreateRole with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateRoleCounter := mm_atomic.LoadUint64(&m.afterCreateRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRoleMock.defaultExpectation != nil && afterCreateRoleCounter < 1 {
+		if m.CreateRoleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.CreateRole")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.CreateRole with params: %#v", *m.CreateRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRole != nil && afterCreateRoleCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.CreateRole")
+	}
+
+	if !m.CreateRoleMock.invocationsDone() && afterCreateRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.CreateRole but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRoleMock.expectedInvocations), afterCreateRoleCounter)
+	}
+}
+
+type mAccessServiceMockDeleteGroup struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockDeleteGroupExpectation
+	expectations       []*AccessServiceMockDeleteGroupExpectation
+
+	callArgs []*AccessServiceMockDeleteGroupParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockDeleteGroupExpectation specifies expectation struct of the AccessService.DeleteGroup
+type AccessServiceMockDeleteGroupExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockDeleteGroupParams
+	paramPtrs *AccessServiceMockDeleteGroupParamPtrs
+	results   *AccessServiceMockDeleteGroupResults
+	Counter   uint64
+}
+
+// AccessServiceMockDeleteGroupParams contains parameters of the AccessService.DeleteGroup
+type AccessServiceMockDeleteGroupParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// AccessServiceMockDeleteGroupParamPtrs contains pointers to parameters of the AccessService.DeleteGroup
+type AccessServiceMockDeleteGroupParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// AccessServiceMockDeleteGroupResults contains results of the AccessService.DeleteGroup
+type AccessServiceMockDeleteGroupResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteGroup *mAccessServiceMockDeleteGroup) Optional() *mAccessServiceMockDeleteGroup {
+	mmDeleteGroup.optional = true
+	return mmDeleteGroup
+}
+
+// Expect sets up expected params for AccessService.DeleteGroup
+func (mmDeleteGroup *mAccessServiceMockDeleteGroup) Expect(ctx context.Context, id int64) *mAccessServiceMockDeleteGroup {
+	if mmDeleteGroup.mock.funcDeleteGroup != nil {
+		mmDeleteGroup.mock.t.Fatalf("AccessServiceMock.DeleteGroup mock is already set by Set")
+	}
+
+	if mmDeleteGroup.defaultExpectation == nil {
+		mmDeleteGroup.defaultExpectation = &AccessServiceMockDeleteGroupExpectation{}
+	}
+
+	if mmDeleteGroup.defaultExpectation.paramPtrs != nil {
+		mmDeleteGroup.mock.t.Fatalf("AccessServiceMock.DeleteGroup mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteGroup.defaultExpectation.params = &AccessServiceMockDeleteGroupParams{ctx, id}
+	for _, e := range mmDeleteGroup.expectations {
+		if minimock.Equal(e.params, mmDeleteGroup.defaultExpectation.params) {
+			mmDeleteGroup.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteGroup.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteGroup
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.DeleteGroup
+func (mmDeleteGroup *mAccessServiceMockDeleteGroup) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockDeleteGroup {
+	if mmDeleteGroup.mock.funcDeleteGroup != nil {
+		mmDeleteGroup.mock.t.Fatalf("AccessServiceMock.DeleteGroup mock is already set by Set")
+	}
+
+	if mmDeleteGroup.defaultExpectation == nil {
+		mmDeleteGroup.defaultExpectation = &AccessServiceMockDeleteGroupExpectation{}
+	}
+
+	if mmDeleteGroup.defaultExpectation.params != nil {
+		mmDeleteGroup.mock.t.Fatalf("AccessServiceMock.DeleteGroup mock is already set by Expect")
+	}
+
+	if mmDeleteGroup.defaultExpectation.paramPtrs == nil {
+		mmDeleteGroup.defaultExpectation.paramPtrs = &AccessServiceMockDeleteGroupParamPtrs{}
+	}
+	mmDeleteGroup.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteGroup
+}
+
+// ExpectIdParam2 sets up expected param id for AccessService.DeleteGroup
+func (mmDeleteGroup *mAccessServiceMockDeleteGroup) ExpectIdParam2(id int64) *mAccessServiceMockDeleteGroup {
+	if mmDeleteGroup.mock.funcDeleteGroup != nil {
+		mmDeleteGroup.mock.t.Fatalf("AccessServiceMock.DeleteGroup mock is already set by Set")
+	}
+
+	if mmDeleteGroup.defaultExpectation == nil {
+		mmDeleteGroup.defaultExpectation = &AccessServiceMockDeleteGroupExpectation{}
+	}
+
+	if mmDeleteGroup.defaultExpectation.params != nil {
+		mmDeleteGroup.mock.t.Fatalf("AccessServiceMock.DeleteGroup mock is already set by Expect")
+	}
+
+	if mmDeleteGroup.defaultExpectation.paramPtrs == nil {
+		mmDeleteGroup.defaultExpectation.paramPtrs = &AccessServiceMockDeleteGroupParamPtrs{}
+	}
+	mmDeleteGroup.defaultExpectation.paramPtrs.id = &id
+
+	return mmDeleteGroup
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.DeleteGroup
+func (mmDeleteGroup *mAccessServiceMockDeleteGroup) Inspect(f func(ctx context.Context, id int64)) *mAccessServiceMockDeleteGroup {
+	if mmDeleteGroup.mock.inspectFuncDeleteGroup != nil {
+		mmDeleteGroup.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.DeleteGroup")
+	}
+
+	mmDeleteGroup.mock.inspectFuncDeleteGroup = f
+
+	return mmDeleteGroup
+}
+
+// Return sets up results that will be returned by AccessService.DeleteGroup
+func (mmDeleteGroup *mAccessServiceMockDeleteGroup) Return(err error) *AccessServiceMock {
+	if mmDeleteGroup.mock.funcDeleteGroup != nil {
+		mmDeleteGroup.mock.t.Fatalf("AccessServiceMock.DeleteGroup mock is already set by Set")
+	}
+
+	if mmDeleteGroup.defaultExpectation == nil {
+		mmDeleteGroup.defaultExpectation = &AccessServiceMockDeleteGroupExpectation{mock: mmDeleteGroup.mock}
+	}
+	mmDeleteGroup.defaultExpectation.results = &AccessServiceMockDeleteGroupResults{err}
+	return mmDeleteGroup.mock
+}
+
+// Set uses given function f to mock the AccessService.DeleteGroup method
+func (mmDeleteGroup *mAccessServiceMockDeleteGroup) Set(f func(ctx context.Context, id int64) (err error)) *AccessServiceMock {
+	if mmDeleteGroup.defaultExpectation != nil {
+		mmDeleteGroup.mock.t.Fatalf("Default expectation is already set for the AccessService.DeleteGroup method")
+	}
+
+	if len(mmDeleteGroup.expectations) > 0 {
+		mmDeleteGroup.mock.t.Fatalf("Some expectations are already set for the AccessService.DeleteGroup method")
+	}
+
+	mmDeleteGroup.mock.funcDeleteGroup = f
+	return mmDeleteGroup.mock
+}
+
+// When sets expectation for the AccessService.DeleteGroup which will trigger the result defined by the following
+// Then helper
+func (mmDeleteGroup *mAccessServiceMockDeleteGroup) When(ctx context.Context, id int64) *AccessServiceMockDeleteGroupExpectation {
+	if mmDeleteGroup.mock.funcDeleteGroup != nil {
+		mmDeleteGroup.mock.t.Fatalf("AccessServiceMock.DeleteGroup mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockDeleteGroupExpectation{
+		mock:   mmDeleteGroup.mock,
+		params: &AccessServiceMockDeleteGroupParams{ctx, id},
+	}
+	mmDeleteGroup.expectations = append(mmDeleteGroup.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.DeleteGroup return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockDeleteGroupExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockDeleteGroupResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.DeleteGroup should be invoked
+func (mmDeleteGroup *mAccessServiceMockDeleteGroup) Times(n uint64) *mAccessServiceMockDeleteGroup {
+	if n == 0 {
+		mmDeleteGroup.mock.t.Fatalf("Times of AccessServiceMock.DeleteGroup mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteGroup.expectedInvocations, n)
+	return mmDeleteGroup
+}
+
+func (mmDeleteGroup *mAccessServiceMockDeleteGroup) invocationsDone() bool {
+	if len(mmDeleteGroup.expectations) == 0 && mmDeleteGroup.defaultExpectation == nil && mmDeleteGroup.mock.funcDeleteGroup == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteGroup.mock.afterDeleteGroupCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteGroup.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteGroup implements service.AccessService
+func (mmDeleteGroup *AccessServiceMock) DeleteGroup(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteGroup.beforeDeleteGroupCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteGroup.afterDeleteGroupCounter, 1)
+
+	if mmDeleteGroup.inspectFuncDeleteGroup != nil {
+		mmDeleteGroup.inspectFuncDeleteGroup(ctx, id)
+	}
+
+	mm_params := AccessServiceMockDeleteGroupParams{ctx, id}
+
+	// Record call args
+	mmDeleteGroup.DeleteGroupMock.mutex.Lock()
+	mmDeleteGroup.DeleteGroupMock.callArgs = append(mmDeleteGroup.DeleteGroupMock.callArgs, &mm_params)
+	mmDeleteGroup.DeleteGroupMock.mutex.Unlock()
+
+	for _, e := range mmDeleteGroup.DeleteGroupMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteGroup.DeleteGroupMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteGroup.DeleteGroupMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteGroup.DeleteGroupMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteGroup.DeleteGroupMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockDeleteGroupParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteGroup.t.Errorf("AccessServiceMock.DeleteGroup got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteGroup.t.Errorf("AccessServiceMock.DeleteGroup got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteGroup.t.Errorf("AccessServiceMock.DeleteGroup got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteGroup.DeleteGroupMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteGroup.t.Fatal("No results are set for the AccessServiceMock.DeleteGroup")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteGroup.funcDeleteGroup != nil {
+		return mmDeleteGroup.funcDeleteGroup(ctx, id)
+	}
+	mmDeleteGroup.t.Fatalf("Unexpected call to AccessServiceMock.DeleteGroup. %v %v", ctx, id)
+	return
+}
+
+// DeleteGroupAfterCounter returns a count of finished AccessServiceMock.DeleteGroup invocations
+func (mmDeleteGroup *AccessServiceMock) DeleteGroupAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteGroup.afterDeleteGroupCounter)
+}
+
+// DeleteGroupBeforeCounter returns a count of AccessServiceMock.DeleteGroup invocations
+func (mmDeleteGroup *AccessServiceMock) DeleteGroupBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteGroup.beforeDeleteGroupCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.DeleteGroup.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteGroup *mAccessServiceMockDeleteGroup) Calls() []*AccessServiceMockDeleteGroupParams {
+	mmDeleteGroup.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockDeleteGroupParams, len(mmDeleteGroup.callArgs))
+	copy(argCopy, mmDeleteGroup.callArgs)
+
+	mmDeleteGroup.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteGroupDone returns true if the count of the DeleteGroup invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockDeleteGroupDone() bool {
+	if m.DeleteGroupMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteGroupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteGroupMock.invocationsDone()
+}
+
+// MinimockDeleteGroupInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockDeleteGroupInspect() {
+	for _, e := range m.DeleteGroupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.DeleteGroup with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteGroupCounter := mm_atomic.LoadUint64(&m.afterDeleteGroupCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteGroupMock.defaultExpectation != nil && afterDeleteGroupCounter < 1 {
+		if m.DeleteGroupMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.DeleteGroup")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.DeleteGroup with params: %#v", *m.DeleteGroupMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteGroup != nil && afterDeleteGroupCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.DeleteGroup")
+	}
+
+	if !m.DeleteGroupMock.invocationsDone() && afterDeleteGroupCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.DeleteGroup but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteGroupMock.expectedInvocations), afterDeleteGroupCounter)
+	}
+}
+
+type mAccessServiceMockDeletePermission struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockDeletePermissionExpectation
+	expectations       []*AccessServiceMockDeletePermissionExpectation
+
+	callArgs []*AccessServiceMockDeletePermissionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockDeletePermissionExpectation specifies expectation struct of the AccessService.DeletePermission
+type AccessServiceMockDeletePermissionExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockDeletePermissionParams
+	paramPtrs *AccessServiceMockDeletePermissionParamPtrs
+	results   *AccessServiceMockDeletePermissionResults
+	Counter   uint64
+}
+
+// AccessServiceMockDeletePermissionParams contains parameters of the AccessService.DeletePermission
+type AccessServiceMockDeletePermissionParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// AccessServiceMockDeletePermissionParamPtrs contains pointers to parameters of the AccessService.DeletePermission
+type AccessServiceMockDeletePermissionParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// AccessServiceMockDeletePermissionResults contains results of the AccessService.DeletePermission
+type AccessServiceMockDeletePermissionResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeletePermission *mAccessServiceMockDeletePermission) Optional() *mAccessServiceMockDeletePermission {
+	mmDeletePermission.optional = true
+	return mmDeletePermission
+}
+
+// Expect sets up expected params for AccessService.DeletePermission
+func (mmDeletePermission *mAccessServiceMockDeletePermission) Expect(ctx context.Context, id int64) *mAccessServiceMockDeletePermission {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("AccessServiceMock.DeletePermission mock is already set by Set")
+	}
+
+	if mmDeletePermission.defaultExpectation == nil {
+		mmDeletePermission.defaultExpectation = &AccessServiceMockDeletePermissionExpectation{}
+	}
+
+	if mmDeletePermission.defaultExpectation.paramPtrs != nil {
+		mmDeletePermission.mock.t.Fatalf("AccessServiceMock.DeletePermission mock is already set by ExpectParams functions")
+	}
+
+	mmDeletePermission.defaultExpectation.params = &AccessServiceMockDeletePermissionParams{ctx, id}
+	for _, e := range mmDeletePermission.expectations {
+		if minimock.Equal(e.params, mmDeletePermission.defaultExpectation.params) {
+			mmDeletePermission.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePermission.defaultExpectation.params)
+		}
+	}
+
+	return mmDeletePermission
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.DeletePermission
+func (mmDeletePermission *mAccessServiceMockDeletePermission) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockDeletePermission {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("AccessServiceMock.DeletePermission mock is already set by Set")
+	}
+
+	if mmDeletePermission.defaultExpectation == nil {
+		mmDeletePermission.defaultExpectation = &AccessServiceMockDeletePermissionExpectation{}
+	}
+
+	if mmDeletePermission.defaultExpectation.params != nil {
+		mmDeletePermission.mock.t.Fatalf("AccessServiceMock.DeletePermission mock is already set by Expect")
+	}
+
+	if mmDeletePermission.defaultExpectation.paramPtrs == nil {
+		mmDeletePermission.defaultExpectation.paramPtrs = &AccessServiceMockDeletePermissionParamPtrs{}
+	}
+	mmDeletePermission.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeletePermission
+}
+
+// ExpectIdParam2 sets up expected param id for AccessService.DeletePermission
+func (mmDeletePermission *mAccessServiceMockDeletePermission) ExpectIdParam2(id int64) *mAccessServiceMockDeletePermission {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("AccessServiceMock.DeletePermission mock is already set by Set")
+	}
+
+	if mmDeletePermission.defaultExpectation == nil {
+		mmDeletePermission.defaultExpectation = &AccessServiceMockDeletePermissionExpectation{}
+	}
+
+	if mmDeletePermission.defaultExpectation.params != nil {
+		mmDeletePermission.mock.t.Fatalf("AccessServiceMock.DeletePermission mock is already set by Expect")
+	}
+
+	if mmDeletePermission.defaultExpectation.paramPtrs == nil {
+		mmDeletePermission.defaultExpectation.paramPtrs = &AccessServiceMockDeletePermissionParamPtrs{}
+	}
+	mmDeletePermission.defaultExpectation.paramPtrs.id = &id
+
+	return mmDeletePermission
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.DeletePermission
+func (mmDeletePermission *mAccessServiceMockDeletePermission) Inspect(f func(ctx context.Context, id int64)) *mAccessServiceMockDeletePermission {
+	if mmDeletePermission.mock.inspectFuncDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.DeletePermission")
+	}
+
+	mmDeletePermission.mock.inspectFuncDeletePermission = f
+
+	return mmDeletePermission
+}
+
+// Return sets up results that will be returned by AccessService.DeletePermission
+func (mmDeletePermission *mAccessServiceMockDeletePermission) Return(err error) *AccessServiceMock {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("AccessServiceMock.DeletePermission mock is already set by Set")
+	}
+
+	if mmDeletePermission.defaultExpectation == nil {
+		mmDeletePermission.defaultExpectation = &AccessServiceMockDeletePermissionExpectation{mock: mmDeletePermission.mock}
+	}
+	mmDeletePermission.defaultExpectation.results = &AccessServiceMockDeletePermissionResults{err}
+	return mmDeletePermission.mock
+}
+
+// Set uses given function f to mock the AccessService.DeletePermission method
+func (mmDeletePermission *mAccessServiceMockDeletePermission) Set(f func(ctx context.Context, id int64) (err error)) *AccessServiceMock {
+	if mmDeletePermission.defaultExpectation != nil {
+		mmDeletePermission.mock.t.Fatalf("Default expectation is already set for the AccessService.DeletePermission method")
+	}
+
+	if len(mmDeletePermission.expectations) > 0 {
+		mmDeletePermission.mock.t.Fatalf("Some expectations are already set for the AccessService.DeletePermission method")
+	}
+
+	mmDeletePermission.mock.funcDeletePermission = f
+	return mmDeletePermission.mock
+}
+
+// When sets expectation for the AccessService.DeletePermission which will trigger the result defined by the following
+// Then helper
+func (mmDeletePermission *mAccessServiceMockDeletePermission) When(ctx context.Context, id int64) *AccessServiceMockDeletePermissionExpectation {
+	if mmDeletePermission.mock.funcDeletePermission != nil {
+		mmDeletePermission.mock.t.Fatalf("AccessServiceMock.DeletePermission mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockDeletePermissionExpectation{
+		mock:   mmDeletePermission.mock,
+		params: &AccessServiceMockDeletePermissionParams{ctx, id},
+	}
+	mmDeletePermission.expectations = append(mmDeletePermission.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.DeletePermission return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockDeletePermissionExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockDeletePermissionResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.DeletePermission should be invoked
+func (mmDeletePermission *mAccessServiceMockDeletePermission) Times(n uint64) *mAccessServiceMockDeletePermission {
+	if n == 0 {
+		mmDeletePermission.mock.t.Fatalf("Times of AccessServiceMock.DeletePermission mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeletePermission.expectedInvocations, n)
+	return mmDeletePermission
+}
+
+func (mmDeletePermission *mAccessServiceMockDeletePermission) invocationsDone() bool {
+	if len(mmDeletePermission.expectations) == 0 && mmDeletePermission.defaultExpectation == nil && mmDeletePermission.mock.funcDeletePermission == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeletePermission.mock.afterDeletePermissionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeletePermission.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeletePermission implements service.AccessService
+func (mmDeletePermission *AccessServiceMock) DeletePermission(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDeletePermission.beforeDeletePermissionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeletePermission.afterDeletePermissionCounter, 1)
+
+	if mmDeletePermission.inspectFuncDeletePermission != nil {
+		mmDeletePermission.inspectFuncDeletePermission(ctx, id)
+	}
+
+	mm_params := AccessServiceMockDeletePermissionParams{ctx, id}
+
+	// Record call args
+	mmDeletePermission.DeletePermissionMock.mutex.Lock()
+	mmDeletePermission.DeletePermissionMock.callArgs = append(mmDeletePermission.DeletePermissionMock.callArgs, &mm_params)
+	mmDeletePermission.DeletePermissionMock.mutex.Unlock()
+
+	for _, e := range mmDeletePermission.DeletePermissionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeletePermission.DeletePermissionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePermission.DeletePermissionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePermission.DeletePermissionMock.defaultExpectation.params
+		mm_want_ptrs := mmDeletePermission.DeletePermissionMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockDeletePermissionParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeletePermission.t.Errorf("AccessServiceMock.DeletePermission got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeletePermission.t.Errorf("AccessServiceMock.DeletePermission got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeletePermission.t.Errorf("AccessServiceMock.DeletePermission got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeletePermission.DeletePermissionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeletePermission.t.Fatal("No results are set for the AccessServiceMock.DeletePermission")
+		}
+		return (*mm_results).err
+	}
+	if mmDeletePermission.funcDeletePermission != nil {
+		return mmDeletePermission.funcDeletePermission(ctx, id)
+	}
+	mmDeletePermission.t.Fatalf("Unexpected call to AccessServiceMock.DeletePermission. %v %v", ctx, id)
+	return
+}
+
+// DeletePermissionAfterCounter returns a count of finished AccessServiceMock.DeletePermission invocations
+func (mmDeletePermission *AccessServiceMock) DeletePermissionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePermission.afterDeletePermissionCounter)
+}
+
+// DeletePermissionBeforeCounter returns a count of AccessServiceMock.DeletePermission invocations
+func (mmDeletePermission *AccessServiceMock) DeletePermissionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePermission.beforeDeletePermissionCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.DeletePermission.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeletePermission *mAccessServiceMockDeletePermission) Calls() []*AccessServiceMockDeletePermissionParams {
+	mmDeletePermission.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockDeletePermissionParams, len(mmDeletePermission.callArgs))
+	copy(argCopy, mmDeletePermission.callArgs)
+
+	mmDeletePermission.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeletePermissionDone returns true if the count of the DeletePermission invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockDeletePermissionDone() bool {
+	if m.DeletePermissionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeletePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeletePermissionMock.invocationsDone()
+}
+
+// MinimockDeletePermissionInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockDeletePermissionInspect() {
+	for _, e := range m.DeletePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.DeletePermission with params: %#v", *e.params)
+		}
+	}
+
+	afterDeletePermissionCounter := mm_atomic.LoadUint64(&m.afterDeletePermissionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeletePermissionMock.defaultExpectation != nil && afterDeletePermissionCounter < 1 {
+		if m.DeletePermissionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.DeletePermission")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.DeletePermission with params: %#v", *m.DeletePermissionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeletePermission != nil && afterDeletePermissionCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.DeletePermission")
+	}
+
+	if !m.DeletePermissionMock.invocationsDone() && afterDeletePermissionCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.DeletePermission but found %d calls",
+			mm_atomic.LoadUint64(&m.DeletePermissionMock.expectedInvocations), afterDeletePermissionCounter)
+	}
+}
+
+type mAccessServiceMockDeleteRelation struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockDeleteRelationExpectation
+	expectations       []*AccessServiceMockDeleteRelationExpectation
+
+	callArgs []*AccessServiceMockDeleteRelationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockDeleteRelationExpectation specifies expectation struct of the AccessService.DeleteRelation
+type AccessServiceMockDeleteRelationExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockDeleteRelationParams
+	paramPtrs *AccessServiceMockDeleteRelationParamPtrs
+	results   *AccessServiceMockDeleteRelationResults
+	Counter   uint64
+}
+
+// AccessServiceMockDeleteRelationParams contains parameters of the AccessService.DeleteRelation
+type AccessServiceMockDeleteRelationParams struct {
+	ctx   context.Context
+	tuple *model.RelationTuple
+}
+
+// AccessServiceMockDeleteRelationParamPtrs contains pointers to parameters of the AccessService.DeleteRelation
+type AccessServiceMockDeleteRelationParamPtrs struct {
+	ctx   *context.Context
+	tuple **model.RelationTuple
+}
+
+// AccessServiceMockDeleteRelationResults contains results of the AccessService.DeleteRelation
+type AccessServiceMockDeleteRelationResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRelation *mAccessServiceMockDeleteRelation) Optional() *mAccessServiceMockDeleteRelation {
+	mmDeleteRelation.optional = true
+	return mmDeleteRelation
+}
+
+// Expect sets up expected params for AccessService.DeleteRelation
+func (mmDeleteRelation *mAccessServiceMockDeleteRelation) Expect(ctx context.Context, tuple *model.RelationTuple) *mAccessServiceMockDeleteRelation {
+	if mmDeleteRelation.mock.funcDeleteRelation != nil {
+		mmDeleteRelation.mock.t.Fatalf("AccessServiceMock.DeleteRelation mock is already set by Set")
+	}
+
+	if mmDeleteRelation.defaultExpectation == nil {
+		mmDeleteRelation.defaultExpectation = &AccessServiceMockDeleteRelationExpectation{}
+	}
+
+	if mmDeleteRelation.defaultExpectation.paramPtrs != nil {
+		mmDeleteRelation.mock.t.Fatalf("AccessServiceMock.DeleteRelation mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRelation.defaultExpectation.params = &AccessServiceMockDeleteRelationParams{ctx, tuple}
+	for _, e := range mmDeleteRelation.expectations {
+		if minimock.Equal(e.params, mmDeleteRelation.defaultExpectation.params) {
+			mmDeleteRelation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRelation.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRelation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.DeleteRelation
+func (mmDeleteRelation *mAccessServiceMockDeleteRelation) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockDeleteRelation {
+	if mmDeleteRelation.mock.funcDeleteRelation != nil {
+		mmDeleteRelation.mock.t.Fatalf("AccessServiceMock.DeleteRelation mock is already set by Set")
+	}
+
+	if mmDeleteRelation.defaultExpectation == nil {
+		mmDeleteRelation.defaultExpectation = &AccessServiceMockDeleteRelationExpectation{}
+	}
+
+	if mmDeleteRelation.defaultExpectation.params != nil {
+		mmDeleteRelation.mock.t.Fatalf("AccessServiceMock.DeleteRelation mock is already set by Expect")
+	}
+
+	if mmDeleteRelation.defaultExpectation.paramPtrs == nil {
+		mmDeleteRelation.defaultExpectation.paramPtrs = &AccessServiceMockDeleteRelationParamPtrs{}
+	}
+	mmDeleteRelation.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteRelation
+}
+
+// ExpectTupleParam2 sets up expected param tuple for AccessService.DeleteRelation
+func (mmDeleteRelation *mAccessServiceMockDeleteRelation) ExpectTupleParam2(tuple *model.RelationTuple) *mAccessServiceMockDeleteRelation {
+	if mmDeleteRelation.mock.funcDeleteRelation != nil {
+		mmDeleteRelation.mock.t.Fatalf("AccessServiceMock.DeleteRelation mock is already set by Set")
+	}
+
+	if mmDeleteRelation.defaultExpectation == nil {
+		mmDeleteRelation.defaultExpectation = &AccessServiceMockDeleteRelationExpectation{}
+	}
+
+	if mmDeleteRelation.defaultExpectation.params != nil {
+		mmDeleteRelation.mock.t.Fatalf("AccessServiceMock.DeleteRelation mock is already set by Expect")
+	}
+
+	if mmDeleteRelation.defaultExpectation.paramPtrs == nil {
+		mmDeleteRelation.defaultExpectation.paramPtrs = &AccessServiceMockDeleteRelationParamPtrs{}
+	}
+	mmDeleteRelation.defaultExpectation.paramPtrs.tuple = &tuple
+
+	return mmDeleteRelation
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.DeleteRelation
+func (mmDeleteRelation *mAccessServiceMockDeleteRelation) Inspect(f func(ctx context.Context, tuple *model.RelationTuple)) *mAccessServiceMockDeleteRelation {
+	if mmDeleteRelation.mock.inspectFuncDeleteRelation != nil {
+		mmDeleteRelation.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.DeleteRelation")
+	}
+
+	mmDeleteRelation.mock.inspectFuncDeleteRelation = f
+
+	return mmDeleteRelation
+}
+
+// Return sets up results that will be returned by AccessService.DeleteRelation
+func (mmDeleteRelation *mAccessServiceMockDeleteRelation) Return(err error) *AccessServiceMock {
+	if mmDeleteRelation.mock.funcDeleteRelation != nil {
+		mmDeleteRelation.mock.t.Fatalf("AccessServiceMock.DeleteRelation mock is already set by Set")
+	}
+
+	if mmDeleteRelation.defaultExpectation == nil {
+		mmDeleteRelation.defaultExpectation = &AccessServiceMockDeleteRelationExpectation{mock: mmDeleteRelation.mock}
+	}
+	mmDeleteRelation.defaultExpectation.results = &AccessServiceMockDeleteRelationResults{err}
+	return mmDeleteRelation.mock
+}
+
+// Set uses given function f to mock the AccessService.DeleteRelation method
+func (mmDeleteRelation *mAccessServiceMockDeleteRelation) Set(f func(ctx context.Context, tuple *model.RelationTuple) (err error)) *AccessServiceMock {
+	if mmDeleteRelation.defaultExpectation != nil {
+		mmDeleteRelation.mock.t.Fatalf("Default expectation is already set for the AccessService.DeleteRelation method")
+	}
+
+	if len(mmDeleteRelation.expectations) > 0 {
+		mmDeleteRelation.mock.t.Fatalf("Some expectations are already set for the AccessService.DeleteRelation method")
+	}
+
+	mmDeleteRelation.mock.funcDeleteRelation = f
+	return mmDeleteRelation.mock
+}
+
+// When sets expectation for the AccessService.DeleteRelation which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRelation *mAccessServiceMockDeleteRelation) When(ctx context.Context, tuple *model.RelationTuple) *AccessServiceMockDeleteRelationExpectation {
+	if mmDeleteRelation.mock.funcDeleteRelation != nil {
+		mmDeleteRelation.mock.t.Fatalf("AccessServiceMock.DeleteRelation mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockDeleteRelationExpectation{
+		mock:   mmDeleteRelation.mock,
+		params: &AccessServiceMockDeleteRelationParams{ctx, tuple},
+	}
+	mmDeleteRelation.expectations = append(mmDeleteRelation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.DeleteRelation return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockDeleteRelationExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockDeleteRelationResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.DeleteRelation should be invoked
+func (mmDeleteRelation *mAccessServiceMockDeleteRelation) Times(n uint64) *mAccessServiceMockDeleteRelation {
+	if n == 0 {
+		mmDeleteRelation.mock.t.Fatalf("Times of AccessServiceMock.DeleteRelation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRelation.expectedInvocations, n)
+	return mmDeleteRelation
+}
+
+func (mmDeleteRelation *mAccessServiceMockDeleteRelation) invocationsDone() bool {
+	if len(mmDeleteRelation.expectations) == 0 && mmDeleteRelation.defaultExpectation == nil && mmDeleteRelation.mock.funcDeleteRelation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRelation.mock.afterDeleteRelationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRelation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRelation implements service.AccessService
+func (mmDeleteRelation *AccessServiceMock) DeleteRelation(ctx context.Context, tuple *model.RelationTuple) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRelation.beforeDeleteRelationCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRelation.afterDeleteRelationCounter, 1)
+
+	if mmDeleteRelation.inspectFuncDeleteRelation != nil {
+		mmDeleteRelation.inspectFuncDeleteRelation(ctx, tuple)
+	}
+
+	mm_params := AccessServiceMockDeleteRelationParams{ctx, tuple}
+
+	// Record call args
+	mmDeleteRelation.DeleteRelationMock.mutex.Lock()
+	mmDeleteRelation.DeleteRelationMock.callArgs = append(mmDeleteRelation.DeleteRelationMock.callArgs, &mm_params)
+	mmDeleteRelation.DeleteRelationMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRelation.DeleteRelationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRelation.DeleteRelationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRelation.DeleteRelationMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRelation.DeleteRelationMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRelation.DeleteRelationMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockDeleteRelationParams{ctx, tuple}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRelation.t.Errorf("AccessServiceMock.DeleteRelation got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tuple != nil && !minimock.Equal(*mm_want_ptrs.tuple, mm_got.tuple) {
+				mmDeleteRelation.t.Errorf("AccessServiceMock.DeleteRelation got unexpected parameter tuple, want: %#v, got: %#v%s\n", *mm_want_ptrs.tuple, mm_got.tuple, minimock.Diff(*mm_want_ptrs.tuple, mm_got.tuple))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRelation.t.Errorf("AccessServiceMock.DeleteRelation got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRelation.DeleteRelationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRelation.t.Fatal("No results are set for the AccessServiceMock.DeleteRelation")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRelation.funcDeleteRelation != nil {
+		return mmDeleteRelation.funcDeleteRelation(ctx, tuple)
+	}
+	mmDeleteRelation.t.Fatalf("Unexpected call to AccessServiceMock.DeleteRelation. %v %v", ctx, tuple)
+	return
+}
+
+// DeleteRelationAfterCounter returns a count of finished AccessServiceMock.DeleteRelation invocations
+func (mmDeleteRelation *AccessServiceMock) DeleteRelationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRelation.afterDeleteRelationCounter)
+}
+
+// DeleteRelationBeforeCounter returns a count of AccessServiceMock.DeleteRelation invocations
+func (mmDeleteRelation *AccessServiceMock) DeleteRelationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRelation.beforeDeleteRelationCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.DeleteRelation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRelation *mAccessServiceMockDeleteRelation) Calls() []*AccessServiceMockDeleteRelationParams {
+	mmDeleteRelation.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockDeleteRelationParams, len(mmDeleteRelation.callArgs))
+	copy(argCopy, mmDeleteRelation.callArgs)
+
+	mmDeleteRelation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRelationDone returns true if the count of the DeleteRelation invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockDeleteRelationDone() bool {
+	if m.DeleteRelationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRelationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRelationMock.invocationsDone()
+}
+
+// MinimockDeleteRelationInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockDeleteRelationInspect() {
+	for _, e := range m.DeleteRelationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.DeleteRelation with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteRelationCounter := mm_atomic.LoadUint64(&m.afterDeleteRelationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRelationMock.defaultExpectation != nil && afterDeleteRelationCounter < 1 {
+		if m.DeleteRelationMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.DeleteRelation")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.DeleteRelation with params: %#v", *m.DeleteRelationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRelation != nil && afterDeleteRelationCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.DeleteRelation")
+	}
+
+	if !m.DeleteRelationMock.invocationsDone() && afterDeleteRelationCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.DeleteRelation but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRelationMock.expectedInvocations), afterDeleteRelationCounter)
+	}
+}
+
+type mAccessServiceMockDeleteRole struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockDeleteRoleExpectation
+	expectations       []*AccessServiceMockDeleteRoleExpectation
+
+	callArgs []*AccessServiceMockDeleteRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockDeleteRoleExpectation specifies expectation struct of the AccessService.DeleteRole
+type AccessServiceMockDeleteRoleExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockDeleteRoleParams
+	paramPtrs *AccessServiceMockDeleteRoleParamPtrs
+	results   *AccessServiceMockDeleteRoleResults
+	Counter   uint64
+}
+
+// AccessServiceMockDeleteRoleParams contains parameters of the AccessService.DeleteRole
+type AccessServiceMockDeleteRoleParams struct {
+	ctx  context.Context
+	name string
+}
+
+// AccessServiceMockDeleteRoleParamPtrs contains pointers to parameters of the AccessService.DeleteRole
+type AccessServiceMockDeleteRoleParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// AccessServiceMockDeleteRoleResults contains results of the AccessService.DeleteRole
+type AccessServiceMockDeleteRoleResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRole *mAccessServiceMockDeleteRole) Optional() *mAccessServiceMockDeleteRole {
+	mmDeleteRole.optional = true
+	return mmDeleteRole
+}
+
+// Expect sets up expected params for AccessService.DeleteRole
+func (mmDeleteRole *mAccessServiceMockDeleteRole) Expect(ctx context.Context, name string) *mAccessServiceMockDeleteRole {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("AccessServiceMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &AccessServiceMockDeleteRoleExpectation{}
+	}
+
+	if mmDeleteRole.defaultExpectation.paramPtrs != nil {
+		mmDeleteRole.mock.t.Fatalf("AccessServiceMock.DeleteRole mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRole.defaultExpectation.params = &AccessServiceMockDeleteRoleParams{ctx, name}
+	for _, e := range mmDeleteRole.expectations {
+		if minimock.Equal(e.params, mmDeleteRole.defaultExpectation.params) {
+			mmDeleteRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRole.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.DeleteRole
+func (mmDeleteRole *mAccessServiceMockDeleteRole) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockDeleteRole {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("AccessServiceMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &AccessServiceMockDeleteRoleExpectation{}
+	}
+
+	if mmDeleteRole.defaultExpectation.params != nil {
+		mmDeleteRole.mock.t.Fatalf("AccessServiceMock.DeleteRole mock is already set by Expect")
+	}
+
+	if mmDeleteRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteRole.defaultExpectation.paramPtrs = &AccessServiceMockDeleteRoleParamPtrs{}
+	}
+	mmDeleteRole.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteRole
+}
+
+// ExpectNameParam2 sets up expected param name for AccessService.DeleteRole
+func (mmDeleteRole *mAccessServiceMockDeleteRole) ExpectNameParam2(name string) *mAccessServiceMockDeleteRole {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("AccessServiceMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &AccessServiceMockDeleteRoleExpectation{}
+	}
+
+	if mmDeleteRole.defaultExpectation.params != nil {
+		mmDeleteRole.mock.t.Fatalf("AccessServiceMock.DeleteRole mock is already set by Expect")
+	}
+
+	if mmDeleteRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteRole.defaultExpectation.paramPtrs = &AccessServiceMockDeleteRoleParamPtrs{}
+	}
+	mmDeleteRole.defaultExpectation.paramPtrs.name = &name
+
+	return mmDeleteRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.DeleteRole
+func (mmDeleteRole *mAccessServiceMockDeleteRole) Inspect(f func(ctx context.Context, name string)) *mAccessServiceMockDeleteRole {
+	if mmDeleteRole.mock.inspectFuncDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.DeleteRole")
+	}
+
+	mmDeleteRole.mock.inspectFuncDeleteRole = f
+
+	return mmDeleteRole
+}
+
+// Return sets up results that will be returned by AccessService.DeleteRole
+func (mmDeleteRole *mAccessServiceMockDeleteRole) Return(err error) *AccessServiceMock {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("AccessServiceMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &AccessServiceMockDeleteRoleExpectation{mock: mmDeleteRole.mock}
+	}
+	mmDeleteRole.defaultExpectation.results = &AccessServiceMockDeleteRoleResults{err}
+	return mmDeleteRole.mock
+}
+
+// Set uses given function f to mock the AccessService.DeleteRole method
+func (mmDeleteRole *mAccessServiceMockDeleteRole) Set(f func(ctx context.Context, name string) (err error)) *AccessServiceMock {
+	if mmDeleteRole.defaultExpectation != nil {
+		mmDeleteRole.mock.t.Fatalf("Default expectation is already set for the AccessService.DeleteRole method")
+	}
+
+	if len(mmDeleteRole.expectations) > 0 {
+		mmDeleteRole.mock.t.Fatalf("Some expectations are already set for the AccessService.DeleteRole method")
+	}
+
+	mmDeleteRole.mock.funcDeleteRole = f
+	return mmDeleteRole.mock
+}
+
+// When sets expectation for the AccessService.DeleteRole which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRole *mAccessServiceMockDeleteRole) When(ctx context.Context, name string) *AccessServiceMockDeleteRoleExpectation {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("AccessServiceMock.DeleteRole mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockDeleteRoleExpectation{
+		mock:   mmDeleteRole.mock,
+		params: &AccessServiceMockDeleteRoleParams{ctx, name},
+	}
+	mmDeleteRole.expectations = append(mmDeleteRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.DeleteRole return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockDeleteRoleExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockDeleteRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.DeleteRole should be invoked
+func (mmDeleteRole *mAccessServiceMockDeleteRole) Times(n uint64) *mAccessServiceMockDeleteRole {
+	if n == 0 {
+		mmDeleteRole.mock.t.Fatalf("Times of AccessServiceMock.DeleteRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRole.expectedInvocations, n)
+	return mmDeleteRole
+}
+
+func (mmDeleteRole *mAccessServiceMockDeleteRole) invocationsDone() bool {
+	if len(mmDeleteRole.expectations) == 0 && mmDeleteRole.defaultExpectation == nil && mmDeleteRole.mock.funcDeleteRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRole.mock.afterDeleteRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRole implements service.AccessService
+func (mmDeleteRole *AccessServiceMock) DeleteRole(ctx context.Context, name string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRole.beforeDeleteRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRole.afterDeleteRoleCounter, 1)
+
+	if mmDeleteRole.inspectFuncDeleteRole != nil {
+		mmDeleteRole.inspectFuncDeleteRole(ctx, name)
+	}
+
+	mm_params := AccessServiceMockDeleteRoleParams{ctx, name}
+
+	// Record call args
+	mmDeleteRole.DeleteRoleMock.mutex.Lock()
+	mmDeleteRole.DeleteRoleMock.callArgs = append(mmDeleteRole.DeleteRoleMock.callArgs, &mm_params)
+	mmDeleteRole.DeleteRoleMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRole.DeleteRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRole.DeleteRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRole.DeleteRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRole.DeleteRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRole.DeleteRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockDeleteRoleParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRole.t.Errorf("AccessServiceMock.DeleteRole got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDeleteRole.t.Errorf("AccessServiceMock.DeleteRole got unexpected parameter name, want: %#v, got: %#v%s\n", *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRole.t.Errorf("AccessServiceMock.DeleteRole got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRole.DeleteRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRole.t.Fatal("No results are set for the AccessServiceMock.DeleteRole")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRole.funcDeleteRole != nil {
+		return mmDeleteRole.funcDeleteRole(ctx, name)
+	}
+	mmDeleteRole.t.Fatalf("Unexpected call to AccessServiceMock.DeleteRole. %v %v", ctx, name)
+	return
+}
+
+// DeleteRoleAfterCounter returns a count of finished AccessServiceMock.DeleteRole invocations
+func (mmDeleteRole *AccessServiceMock) DeleteRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRole.afterDeleteRoleCounter)
+}
+
+// DeleteRoleBeforeCounter returns a count of AccessServiceMock.DeleteRole invocations
+func (mmDeleteRole *AccessServiceMock) DeleteRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRole.beforeDeleteRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.DeleteRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRole *mAccessServiceMockDeleteRole) Calls() []*AccessServiceMockDeleteRoleParams {
+	mmDeleteRole.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockDeleteRoleParams, len(mmDeleteRole.callArgs))
+	copy(argCopy, mmDeleteRole.callArgs)
+
+	mmDeleteRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRoleDone returns true if the count of the DeleteRole invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockDeleteRoleDone() bool {
+	if m.DeleteRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRoleMock.invocationsDone()
+}
+
+// MinimockDeleteRoleInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockDeleteRoleInspect() {
+	for _, e := range m.DeleteRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.DeleteRole with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteRoleCounter := mm_atomic.LoadUint64(&m.afterDeleteRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRoleMock.defaultExpectation != nil && afterDeleteRoleCounter < 1 {
+		if m.DeleteRoleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.DeleteRole")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.DeleteRole with params: %#v", *m.DeleteRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRole != nil && afterDeleteRoleCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.DeleteRole")
+	}
+
+	if !m.DeleteRoleMock.invocationsDone() && afterDeleteRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.DeleteRole but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRoleMock.expectedInvocations), afterDeleteRoleCounter)
+	}
+}
+
+type mAccessServiceMockExplain struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockExplainExpectation
+	expectations       []*AccessServiceMockExplainExpectation
+
+	callArgs []*AccessServiceMockExplainParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockExplainExpectation specifies expectation struct of the AccessService.Explain
+type AccessServiceMockExplainExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockExplainParams
+	paramPtrs *AccessServiceMockExplainParamPtrs
+	results   *AccessServiceMockExplainResults
+	Counter   uint64
+}
+
+// AccessServiceMockExplainParams contains parameters of the AccessService.Explain
+type AccessServiceMockExplainParams struct {
+	ctx      context.Context
+	userID   int64
+	endpoint string
+}
+
+// AccessServiceMockExplainParamPtrs contains pointers to parameters of the AccessService.Explain
+type AccessServiceMockExplainParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	endpoint *string
+}
+
+// AccessServiceMockExplainResults contains results of the AccessService.Explain
+type AccessServiceMockExplainResults struct {
+	ep1 *model.Explanation
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExplain *mAccessServiceMockExplain) Optional() *mAccessServiceMockExplain {
+	mmExplain.optional = true
+	return mmExplain
+}
+
+// Expect sets up expected params for AccessService.Explain
+func (mmExplain *mAccessServiceMockExplain) Expect(ctx context.Context, userID int64, endpoint string) *mAccessServiceMockExplain {
+	if mmExplain.mock.funcExplain != nil {
+		mmExplain.mock.t.Fatalf("AccessServiceMock.Explain mock is already set by Set")
+	}
+
+	if mmExplain.defaultExpectation == nil {
+		mmExplain.defaultExpectation = &AccessServiceMockExplainExpectation{}
+	}
+
+	if mmExplain.defaultExpectation.paramPtrs != nil {
+		mmExplain.mock.t.Fatalf("AccessServiceMock.Explain mock is already set by ExpectParams functions")
+	}
+
+	mmExplain.defaultExpectation.params = &AccessServiceMockExplainParams{ctx, userID, endpoint}
+	for _, e := range mmExplain.expectations {
+		if minimock.Equal(e.params, mmExplain.defaultExpectation.params) {
+			mmExplain.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExplain.defaultExpectation.params)
+		}
+	}
+
+	return mmExplain
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.Explain
+func (mmExplain *mAccessServiceMockExplain) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockExplain {
+	if mmExplain.mock.funcExplain != nil {
+		mmExplain.mock.t.Fatalf("AccessServiceMock.Explain mock is already set by Set")
+	}
+
+	if mmExplain.defaultExpectation == nil {
+		mmExplain.defaultExpectation = &AccessServiceMockExplainExpectation{}
+	}
+
+	if mmExplain.defaultExpectation.params != nil {
+		mmExplain.mock.t.Fatalf("AccessServiceMock.Explain mock is already set by Expect")
+	}
+
+	if mmExplain.defaultExpectation.paramPtrs == nil {
+		mmExplain.defaultExpectation.paramPtrs = &AccessServiceMockExplainParamPtrs{}
+	}
+	mmExplain.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmExplain
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AccessService.Explain
+func (mmExplain *mAccessServiceMockExplain) ExpectUserIDParam2(userID int64) *mAccessServiceMockExplain {
+	if mmExplain.mock.funcExplain != nil {
+		mmExplain.mock.t.Fatalf("AccessServiceMock.Explain mock is already set by Set")
+	}
+
+	if mmExplain.defaultExpectation == nil {
+		mmExplain.defaultExpectation = &AccessServiceMockExplainExpectation{}
+	}
+
+	if mmExplain.defaultExpectation.params != nil {
+		mmExplain.mock.t.Fatalf("AccessServiceMock.Explain mock is already set by Expect")
+	}
+
+	if mmExplain.defaultExpectation.paramPtrs == nil {
+		mmExplain.defaultExpectation.paramPtrs = &AccessServiceMockExplainParamPtrs{}
+	}
+	mmExplain.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmExplain
+}
+
+// ExpectEndpointParam3 sets up expected param endpoint for AccessService.Explain
+func (mmExplain *mAccessServiceMockExplain) ExpectEndpointParam3(endpoint string) *mAccessServiceMockExplain {
+	if mmExplain.mock.funcExplain != nil {
+		mmExplain.mock.t.Fatalf("AccessServiceMock.Explain mock is already set by Set")
+	}
+
+	if mmExplain.defaultExpectation == nil {
+		mmExplain.defaultExpectation = &AccessServiceMockExplainExpectation{}
+	}
+
+	if mmExplain.defaultExpectation.params != nil {
+		mmExplain.mock.t.Fatalf("AccessServiceMock.Explain mock is already set by Expect")
+	}
+
+	if mmExplain.defaultExpectation.paramPtrs == nil {
+		mmExplain.defaultExpectation.paramPtrs = &AccessServiceMockExplainParamPtrs{}
+	}
+	mmExplain.defaultExpectation.paramPtrs.endpoint = &endpoint
+
+	return mmExplain
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.Explain
+func (mmExplain *mAccessServiceMockExplain) Inspect(f func(ctx context.Context, userID int64, endpoint string)) *mAccessServiceMockExplain {
+	if mmExplain.mock.inspectFuncExplain != nil {
+		mmExplain.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.Explain")
+	}
+
+	mmExplain.mock.inspectFuncExplain = f
+
+	return mmExplain
+}
+
+// Return sets up results that will be returned by AccessService.Explain
+func (mmExplain *mAccessServiceMockExplain) Return(ep1 *model.Explanation, err error) *AccessServiceMock {
+	if mmExplain.mock.funcExplain != nil {
+		mmExplain.mock.t.Fatalf("AccessServiceMock.Explain mock is already set by Set")
+	}
+
+	if mmExplain.defaultExpectation == nil {
+		mmExplain.defaultExpectation = &AccessServiceMockExplainExpectation{mock: mmExplain.mock}
+	}
+	mmExplain.defaultExpectation.results = &AccessServiceMockExplainResults{ep1, err}
+	return mmExplain.mock
+}
+
+// Set uses given function f to mock the AccessService.Explain method
+func (mmExplain *mAccessServiceMockExplain) Set(f func(ctx context.Context, userID int64, endpoint string) (ep1 *model.Explanation, err error)) *AccessServiceMock {
+	if mmExplain.defaultExpectation != nil {
+		mmExplain.mock.t.Fatalf("Default expectation is already set for the AccessService.Explain method")
+	}
+
+	if len(mmExplain.expectations) > 0 {
+		mmExplain.mock.t.Fatalf("Some expectations are already set for the AccessService.Explain method")
+	}
+
+	mmExplain.mock.funcExplain = f
+	return mmExplain.mock
+}
+
+// When sets expectation for the AccessService.Explain which will trigger the result defined by the following
+// Then helper
+func (mmExplain *mAccessServiceMockExplain) When(ctx context.Context, userID int64, endpoint string) *AccessServiceMockExplainExpectation {
+	if mmExplain.mock.funcExplain != nil {
+		mmExplain.mock.t.Fatalf("AccessServiceMock.Explain mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockExplainExpectation{
+		mock:   mmExplain.mock,
+		params: &AccessServiceMockExplainParams{ctx, userID, endpoint},
+	}
+	mmExplain.expectations = append(mmExplain.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.Explain return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockExplainExpectation) Then(ep1 *model.Explanation, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockExplainResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.Explain should be invoked
+func (mmExplain *mAccessServiceMockExplain) Times(n uint64) *mAccessServiceMockExplain {
+	if n == 0 {
+		mmExplain.mock.t.Fatalf("Times of AccessServiceMock.Explain mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExplain.expectedInvocations, n)
+	return mmExplain
+}
+
+func (mmExplain *mAccessServiceMockExplain) invocationsDone() bool {
+	if len(mmExplain.expectations) == 0 && mmExplain.defaultExpectation == nil && mmExplain.mock.funcExplain == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExplain.mock.afterExplainCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExplain.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Explain implements service.AccessService
+func (mmExplain *AccessServiceMock) Explain(ctx context.Context, userID int64, endpoint string) (ep1 *model.Explanation, err error) {
+	mm_atomic.AddUint64(&mmExplain.beforeExplainCounter, 1)
+	defer mm_atomic.AddUint64(&mmExplain.afterExplainCounter, 1)
+
+	if mmExplain.inspectFuncExplain != nil {
+		mmExplain.inspectFuncExplain(ctx, userID, endpoint)
+	}
+
+	mm_params := AccessServiceMockExplainParams{ctx, userID, endpoint}
+
+	// Record call args
+	mmExplain.ExplainMock.mutex.Lock()
+	mmExplain.ExplainMock.callArgs = append(mmExplain.ExplainMock.callArgs, &mm_params)
+	mmExplain.ExplainMock.mutex.Unlock()
+
+	for _, e := range mmExplain.ExplainMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmExplain.ExplainMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExplain.ExplainMock.defaultExpectation.Counter, 1)
+		mm_want := mmExplain.ExplainMock.defaultExpectation.params
+		mm_want_ptrs := mmExplain.ExplainMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockExplainParams{ctx, userID, endpoint}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExplain.t.Errorf("AccessServiceMock.Explain got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmExplain.t.Errorf("AccessServiceMock.Explain got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.endpoint != nil && !minimock.Equal(*mm_want_ptrs.endpoint, mm_got.endpoint) {
+				mmExplain.t.Errorf("AccessServiceMock.Explain got unexpected parameter endpoint, want: %#v, got: %#v%s\n", *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExplain.t.Errorf("AccessServiceMock.Explain got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExplain.ExplainMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExplain.t.Fatal("No results are set for the AccessServiceMock.Explain")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmExplain.funcExplain != nil {
+		return mmExplain.funcExplain(ctx, userID, endpoint)
+	}
+	mmExplain.t.Fatalf("Unexpected call to AccessServiceMock.Explain. %v %v %v", ctx, userID, endpoint)
+	return
+}
+
+// ExplainAfterCounter returns a count of finished AccessServiceMock.Explain invocations
+func (mmExplain *AccessServiceMock) ExplainAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExplain.afterExplainCounter)
+}
+
+// ExplainBeforeCounter returns a count of AccessServiceMock.Explain invocations
+func (mmExplain *AccessServiceMock) ExplainBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExplain.beforeExplainCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.Explain.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExplain *mAccessServiceMockExplain) Calls() []*AccessServiceMockExplainParams {
+	mmExplain.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockExplainParams, len(mmExplain.callArgs))
+	copy(argCopy, mmExplain.callArgs)
+
+	mmExplain.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExplainDone returns true if the count of the Explain invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockExplainDone() bool {
+	if m.ExplainMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExplainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExplainMock.invocationsDone()
+}
+
+// MinimockExplainInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockExplainInspect() {
+	for _, e := range m.ExplainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.Explain with params: %#v", *e.params)
+		}
+	}
+
+	afterExplainCounter := mm_atomic.LoadUint64(&m.afterExplainCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExplainMock.defaultExpectation != nil && afterExplainCounter < 1 {
+		if m.ExplainMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.Explain")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.Explain with params: %#v", *m.ExplainMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExplain != nil && afterExplainCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.Explain")
+	}
+
+	if !m.ExplainMock.invocationsDone() && afterExplainCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.Explain but found %d calls",
+			mm_atomic.LoadUint64(&m.ExplainMock.expectedInvocations), afterExplainCounter)
+	}
+}
+
+type mAccessServiceMockExportPolicy struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockExportPolicyExpectation
+	expectations       []*AccessServiceMockExportPolicyExpectation
+
+	callArgs []*AccessServiceMockExportPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockExportPolicyExpectation specifies expectation struct of the AccessService.ExportPolicy
+type AccessServiceMockExportPolicyExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockExportPolicyParams
+	paramPtrs *AccessServiceMockExportPolicyParamPtrs
+	results   *AccessServiceMockExportPolicyResults
+	Counter   uint64
+}
+
+// AccessServiceMockExportPolicyParams contains parameters of the AccessService.ExportPolicy
+type AccessServiceMockExportPolicyParams struct {
+	ctx context.Context
+}
+
+// AccessServiceMockExportPolicyParamPtrs contains pointers to parameters of the AccessService.ExportPolicy
+type AccessServiceMockExportPolicyParamPtrs struct {
+	ctx *context.Context
+}
+
+// AccessServiceMockExportPolicyResults contains results of the AccessService.ExportPolicy
+type AccessServiceMockExportPolicyResults struct {
+	pp1 *model.Policy
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExportPolicy *mAccessServiceMockExportPolicy) Optional() *mAccessServiceMockExportPolicy {
+	mmExportPolicy.optional = true
+	return mmExportPolicy
+}
+
+// Expect sets up expected params for AccessService.ExportPolicy
+func (mmExportPolicy *mAccessServiceMockExportPolicy) Expect(ctx context.Context) *mAccessServiceMockExportPolicy {
+	if mmExportPolicy.mock.funcExportPolicy != nil {
+		mmExportPolicy.mock.t.Fatalf("AccessServiceMock.ExportPolicy mock is already set by Set")
+	}
+
+	if mmExportPolicy.defaultExpectation == nil {
+		mmExportPolicy.defaultExpectation = &AccessServiceMockExportPolicyExpectation{}
+	}
+
+	if mmExportPolicy.defaultExpectation.paramPtrs != nil {
+		mmExportPolicy.mock.t.Fatalf("AccessServiceMock.ExportPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmExportPolicy.defaultExpectation.params = &AccessServiceMockExportPolicyParams{ctx}
+	for _, e := range mmExportPolicy.expectations {
+		if minimock.Equal(e.params, mmExportPolicy.defaultExpectation.params) {
+			mmExportPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExportPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmExportPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.ExportPolicy
+func (mmExportPolicy *mAccessServiceMockExportPolicy) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockExportPolicy {
+	if mmExportPolicy.mock.funcExportPolicy != nil {
+		mmExportPolicy.mock.t.Fatalf("AccessServiceMock.ExportPolicy mock is already set by Set")
+	}
+
+	if mmExportPolicy.defaultExpectation == nil {
+		mmExportPolicy.defaultExpectation = &AccessServiceMockExportPolicyExpectation{}
+	}
+
+	if mmExportPolicy.defaultExpectation.params != nil {
+		mmExportPolicy.mock.t.Fatalf("AccessServiceMock.ExportPolicy mock is already set by Expect")
+	}
+
+	if mmExportPolicy.defaultExpectation.paramPtrs == nil {
+		mmExportPolicy.defaultExpectation.paramPtrs = &AccessServiceMockExportPolicyParamPtrs{}
+	}
+	mmExportPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmExportPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.ExportPolicy
+func (mmExportPolicy *mAccessServiceMockExportPolicy) Inspect(f func(ctx context.Context)) *mAccessServiceMockExportPolicy {
+	if mmExportPolicy.mock.inspectFuncExportPolicy != nil {
+		mmExportPolicy.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.ExportPolicy")
+	}
+
+	mmExportPolicy.mock.inspectFuncExportPolicy = f
+
+	return mmExportPolicy
+}
+
+// Return sets up results that will be returned by AccessService.ExportPolicy
+func (mmExportPolicy *mAccessServiceMockExportPolicy) Return(pp1 *model.Policy, err error) *AccessServiceMock {
+	if mmExportPolicy.mock.funcExportPolicy != nil {
+		mmExportPolicy.mock.t.Fatalf("AccessServiceMock.ExportPolicy mock is already set by Set")
+	}
+
+	if mmExportPolicy.defaultExpectation == nil {
+		mmExportPolicy.defaultExpectation = &AccessServiceMockExportPolicyExpectation{mock: mmExportPolicy.mock}
+	}
+	mmExportPolicy.defaultExpectation.results = &AccessServiceMockExportPolicyResults{pp1, err}
+	return mmExportPolicy.mock
+}
+
+// Set uses given function f to mock the AccessService.ExportPolicy method
+func (mmExportPolicy *mAccessServiceMockExportPolicy) Set(f func(ctx context.Context) (pp1 *model.Policy, err error)) *AccessServiceMock {
+	if mmExportPolicy.defaultExpectation != nil {
+		mmExportPolicy.mock.t.Fatalf("Default expectation is already set for the AccessService.ExportPolicy method")
+	}
+
+	if len(mmExportPolicy.expectations) > 0 {
+		mmExportPolicy.mock.t.Fatalf("Some expectations are already set for the AccessService.ExportPolicy method")
+	}
+
+	mmExportPolicy.mock.funcExportPolicy = f
+	return mmExportPolicy.mock
+}
+
+// When sets expectation for the AccessService.ExportPolicy which will trigger the result defined by the following
+// Then helper
+func (mmExportPolicy *mAccessServiceMockExportPolicy) When(ctx context.Context) *AccessServiceMockExportPolicyExpectation {
+	if mmExportPolicy.mock.funcExportPolicy != nil {
+		mmExportPolicy.mock.t.Fatalf("AccessServiceMock.ExportPolicy mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockExportPolicyExpectation{
+		mock:   mmExportPolicy.mock,
+		params: &AccessServiceMockExportPolicyParams{ctx},
+	}
+	mmExportPolicy.expectations = append(mmExportPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.ExportPolicy return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockExportPolicyExpectation) Then(pp1 *model.Policy, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockExportPolicyResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.ExportPolicy should be invoked
+func (mmExportPolicy *mAccessServiceMockExportPolicy) Times(n uint64) *mAccessServiceMockExportPolicy {
+	if n == 0 {
+		mmExportPolicy.mock.t.Fatalf("Times of AccessServiceMock.ExportPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExportPolicy.expectedInvocations, n)
+	return mmExportPolicy
+}
+
+func (mmExportPolicy *mAccessServiceMockExportPolicy) invocationsDone() bool {
+	if len(mmExportPolicy.expectations) == 0 && mmExportPolicy.defaultExpectation == nil && mmExportPolicy.mock.funcExportPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExportPolicy.mock.afterExportPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExportPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExportPolicy implements service.AccessService
+func (mmExportPolicy *AccessServiceMock) ExportPolicy(ctx context.Context) (pp1 *model.Policy, err error) {
+	mm_atomic.AddUint64(&mmExportPolicy.beforeExportPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmExportPolicy.afterExportPolicyCounter, 1)
+
+	if mmExportPolicy.inspectFuncExportPolicy != nil {
+		mmExportPolicy.inspectFuncExportPolicy(ctx)
+	}
+
+	mm_params := AccessServiceMockExportPolicyParams{ctx}
+
+	// Record call args
+	mmExportPolicy.ExportPolicyMock.mutex.Lock()
+	mmExportPolicy.ExportPolicyMock.callArgs = append(mmExportPolicy.ExportPolicyMock.callArgs, &mm_params)
+	mmExportPolicy.ExportPolicyMock.mutex.Unlock()
+
+	for _, e := range mmExportPolicy.ExportPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmExportPolicy.ExportPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExportPolicy.ExportPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmExportPolicy.ExportPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmExportPolicy.ExportPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockExportPolicyParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExportPolicy.t.Errorf("AccessServiceMock.ExportPolicy got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExportPolicy.t.Errorf("AccessServiceMock.ExportPolicy got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExportPolicy.ExportPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExportPolicy.t.Fatal("No results are set for the AccessServiceMock.ExportPolicy")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmExportPolicy.funcExportPolicy != nil {
+		return mmExportPolicy.funcExportPolicy(ctx)
+	}
+	mmExportPolicy.t.Fatalf("Unexpected call to AccessServiceMock.ExportPolicy. %v", ctx)
+	return
+}
+
+// ExportPolicyAfterCounter returns a count of finished AccessServiceMock.ExportPolicy invocations
+func (mmExportPolicy *AccessServiceMock) ExportPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportPolicy.afterExportPolicyCounter)
+}
+
+// ExportPolicyBeforeCounter returns a count of AccessServiceMock.ExportPolicy invocations
+func (mmExportPolicy *AccessServiceMock) ExportPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportPolicy.beforeExportPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.ExportPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExportPolicy *mAccessServiceMockExportPolicy) Calls() []*AccessServiceMockExportPolicyParams {
+	mmExportPolicy.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockExportPolicyParams, len(mmExportPolicy.callArgs))
+	copy(argCopy, mmExportPolicy.callArgs)
+
+	mmExportPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportPolicyDone returns true if the count of the ExportPolicy invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockExportPolicyDone() bool {
+	if m.ExportPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportPolicyMock.invocationsDone()
+}
+
+// MinimockExportPolicyInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockExportPolicyInspect() {
+	for _, e := range m.ExportPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.ExportPolicy with params: %#v", *e.params)
+		}
+	}
+
+	afterExportPolicyCounter := mm_atomic.LoadUint64(&m.afterExportPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportPolicyMock.defaultExpectation != nil && afterExportPolicyCounter < 1 {
+		if m.ExportPolicyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.ExportPolicy")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.ExportPolicy with params: %#v", *m.ExportPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportPolicy != nil && afterExportPolicyCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.ExportPolicy")
+	}
+
+	if !m.ExportPolicyMock.invocationsDone() && afterExportPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.ExportPolicy but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportPolicyMock.expectedInvocations), afterExportPolicyCounter)
+	}
+}
+
+type mAccessServiceMockGetRole struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockGetRoleExpectation
+	expectations       []*AccessServiceMockGetRoleExpectation
+
+	callArgs []*AccessServiceMockGetRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockGetRoleExpectation specifies expectation struct of the AccessService.GetRole
+type AccessServiceMockGetRoleExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockGetRoleParams
+	paramPtrs *AccessServiceMockGetRoleParamPtrs
+	results   *AccessServiceMockGetRoleResults
+	Counter   uint64
+}
+
+// AccessServiceMockGetRoleParams contains parameters of the AccessService.GetRole
+type AccessServiceMockGetRoleParams struct {
+	ctx  context.Context
+	name string
+}
+
+// AccessServiceMockGetRoleParamPtrs contains pointers to parameters of the AccessService.GetRole
+type AccessServiceMockGetRoleParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// AccessServiceMockGetRoleResults contains results of the AccessService.GetRole
+type AccessServiceMockGetRoleResults struct {
+	rp1 *model.Role
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRole *mAccessServiceMockGetRole) Optional() *mAccessServiceMockGetRole {
+	mmGetRole.optional = true
+	return mmGetRole
+}
+
+// Expect sets up expected params for AccessService.GetRole
+func (mmGetRole *mAccessServiceMockGetRole) Expect(ctx context.Context, name string) *mAccessServiceMockGetRole {
+	if mmGetRole.mock.funcGetRole != nil {
+		mmGetRole.mock.t.Fatalf("AccessServiceMock.GetRole mock is already set by Set")
+	}
+
+	if mmGetRole.defaultExpectation == nil {
+		mmGetRole.defaultExpectation = &AccessServiceMockGetRoleExpectation{}
+	}
+
+	if mmGetRole.defaultExpectation.paramPtrs != nil {
+		mmGetRole.mock.t.Fatalf("AccessServiceMock.GetRole mock is already set by ExpectParams functions")
+	}
+
+	mmGetRole.defaultExpectation.params = &AccessServiceMockGetRoleParams{ctx, name}
+	for _, e := range mmGetRole.expectations {
+		if minimock.Equal(e.params, mmGetRole.defaultExpectation.params) {
+			mmGetRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRole.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.GetRole
+func (mmGetRole *mAccessServiceMockGetRole) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockGetRole {
+	if mmGetRole.mock.funcGetRole != nil {
+		mmGetRole.mock.t.Fatalf("AccessServiceMock.GetRole mock is already set by Set")
+	}
+
+	if mmGetRole.defaultExpectation == nil {
+		mmGetRole.defaultExpectation = &AccessServiceMockGetRoleExpectation{}
+	}
+
+	if mmGetRole.defaultExpectation.params != nil {
+		mmGetRole.mock.t.Fatalf("AccessServiceMock.GetRole mock is already set by Expect")
+	}
+
+	if mmGetRole.defaultExpectation.paramPtrs == nil {
+		mmGetRole.defaultExpectation.paramPtrs = &AccessServiceMockGetRoleParamPtrs{}
+	}
+	mmGetRole.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetRole
+}
+
+// ExpectNameParam2 sets up expected param name for AccessService.GetRole
+func (mmGetRole *mAccessServiceMockGetRole) ExpectNameParam2(name string) *mAccessServiceMockGetRole {
+	if mmGetRole.mock.funcGetRole != nil {
+		mmGetRole.mock.t.Fatalf("AccessServiceMock.GetRole mock is already set by Set")
+	}
+
+	if mmGetRole.defaultExpectation == nil {
+		mmGetRole.defaultExpectation = &AccessServiceMockGetRoleExpectation{}
+	}
+
+	if mmGetRole.defaultExpectation.params != nil {
+		mmGetRole.mock.t.Fatalf("AccessServiceMock.GetRole mock is already set by Expect")
+	}
+
+	if mmGetRole.defaultExpectation.paramPtrs == nil {
+		mmGetRole.defaultExpectation.paramPtrs = &AccessServiceMockGetRoleParamPtrs{}
+	}
+	mmGetRole.defaultExpectation.paramPtrs.name = &name
+
+	return mmGetRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.GetRole
+func (mmGetRole *mAccessServiceMockGetRole) Inspect(f func(ctx context.Context, name string)) *mAccessServiceMockGetRole {
+	if mmGetRole.mock.inspectFuncGetRole != nil {
+		mmGetRole.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.GetRole")
+	}
+
+	mmGetRole.mock.inspectFuncGetRole = f
+
+	return mmGetRole
+}
+
+// Return sets up results that will be returned by AccessService.GetRole
+func (mmGetRole *mAccessServiceMockGetRole) Return(rp1 *model.Role, err error) *AccessServiceMock {
+	if mmGetRole.mock.funcGetRole != nil {
+		mmGetRole.mock.t.Fatalf("AccessServiceMock.GetRole mock is already set by Set")
+	}
+
+	if mmGetRole.defaultExpectation == nil {
+		mmGetRole.defaultExpectation = &AccessServiceMockGetRoleExpectation{mock: mmGetRole.mock}
+	}
+	mmGetRole.defaultExpectation.results = &AccessServiceMockGetRoleResults{rp1, err}
+	return mmGetRole.mock
+}
+
+// Set uses given function f to mock the AccessService.GetRole method
+func (mmGetRole *mAccessServiceMockGetRole) Set(f func(ctx context.Context, name string) (rp1 *model.Role, err error)) *AccessServiceMock {
+	if mmGetRole.defaultExpectation != nil {
+		mmGetRole.mock.t.Fatalf("Default expectation is already set for the AccessService.GetRole method")
+	}
+
+	if len(mmGetRole.expectations) > 0 {
+		mmGetRole.mock.t.Fatalf("Some expectations are already set for the AccessService.GetRole method")
+	}
+
+	mmGetRole.mock.funcGetRole = f
+	return mmGetRole.mock
+}
+
+// When sets expectation for the AccessService.GetRole which will trigger the result defined by the following
+// Then helper
+func (mmGetRole *mAccessServiceMockGetRole) When(ctx context.Context, name string) *AccessServiceMockGetRoleExpectation {
+	if mmGetRole.mock.funcGetRole != nil {
+		mmGetRole.mock.t.Fatalf("AccessServiceMock.GetRole mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockGetRoleExpectation{
+		mock:   mmGetRole.mock,
+		params: &AccessServiceMockGetRoleParams{ctx, name},
+	}
+	mmGetRole.expectations = append(mmGetRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.GetRole return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockGetRoleExpectation) Then(rp1 *model.Role, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockGetRoleResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.GetRole should be invoked
+func (mmGetRole *mAccessServiceMockGetRole) Times(n uint64) *mAccessServiceMockGetRole {
+	if n == 0 {
+		mmGetRole.mock.t.Fatalf("Times of AccessServiceMock.GetRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRole.expectedInvocations, n)
+	return mmGetRole
+}
+
+func (mmGetRole *mAccessServiceMockGetRole) invocationsDone() bool {
+	if len(mmGetRole.expectations) == 0 && mmGetRole.defaultExpectation == nil && mmGetRole.mock.funcGetRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRole.mock.afterGetRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRole implements service.AccessService
+func (mmGetRole *AccessServiceMock) GetRole(ctx context.Context, name string) (rp1 *model.Role, err error) {
+	mm_atomic.AddUint64(&mmGetRole.beforeGetRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRole.afterGetRoleCounter, 1)
+
+	if mmGetRole.inspectFuncGetRole != nil {
+		mmGetRole.inspectFuncGetRole(ctx, name)
+	}
+
+	mm_params := AccessServiceMockGetRoleParams{ctx, name}
+
+	// Record call args
+	mmGetRole.GetRoleMock.mutex.Lock()
+	mmGetRole.GetRoleMock.callArgs = append(mmGetRole.GetRoleMock.callArgs, &mm_params)
+	mmGetRole.GetRoleMock.mutex.Unlock()
+
+	for _, e := range mmGetRole.GetRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGetRole.GetRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRole.GetRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRole.GetRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRole.GetRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockGetRoleParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRole.t.Errorf("AccessServiceMock.GetRole got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmGetRole.t.Errorf("AccessServiceMock.GetRole got unexpected parameter name, want: %#v, got: %#v%s\n", *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRole.t.Errorf("AccessServiceMock.GetRole got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRole.GetRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRole.t.Fatal("No results are set for the AccessServiceMock.GetRole")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGetRole.funcGetRole != nil {
+		return mmGetRole.funcGetRole(ctx, name)
+	}
+	mmGetRole.t.Fatalf("Unexpected call to AccessServiceMock.GetRole. %v %v", ctx, name)
+	return
+}
+
+// GetRoleAfterCounter returns a count of finished AccessServiceMock.GetRole invocations
+func (mmGetRole *AccessServiceMock) GetRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRole.afterGetRoleCounter)
+}
+
+// GetRoleBeforeCounter returns a count of AccessServiceMock.GetRole invocations
+func (mmGetRole *AccessServiceMock) GetRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRole.beforeGetRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.GetRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRole *mAccessServiceMockGetRole) Calls() []*AccessServiceMockGetRoleParams {
+	mmGetRole.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockGetRoleParams, len(mmGetRole.callArgs))
+	copy(argCopy, mmGetRole.callArgs)
+
+	mmGetRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRoleDone returns true if the count of the GetRole invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockGetRoleDone() bool {
+	if m.GetRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRoleMock.invocationsDone()
+}
+
+// MinimockGetRoleInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockGetRoleInspect() {
+	for _, e := range m.GetRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.GetRole with params: %#v", *e.params)
+		}
+	}
+
+	afterGetRoleCounter := mm_atomic.LoadUint64(&m.afterGetRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRoleMock.defaultExpectation != nil && afterGetRoleCounter < 1 {
+		if m.GetRoleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.GetRole")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.GetRole with params: %#v", *m.GetRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRole != nil && afterGetRoleCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.GetRole")
+	}
+
+	if !m.GetRoleMock.invocationsDone() && afterGetRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.GetRole but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRoleMock.expectedInvocations), afterGetRoleCounter)
+	}
+}
+
+type mAccessServiceMockGetUserRoles struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockGetUserRolesExpectation
+	expectations       []*AccessServiceMockGetUserRolesExpectation
+
+	callArgs []*AccessServiceMockGetUserRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockGetUserRolesExpectation specifies expectation struct of the AccessService.GetUserRoles
+type AccessServiceMockGetUserRolesExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockGetUserRolesParams
+	paramPtrs *AccessServiceMockGetUserRolesParamPtrs
+	results   *AccessServiceMockGetUserRolesResults
+	Counter   uint64
+}
+
+// AccessServiceMockGetUserRolesParams contains parameters of the AccessService.GetUserRoles
+type AccessServiceMockGetUserRolesParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// AccessServiceMockGetUserRolesParamPtrs contains pointers to parameters of the AccessService.GetUserRoles
+type AccessServiceMockGetUserRolesParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// AccessServiceMockGetUserRolesResults contains results of the AccessService.GetUserRoles
+type AccessServiceMockGetUserRolesResults struct {
+	sa1 []string
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserRoles *mAccessServiceMockGetUserRoles) Optional() *mAccessServiceMockGetUserRoles {
+	mmGetUserRoles.optional = true
+	return mmGetUserRoles
+}
+
+// Expect sets up expected params for AccessService.GetUserRoles
+func (mmGetUserRoles *mAccessServiceMockGetUserRoles) Expect(ctx context.Context, userID int64) *mAccessServiceMockGetUserRoles {
+	if mmGetUserRoles.mock.funcGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("AccessServiceMock.GetUserRoles mock is already set by Set")
+	}
+
+	if mmGetUserRoles.defaultExpectation == nil {
+		mmGetUserRoles.defaultExpectation = &AccessServiceMockGetUserRolesExpectation{}
+	}
+
+	if mmGetUserRoles.defaultExpectation.paramPtrs != nil {
+		mmGetUserRoles.mock.t.Fatalf("AccessServiceMock.GetUserRoles mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserRoles.defaultExpectation.params = &AccessServiceMockGetUserRolesParams{ctx, userID}
+	for _, e := range mmGetUserRoles.expectations {
+		if minimock.Equal(e.params, mmGetUserRoles.defaultExpectation.params) {
+			mmGetUserRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.GetUserRoles
+func (mmGetUserRoles *mAccessServiceMockGetUserRoles) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockGetUserRoles {
+	if mmGetUserRoles.mock.funcGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("AccessServiceMock.GetUserRoles mock is already set by Set")
+	}
+
+	if mmGetUserRoles.defaultExpectation == nil {
+		mmGetUserRoles.defaultExpectation = &AccessServiceMockGetUserRolesExpectation{}
+	}
+
+	if mmGetUserRoles.defaultExpectation.params != nil {
+		mmGetUserRoles.mock.t.Fatalf("AccessServiceMock.GetUserRoles mock is already set by Expect")
+	}
+
+	if mmGetUserRoles.defaultExpectation.paramPtrs == nil {
+		mmGetUserRoles.defaultExpectation.paramPtrs = &AccessServiceMockGetUserRolesParamPtrs{}
+	}
+	mmGetUserRoles.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetUserRoles
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AccessService.GetUserRoles
+func (mmGetUserRoles *mAccessServiceMockGetUserRoles) ExpectUserIDParam2(userID int64) *mAccessServiceMockGetUserRoles {
+	if mmGetUserRoles.mock.funcGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("AccessServiceMock.GetUserRoles mock is already set by Set")
+	}
+
+	if mmGetUserRoles.defaultExpectation == nil {
+		mmGetUserRoles.defaultExpectation = &AccessServiceMockGetUserRolesExpectation{}
+	}
+
+	if mmGetUserRoles.defaultExpectation.params != nil {
+		mmGetUserRoles.mock.t.Fatalf("AccessServiceMock.GetUserRoles mock is already set by Expect")
+	}
+
+	if mmGetUserRoles.defaultExpectation.paramPtrs == nil {
+		mmGetUserRoles.defaultExpectation.paramPtrs = &AccessServiceMockGetUserRolesParamPtrs{}
+	}
+	mmGetUserRoles.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmGetUserRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.GetUserRoles
+func (mmGetUserRoles *mAccessServiceMockGetUserRoles) Inspect(f func(ctx context.Context, userID int64)) *mAccessServiceMockGetUserRoles {
+	if mmGetUserRoles.mock.inspectFuncGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.GetUserRoles")
+	}
+
+	mmGetUserRoles.mock.inspectFuncGetUserRoles = f
+
+	return mmGetUserRoles
+}
+
+// Return sets up results that will be returned by AccessService.GetUserRoles
+func (mmGetUserRoles *mAccessServiceMockGetUserRoles) Return(sa1 []string, err error) *AccessServiceMock {
+	if mmGetUserRoles.mock.funcGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("AccessServiceMock.GetUserRoles mock is already set by Set")
+	}
+
+	if mmGetUserRoles.defaultExpectation == nil {
+		mmGetUserRoles.defaultExpectation = &AccessServiceMockGetUserRolesExpectation{mock: mmGetUserRoles.mock}
+	}
+	mmGetUserRoles.defaultExpectation.results = &AccessServiceMockGetUserRolesResults{sa1, err}
+	return mmGetUserRoles.mock
+}
+
+// Set uses given function f to mock the AccessService.GetUserRoles method
+func (mmGetUserRoles *mAccessServiceMockGetUserRoles) Set(f func(ctx context.Context, userID int64) (sa1 []string, err error)) *AccessServiceMock {
+	if mmGetUserRoles.defaultExpectation != nil {
+		mmGetUserRoles.mock.t.Fatalf("Default expectation is already set for the AccessService.GetUserRoles method")
+	}
+
+	if len(mmGetUserRoles.expectations) > 0 {
+		mmGetUserRoles.mock.t.Fatalf("Some expectations are already set for the AccessService.GetUserRoles method")
+	}
+
+	mmGetUserRoles.mock.funcGetUserRoles = f
+	return mmGetUserRoles.mock
+}
+
+// When sets expectation for the AccessService.GetUserRoles which will trigger the result defined by the following
+// Then helper
+func (mmGetUserRoles *mAccessServiceMockGetUserRoles) When(ctx context.Context, userID int64) *AccessServiceMockGetUserRolesExpectation {
+	if mmGetUserRoles.mock.funcGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("AccessServiceMock.GetUserRoles mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockGetUserRolesExpectation{
+		mock:   mmGetUserRoles.mock,
+		params: &AccessServiceMockGetUserRolesParams{ctx, userID},
+	}
+	mmGetUserRoles.expectations = append(mmGetUserRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.GetUserRoles return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockGetUserRolesExpectation) Then(sa1 []string, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockGetUserRolesResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.GetUserRoles should be invoked
+func (mmGetUserRoles *mAccessServiceMockGetUserRoles) Times(n uint64) *mAccessServiceMockGetUserRoles {
+	if n == 0 {
+		mmGetUserRoles.mock.t.Fatalf("Times of AccessServiceMock.GetUserRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserRoles.expectedInvocations, n)
+	return mmGetUserRoles
+}
+
+func (mmGetUserRoles *mAccessServiceMockGetUserRoles) invocationsDone() bool {
+	if len(mmGetUserRoles.expectations) == 0 && mmGetUserRoles.defaultExpectation == nil && mmGetUserRoles.mock.funcGetUserRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserRoles.mock.afterGetUserRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserRoles implements service.AccessService
+func (mmGetUserRoles *AccessServiceMock) GetUserRoles(ctx context.Context, userID int64) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmGetUserRoles.beforeGetUserRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserRoles.afterGetUserRolesCounter, 1)
+
+	if mmGetUserRoles.inspectFuncGetUserRoles != nil {
+		mmGetUserRoles.inspectFuncGetUserRoles(ctx, userID)
+	}
+
+	mm_params := AccessServiceMockGetUserRolesParams{ctx, userID}
+
+	// Record call args
+	mmGetUserRoles.GetUserRolesMock.mutex.Lock()
+	mmGetUserRoles.GetUserRolesMock.callArgs = append(mmGetUserRoles.GetUserRolesMock.callArgs, &mm_params)
+	mmGetUserRoles.GetUserRolesMock.mutex.Unlock()
+
+	for _, e := range mmGetUserRoles.GetUserRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmGetUserRoles.GetUserRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserRoles.GetUserRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserRoles.GetUserRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserRoles.GetUserRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockGetUserRolesParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserRoles.t.Errorf("AccessServiceMock.GetUserRoles got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetUserRoles.t.Errorf("AccessServiceMock.GetUserRoles got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserRoles.t.Errorf("AccessServiceMock.GetUserRoles got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserRoles.GetUserRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserRoles.t.Fatal("No results are set for the AccessServiceMock.GetUserRoles")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmGetUserRoles.funcGetUserRoles != nil {
+		return mmGetUserRoles.funcGetUserRoles(ctx, userID)
+	}
+	mmGetUserRoles.t.Fatalf("Unexpected call to AccessServiceMock.GetUserRoles. %v %v", ctx, userID)
+	return
+}
+
+// GetUserRolesAfterCounter returns a count of finished AccessServiceMock.GetUserRoles invocations
+func (mmGetUserRoles *AccessServiceMock) GetUserRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserRoles.afterGetUserRolesCounter)
+}
+
+// GetUserRolesBeforeCounter returns a count of AccessServiceMock.GetUserRoles invocations
+func (mmGetUserRoles *AccessServiceMock) GetUserRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserRoles.beforeGetUserRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.GetUserRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserRoles *mAccessServiceMockGetUserRoles) Calls() []*AccessServiceMockGetUserRolesParams {
+	mmGetUserRoles.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockGetUserRolesParams, len(mmGetUserRoles.callArgs))
+	copy(argCopy, mmGetUserRoles.callArgs)
+
+	mmGetUserRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserRolesDone returns true if the count of the GetUserRoles invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockGetUserRolesDone() bool {
+	if m.GetUserRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserRolesMock.invocationsDone()
+}
+
+// MinimockGetUserRolesInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockGetUserRolesInspect() {
+	for _, e := range m.GetUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.GetUserRoles with params: %#v", *e.params)
+		}
+	}
+
+	afterGetUserRolesCounter := mm_atomic.LoadUint64(&m.afterGetUserRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserRolesMock.defaultExpectation != nil && afterGetUserRolesCounter < 1 {
+		if m.GetUserRolesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.GetUserRoles")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.GetUserRoles with params: %#v", *m.GetUserRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserRoles != nil && afterGetUserRolesCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.GetUserRoles")
+	}
+
+	if !m.GetUserRolesMock.invocationsDone() && afterGetUserRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.GetUserRoles but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserRolesMock.expectedInvocations), afterGetUserRolesCounter)
+	}
+}
+
+type mAccessServiceMockListDecisions struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockListDecisionsExpectation
+	expectations       []*AccessServiceMockListDecisionsExpectation
+
+	callArgs []*AccessServiceMockListDecisionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockListDecisionsExpectation specifies expectation struct of the AccessService.ListDecisions
+type AccessServiceMockListDecisionsExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockListDecisionsParams
+	paramPtrs *AccessServiceMockListDecisionsParamPtrs
+	results   *AccessServiceMockListDecisionsResults
+	Counter   uint64
+}
+
+// AccessServiceMockListDecisionsParams contains parameters of the AccessService.ListDecisions
+type AccessServiceMockListDecisionsParams struct {
+	ctx    context.Context
+	filter decisionFilter.DecisionFilter
+}
+
+// AccessServiceMockListDecisionsParamPtrs contains pointers to parameters of the AccessService.ListDecisions
+type AccessServiceMockListDecisionsParamPtrs struct {
+	ctx    *context.Context
+	filter *decisionFilter.DecisionFilter
+}
+
+// AccessServiceMockListDecisionsResults contains results of the AccessService.ListDecisions
+type AccessServiceMockListDecisionsResults struct {
+	dpa1 []*model.DecisionEvent
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListDecisions *mAccessServiceMockListDecisions) Optional() *mAccessServiceMockListDecisions {
+	mmListDecisions.optional = true
+	return mmListDecisions
+}
+
+// Expect sets up expected params for AccessService.ListDecisions
+func (mmListDecisions *mAccessServiceMockListDecisions) Expect(ctx context.Context, filter decisionFilter.DecisionFilter) *mAccessServiceMockListDecisions {
+	if mmListDecisions.mock.funcListDecisions != nil {
+		mmListDecisions.mock.t.Fatalf("AccessServiceMock.ListDecisions mock is already set by Set")
+	}
+
+	if mmListDecisions.defaultExpectation == nil {
+		mmListDecisions.defaultExpectation = &AccessServiceMockListDecisionsExpectation{}
+	}
+
+	if mmListDecisions.defaultExpectation.paramPtrs != nil {
+		mmListDecisions.mock.t.Fatalf("AccessServiceMock.ListDecisions mock is already set by ExpectParams functions")
+	}
+
+	mmListDecisions.defaultExpectation.params = &AccessServiceMockListDecisionsParams{ctx, filter}
+	for _, e := range mmListDecisions.expectations {
+		if minimock.Equal(e.params, mmListDecisions.defaultExpectation.params) {
+			mmListDecisions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListDecisions.defaultExpectation.params)
+		}
+	}
+
+	return mmListDecisions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.ListDecisions
+func (mmListDecisions *mAccessServiceMockListDecisions) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockListDecisions {
+	if mmListDecisions.mock.funcListDecisions != nil {
+		mmListDecisions.mock.t.Fatalf("AccessServiceMock.ListDecisions mock is already set by Set")
+	}
+
+	if mmListDecisions.defaultExpectation == nil {
+		mmListDecisions.defaultExpectation = &AccessServiceMockListDecisionsExpectation{}
+	}
+
+	if mmListDecisions.defaultExpectation.params != nil {
+		mmListDecisions.mock.t.Fatalf("AccessServiceMock.ListDecisions mock is already set by Expect")
+	}
+
+	if mmListDecisions.defaultExpectation.paramPtrs == nil {
+		mmListDecisions.defaultExpectation.paramPtrs = &AccessServiceMockListDecisionsParamPtrs{}
+	}
+	mmListDecisions.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListDecisions
+}
+
+// ExpectFilterParam2 sets up expected param filter for AccessService.ListDecisions
+func (mmListDecisions *mAccessServiceMockListDecisions) ExpectFilterParam2(filter decisionFilter.DecisionFilter) *mAccessServiceMockListDecisions {
+	if mmListDecisions.mock.funcListDecisions != nil {
+		mmListDecisions.mock.t.Fatalf("AccessServiceMock.ListDecisions mock is already set by Set")
+	}
+
+	if mmListDecisions.defaultExpectation == nil {
+		mmListDecisions.defaultExpectation = &AccessServiceMockListDecisionsExpectation{}
+	}
+
+	if mmListDecisions.defaultExpectation.params != nil {
+		mmListDecisions.mock.t.Fatalf("AccessServiceMock.ListDecisions mock is already set by Expect")
+	}
+
+	if mmListDecisions.defaultExpectation.paramPtrs == nil {
+		mmListDecisions.defaultExpectation.paramPtrs = &AccessServiceMockListDecisionsParamPtrs{}
+	}
+	mmListDecisions.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmListDecisions
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.ListDecisions
+func (mmListDecisions *mAccessServiceMockListDecisions) Inspect(f func(ctx context.Context, filter decisionFilter.DecisionFilter)) *mAccessServiceMockListDecisions {
+	if mmListDecisions.mock.inspectFuncListDecisions != nil {
+		mmListDecisions.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.ListDecisions")
+	}
+
+	mmListDecisions.mock.inspectFuncListDecisions = f
+
+	return mmListDecisions
+}
+
+// Return sets up results that will be returned by AccessService.ListDecisions
+func (mmListDecisions *mAccessServiceMockListDecisions) Return(dpa1 []*model.DecisionEvent, err error) *AccessServiceMock {
+	if mmListDecisions.mock.funcListDecisions != nil {
+		mmListDecisions.mock.t.Fatalf("AccessServiceMock.ListDecisions mock is already set by Set")
+	}
+
+	if mmListDecisions.defaultExpectation == nil {
+		mmListDecisions.defaultExpectation = &AccessServiceMockListDecisionsExpectation{mock: mmListDecisions.mock}
+	}
+	mmListDecisions.defaultExpectation.results = &AccessServiceMockListDecisionsResults{dpa1, err}
+	return mmListDecisions.mock
+}
+
+// Set uses given function f to mock the AccessService.ListDecisions method
+func (mmListDecisions *mAccessServiceMockListDecisions) Set(f func(ctx context.Context, filter decisionFilter.DecisionFilter) (dpa1 []*model.DecisionEvent, err error)) *AccessServiceMock {
+	if mmListDecisions.defaultExpectation != nil {
+		mmListDecisions.mock.t.Fatalf("Default expectation is already set for the AccessService.ListDecisions method")
+	}
+
+	if len(mmListDecisions.expectations) > 0 {
+		mmListDecisions.mock.t.Fatalf("Some expectations are already set for the AccessService.ListDecisions method")
+	}
+
+	mmListDecisions.mock.funcListDecisions = f
+	return mmListDecisions.mock
+}
+
+// When sets expectation for the AccessService.ListDecisions which will trigger the result defined by the following
+// Then helper
+func (mmListDecisions *mAccessServiceMockListDecisions) When(ctx context.Context, filter decisionFilter.DecisionFilter) *AccessServiceMockListDecisionsExpectation {
+	if mmListDecisions.mock.funcListDecisions != nil {
+		mmListDecisions.mock.t.Fatalf("AccessServiceMock.ListDecisions mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockListDecisionsExpectation{
+		mock:   mmListDecisions.mock,
+		params: &AccessServiceMockListDecisionsParams{ctx, filter},
+	}
+	mmListDecisions.expectations = append(mmListDecisions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.ListDecisions return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockListDecisionsExpectation) Then(dpa1 []*model.DecisionEvent, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockListDecisionsResults{dpa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.ListDecisions should be invoked
+func (mmListDecisions *mAccessServiceMockListDecisions) Times(n uint64) *mAccessServiceMockListDecisions {
+	if n == 0 {
+		mmListDecisions.mock.t.Fatalf("Times of AccessServiceMock.ListDecisions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListDecisions.expectedInvocations, n)
+	return mmListDecisions
+}
+
+func (mmListDecisions *mAccessServiceMockListDecisions) invocationsDone() bool {
+	if len(mmListDecisions.expectations) == 0 && mmListDecisions.defaultExpectation == nil && mmListDecisions.mock.funcListDecisions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListDecisions.mock.afterListDecisionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListDecisions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListDecisions implements service.AccessService
+func (mmListDecisions *AccessServiceMock) ListDecisions(ctx context.Context, filter decisionFilter.DecisionFilter) (dpa1 []*model.DecisionEvent, err error) {
+	mm_atomic.AddUint64(&mmListDecisions.beforeListDecisionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListDecisions.afterListDecisionsCounter, 1)
+
+	if mmListDecisions.inspectFuncListDecisions != nil {
+		mmListDecisions.inspectFuncListDecisions(ctx, filter)
+	}
+
+	mm_params := AccessServiceMockListDecisionsParams{ctx, filter}
+
+	// Record call args
+	mmListDecisions.ListDecisionsMock.mutex.Lock()
+	mmListDecisions.ListDecisionsMock.callArgs = append(mmListDecisions.ListDecisionsMock.callArgs, &mm_params)
+	mmListDecisions.ListDecisionsMock.mutex.Unlock()
+
+	for _, e := range mmListDecisions.ListDecisionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.dpa1, e.results.err
+		}
+	}
+
+	if mmListDecisions.ListDecisionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListDecisions.ListDecisionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListDecisions.ListDecisionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListDecisions.ListDecisionsMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockListDecisionsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListDecisions.t.Errorf("AccessServiceMock.ListDecisions got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListDecisions.t.Errorf("AccessServiceMock.ListDecisions got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListDecisions.t.Errorf("AccessServiceMock.ListDecisions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListDecisions.ListDecisionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListDecisions.t.Fatal("No results are set for the AccessServiceMock.ListDecisions")
+		}
+		return (*mm_results).dpa1, (*mm_results).err
+	}
+	if mmListDecisions.funcListDecisions != nil {
+		return mmListDecisions.funcListDecisions(ctx, filter)
+	}
+	mmListDecisions.t.Fatalf("Unexpected call to AccessServiceMock.ListDecisions. %v %v", ctx, filter)
+	return
+}
+
+// ListDecisionsAfterCounter returns a count of finished AccessServiceMock.ListDecisions invocations
+func (mmListDecisions *AccessServiceMock) ListDecisionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDecisions.afterListDecisionsCounter)
+}
+
+// ListDecisionsBeforeCounter returns a count of AccessServiceMock.ListDecisions invocations
+func (mmListDecisions *AccessServiceMock) ListDecisionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDecisions.beforeListDecisionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.ListDecisions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListDecisions *mAccessServiceMockListDecisions) Calls() []*AccessServiceMockListDecisionsParams {
+	mmListDecisions.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockListDecisionsParams, len(mmListDecisions.callArgs))
+	copy(argCopy, mmListDecisions.callArgs)
+
+	mmListDecisions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDecisionsDone returns true if the count of the ListDecisions invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockListDecisionsDone() bool {
+	if m.ListDecisionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListDecisionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListDecisionsMock.invocationsDone()
+}
+
+// MinimockListDecisionsInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockListDecisionsInspect() {
+	for _, e := range m.ListDecisionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.ListDecisions with params: %#v", *e.params)
+		}
+	}
+
+	afterListDecisionsCounter := mm_atomic.LoadUint64(&m.afterListDecisionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListDecisionsMock.defaultExpectation != nil && afterListDecisionsCounter < 1 {
+		if m.ListDecisionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.ListDecisions")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.ListDecisions with params: %#v", *m.ListDecisionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListDecisions != nil && afterListDecisionsCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.ListDecisions")
+	}
+
+	if !m.ListDecisionsMock.invocationsDone() && afterListDecisionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.ListDecisions but found %d calls",
+			mm_atomic.LoadUint64(&m.ListDecisionsMock.expectedInvocations), afterListDecisionsCounter)
+	}
+}
+
+type mAccessServiceMockListGroups struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockListGroupsExpectation
+	expectations       []*AccessServiceMockListGroupsExpectation
+
+	callArgs []*AccessServiceMockListGroupsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockListGroupsExpectation specifies expectation struct of the AccessService.ListGroups
+type AccessServiceMockListGroupsExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockListGroupsParams
+	paramPtrs *AccessServiceMockListGroupsParamPtrs
+	results   *AccessServiceMockListGroupsResults
+	Counter   uint64
+}
+
+// AccessServiceMockListGroupsParams contains parameters of the AccessService.ListGroups
+type AccessServiceMockListGroupsParams struct {
+	ctx context.Context
+}
+
+// AccessServiceMockListGroupsParamPtrs contains pointers to parameters of the AccessService.ListGroups
+type AccessServiceMockListGroupsParamPtrs struct {
+	ctx *context.Context
+}
+
+// AccessServiceMockListGroupsResults contains results of the AccessService.ListGroups
+type AccessServiceMockListGroupsResults struct {
+	gpa1 []*model.Group
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListGroups *mAccessServiceMockListGroups) Optional() *mAccessServiceMockListGroups {
+	mmListGroups.optional = true
+	return mmListGroups
+}
+
+// Expect sets up expected params for AccessService.ListGroups
+func (mmListGroups *mAccessServiceMockListGroups) Expect(ctx context.Context) *mAccessServiceMockListGroups {
+	if mmListGroups.mock.funcListGroups != nil {
+		mmListGroups.mock.t.Fatalf("AccessServiceMock.ListGroups mock is already set by Set")
+	}
+
+	if mmListGroups.defaultExpectation == nil {
+		mmListGroups.defaultExpectation = &AccessServiceMockListGroupsExpectation{}
+	}
+
+	if mmListGroups.defaultExpectation.paramPtrs != nil {
+		mmListGroups.mock.t.Fatalf("AccessServiceMock.ListGroups mock is already set by ExpectParams functions")
+	}
+
+	mmListGroups.defaultExpectation.params = &AccessServiceMockListGroupsParams{ctx}
+	for _, e := range mmListGroups.expectations {
+		if minimock.Equal(e.params, mmListGroups.defaultExpectation.params) {
+			mmListGroups.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListGroups.defaultExpectation.params)
+		}
+	}
+
+	return mmListGroups
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.ListGroups
+func (mmListGroups *mAccessServiceMockListGroups) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockListGroups {
+	if mmListGroups.mock.funcListGroups != nil {
+		mmListGroups.mock.t.Fatalf("AccessServiceMock.ListGroups mock is already set by Set")
+	}
+
+	if mmListGroups.defaultExpectation == nil {
+		mmListGroups.defaultExpectation = &AccessServiceMockListGroupsExpectation{}
+	}
+
+	if mmListGroups.defaultExpectation.params != nil {
+		mmListGroups.mock.t.Fatalf("AccessServiceMock.ListGroups mock is already set by Expect")
+	}
+
+	if mmListGroups.defaultExpectation.paramPtrs == nil {
+		mmListGroups.defaultExpectation.paramPtrs = &AccessServiceMockListGroupsParamPtrs{}
+	}
+	mmListGroups.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListGroups
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.ListGroups
+func (mmListGroups *mAccessServiceMockListGroups) Inspect(f func(ctx context.Context)) *mAccessServiceMockListGroups {
+	if mmListGroups.mock.inspectFuncListGroups != nil {
+		mmListGroups.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.ListGroups")
+	}
+
+	mmListGroups.mock.inspectFuncListGroups = f
+
+	return mmListGroups
+}
+
+// Return sets up results that will be returned by AccessService.ListGroups
+func (mmListGroups *mAccessServiceMockListGroups) Return(gpa1 []*model.Group, err error) *AccessServiceMock {
+	if mmListGroups.mock.funcListGroups != nil {
+		mmListGroups.mock.t.Fatalf("AccessServiceMock.ListGroups mock is already set by Set")
+	}
+
+	if mmListGroups.defaultExpectation == nil {
+		mmListGroups.defaultExpectation = &AccessServiceMockListGroupsExpectation{mock: mmListGroups.mock}
+	}
+	mmListGroups.defaultExpectation.results = &AccessServiceMockListGroupsResults{gpa1, err}
+	return mmListGroups.mock
+}
+
+// Set uses given function f to mock the AccessService.ListGroups method
+func (mmListGroups *mAccessServiceMockListGroups) Set(f func(ctx context.Context) (gpa1 []*model.Group, err error)) *AccessServiceMock {
+	if mmListGroups.defaultExpectation != nil {
+		mmListGroups.mock.t.Fatalf("Default expectation is already set for the AccessService.ListGroups method")
+	}
+
+	if len(mmListGroups.expectations) > 0 {
+		mmListGroups.mock.t.Fatalf("Some expectations are already set for the AccessService.ListGroups method")
+	}
+
+	mmListGroups.mock.funcListGroups = f
+	return mmListGroups.mock
+}
+
+// When sets expectation for the AccessService.ListGroups which will trigger the result defined by the following
+// Then helper
+func (mmListGroups *mAccessServiceMockListGroups) When(ctx context.Context) *AccessServiceMockListGroupsExpectation {
+	if mmListGroups.mock.funcListGroups != nil {
+		mmListGroups.mock.t.Fatalf("AccessServiceMock.ListGroups mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockListGroupsExpectation{
+		mock:   mmListGroups.mock,
+		params: &AccessServiceMockListGroupsParams{ctx},
+	}
+	mmListGroups.expectations = append(mmListGroups.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.ListGroups return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockListGroupsExpectation) Then(gpa1 []*model.Group, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockListGroupsResults{gpa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.ListGroups should be invoked
+func (mmListGroups *mAccessServiceMockListGroups) Times(n uint64) *mAccessServiceMockListGroups {
+	if n == 0 {
+		mmListGroups.mock.t.Fatalf("Times of AccessServiceMock.ListGroups mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListGroups.expectedInvocations, n)
+	return mmListGroups
+}
+
+func (mmListGroups *mAccessServiceMockListGroups) invocationsDone() bool {
+	if len(mmListGroups.expectations) == 0 && mmListGroups.defaultExpectation == nil && mmListGroups.mock.funcListGroups == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListGroups.mock.afterListGroupsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListGroups.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListGroups implements service.AccessService
+func (mmListGroups *AccessServiceMock) ListGroups(ctx context.Context) (gpa1 []*model.Group, err error) {
+	mm_atomic.AddUint64(&mmListGroups.beforeListGroupsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListGroups.afterListGroupsCounter, 1)
+
+	if mmListGroups.inspectFuncListGroups != nil {
+		mmListGroups.inspectFuncListGroups(ctx)
+	}
+
+	mm_params := AccessServiceMockListGroupsParams{ctx}
+
+	// Record call args
+	mmListGroups.ListGroupsMock.mutex.Lock()
+	mmListGroups.ListGroupsMock.callArgs = append(mmListGroups.ListGroupsMock.callArgs, &mm_params)
+	mmListGroups.ListGroupsMock.mutex.Unlock()
+
+	for _, e := range mmListGroups.ListGroupsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.gpa1, e.results.err
+		}
+	}
+
+	if mmListGroups.ListGroupsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListGroups.ListGroupsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListGroups.ListGroupsMock.defaultExpectation.params
+		mm_want_ptrs := mmListGroups.ListGroupsMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockListGroupsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListGroups.t.Errorf("AccessServiceMock.ListGroups got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListGroups.t.Errorf("AccessServiceMock.ListGroups got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListGroups.ListGroupsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListGroups.t.Fatal("No results are set for the AccessServiceMock.ListGroups")
+		}
+		return (*mm_results).gpa1, (*mm_results).err
+	}
+	if mmListGroups.funcListGroups != nil {
+		return mmListGroups.funcListGroups(ctx)
+	}
+	mmListGroups.t.Fatalf("Unexpected call to AccessServiceMock.ListGroups. %v", ctx)
+	return
+}
+
+// ListGroupsAfterCounter returns a count of finished AccessServiceMock.ListGroups invocations
+func (mmListGroups *AccessServiceMock) ListGroupsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListGroups.afterListGroupsCounter)
+}
+
+// ListGroupsBeforeCounter returns a count of AccessServiceMock.ListGroups invocations
+func (mmListGroups *AccessServiceMock) ListGroupsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListGroups.beforeListGroupsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.ListGroups.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListGroups *mAccessServiceMockListGroups) Calls() []*AccessServiceMockListGroupsParams {
+	mmListGroups.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockListGroupsParams, len(mmListGroups.callArgs))
+	copy(argCopy, mmListGroups.callArgs)
+
+	mmListGroups.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListGroupsDone returns true if the count of the ListGroups invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockListGroupsDone() bool {
+	if m.ListGroupsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListGroupsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListGroupsMock.invocationsDone()
+}
+
+// MinimockListGroupsInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockListGroupsInspect() {
+	for _, e := range m.ListGroupsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.ListGroups with params: %#v", *e.params)
+		}
+	}
+
+	afterListGroupsCounter := mm_atomic.LoadUint64(&m.afterListGroupsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListGroupsMock.defaultExpectation != nil && afterListGroupsCounter < 1 {
+		if m.ListGroupsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.ListGroups")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.ListGroups with params: %#v", *m.ListGroupsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListGroups != nil && afterListGroupsCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.ListGroups")
+	}
+
+	if !m.ListGroupsMock.invocationsDone() && afterListGroupsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.ListGroups but found %d calls",
+			mm_atomic.LoadUint64(&m.ListGroupsMock.expectedInvocations), afterListGroupsCounter)
+	}
+}
+
+type mAccessServiceMockListPermissions struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockListPermissionsExpectation
+	expectations       []*AccessServiceMockListPermissionsExpectation
+
+	callArgs []*AccessServiceMockListPermissionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockListPermissionsExpectation specifies expectation struct of the AccessService.ListPermissions
+type AccessServiceMockListPermissionsExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockListPermissionsParams
+	paramPtrs *AccessServiceMockListPermissionsParamPtrs
+	results   *AccessServiceMockListPermissionsResults
+	Counter   uint64
+}
+
+// AccessServiceMockListPermissionsParams contains parameters of the AccessService.ListPermissions
+type AccessServiceMockListPermissionsParams struct {
+	ctx    context.Context
+	filter permissionFilter.PermissionFilter
+}
+
+// AccessServiceMockListPermissionsParamPtrs contains pointers to parameters of the AccessService.ListPermissions
+type AccessServiceMockListPermissionsParamPtrs struct {
+	ctx    *context.Context
+	filter *permissionFilter.PermissionFilter
+}
+
+// AccessServiceMockListPermissionsResults contains results of the AccessService.ListPermissions
+type AccessServiceMockListPermissionsResults struct {
+	ppa1 []*model.Permission
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPermissions *mAccessServiceMockListPermissions) Optional() *mAccessServiceMockListPermissions {
+	mmListPermissions.optional = true
+	return mmListPermissions
+}
+
+// Expect sets up expected params for AccessService.ListPermissions
+func (mmListPermissions *mAccessServiceMockListPermissions) Expect(ctx context.Context, filter permissionFilter.PermissionFilter) *mAccessServiceMockListPermissions {
+	if mmListPermissions.mock.funcListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("AccessServiceMock.ListPermissions mock is already set by Set")
+	}
+
+	if mmListPermissions.defaultExpectation == nil {
+		mmListPermissions.defaultExpectation = &AccessServiceMockListPermissionsExpectation{}
+	}
+
+	if mmListPermissions.defaultExpectation.paramPtrs != nil {
+		mmListPermissions.mock.t.Fatalf("AccessServiceMock.ListPermissions mock is already set by ExpectParams functions")
+	}
+
+	mmListPermissions.defaultExpectation.params = &AccessServiceMockListPermissionsParams{ctx, filter}
+	for _, e := range mmListPermissions.expectations {
+		if minimock.Equal(e.params, mmListPermissions.defaultExpectation.params) {
+			mmListPermissions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPermissions.defaultExpectation.params)
+		}
+	}
+
+	return mmListPermissions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.ListPermissions
+func (mmListPermissions *mAccessServiceMockListPermissions) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockListPermissions {
+	if mmListPermissions.mock.funcListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("AccessServiceMock.ListPermissions mock is already set by Set")
+	}
+
+	if mmListPermissions.defaultExpectation == nil {
+		mmListPermissions.defaultExpectation = &AccessServiceMockListPermissionsExpectation{}
+	}
+
+	if mmListPermissions.defaultExpectation.params != nil {
+		mmListPermissions.mock.t.Fatalf("AccessServiceMock.ListPermissions mock is already set by Expect")
+	}
+
+	if mmListPermissions.defaultExpectation.paramPtrs == nil {
+		mmListPermissions.defaultExpectation.paramPtrs = &AccessServiceMockListPermissionsParamPtrs{}
+	}
+	mmListPermissions.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListPermissions
+}
+
+// ExpectFilterParam2 sets up expected param filter for AccessService.ListPermissions
+func (mmListPermissions *mAccessServiceMockListPermissions) ExpectFilterParam2(filter permissionFilter.PermissionFilter) *mAccessServiceMockListPermissions {
+	if mmListPermissions.mock.funcListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("AccessServiceMock.ListPermissions mock is already set by Set")
+	}
+
+	if mmListPermissions.defaultExpectation == nil {
+		mmListPermissions.defaultExpectation = &AccessServiceMockListPermissionsExpectation{}
+	}
+
+	if mmListPermissions.defaultExpectation.params != nil {
+		mmListPermissions.mock.t.Fatalf("AccessServiceMock.ListPermissions mock is already set by Expect")
+	}
+
+	if mmListPermissions.defaultExpectation.paramPtrs == nil {
+		mmListPermissions.defaultExpectation.paramPtrs = &AccessServiceMockListPermissionsParamPtrs{}
+	}
+	mmListPermissions.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmListPermissions
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.ListPermissions
+func (mmListPermissions *mAccessServiceMockListPermissions) Inspect(f func(ctx context.Context, filter permissionFilter.PermissionFilter)) *mAccessServiceMockListPermissions {
+	if mmListPermissions.mock.inspectFuncListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.ListPermissions")
+	}
+
+	mmListPermissions.mock.inspectFuncListPermissions = f
+
+	return mmListPermissions
+}
+
+// Return sets up results that will be returned by AccessService.ListPermissions
+func (mmListPermissions *mAccessServiceMockListPermissions) Return(ppa1 []*model.Permission, err error) *AccessServiceMock {
+	if mmListPermissions.mock.funcListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("AccessServiceMock.ListPermissions mock is already set by Set")
+	}
+
+	if mmListPermissions.defaultExpectation == nil {
+		mmListPermissions.defaultExpectation = &AccessServiceMockListPermissionsExpectation{mock: mmListPermissions.mock}
+	}
+	mmListPermissions.defaultExpectation.results = &AccessServiceMockListPermissionsResults{ppa1, err}
+	return mmListPermissions.mock
+}
+
+// Set uses given function f to mock the AccessService.ListPermissions method
+func (mmListPermissions *mAccessServiceMockListPermissions) Set(f func(ctx context.Context, filter permissionFilter.PermissionFilter) (ppa1 []*model.Permission, err error)) *AccessServiceMock {
+	if mmListPermissions.defaultExpectation != nil {
+		mmListPermissions.mock.t.Fatalf("Default expectation is already set for the AccessService.ListPermissions method")
+	}
+
+	if len(mmListPermissions.expectations) > 0 {
+		mmListPermissions.mock.t.Fatalf("Some expectations are already set for the AccessService.ListPermissions method")
+	}
+
+	mmListPermissions.mock.funcListPermissions = f
+	return mmListPermissions.mock
+}
+
+// When sets expectation for the AccessService.ListPermissions which will trigger the result defined by the following
+// Then helper
+func (mmListPermissions *mAccessServiceMockListPermissions) When(ctx context.Context, filter permissionFilter.PermissionFilter) *AccessServiceMockListPermissionsExpectation {
+	if mmListPermissions.mock.funcListPermissions != nil {
+		mmListPermissions.mock.t.Fatalf("AccessServiceMock.ListPermissions mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockListPermissionsExpectation{
+		mock:   mmListPermissions.mock,
+		params: &AccessServiceMockListPermissionsParams{ctx, filter},
+	}
+	mmListPermissions.expectations = append(mmListPermissions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.ListPermissions return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockListPermissionsExpectation) Then(ppa1 []*model.Permission, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockListPermissionsResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.ListPermissions should be invoked
+func (mmListPermissions *mAccessServiceMockListPermissions) Times(n uint64) *mAccessServiceMockListPermissions {
+	if n == 0 {
+		mmListPermissions.mock.t.Fatalf("Times of AccessServiceMock.ListPermissions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPermissions.expectedInvocations, n)
+	return mmListPermissions
+}
+
+func (mmListPermissions *mAccessServiceMockListPermissions) invocationsDone() bool {
+	if len(mmListPermissions.expectations) == 0 && mmListPermissions.defaultExpectation == nil && mmListPermissions.mock.funcListPermissions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPermissions.mock.afterListPermissionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPermissions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPermissions implements service.AccessService
+func (mmListPermissions *AccessServiceMock) ListPermissions(ctx context.Context, filter permissionFilter.PermissionFilter) (ppa1 []*model.Permission, err error) {
+	mm_atomic.AddUint64(&mmListPermissions.beforeListPermissionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPermissions.afterListPermissionsCounter, 1)
+
+	if mmListPermissions.inspectFuncListPermissions != nil {
+		mmListPermissions.inspectFuncListPermissions(ctx, filter)
+	}
+
+	mm_params := AccessServiceMockListPermissionsParams{ctx, filter}
+
+	// Record call args
+	mmListPermissions.ListPermissionsMock.mutex.Lock()
+	mmListPermissions.ListPermissionsMock.callArgs = append(mmListPermissions.ListPermissionsMock.callArgs, &mm_params)
+	mmListPermissions.ListPermissionsMock.mutex.Unlock()
+
+	for _, e := range mmListPermissions.ListPermissionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmListPermissions.ListPermissionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPermissions.ListPermissionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPermissions.ListPermissionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPermissions.ListPermissionsMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockListPermissionsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPermissions.t.Errorf("AccessServiceMock.ListPermissions got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListPermissions.t.Errorf("AccessServiceMock.ListPermissions got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPermissions.t.Errorf("AccessServiceMock.ListPermissions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPermissions.ListPermissionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPermissions.t.Fatal("No results are set for the AccessServiceMock.ListPermissions")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmListPermissions.funcListPermissions != nil {
+		return mmListPermissions.funcListPermissions(ctx, filter)
+	}
+	mmListPermissions.t.Fatalf("Unexpected call to AccessServiceMock.ListPermissions. %v %v", ctx, filter)
+	return
+}
+
+// ListPermissionsAfterCounter returns a count of finished AccessServiceMock.ListPermissions invocations
+func (mmListPermissions *AccessServiceMock) ListPermissionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPermissions.afterListPermissionsCounter)
+}
+
+// ListPermissionsBeforeCounter returns a count of AccessServiceMock.ListPermissions invocations
+func (mmListPermissions *AccessServiceMock) ListPermissionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPermissions.beforeListPermissionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.ListPermissions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPermissions *mAccessServiceMockListPermissions) Calls() []*AccessServiceMockListPermissionsParams {
+	mmListPermissions.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockListPermissionsParams, len(mmListPermissions.callArgs))
+	copy(argCopy, mmListPermissions.callArgs)
+
+	mmListPermissions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPermissionsDone returns true if the count of the ListPermissions invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockListPermissionsDone() bool {
+	if m.ListPermissionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPermissionsMock.invocationsDone()
+}
+
+// MinimockListPermissionsInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockListPermissionsInspect() {
+	for _, e := range m.ListPermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.ListPermissions with params: %#v", *e.params)
+		}
+	}
+
+	afterListPermissionsCounter := mm_atomic.LoadUint64(&m.afterListPermissionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPermissionsMock.defaultExpectation != nil && afterListPermissionsCounter < 1 {
+		if m.ListPermissionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.ListPermissions")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.ListPermissions with params: %#v", *m.ListPermissionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPermissions != nil && afterListPermissionsCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.ListPermissions")
+	}
+
+	if !m.ListPermissionsMock.invocationsDone() && afterListPermissionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.ListPermissions but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPermissionsMock.expectedInvocations), afterListPermissionsCounter)
+	}
+}
+
+type mAccessServiceMockListRelations struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockListRelationsExpectation
+	expectations       []*AccessServiceMockListRelationsExpectation
+
+	callArgs []*AccessServiceMockListRelationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockListRelationsExpectation specifies expectation struct of the AccessService.ListRelations
+type AccessServiceMockListRelationsExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockListRelationsParams
+	paramPtrs *AccessServiceMockListRelationsParamPtrs
+	results   *AccessServiceMockListRelationsResults
+	Counter   uint64
+}
+
+// AccessServiceMockListRelationsParams contains parameters of the AccessService.ListRelations
+type AccessServiceMockListRelationsParams struct {
+	ctx      context.Context
+	object   string
+	relation string
+	subject  string
+}
+
+// AccessServiceMockListRelationsParamPtrs contains pointers to parameters of the AccessService.ListRelations
+type AccessServiceMockListRelationsParamPtrs struct {
+	ctx      *context.Context
+	object   *string
+	relation *string
+	subject  *string
+}
+
+// AccessServiceMockListRelationsResults contains results of the AccessService.ListRelations
+type AccessServiceMockListRelationsResults struct {
+	rpa1 []*model.RelationTuple
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListRelations *mAccessServiceMockListRelations) Optional() *mAccessServiceMockListRelations {
+	mmListRelations.optional = true
+	return mmListRelations
+}
+
+// Expect sets up expected params for AccessService.ListRelations
+func (mmListRelations *mAccessServiceMockListRelations) Expect(ctx context.Context, object string, relation string, subject string) *mAccessServiceMockListRelations {
+	if mmListRelations.mock.funcListRelations != nil {
+		mmListRelations.mock.t.Fatalf("AccessServiceMock.ListRelations mock is already set by Set")
+	}
+
+	if mmListRelations.defaultExpectation == nil {
+		mmListRelations.defaultExpectation = &AccessServiceMockListRelationsExpectation{}
+	}
+
+	if mmListRelations.defaultExpectation.paramPtrs != nil {
+		mmListRelations.mock.t.Fatalf("AccessServiceMock.ListRelations mock is already set by ExpectParams functions")
+	}
+
+	mmListRelations.defaultExpectation.params = &AccessServiceMockListRelationsParams{ctx, object, relation, subject}
+	for _, e := range mmListRelations.expectations {
+		if minimock.Equal(e.params, mmListRelations.defaultExpectation.params) {
+			mmListRelations.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRelations.defaultExpectation.params)
+		}
+	}
+
+	return mmListRelations
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.ListRelations
+func (mmListRelations *mAccessServiceMockListRelations) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockListRelations {
+	if mmListRelations.mock.funcListRelations != nil {
+		mmListRelations.mock.t.Fatalf("AccessServiceMock.ListRelations mock is already set by Set")
+	}
+
+	if mmListRelations.defaultExpectation == nil {
+		mmListRelations.defaultExpectation = &AccessServiceMockListRelationsExpectation{}
+	}
+
+	if mmListRelations.defaultExpectation.params != nil {
+		mmListRelations.mock.t.Fatalf("AccessServiceMock.ListRelations mock is already set by Expect")
+	}
+
+	if mmListRelations.defaultExpectation.paramPtrs == nil {
+		mmListRelations.defaultExpectation.paramPtrs = &AccessServiceMockListRelationsParamPtrs{}
+	}
+	mmListRelations.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListRelations
+}
+
+// ExpectObjectParam2 sets up expected param object for AccessService.ListRelations
+func (mmListRelations *mAccessServiceMockListRelations) ExpectObjectParam2(object string) *mAccessServiceMockListRelations {
+	if mmListRelations.mock.funcListRelations != nil {
+		mmListRelations.mock.t.Fatalf("AccessServiceMock.ListRelations mock is already set by Set")
+	}
+
+	if mmListRelations.defaultExpectation == nil {
+		mmListRelations.defaultExpectation = &AccessServiceMockListRelationsExpectation{}
+	}
+
+	if mmListRelations.defaultExpectation.params != nil {
+		mmListRelations.mock.t.Fatalf("AccessServiceMock.ListRelations mock is already set by Expect")
+	}
+
+	if mmListRelations.defaultExpectation.paramPtrs == nil {
+		mmListRelations.defaultExpectation.paramPtrs = &AccessServiceMockListRelationsParamPtrs{}
+	}
+	mmListRelations.defaultExpectation.paramPtrs.object = &object
+
+	return mmListRelations
+}
+
+// ExpectRelationParam3 sets up expected param relation for AccessService.ListRelations
+func (mmListRelations *mAccessServiceMockListRelations) ExpectRelationParam3(relation string) *mAccessServiceMockListRelations {
+	if mmListRelations.mock.funcListRelations != nil {
+		mmListRelations.mock.t.Fatalf("AccessServiceMock.ListRelations mock is already set by Set")
+	}
+
+	if mmListRelations.defaultExpectation == nil {
+		mmListRelations.defaultExpectation = &AccessServiceMockListRelationsExpectation{}
+	}
+
+	if mmListRelations.defaultExpectation.params != nil {
+		mmListRelations.mock.t.Fatalf("AccessServiceMock.ListRelations mock is already set by Expect")
+	}
+
+	if mmListRelations.defaultExpectation.paramPtrs == nil {
+		mmListRelations.defaultExpectation.paramPtrs = &AccessServiceMockListRelationsParamPtrs{}
+	}
+	mmListRelations.defaultExpectation.paramPtrs.relation = &relation
+
+	return mmListRelations
+}
+
+// ExpectSubjectParam4 sets up expected param subject for AccessService.ListRelations
+func (mmListRelations *mAccessServiceMockListRelations) ExpectSubjectParam4(subject string) *mAccessServiceMockListRelations {
+	if mmListRelations.mock.funcListRelations != nil {
+		mmListRelations.mock.t.Fatalf("AccessServiceMock.ListRelations mock is already set by Set")
+	}
+
+	if mmListRelations.defaultExpectation == nil {
+		mmListRelations.defaultExpectation = &AccessServiceMockListRelationsExpectation{}
+	}
+
+	if mmListRelations.defaultExpectation.params != nil {
+		mmListRelations.mock.t.Fatalf("AccessServiceMock.ListRelations mock is already set by Expect")
+	}
+
+	if mmListRelations.defaultExpectation.paramPtrs == nil {
+		mmListRelations.defaultExpectation.paramPtrs = &AccessServiceMockListRelationsParamPtrs{}
+	}
+	mmListRelations.defaultExpectation.paramPtrs.subject = &subject
+
+	return mmListRelations
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.ListRelations
+func (mmListRelations *mAccessServiceMockListRelations) Inspect(f func(ctx context.Context, object string, relation string, subject string)) *mAccessServiceMockListRelations {
+	if mmListRelations.mock.inspectFuncListRelations != nil {
+		mmListRelations.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.ListRelations")
+	}
+
+	mmListRelations.mock.inspectFuncListRelations = f
+
+	return mmListRelations
+}
+
+// Return sets up results that will be returned by AccessService.ListRelations
+func (mmListRelations *mAccessServiceMockListRelations) Return(rpa1 []*model.RelationTuple, err error) *AccessServiceMock {
+	if mmListRelations.mock.funcListRelations != nil {
+		mmListRelations.mock.t.Fatalf("AccessServiceMock.ListRelations mock is already set by Set")
+	}
+
+	if mmListRelations.defaultExpectation == nil {
+		mmListRelations.defaultExpectation = &AccessServiceMockListRelationsExpectation{mock: mmListRelations.mock}
+	}
+	mmListRelations.defaultExpectation.results = &AccessServiceMockListRelationsResults{rpa1, err}
+	return mmListRelations.mock
+}
+
+// Set uses given function f to mock the AccessService.ListRelations method
+func (mmListRelations *mAccessServiceMockListRelations) Set(f func(ctx context.Context, object string, relation string, subject string) (rpa1 []*model.RelationTuple, err error)) *AccessServiceMock {
+	if mmListRelations.defaultExpectation != nil {
+		mmListRelations.mock.t.Fatalf("Default expectation is already set for the AccessService.ListRelations method")
+	}
+
+	if len(mmListRelations.expectations) > 0 {
+		mmListRelations.mock.t.Fatalf("Some expectations are already set for the AccessService.ListRelations method")
+	}
+
+	mmListRelations.mock.funcListRelations = f
+	return mmListRelations.mock
+}
+
+// When sets expectation for the AccessService.ListRelations which will trigger the result defined by the following
+// Then helper
+func (mmListRelations *mAccessServiceMockListRelations) When(ctx context.Context, object string, relation string, subject string) *AccessServiceMockListRelationsExpectation {
+	if mmListRelations.mock.funcListRelations != nil {
+		mmListRelations.mock.t.Fatalf("AccessServiceMock.ListRelations mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockListRelationsExpectation{
+		mock:   mmListRelations.mock,
+		params: &AccessServiceMockListRelationsParams{ctx, object, relation, subject},
+	}
+	mmListRelations.expectations = append(mmListRelations.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.ListRelations return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockListRelationsExpectation) Then(rpa1 []*model.RelationTuple, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockListRelationsResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.ListRelations should be invoked
+func (mmListRelations *mAccessServiceMockListRelations) Times(n uint64) *mAccessServiceMockListRelations {
+	if n == 0 {
+		mmListRelations.mock.t.Fatalf("Times of AccessServiceMock.ListRelations mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListRelations.expectedInvocations, n)
+	return mmListRelations
+}
+
+func (mmListRelations *mAccessServiceMockListRelations) invocationsDone() bool {
+	if len(mmListRelations.expectations) == 0 && mmListRelations.defaultExpectation == nil && mmListRelations.mock.funcListRelations == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListRelations.mock.afterListRelationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListRelations.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListRelations implements service.AccessService
+func (mmListRelations *AccessServiceMock) ListRelations(ctx context.Context, object string, relation string, subject string) (rpa1 []*model.RelationTuple, err error) {
+	mm_atomic.AddUint64(&mmListRelations.beforeListRelationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRelations.afterListRelationsCounter, 1)
+
+	if mmListRelations.inspectFuncListRelations != nil {
+		mmListRelations.inspectFuncListRelations(ctx, object, relation, subject)
+	}
+
+	mm_params := AccessServiceMockListRelationsParams{ctx, object, relation, subject}
+
+	// Record call args
+	mmListRelations.ListRelationsMock.mutex.Lock()
+	mmListRelations.ListRelationsMock.callArgs = append(mmListRelations.ListRelationsMock.callArgs, &mm_params)
+	mmListRelations.ListRelationsMock.mutex.Unlock()
+
+	for _, e := range mmListRelations.ListRelationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmListRelations.ListRelationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRelations.ListRelationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRelations.ListRelationsMock.defaultExpectation.params
+		mm_want_ptrs := mmListRelations.ListRelationsMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockListRelationsParams{ctx, object, relation, subject}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRelations.t.Errorf("AccessServiceMock.ListRelations got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.object != nil && !minimock.Equal(*mm_want_ptrs.object, mm_got.object) {
+				mmListRelations.t.Errorf("AccessServiceMock.ListRelations got unexpected parameter object, want: %#v, got: %#v%s\n", *mm_want_ptrs.object, mm_got.object, minimock.Diff(*mm_want_ptrs.object, mm_got.object))
+			}
+
+			if mm_want_ptrs.relation != nil && !minimock.Equal(*mm_want_ptrs.relation, mm_got.relation) {
+				mmListRelations.t.Errorf("AccessServiceMock.ListRelations got unexpected parameter relation, want: %#v, got: %#v%s\n", *mm_want_ptrs.relation, mm_got.relation, minimock.Diff(*mm_want_ptrs.relation, mm_got.relation))
+			}
+
+			if mm_want_ptrs.subject != nil && !minimock.Equal(*mm_want_ptrs.subject, mm_got.subject) {
+				mmListRelations.t.Errorf("AccessServiceMock.ListRelations got unexpected parameter subject, want: %#v, got: %#v%s\n", *mm_want_ptrs.subject, mm_got.subject, minimock.Diff(*mm_want_ptrs.subject, mm_got.subject))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRelations.t.Errorf("AccessServiceMock.ListRelations got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRelations.ListRelationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRelations.t.Fatal("No results are set for the AccessServiceMock.ListRelations")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmListRelations.funcListRelations != nil {
+		return mmListRelations.funcListRelations(ctx, object, relation, subject)
+	}
+	mmListRelations.t.Fatalf("Unexpected call to AccessServiceMock.ListRelations. %v %v %v %v", ctx, object, relation, subject)
+	return
+}
+
+// ListRelationsAfterCounter returns a count of finished AccessServiceMock.ListRelations invocations
+func (mmListRelations *AccessServiceMock) ListRelationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRelations.afterListRelationsCounter)
+}
+
+// ListRelationsBeforeCounter returns a count of AccessServiceMock.ListRelations invocations
+func (mmListRelations *AccessServiceMock) ListRelationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRelations.beforeListRelationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.ListRelations.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRelations *mAccessServiceMockListRelations) Calls() []*AccessServiceMockListRelationsParams {
+	mmListRelations.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockListRelationsParams, len(mmListRelations.callArgs))
+	copy(argCopy, mmListRelations.callArgs)
+
+	mmListRelations.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRelationsDone returns true if the count of the ListRelations invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockListRelationsDone() bool {
+	if m.ListRelationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRelationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRelationsMock.invocationsDone()
+}
+
+// MinimockListRelationsInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockListRelationsInspect() {
+	for _, e := range m.ListRelationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.ListRelations with params: %#v", *e.params)
+		}
+	}
+
+	afterListRelationsCounter := mm_atomic.LoadUint64(&m.afterListRelationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRelationsMock.defaultExpectation != nil && afterListRelationsCounter < 1 {
+		if m.ListRelationsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.ListRelations")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.ListRelations with params: %#v", *m.ListRelationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRelations != nil && afterListRelationsCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.ListRelations")
+	}
+
+	if !m.ListRelationsMock.invocationsDone() && afterListRelationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.ListRelations but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRelationsMock.expectedInvocations), afterListRelationsCounter)
+	}
+}
+
+type mAccessServiceMockListRoles struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockListRolesExpectation
+	expectations       []*AccessServiceMockListRolesExpectation
+
+	callArgs []*AccessServiceMockListRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockListRolesExpectation specifies expectation struct of the AccessService.ListRoles
+type AccessServiceMockListRolesExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockListRolesParams
+	paramPtrs *AccessServiceMockListRolesParamPtrs
+	results   *AccessServiceMockListRolesResults
+	Counter   uint64
+}
+
+// AccessServiceMockListRolesParams contains parameters of the AccessService.ListRoles
+type AccessServiceMockListRolesParams struct {
+	ctx context.Context
+}
+
+// AccessServiceMockListRolesParamPtrs contains pointers to parameters of the AccessService.ListRoles
+type AccessServiceMockListRolesParamPtrs struct {
+	ctx *context.Context
+}
+
+// AccessServiceMockListRolesResults contains results of the AccessService.ListRoles
+type AccessServiceMockListRolesResults struct {
+	rpa1 []*model.Role
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListRoles *mAccessServiceMockListRoles) Optional() *mAccessServiceMockListRoles {
+	mmListRoles.optional = true
+	return mmListRoles
+}
+
+// Expect sets up expected params for AccessService.ListRoles
+func (mmListRoles *mAccessServiceMockListRoles) Expect(ctx context.Context) *mAccessServiceMockListRoles {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("AccessServiceMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &AccessServiceMockListRolesExpectation{}
+	}
+
+	if mmListRoles.defaultExpectation.paramPtrs != nil {
+		mmListRoles.mock.t.Fatalf("AccessServiceMock.ListRoles mock is already set by ExpectParams functions")
+	}
+
+	mmListRoles.defaultExpectation.params = &AccessServiceMockListRolesParams{ctx}
+	for _, e := range mmListRoles.expectations {
+		if minimock.Equal(e.params, mmListRoles.defaultExpectation.params) {
+			mmListRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmListRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.ListRoles
+func (mmListRoles *mAccessServiceMockListRoles) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockListRoles {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("AccessServiceMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &AccessServiceMockListRolesExpectation{}
+	}
+
+	if mmListRoles.defaultExpectation.params != nil {
+		mmListRoles.mock.t.Fatalf("AccessServiceMock.ListRoles mock is already set by Expect")
+	}
+
+	if mmListRoles.defaultExpectation.paramPtrs == nil {
+		mmListRoles.defaultExpectation.paramPtrs = &AccessServiceMockListRolesParamPtrs{}
+	}
+	mmListRoles.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.ListRoles
+func (mmListRoles *mAccessServiceMockListRoles) Inspect(f func(ctx context.Context)) *mAccessServiceMockListRoles {
+	if mmListRoles.mock.inspectFuncListRoles != nil {
+		mmListRoles.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.ListRoles")
+	}
+
+	mmListRoles.mock.inspectFuncListRoles = f
+
+	return mmListRoles
+}
+
+// Return sets up results that will be returned by AccessService.ListRoles
+func (mmListRoles *mAccessServiceMockListRoles) Return(rpa1 []*model.Role, err error) *AccessServiceMock {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("AccessServiceMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &AccessServiceMockListRolesExpectation{mock: mmListRoles.mock}
+	}
+	mmListRoles.defaultExpectation.results = &AccessServiceMockListRolesResults{rpa1, err}
+	return mmListRoles.mock
+}
+
+// Set uses given function f to mock the AccessService.ListRoles method
+func (mmListRoles *mAccessServiceMockListRoles) Set(f func(ctx context.Context) (rpa1 []*model.Role, err error)) *AccessServiceMock {
+	if mmListRoles.defaultExpectation != nil {
+		mmListRoles.mock.t.Fatalf("Default expectation is already set for the AccessService.ListRoles method")
+	}
+
+	if len(mmListRoles.expectations) > 0 {
+		mmListRoles.mock.t.Fatalf("Some expectations are already set for the AccessService.ListRoles method")
+	}
+
+	mmListRoles.mock.funcListRoles = f
+	return mmListRoles.mock
+}
+
+// When sets expectation for the AccessService.ListRoles which will trigger the result defined by the following
+// Then helper
+func (mmListRoles *mAccessServiceMockListRoles) When(ctx context.Context) *AccessServiceMockListRolesExpectation {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("AccessServiceMock.ListRoles mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockListRolesExpectation{
+		mock:   mmListRoles.mock,
+		params: &AccessServiceMockListRolesParams{ctx},
+	}
+	mmListRoles.expectations = append(mmListRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.ListRoles return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockListRolesExpectation) Then(rpa1 []*model.Role, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockListRolesResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.ListRoles should be invoked
+func (mmListRoles *mAccessServiceMockListRoles) Times(n uint64) *mAccessServiceMockListRoles {
+	if n == 0 {
+		mmListRoles.mock.t.Fatalf("Times of AccessServiceMock.ListRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListRoles.expectedInvocations, n)
+	return mmListRoles
+}
+
+func (mmListRoles *mAccessServiceMockListRoles) invocationsDone() bool {
+	if len(mmListRoles.expectations) == 0 && mmListRoles.defaultExpectation == nil && mmListRoles.mock.funcListRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListRoles.mock.afterListRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListRoles implements service.AccessService
+func (mmListRoles *AccessServiceMock) ListRoles(ctx context.Context) (rpa1 []*model.Role, err error) {
+	mm_atomic.AddUint64(&mmListRoles.beforeListRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRoles.afterListRolesCounter, 1)
+
+	if mmListRoles.inspectFuncListRoles != nil {
+		mmListRoles.inspectFuncListRoles(ctx)
+	}
+
+	mm_params := AccessServiceMockListRolesParams{ctx}
+
+	// Record call args
+	mmListRoles.ListRolesMock.mutex.Lock()
+	mmListRoles.ListRolesMock.callArgs = append(mmListRoles.ListRolesMock.callArgs, &mm_params)
+	mmListRoles.ListRolesMock.mutex.Unlock()
+
+	for _, e := range mmListRoles.ListRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmListRoles.ListRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRoles.ListRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRoles.ListRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmListRoles.ListRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockListRolesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRoles.t.Errorf("AccessServiceMock.ListRoles got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRoles.t.Errorf("AccessServiceMock.ListRoles got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRoles.ListRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRoles.t.Fatal("No results are set for the AccessServiceMock.ListRoles")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmListRoles.funcListRoles != nil {
+		return mmListRoles.funcListRoles(ctx)
+	}
+	mmListRoles.t.Fatalf("Unexpected call to AccessServiceMock.ListRoles. %v", ctx)
+	return
+}
+
+// ListRolesAfterCounter returns a count of finished AccessServiceMock.ListRoles invocations
+func (mmListRoles *AccessServiceMock) ListRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoles.afterListRolesCounter)
+}
+
+// ListRolesBeforeCounter returns a count of AccessServiceMock.ListRoles invocations
+func (mmListRoles *AccessServiceMock) ListRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoles.beforeListRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.ListRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRoles *mAccessServiceMockListRoles) Calls() []*AccessServiceMockListRolesParams {
+	mmListRoles.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockListRolesParams, len(mmListRoles.callArgs))
+	copy(argCopy, mmListRoles.callArgs)
+
+	mmListRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRolesDone returns true if the count of the ListRoles invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockListRolesDone() bool {
+	if m.ListRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRolesMock.invocationsDone()
+}
+
+// MinimockListRolesInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockListRolesInspect() {
+	for _, e := range m.ListRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.ListRoles with params: %#v", *e.params)
+		}
+	}
+
+	afterListRolesCounter := mm_atomic.LoadUint64(&m.afterListRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRolesMock.defaultExpectation != nil && afterListRolesCounter < 1 {
+		if m.ListRolesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.ListRoles")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.ListRoles with params: %#v", *m.ListRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRoles != nil && afterListRolesCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.ListRoles")
+	}
+
+	if !m.ListRolesMock.invocationsDone() && afterListRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.ListRoles but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRolesMock.expectedInvocations), afterListRolesCounter)
+	}
+}
+
+type mAccessServiceMockRemoveGroupMember struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockRemoveGroupMemberExpectation
+	expectations       []*AccessServiceMockRemoveGroupMemberExpectation
+
+	callArgs []*AccessServiceMockRemoveGroupMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockRemoveGroupMemberExpectation specifies expectation struct of the AccessService.RemoveGroupMember
+type AccessServiceMockRemoveGroupMemberExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockRemoveGroupMemberParams
+	paramPtrs *AccessServiceMockRemoveGroupMemberParamPtrs
+	results   *AccessServiceMockRemoveGroupMemberResults
+	Counter   uint64
+}
+
+// AccessServiceMockRemoveGroupMemberParams contains parameters of the AccessService.RemoveGroupMember
+type AccessServiceMockRemoveGroupMemberParams struct {
+	ctx     context.Context
+	groupID int64
+	userID  int64
+}
+
+// AccessServiceMockRemoveGroupMemberParamPtrs contains pointers to parameters of the AccessService.RemoveGroupMember
+type AccessServiceMockRemoveGroupMemberParamPtrs struct {
+	ctx     *context.Context
+	groupID *int64
+	userID  *int64
+}
+
+// AccessServiceMockRemoveGroupMemberResults contains results of the AccessService.RemoveGroupMember
+type AccessServiceMockRemoveGroupMemberResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveGroupMember *mAccessServiceMockRemoveGroupMember) Optional() *mAccessServiceMockRemoveGroupMember {
+	mmRemoveGroupMember.optional = true
+	return mmRemoveGroupMember
+}
+
+// Expect sets up expected params for AccessService.RemoveGroupMember
+func (mmRemoveGroupMember *mAccessServiceMockRemoveGroupMember) Expect(ctx context.Context, groupID int64, userID int64) *mAccessServiceMockRemoveGroupMember {
+	if mmRemoveGroupMember.mock.funcRemoveGroupMember != nil {
+		mmRemoveGroupMember.mock.t.Fatalf("AccessServiceMock.RemoveGroupMember mock is already set by Set")
+	}
+
+	if mmRemoveGroupMember.defaultExpectation == nil {
+		mmRemoveGroupMember.defaultExpectation = &AccessServiceMockRemoveGroupMemberExpectation{}
+	}
+
+	if mmRemoveGroupMember.defaultExpectation.paramPtrs != nil {
+		mmRemoveGroupMember.mock.t.Fatalf("AccessServiceMock.RemoveGroupMember mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveGroupMember.defaultExpectation.params = &AccessServiceMockRemoveGroupMemberParams{ctx, groupID, userID}
+	for _, e := range mmRemoveGroupMember.expectations {
+		if minimock.Equal(e.params, mmRemoveGroupMember.defaultExpectation.params) {
+			mmRemoveGroupMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveGroupMember.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveGroupMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.RemoveGroupMember
+func (mmRemoveGroupMember *mAccessServiceMockRemoveGroupMember) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockRemoveGroupMember {
+	if mmRemoveGroupMember.mock.funcRemoveGroupMember != nil {
+		mmRemoveGroupMember.mock.t.Fatalf("AccessServiceMock.RemoveGroupMember mock is already set by Set")
+	}
+
+	if mmRemoveGroupMember.defaultExpectation == nil {
+		mmRemoveGroupMember.defaultExpectation = &AccessServiceMockRemoveGroupMemberExpectation{}
+	}
+
+	if mmRemoveGroupMember.defaultExpectation.params != nil {
+		mmRemoveGroupMember.mock.t.Fatalf("AccessServiceMock.RemoveGroupMember mock is already set by Expect")
+	}
+
+	if mmRemoveGroupMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveGroupMember.defaultExpectation.paramPtrs = &AccessServiceMockRemoveGroupMemberParamPtrs{}
+	}
+	mmRemoveGroupMember.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRemoveGroupMember
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for AccessService.RemoveGroupMember
+func (mmRemoveGroupMember *mAccessServiceMockRemoveGroupMember) ExpectGroupIDParam2(groupID int64) *mAccessServiceMockRemoveGroupMember {
+	if mmRemoveGroupMember.mock.funcRemoveGroupMember != nil {
+		mmRemoveGroupMember.mock.t.Fatalf("AccessServiceMock.RemoveGroupMember mock is already set by Set")
+	}
+
+	if mmRemoveGroupMember.defaultExpectation == nil {
+		mmRemoveGroupMember.defaultExpectation = &AccessServiceMockRemoveGroupMemberExpectation{}
+	}
+
+	if mmRemoveGroupMember.defaultExpectation.params != nil {
+		mmRemoveGroupMember.mock.t.Fatalf("AccessServiceMock.RemoveGroupMember mock is already set by Expect")
+	}
+
+	if mmRemoveGroupMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveGroupMember.defaultExpectation.paramPtrs = &AccessServiceMockRemoveGroupMemberParamPtrs{}
+	}
+	mmRemoveGroupMember.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmRemoveGroupMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for AccessService.RemoveGroupMember
+func (mmRemoveGroupMember *mAccessServiceMockRemoveGroupMember) ExpectUserIDParam3(userID int64) *mAccessServiceMockRemoveGroupMember {
+	if mmRemoveGroupMember.mock.funcRemoveGroupMember != nil {
+		mmRemoveGroupMember.mock.t.Fatalf("AccessServiceMock.RemoveGroupMember mock is already set by Set")
+	}
+
+	if mmRemoveGroupMember.defaultExpectation == nil {
+		mmRemoveGroupMember.defaultExpectation = &AccessServiceMockRemoveGroupMemberExpectation{}
+	}
+
+	if mmRemoveGroupMember.defaultExpectation.params != nil {
+		mmRemoveGroupMember.mock.t.Fatalf("AccessServiceMock.RemoveGroupMember mock is already set by Expect")
+	}
+
+	if mmRemoveGroupMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveGroupMember.defaultExpectation.paramPtrs = &AccessServiceMockRemoveGroupMemberParamPtrs{}
+	}
+	mmRemoveGroupMember.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmRemoveGroupMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.RemoveGroupMember
+func (mmRemoveGroupMember *mAccessServiceMockRemoveGroupMember) Inspect(f func(ctx context.Context, groupID int64, userID int64)) *mAccessServiceMockRemoveGroupMember {
+	if mmRemoveGroupMember.mock.inspectFuncRemoveGroupMember != nil {
+		mmRemoveGroupMember.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.RemoveGroupMember")
+	}
+
+	mmRemoveGroupMember.mock.inspectFuncRemoveGroupMember = f
+
+	return mmRemoveGroupMember
+}
+
+// Return sets up results that will be returned by AccessService.RemoveGroupMember
+func (mmRemoveGroupMember *mAccessServiceMockRemoveGroupMember) Return(err error) *AccessServiceMock {
+	if mmRemoveGroupMember.mock.funcRemoveGroupMember != nil {
+		mmRemoveGroupMember.mock.t.Fatalf("AccessServiceMock.RemoveGroupMember mock is already set by Set")
+	}
+
+	if mmRemoveGroupMember.defaultExpectation == nil {
+		mmRemoveGroupMember.defaultExpectation = &AccessServiceMockRemoveGroupMemberExpectation{mock: mmRemoveGroupMember.mock}
+	}
+	mmRemoveGroupMember.defaultExpectation.results = &AccessServiceMockRemoveGroupMemberResults{err}
+	return mmRemoveGroupMember.mock
+}
+
+// Set uses given function f to mock the AccessService.RemoveGroupMember method
+func (mmRemoveGroupMember *mAccessServiceMockRemoveGroupMember) Set(f func(ctx context.Context, groupID int64, userID int64) (err error)) *AccessServiceMock {
+	if mmRemoveGroupMember.defaultExpectation != nil {
+		mmRemoveGroupMember.mock.t.Fatalf("Default expectation is already set for the AccessService.RemoveGroupMember method")
+	}
+
+	if len(mmRemoveGroupMember.expectations) > 0 {
+		mmRemoveGroupMember.mock.t.Fatalf("Some expectations are already set for the AccessService.RemoveGroupMember method")
+	}
+
+	mmRemoveGroupMember.mock.funcRemoveGroupMember = f
+	return mmRemoveGroupMember.mock
+}
+
+// When sets expectation for the AccessService.RemoveGroupMember which will trigger the result defined by the following
+// Then helper
+func (mmRemoveGroupMember *mAccessServiceMockRemoveGroupMember) When(ctx context.Context, groupID int64, userID int64) *AccessServiceMockRemoveGroupMemberExpectation {
+	if mmRemoveGroupMember.mock.funcRemoveGroupMember != nil {
+		mmRemoveGroupMember.mock.t.Fatalf("AccessServiceMock.RemoveGroupMember mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockRemoveGroupMemberExpectation{
+		mock:   mmRemoveGroupMember.mock,
+		params: &AccessServiceMockRemoveGroupMemberParams{ctx, groupID, userID},
+	}
+	mmRemoveGroupMember.expectations = append(mmRemoveGroupMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.RemoveGroupMember return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockRemoveGroupMemberExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockRemoveGroupMemberResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.RemoveGroupMember should be invoked
+func (mmRemoveGroupMember *mAccessServiceMockRemoveGroupMember) Times(n uint64) *mAccessServiceMockRemoveGroupMember {
+	if n == 0 {
+		mmRemoveGroupMember.mock.t.Fatalf("Times of AccessServiceMock.RemoveGroupMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveGroupMember.expectedInvocations, n)
+	return mmRemoveGroupMember
+}
+
+func (mmRemoveGroupMember *mAccessServiceMockRemoveGroupMember) invocationsDone() bool {
+	if len(mmRemoveGroupMember.expectations) == 0 && mmRemoveGroupMember.defaultExpectation == nil && mmRemoveGroupMember.mock.funcRemoveGroupMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveGroupMember.mock.afterRemoveGroupMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveGroupMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveGroupMember implements service.AccessService
+func (mmRemoveGroupMember *AccessServiceMock) RemoveGroupMember(ctx context.Context, groupID int64, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveGroupMember.beforeRemoveGroupMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveGroupMember.afterRemoveGroupMemberCounter, 1)
+
+	if mmRemoveGroupMember.inspectFuncRemoveGroupMember != nil {
+		mmRemoveGroupMember.inspectFuncRemoveGroupMember(ctx, groupID, userID)
+	}
+
+	mm_params := AccessServiceMockRemoveGroupMemberParams{ctx, groupID, userID}
+
+	// Record call args
+	mmRemoveGroupMember.RemoveGroupMemberMock.mutex.Lock()
+	mmRemoveGroupMember.RemoveGroupMemberMock.callArgs = append(mmRemoveGroupMember.RemoveGroupMemberMock.callArgs, &mm_params)
+	mmRemoveGroupMember.RemoveGroupMemberMock.mutex.Unlock()
+
+	for _, e := range mmRemoveGroupMember.RemoveGroupMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveGroupMember.RemoveGroupMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveGroupMember.RemoveGroupMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveGroupMember.RemoveGroupMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveGroupMember.RemoveGroupMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockRemoveGroupMemberParams{ctx, groupID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveGroupMember.t.Errorf("AccessServiceMock.RemoveGroupMember got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmRemoveGroupMember.t.Errorf("AccessServiceMock.RemoveGroupMember got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveGroupMember.t.Errorf("AccessServiceMock.RemoveGroupMember got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveGroupMember.t.Errorf("AccessServiceMock.RemoveGroupMember got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveGroupMember.RemoveGroupMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveGroupMember.t.Fatal("No results are set for the AccessServiceMock.RemoveGroupMember")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveGroupMember.funcRemoveGroupMember != nil {
+		return mmRemoveGroupMember.funcRemoveGroupMember(ctx, groupID, userID)
+	}
+	mmRemoveGroupMember.t.Fatalf("Unexpected call to AccessServiceMock.RemoveGroupMember. %v %v %v", ctx, groupID, userID)
+	return
+}
+
+// RemoveGroupMemberAfterCounter returns a count of finished AccessServiceMock.RemoveGroupMember invocations
+func (mmRemoveGroupMember *AccessServiceMock) RemoveGroupMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveGroupMember.afterRemoveGroupMemberCounter)
+}
+
+// RemoveGroupMemberBeforeCounter returns a count of AccessServiceMock.RemoveGroupMember invocations
+func (mmRemoveGroupMember *AccessServiceMock) RemoveGroupMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveGroupMember.beforeRemoveGroupMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.RemoveGroupMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveGroupMember *mAccessServiceMockRemoveGroupMember) Calls() []*AccessServiceMockRemoveGroupMemberParams {
+	mmRemoveGroupMember.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockRemoveGroupMemberParams, len(mmRemoveGroupMember.callArgs))
+	copy(argCopy, mmRemoveGroupMember.callArgs)
+
+	mmRemoveGroupMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveGroupMemberDone returns true if the count of the RemoveGroupMember invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockRemoveGroupMemberDone() bool {
+	if m.RemoveGroupMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveGroupMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveGroupMemberMock.invocationsDone()
+}
+
+// MinimockRemoveGroupMemberInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockRemoveGroupMemberInspect() {
+	for _, e := range m.RemoveGroupMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.RemoveGroupMember with params: %#v", *e.params)
+		}
+	}
+
+	afterRemoveGroupMemberCounter := mm_atomic.LoadUint64(&m.afterRemoveGroupMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveGroupMemberMock.defaultExpectation != nil && afterRemoveGroupMemberCounter < 1 {
+		if m.RemoveGroupMemberMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.RemoveGroupMember")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.RemoveGroupMember with params: %#v", *m.RemoveGroupMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveGroupMember != nil && afterRemoveGroupMemberCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.RemoveGroupMember")
+	}
+
+	if !m.RemoveGroupMemberMock.invocationsDone() && afterRemoveGroupMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.RemoveGroupMember but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveGroupMemberMock.expectedInvocations), afterRemoveGroupMemberCounter)
+	}
+}
+
+type mAccessServiceMockRequestElevation struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockRequestElevationExpectation
+	expectations       []*AccessServiceMockRequestElevationExpectation
+
+	callArgs []*AccessServiceMockRequestElevationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockRequestElevationExpectation specifies expectation struct of the AccessService.RequestElevation
+type AccessServiceMockRequestElevationExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockRequestElevationParams
+	paramPtrs *AccessServiceMockRequestElevationParamPtrs
+	results   *AccessServiceMockRequestElevationResults
+	Counter   uint64
+}
+
+// AccessServiceMockRequestElevationParams contains parameters of the AccessService.RequestElevation
+type AccessServiceMockRequestElevationParams struct {
+	ctx     context.Context
+	request *model.ElevationRequest
+}
+
+// AccessServiceMockRequestElevationParamPtrs contains pointers to parameters of the AccessService.RequestElevation
+type AccessServiceMockRequestElevationParamPtrs struct {
+	ctx     *context.Context
+	request **model.ElevationRequest
+}
+
+// AccessServiceMockRequestElevationResults contains results of the AccessService.RequestElevation
+type AccessServiceMockRequestElevationResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRequestElevation *mAccessServiceMockRequestElevation) Optional() *mAccessServiceMockRequestElevation {
+	mmRequestElevation.optional = true
+	return mmRequestElevation
+}
+
+// Expect sets up expected params for AccessService.RequestElevation
+func (mmRequestElevation *mAccessServiceMockRequestElevation) Expect(ctx context.Context, request *model.ElevationRequest) *mAccessServiceMockRequestElevation {
+	if mmRequestElevation.mock.funcRequestElevation != nil {
+		mmRequestElevation.mock.t.Fatalf("AccessServiceMock.RequestElevation mock is already set by Set")
+	}
+
+	if mmRequestElevation.defaultExpectation == nil {
+		mmRequestElevation.defaultExpectation = &AccessServiceMockRequestElevationExpectation{}
+	}
+
+	if mmRequestElevation.defaultExpectation.paramPtrs != nil {
+		mmRequestElevation.mock.t.Fatalf("AccessServiceMock.RequestElevation mock is already set by ExpectParams functions")
+	}
+
+	mmRequestElevation.defaultExpectation.params = &AccessServiceMockRequestElevationParams{ctx, request}
+	for _, e := range mmRequestElevation.expectations {
+		if minimock.Equal(e.params, mmRequestElevation.defaultExpectation.params) {
+			mmRequestElevation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequestElevation.defaultExpectation.params)
+		}
+	}
+
+	return mmRequestElevation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.RequestElevation
+func (mmRequestElevation *mAccessServiceMockRequestElevation) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockRequestElevation {
+	if mmRequestElevation.mock.funcRequestElevation != nil {
+		mmRequestElevation.mock.t.Fatalf("AccessServiceMock.RequestElevation mock is already set by Set")
+	}
+
+	if mmRequestElevation.defaultExpectation == nil {
+		mmRequestElevation.defaultExpectation = &AccessServiceMockRequestElevationExpectation{}
+	}
+
+	if mmRequestElevation.defaultExpectation.params != nil {
+		mmRequestElevation.mock.t.Fatalf("AccessServiceMock.RequestElevation mock is already set by Expect")
+	}
+
+	if mmRequestElevation.defaultExpectation.paramPtrs == nil {
+		mmRequestElevation.defaultExpectation.paramPtrs = &AccessServiceMockRequestElevationParamPtrs{}
+	}
+	mmRequestElevation.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRequestElevation
+}
+
+// ExpectRequestParam2 sets up expected param request for AccessService.RequestElevation
+func (mmRequestElevation *mAccessServiceMockRequestElevation) ExpectRequestParam2(request *model.ElevationRequest) *mAccessServiceMockRequestElevation {
+	if mmRequestElevation.mock.funcRequestElevation != nil {
+		mmRequestElevation.mock.t.Fatalf("AccessServiceMock.RequestElevation mock is already set by Set")
+	}
+
+	if mmRequestElevation.defaultExpectation == nil {
+		mmRequestElevation.defaultExpectation = &AccessServiceMockRequestElevationExpectation{}
+	}
+
+	if mmRequestElevation.defaultExpectation.params != nil {
+		mmRequestElevation.mock.t.Fatalf("AccessServiceMock.RequestElevation mock is already set by Expect")
+	}
+
+	if mmRequestElevation.defaultExpectation.paramPtrs == nil {
+		mmRequestElevation.defaultExpectation.paramPtrs = &AccessServiceMockRequestElevationParamPtrs{}
+	}
+	mmRequestElevation.defaultExpectation.paramPtrs.request = &request
+
+	return mmRequestElevation
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.RequestElevation
+func (mmRequestElevation *mAccessServiceMockRequestElevation) Inspect(f func(ctx context.Context, request *model.ElevationRequest)) *mAccessServiceMockRequestElevation {
+	if mmRequestElevation.mock.inspectFuncRequestElevation != nil {
+		mmRequestElevation.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.RequestElevation")
+	}
+
+	mmRequestElevation.mock.inspectFuncRequestElevation = f
+
+	return mmRequestElevation
+}
+
+// Return sets up results that will be returned by AccessService.RequestElevation
+func (mmRequestElevation *mAccessServiceMockRequestElevation) Return(i1 int64, err error) *AccessServiceMock {
+	if mmRequestElevation.mock.funcRequestElevation != nil {
+		mmRequestElevation.mock.t.Fatalf("AccessServiceMock.RequestElevation mock is already set by Set")
+	}
+
+	if mmRequestElevation.defaultExpectation == nil {
+		mmRequestElevation.defaultExpectation = &AccessServiceMockRequestElevationExpectation{mock: mmRequestElevation.mock}
+	}
+	mmRequestElevation.defaultExpectation.results = &AccessServiceMockRequestElevationResults{i1, err}
+	return mmRequestElevation.mock
+}
+
+// Set uses given function f to mock the AccessService.RequestElevation method
+func (mmRequestElevation *mAccessServiceMockRequestElevation) Set(f func(ctx context.Context, request *model.ElevationRequest) (i1 int64, err error)) *AccessServiceMock {
+	if mmRequestElevation.defaultExpectation != nil {
+		mmRequestElevation.mock.t.Fatalf("Default expectation is already set for the AccessService.RequestElevation method")
+	}
+
+	if len(mmRequestElevation.expectations) > 0 {
+		mmRequestElevation.mock.t.Fatalf("Some expectations are already set for the AccessService.RequestElevation method")
+	}
+
+	mmRequestElevation.mock.funcRequestElevation = f
+	return mmRequestElevation.mock
+}
+
+// When sets expectation for the AccessService.RequestElevation which will trigger the result defined by the following
+// Then helper
+func (mmRequestElevation *mAccessServiceMockRequestElevation) When(ctx context.Context, request *model.ElevationRequest) *AccessServiceMockRequestElevationExpectation {
+	if mmRequestElevation.mock.funcRequestElevation != nil {
+		mmRequestElevation.mock.t.Fatalf("AccessServiceMock.RequestElevation mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockRequestElevationExpectation{
+		mock:   mmRequestElevation.mock,
+		params: &AccessServiceMockRequestElevationParams{ctx, request},
+	}
+	mmRequestElevation.expectations = append(mmRequestElevation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.RequestElevation return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockRequestElevationExpectation) Then(i1 int64, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockRequestElevationResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.RequestElevation should be invoked
+func (mmRequestElevation *mAccessServiceMockRequestElevation) Times(n uint64) *mAccessServiceMockRequestElevation {
+	if n == 0 {
+		mmRequestElevation.mock.t.Fatalf("Times of AccessServiceMock.RequestElevation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRequestElevation.expectedInvocations, n)
+	return mmRequestElevation
+}
+
+func (mmRequestElevation *mAccessServiceMockRequestElevation) invocationsDone() bool {
+	if len(mmRequestElevation.expectations) == 0 && mmRequestElevation.defaultExpectation == nil && mmRequestElevation.mock.funcRequestElevation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRequestElevation.mock.afterRequestElevationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRequestElevation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RequestElevation implements service.AccessService
+func (mmRequestElevation *AccessServiceMock) RequestElevation(ctx context.Context, request *model.ElevationRequest) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmRequestElevation.beforeRequestElevationCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequestElevation.afterRequestElevationCounter, 1)
+
+	if mmRequestElevation.inspectFuncRequestElevation != nil {
+		mmRequestElevation.inspectFuncRequestElevation(ctx, request)
+	}
+
+	mm_params := AccessServiceMockRequestElevationParams{ctx, request}
+
+	// Record call args
+	mmRequestElevation.RequestElevationMock.mutex.Lock()
+	mmRequestElevation.RequestElevationMock.callArgs = append(mmRequestElevation.RequestElevationMock.callArgs, &mm_params)
+	mmRequestElevation.RequestElevationMock.mutex.Unlock()
+
+	for _, e := range mmRequestElevation.RequestElevationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmRequestElevation.RequestElevationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequestElevation.RequestElevationMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequestElevation.RequestElevationMock.defaultExpectation.params
+		mm_want_ptrs := mmRequestElevation.RequestElevationMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockRequestElevationParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRequestElevation.t.Errorf("AccessServiceMock.RequestElevation got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmRequestElevation.t.Errorf("AccessServiceMock.RequestElevation got unexpected parameter request, want: %#v, got: %#v%s\n", *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequestElevation.t.Errorf("AccessServiceMock.RequestElevation got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequestElevation.RequestElevationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequestElevation.t.Fatal("No results are set for the AccessServiceMock.RequestElevation")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmRequestElevation.funcRequestElevation != nil {
+		return mmRequestElevation.funcRequestElevation(ctx, request)
+	}
+	mmRequestElevation.t.Fatalf("Unexpected call to AccessServiceMock.RequestElevation. %v %v", ctx, request)
+	return
+}
+
+// RequestElevationAfterCounter returns a count of finished AccessServiceMock.RequestElevation invocations
+func (mmRequestElevation *AccessServiceMock) RequestElevationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestElevation.afterRequestElevationCounter)
+}
+
+// RequestElevationBeforeCounter returns a count of AccessServiceMock.RequestElevation invocations
+func (mmRequestElevation *AccessServiceMock) RequestElevationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestElevation.beforeRequestElevationCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.RequestElevation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequestElevation *mAccessServiceMockRequestElevation) Calls() []*AccessServiceMockRequestElevationParams {
+	mmRequestElevation.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockRequestElevationParams, len(mmRequestElevation.callArgs))
+	copy(argCopy, mmRequestElevation.callArgs)
+
+	mmRequestElevation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequestElevationDone returns true if the count of the RequestElevation invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockRequestElevationDone() bool {
+	if m.RequestElevationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RequestElevationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RequestElevationMock.invocationsDone()
+}
+
+// MinimockRequestElevationInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockRequestElevationInspect() {
+	for _, e := range m.RequestElevationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.RequestElevation with params: %#v", *e.params)
+		}
+	}
+
+	afterRequestElevationCounter := mm_atomic.LoadUint64(&m.afterRequestElevationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestElevationMock.defaultExpectation != nil && afterRequestElevationCounter < 1 {
+		if m.RequestElevationMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.RequestElevation")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.RequestElevation with params: %#v", *m.RequestElevationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequestElevation != nil && afterRequestElevationCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.RequestElevation")
+	}
+
+	if !m.RequestElevationMock.invocationsDone() && afterRequestElevationCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.RequestElevation but found %d calls",
+			mm_atomic.LoadUint64(&m.RequestElevationMock.expectedInvocations), afterRequestElevationCounter)
+	}
+}
+
+type mAccessServiceMockRevokeGroupRole struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockRevokeGroupRoleExpectation
+	expectations       []*AccessServiceMockRevokeGroupRoleExpectation
+
+	callArgs []*AccessServiceMockRevokeGroupRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockRevokeGroupRoleExpectation specifies expectation struct of the AccessService.RevokeGroupRole
+type AccessServiceMockRevokeGroupRoleExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockRevokeGroupRoleParams
+	paramPtrs *AccessServiceMockRevokeGroupRoleParamPtrs
+	results   *AccessServiceMockRevokeGroupRoleResults
+	Counter   uint64
+}
+
+// AccessServiceMockRevokeGroupRoleParams contains parameters of the AccessService.RevokeGroupRole
+type AccessServiceMockRevokeGroupRoleParams struct {
+	ctx     context.Context
+	groupID int64
+	role    string
+}
+
+// AccessServiceMockRevokeGroupRoleParamPtrs contains pointers to parameters of the AccessService.RevokeGroupRole
+type AccessServiceMockRevokeGroupRoleParamPtrs struct {
+	ctx     *context.Context
+	groupID *int64
+	role    *string
+}
+
+// AccessServiceMockRevokeGroupRoleResults contains results of the AccessService.RevokeGroupRole
+type AccessServiceMockRevokeGroupRoleResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeGroupRole *mAccessServiceMockRevokeGroupRole) Optional() *mAccessServiceMockRevokeGroupRole {
+	mmRevokeGroupRole.optional = true
+	return mmRevokeGroupRole
+}
+
+// Expect sets up expected params for AccessService.RevokeGroupRole
+func (mmRevokeGroupRole *mAccessServiceMockRevokeGroupRole) Expect(ctx context.Context, groupID int64, role string) *mAccessServiceMockRevokeGroupRole {
+	if mmRevokeGroupRole.mock.funcRevokeGroupRole != nil {
+		mmRevokeGroupRole.mock.t.Fatalf("AccessServiceMock.RevokeGroupRole mock is already set by Set")
+	}
+
+	if mmRevokeGroupRole.defaultExpectation == nil {
+		mmRevokeGroupRole.defaultExpectation = &AccessServiceMockRevokeGroupRoleExpectation{}
+	}
+
+	if mmRevokeGroupRole.defaultExpectation.paramPtrs != nil {
+		mmRevokeGroupRole.mock.t.Fatalf("AccessServiceMock.RevokeGroupRole mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeGroupRole.defaultExpectation.params = &AccessServiceMockRevokeGroupRoleParams{ctx, groupID, role}
+	for _, e := range mmRevokeGroupRole.expectations {
+		if minimock.Equal(e.params, mmRevokeGroupRole.defaultExpectation.params) {
+			mmRevokeGroupRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeGroupRole.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeGroupRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.RevokeGroupRole
+func (mmRevokeGroupRole *mAccessServiceMockRevokeGroupRole) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockRevokeGroupRole {
+	if mmRevokeGroupRole.mock.funcRevokeGroupRole != nil {
+		mmRevokeGroupRole.mock.t.Fatalf("AccessServiceMock.RevokeGroupRole mock is already set by Set")
+	}
+
+	if mmRevokeGroupRole.defaultExpectation == nil {
+		mmRevokeGroupRole.defaultExpectation = &AccessServiceMockRevokeGroupRoleExpectation{}
+	}
+
+	if mmRevokeGroupRole.defaultExpectation.params != nil {
+		mmRevokeGroupRole.mock.t.Fatalf("AccessServiceMock.RevokeGroupRole mock is already set by Expect")
+	}
+
+	if mmRevokeGroupRole.defaultExpectation.paramPtrs == nil {
+		mmRevokeGroupRole.defaultExpectation.paramPtrs = &AccessServiceMockRevokeGroupRoleParamPtrs{}
+	}
+	mmRevokeGroupRole.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevokeGroupRole
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for AccessService.RevokeGroupRole
+func (mmRevokeGroupRole *mAccessServiceMockRevokeGroupRole) ExpectGroupIDParam2(groupID int64) *mAccessServiceMockRevokeGroupRole {
+	if mmRevokeGroupRole.mock.funcRevokeGroupRole != nil {
+		mmRevokeGroupRole.mock.t.Fatalf("AccessServiceMock.RevokeGroupRole mock is already set by Set")
+	}
+
+	if mmRevokeGroupRole.defaultExpectation == nil {
+		mmRevokeGroupRole.defaultExpectation = &AccessServiceMockRevokeGroupRoleExpectation{}
+	}
+
+	if mmRevokeGroupRole.defaultExpectation.params != nil {
+		mmRevokeGroupRole.mock.t.Fatalf("AccessServiceMock.RevokeGroupRole mock is already set by Expect")
+	}
+
+	if mmRevokeGroupRole.defaultExpectation.paramPtrs == nil {
+		mmRevokeGroupRole.defaultExpectation.paramPtrs = &AccessServiceMockRevokeGroupRoleParamPtrs{}
+	}
+	mmRevokeGroupRole.defaultExpectation.paramPtrs.groupID = &groupID
+
+	return mmRevokeGroupRole
+}
+
+// ExpectRoleParam3 sets up expected param role for AccessService.RevokeGroupRole
+func (mmRevokeGroupRole *mAccessServiceMockRevokeGroupRole) ExpectRoleParam3(role string) *mAccessServiceMockRevokeGroupRole {
+	if mmRevokeGroupRole.mock.funcRevokeGroupRole != nil {
+		mmRevokeGroupRole.mock.t.Fatalf("AccessServiceMock.RevokeGroupRole mock is already set by Set")
+	}
+
+	if mmRevokeGroupRole.defaultExpectation == nil {
+		mmRevokeGroupRole.defaultExpectation = &AccessServiceMockRevokeGroupRoleExpectation{}
+	}
+
+	if mmRevokeGroupRole.defaultExpectation.params != nil {
+		mmRevokeGroupRole.mock.t.Fatalf("AccessServiceMock.RevokeGroupRole mock is already set by Expect")
+	}
+
+	if mmRevokeGroupRole.defaultExpectation.paramPtrs == nil {
+		mmRevokeGroupRole.defaultExpectation.paramPtrs = &AccessServiceMockRevokeGroupRoleParamPtrs{}
+	}
+	mmRevokeGroupRole.defaultExpectation.paramPtrs.role = &role
+
+	return mmRevokeGroupRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.RevokeGroupRole
+func (mmRevokeGroupRole *mAccessServiceMockRevokeGroupRole) Inspect(f func(ctx context.Context, groupID int64, role string)) *mAccessServiceMockRevokeGroupRole {
+	if mmRevokeGroupRole.mock.inspectFuncRevokeGroupRole != nil {
+		mmRevokeGroupRole.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.RevokeGroupRole")
+	}
+
+	mmRevokeGroupRole.mock.inspectFuncRevokeGroupRole = f
+
+	return mmRevokeGroupRole
+}
+
+// Return sets up results that will be returned by AccessService.RevokeGroupRole
+func (mmRevokeGroupRole *mAccessServiceMockRevokeGroupRole) Return(err error) *AccessServiceMock {
+	if mmRevokeGroupRole.mock.funcRevokeGroupRole != nil {
+		mmRevokeGroupRole.mock.t.Fatalf("AccessServiceMock.RevokeGroupRole mock is already set by Set")
+	}
+
+	if mmRevokeGroupRole.defaultExpectation == nil {
+		mmRevokeGroupRole.defaultExpectation = &AccessServiceMockRevokeGroupRoleExpectation{mock: mmRevokeGroupRole.mock}
+	}
+	mmRevokeGroupRole.defaultExpectation.results = &AccessServiceMockRevokeGroupRoleResults{err}
+	return mmRevokeGroupRole.mock
+}
+
+// Set uses given function f to mock the AccessService.RevokeGroupRole method
+func (mmRevokeGroupRole *mAccessServiceMockRevokeGroupRole) Set(f func(ctx context.Context, groupID int64, role string) (err error)) *AccessServiceMock {
+	if mmRevokeGroupRole.defaultExpectation != nil {
+		mmRevokeGroupRole.mock.t.Fatalf("Default expectation is already set for the AccessService.RevokeGroupRole method")
+	}
+
+	if len(mmRevokeGroupRole.expectations) > 0 {
+		mmRevokeGroupRole.mock.t.Fatalf("Some expectations are already set for the AccessService.RevokeGroupRole method")
+	}
+
+	mmRevokeGroupRole.mock.funcRevokeGroupRole = f
+	return mmRevokeGroupRole.mock
+}
+
+// When sets expectation for the AccessService.RevokeGroupRole which will trigger the result defined by the following
+// Then helper
+func (mmRevokeGroupRole *mAccessServiceMockRevokeGroupRole) When(ctx context.Context, groupID int64, role string) *AccessServiceMockRevokeGroupRoleExpectation {
+	if mmRevokeGroupRole.mock.funcRevokeGroupRole != nil {
+		mmRevokeGroupRole.mock.t.Fatalf("AccessServiceMock.RevokeGroupRole mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockRevokeGroupRoleExpectation{
+		mock:   mmRevokeGroupRole.mock,
+		params: &AccessServiceMockRevokeGroupRoleParams{ctx, groupID, role},
+	}
+	mmRevokeGroupRole.expectations = append(mmRevokeGroupRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.RevokeGroupRole return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockRevokeGroupRoleExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockRevokeGroupRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.RevokeGroupRole should be invoked
+func (mmRevokeGroupRole *mAccessServiceMockRevokeGroupRole) Times(n uint64) *mAccessServiceMockRevokeGroupRole {
+	if n == 0 {
+		mmRevokeGroupRole.mock.t.Fatalf("Times of AccessServiceMock.RevokeGroupRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeGroupRole.expectedInvocations, n)
+	return mmRevokeGroupRole
+}
+
+func (mmRevokeGroupRole *mAccessServiceMockRevokeGroupRole) invocationsDone() bool {
+	if len(mmRevokeGroupRole.expectations) == 0 && mmRevokeGroupRole.defaultExpectation == nil && mmRevokeGroupRole.mock.funcRevokeGroupRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeGroupRole.mock.afterRevokeGroupRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeGroupRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeGroupRole implements service.AccessService
+func (mmRevokeGroupRole *AccessServiceMock) RevokeGroupRole(ctx context.Context, groupID int64, role string) (err error) {
+	mm_atomic.AddUint64(&mmRevokeGroupRole.beforeRevokeGroupRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeGroupRole.afterRevokeGroupRoleCounter, 1)
+
+	if mmRevokeGroupRole.inspectFuncRevokeGroupRole != nil {
+		mmRevokeGroupRole.inspectFuncRevokeGroupRole(ctx, groupID, role)
+	}
+
+	mm_params := AccessServiceMockRevokeGroupRoleParams{ctx, groupID, role}
+
+	// Record call args
+	mmRevokeGroupRole.RevokeGroupRoleMock.mutex.Lock()
+	mmRevokeGroupRole.RevokeGroupRoleMock.callArgs = append(mmRevokeGroupRole.RevokeGroupRoleMock.callArgs, &mm_params)
+	mmRevokeGroupRole.RevokeGroupRoleMock.mutex.Unlock()
+
+	for _, e := range mmRevokeGroupRole.RevokeGroupRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeGroupRole.RevokeGroupRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeGroupRole.RevokeGroupRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeGroupRole.RevokeGroupRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeGroupRole.RevokeGroupRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockRevokeGroupRoleParams{ctx, groupID, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeGroupRole.t.Errorf("AccessServiceMock.RevokeGroupRole got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmRevokeGroupRole.t.Errorf("AccessServiceMock.RevokeGroupRole got unexpected parameter groupID, want: %#v, got: %#v%s\n", *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmRevokeGroupRole.t.Errorf("AccessServiceMock.RevokeGroupRole got unexpected parameter role, want: %#v, got: %#v%s\n", *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeGroupRole.t.Errorf("AccessServiceMock.RevokeGroupRole got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeGroupRole.RevokeGroupRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeGroupRole.t.Fatal("No results are set for the AccessServiceMock.RevokeGroupRole")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeGroupRole.funcRevokeGroupRole != nil {
+		return mmRevokeGroupRole.funcRevokeGroupRole(ctx, groupID, role)
+	}
+	mmRevokeGroupRole.t.Fatalf("Unexpected call to AccessServiceMock.RevokeGroupRole. %v %v %v", ctx, groupID, role)
+	return
+}
+
+// RevokeGroupRoleAfterCounter returns a count of finished AccessServiceMock.RevokeGroupRole invocations
+func (mmRevokeGroupRole *AccessServiceMock) RevokeGroupRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeGroupRole.afterRevokeGroupRoleCounter)
+}
+
+// RevokeGroupRoleBeforeCounter returns a count of AccessServiceMock.RevokeGroupRole invocations
+func (mmRevokeGroupRole *AccessServiceMock) RevokeGroupRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeGroupRole.beforeRevokeGroupRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.RevokeGroupRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeGroupRole *mAccessServiceMockRevokeGroupRole) Calls() []*AccessServiceMockRevokeGroupRoleParams {
+	mmRevokeGroupRole.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockRevokeGroupRoleParams, len(mmRevokeGroupRole.callArgs))
+	copy(argCopy, mmRevokeGroupRole.callArgs)
+
+	mmRevokeGroupRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeGroupRoleDone returns true if the count of the RevokeGroupRole invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockRevokeGroupRoleDone() bool {
+	if m.RevokeGroupRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeGroupRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeGroupRoleMock.invocationsDone()
+}
+
+// MinimockRevokeGroupRoleInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockRevokeGroupRoleInspect() {
+	for _, e := range m.RevokeGroupRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.RevokeGroupRole with params: %#v", *e.params)
+		}
+	}
+
+	afterRevokeGroupRoleCounter := mm_atomic.LoadUint64(&m.afterRevokeGroupRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeGroupRoleMock.defaultExpectation != nil && afterRevokeGroupRoleCounter < 1 {
+		if m.RevokeGroupRoleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.RevokeGroupRole")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.RevokeGroupRole with params: %#v", *m.RevokeGroupRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeGroupRole != nil && afterRevokeGroupRoleCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.RevokeGroupRole")
+	}
+
+	if !m.RevokeGroupRoleMock.invocationsDone() && afterRevokeGroupRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.RevokeGroupRole but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeGroupRoleMock.expectedInvocations), afterRevokeGroupRoleCounter)
+	}
+}
+
+type mAccessServiceMockRevokeUserRole struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockRevokeUserRoleExpectation
+	expectations       []*AccessServiceMockRevokeUserRoleExpectation
+
+	callArgs []*AccessServiceMockRevokeUserRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockRevokeUserRoleExpectation specifies expectation struct of the AccessService.RevokeUserRole
+type AccessServiceMockRevokeUserRoleExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockRevokeUserRoleParams
+	paramPtrs *AccessServiceMockRevokeUserRoleParamPtrs
+	results   *AccessServiceMockRevokeUserRoleResults
+	Counter   uint64
+}
+
+// AccessServiceMockRevokeUserRoleParams contains parameters of the AccessService.RevokeUserRole
+type AccessServiceMockRevokeUserRoleParams struct {
+	ctx    context.Context
+	userID int64
+	role   string
+}
+
+// AccessServiceMockRevokeUserRoleParamPtrs contains pointers to parameters of the AccessService.RevokeUserRole
+type AccessServiceMockRevokeUserRoleParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	role   *string
+}
+
+// AccessServiceMockRevokeUserRoleResults contains results of the AccessService.RevokeUserRole
+type AccessServiceMockRevokeUserRoleResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeUserRole *mAccessServiceMockRevokeUserRole) Optional() *mAccessServiceMockRevokeUserRole {
+	mmRevokeUserRole.optional = true
+	return mmRevokeUserRole
+}
+
+// Expect sets up expected params for AccessService.RevokeUserRole
+func (mmRevokeUserRole *mAccessServiceMockRevokeUserRole) Expect(ctx context.Context, userID int64, role string) *mAccessServiceMockRevokeUserRole {
+	if mmRevokeUserRole.mock.funcRevokeUserRole != nil {
+		mmRevokeUserRole.mock.t.Fatalf("AccessServiceMock.RevokeUserRole mock is already set by Set")
+	}
+
+	if mmRevokeUserRole.defaultExpectation == nil {
+		mmRevokeUserRole.defaultExpectation = &AccessServiceMockRevokeUserRoleExpectation{}
+	}
+
+	if mmRevokeUserRole.defaultExpectation.paramPtrs != nil {
+		mmRevokeUserRole.mock.t.Fatalf("AccessServiceMock.RevokeUserRole mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeUserRole.defaultExpectation.params = &AccessServiceMockRevokeUserRoleParams{ctx, userID, role}
+	for _, e := range mmRevokeUserRole.expectations {
+		if minimock.Equal(e.params, mmRevokeUserRole.defaultExpectation.params) {
+			mmRevokeUserRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeUserRole.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeUserRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.RevokeUserRole
+func (mmRevokeUserRole *mAccessServiceMockRevokeUserRole) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockRevokeUserRole {
+	if mmRevokeUserRole.mock.funcRevokeUserRole != nil {
+		mmRevokeUserRole.mock.t.Fatalf("AccessServiceMock.RevokeUserRole mock is already set by Set")
+	}
+
+	if mmRevokeUserRole.defaultExpectation == nil {
+		mmRevokeUserRole.defaultExpectation = &AccessServiceMockRevokeUserRoleExpectation{}
+	}
+
+	if mmRevokeUserRole.defaultExpectation.params != nil {
+		mmRevokeUserRole.mock.t.Fatalf("AccessServiceMock.RevokeUserRole mock is already set by Expect")
+	}
+
+	if mmRevokeUserRole.defaultExpectation.paramPtrs == nil {
+		mmRevokeUserRole.defaultExpectation.paramPtrs = &AccessServiceMockRevokeUserRoleParamPtrs{}
+	}
+	mmRevokeUserRole.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevokeUserRole
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AccessService.RevokeUserRole
+func (mmRevokeUserRole *mAccessServiceMockRevokeUserRole) ExpectUserIDParam2(userID int64) *mAccessServiceMockRevokeUserRole {
+	if mmRevokeUserRole.mock.funcRevokeUserRole != nil {
+		mmRevokeUserRole.mock.t.Fatalf("AccessServiceMock.RevokeUserRole mock is already set by Set")
+	}
+
+	if mmRevokeUserRole.defaultExpectation == nil {
+		mmRevokeUserRole.defaultExpectation = &AccessServiceMockRevokeUserRoleExpectation{}
+	}
+
+	if mmRevokeUserRole.defaultExpectation.params != nil {
+		mmRevokeUserRole.mock.t.Fatalf("AccessServiceMock.RevokeUserRole mock is already set by Expect")
+	}
+
+	if mmRevokeUserRole.defaultExpectation.paramPtrs == nil {
+		mmRevokeUserRole.defaultExpectation.paramPtrs = &AccessServiceMockRevokeUserRoleParamPtrs{}
+	}
+	mmRevokeUserRole.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmRevokeUserRole
+}
+
+// ExpectRoleParam3 sets up expected param role for AccessService.RevokeUserRole
+func (mmRevokeUserRole *mAccessServiceMockRevokeUserRole) ExpectRoleParam3(role string) *mAccessServiceMockRevokeUserRole {
+	if mmRevokeUserRole.mock.funcRevokeUserRole != nil {
+		mmRevokeUserRole.mock.t.Fatalf("AccessServiceMock.RevokeUserRole mock is already set by Set")
+	}
+
+	if mmRevokeUserRole.defaultExpectation == nil {
+		mmRevokeUserRole.defaultExpectation = &AccessServiceMockRevokeUserRoleExpectation{}
+	}
+
+	if mmRevokeUserRole.defaultExpectation.params != nil {
+		mmRevokeUserRole.mock.t.Fatalf("AccessServiceMock.RevokeUserRole mock is already set by Expect")
+	}
+
+	if mmRevokeUserRole.defaultExpectation.paramPtrs == nil {
+		mmRevokeUserRole.defaultExpectation.paramPtrs = &AccessServiceMockRevokeUserRoleParamPtrs{}
+	}
+	mmRevokeUserRole.defaultExpectation.paramPtrs.role = &role
+
+	return mmRevokeUserRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.RevokeUserRole
+func (mmRevokeUserRole *mAccessServiceMockRevokeUserRole) Inspect(f func(ctx context.Context, userID int64, role string)) *mAccessServiceMockRevokeUserRole {
+	if mmRevokeUserRole.mock.inspectFuncRevokeUserRole != nil {
+		mmRevokeUserRole.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.RevokeUserRole")
+	}
+
+	mmRevokeUserRole.mock.inspectFuncRevokeUserRole = f
+
+	return mmRevokeUserRole
+}
+
+// Return sets up results that will be returned by AccessService.RevokeUserRole
+func (mmRevokeUserRole *mAccessServiceMockRevokeUserRole) Return(err error) *AccessServiceMock {
+	if mmRevokeUserRole.mock.funcRevokeUserRole != nil {
+		mmRevokeUserRole.mock.t.Fatalf("AccessServiceMock.RevokeUserRole mock is already set by Set")
+	}
+
+	if mmRevokeUserRole.defaultExpectation == nil {
+		mmRevokeUserRole.defaultExpectation = &AccessServiceMockRevokeUserRoleExpectation{mock: mmRevokeUserRole.mock}
+	}
+	mmRevokeUserRole.defaultExpectation.results = &AccessServiceMockRevokeUserRoleResults{err}
+	return mmRevokeUserRole.mock
+}
+
+// Set uses given function f to mock the AccessService.RevokeUserRole method
+func (mmRevokeUserRole *mAccessServiceMockRevokeUserRole) Set(f func(ctx context.Context, userID int64, role string) (err error)) *AccessServiceMock {
+	if mmRevokeUserRole.defaultExpectation != nil {
+		mmRevokeUserRole.mock.t.Fatalf("Default expectation is already set for the AccessService.RevokeUserRole method")
+	}
+
+	if len(mmRevokeUserRole.expectations) > 0 {
+		mmRevokeUserRole.mock.t.Fatalf("Some expectations are already set for the AccessService.RevokeUserRole method")
+	}
+
+	mmRevokeUserRole.mock.funcRevokeUserRole = f
+	return mmRevokeUserRole.mock
+}
+
+// When sets expectation for the AccessService.RevokeUserRole which will trigger the result defined by the following
+// Then helper
+func (mmRevokeUserRole *mAccessServiceMockRevokeUserRole) When(ctx context.Context, userID int64, role string) *AccessServiceMockRevokeUserRoleExpectation {
+	if mmRevokeUserRole.mock.funcRevokeUserRole != nil {
+		mmRevokeUserRole.mock.t.Fatalf("AccessServiceMock.RevokeUserRole mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockRevokeUserRoleExpectation{
+		mock:   mmRevokeUserRole.mock,
+		params: &AccessServiceMockRevokeUserRoleParams{ctx, userID, role},
+	}
+	mmRevokeUserRole.expectations = append(mmRevokeUserRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.RevokeUserRole return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockRevokeUserRoleExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockRevokeUserRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.RevokeUserRole should be invoked
+func (mmRevokeUserRole *mAccessServiceMockRevokeUserRole) Times(n uint64) *mAccessServiceMockRevokeUserRole {
+	if n == 0 {
+		mmRevokeUserRole.mock.t.Fatalf("Times of AccessServiceMock.RevokeUserRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeUserRole.expectedInvocations, n)
+	return mmRevokeUserRole
+}
+
+func (mmRevokeUserRole *mAccessServiceMockRevokeUserRole) invocationsDone() bool {
+	if len(mmRevokeUserRole.expectations) == 0 && mmRevokeUserRole.defaultExpectation == nil && mmRevokeUserRole.mock.funcRevokeUserRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeUserRole.mock.afterRevokeUserRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeUserRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeUserRole implements service.AccessService
+func (mmRevokeUserRole *AccessServiceMock) RevokeUserRole(ctx context.Context, userID int64, role string) (err error) {
+	mm_atomic.AddUint64(&mmRevokeUserRole.beforeRevokeUserRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeUserRole.afterRevokeUserRoleCounter, 1)
+
+	if mmRevokeUserRole.inspectFuncRevokeUserRole != nil {
+		mmRevokeUserRole.inspectFuncRevokeUserRole(ctx, userID, role)
+	}
+
+	mm_params := AccessServiceMockRevokeUserRoleParams{ctx, userID, role}
+
+	// Record call args
+	mmRevokeUserRole.RevokeUserRoleMock.mutex.Lock()
+	mmRevokeUserRole.RevokeUserRoleMock.callArgs = append(mmRevokeUserRole.RevokeUserRoleMock.callArgs, &mm_params)
+	mmRevokeUserRole.RevokeUserRoleMock.mutex.Unlock()
+
+	for _, e := range mmRevokeUserRole.RevokeUserRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeUserRole.RevokeUserRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeUserRole.RevokeUserRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeUserRole.RevokeUserRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeUserRole.RevokeUserRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockRevokeUserRoleParams{ctx, userID, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeUserRole.t.Errorf("AccessServiceMock.RevokeUserRole got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRevokeUserRole.t.Errorf("AccessServiceMock.RevokeUserRole got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmRevokeUserRole.t.Errorf("AccessServiceMock.RevokeUserRole got unexpected parameter role, want: %#v, got: %#v%s\n", *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeUserRole.t.Errorf("AccessServiceMock.RevokeUserRole got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeUserRole.RevokeUserRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeUserRole.t.Fatal("No results are set for the AccessServiceMock.RevokeUserRole")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeUserRole.funcRevokeUserRole != nil {
+		return mmRevokeUserRole.funcRevokeUserRole(ctx, userID, role)
+	}
+	mmRevokeUserRole.t.Fatalf("Unexpected call to AccessServiceMock.RevokeUserRole. %v %v %v", ctx, userID, role)
+	return
+}
+
+// RevokeUserRoleAfterCounter returns a count of finished AccessServiceMock.RevokeUserRole invocations
+func (mmRevokeUserRole *AccessServiceMock) RevokeUserRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeUserRole.afterRevokeUserRoleCounter)
+}
+
+// RevokeUserRoleBeforeCounter returns a count of AccessServiceMock.RevokeUserRole invocations
+func (mmRevokeUserRole *AccessServiceMock) RevokeUserRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeUserRole.beforeRevokeUserRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.RevokeUserRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeUserRole *mAccessServiceMockRevokeUserRole) Calls() []*AccessServiceMockRevokeUserRoleParams {
+	mmRevokeUserRole.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockRevokeUserRoleParams, len(mmRevokeUserRole.callArgs))
+	copy(argCopy, mmRevokeUserRole.callArgs)
+
+	mmRevokeUserRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeUserRoleDone returns true if the count of the RevokeUserRole invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockRevokeUserRoleDone() bool {
+	if m.RevokeUserRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeUserRoleMock.invocationsDone()
+}
+
+// MinimockRevokeUserRoleInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockRevokeUserRoleInspect() {
+	for _, e := range m.RevokeUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.RevokeUserRole with params: %#v", *e.params)
+		}
+	}
+
+	afterRevokeUserRoleCounter := mm_atomic.LoadUint64(&m.afterRevokeUserRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeUserRoleMock.defaultExpectation != nil && afterRevokeUserRoleCounter < 1 {
+		if m.RevokeUserRoleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.RevokeUserRole")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.RevokeUserRole with params: %#v", *m.RevokeUserRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeUserRole != nil && afterRevokeUserRoleCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.RevokeUserRole")
+	}
+
+	if !m.RevokeUserRoleMock.invocationsDone() && afterRevokeUserRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.RevokeUserRole but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeUserRoleMock.expectedInvocations), afterRevokeUserRoleCounter)
+	}
+}
+
+type mAccessServiceMockSimulate struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockSimulateExpectation
+	expectations       []*AccessServiceMockSimulateExpectation
+
+	callArgs []*AccessServiceMockSimulateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockSimulateExpectation specifies expectation struct of the AccessService.Simulate
+type AccessServiceMockSimulateExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockSimulateParams
+	paramPtrs *AccessServiceMockSimulateParamPtrs
+	results   *AccessServiceMockSimulateResults
+	Counter   uint64
+}
+
+// AccessServiceMockSimulateParams contains parameters of the AccessService.Simulate
+type AccessServiceMockSimulateParams struct {
+	ctx     context.Context
+	changes []*model.PolicyChange
+}
+
+// AccessServiceMockSimulateParamPtrs contains pointers to parameters of the AccessService.Simulate
+type AccessServiceMockSimulateParamPtrs struct {
+	ctx     *context.Context
+	changes *[]*model.PolicyChange
+}
+
+// AccessServiceMockSimulateResults contains results of the AccessService.Simulate
+type AccessServiceMockSimulateResults struct {
+	spa1 []*model.SimulationResult
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSimulate *mAccessServiceMockSimulate) Optional() *mAccessServiceMockSimulate {
+	mmSimulate.optional = true
+	return mmSimulate
+}
+
+// Expect sets up expected params for AccessService.Simulate
+func (mmSimulate *mAccessServiceMockSimulate) Expect(ctx context.Context, changes []*model.PolicyChange) *mAccessServiceMockSimulate {
+	if mmSimulate.mock.funcSimulate != nil {
+		mmSimulate.mock.t.Fatalf("AccessServiceMock.Simulate mock is already set by Set")
+	}
+
+	if mmSimulate.defaultExpectation == nil {
+		mmSimulate.defaultExpectation = &AccessServiceMockSimulateExpectation{}
+	}
+
+	if mmSimulate.defaultExpectation.paramPtrs != nil {
+		mmSimulate.mock.t.Fatalf("AccessServiceMock.Simulate mock is already set by ExpectParams functions")
+	}
+
+	mmSimulate.defaultExpectation.params = &AccessServiceMockSimulateParams{ctx, changes}
+	for _, e := range mmSimulate.expectations {
+		if minimock.Equal(e.params, mmSimulate.defaultExpectation.params) {
+			mmSimulate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSimulate.defaultExpectation.params)
+		}
+	}
+
+	return mmSimulate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.Simulate
+func (mmSimulate *mAccessServiceMockSimulate) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockSimulate {
+	if mmSimulate.mock.funcSimulate != nil {
+		mmSimulate.mock.t.Fatalf("AccessServiceMock.Simulate mock is already set by Set")
+	}
+
+	if mmSimulate.defaultExpectation == nil {
+		mmSimulate.defaultExpectation = &AccessServiceMockSimulateExpectation{}
+	}
+
+	if mmSimulate.defaultExpectation.params != nil {
+		mmSimulate.mock.t.Fatalf("AccessServiceMock.Simulate mock is already set by Expect")
+	}
+
+	if mmSimulate.defaultExpectation.paramPtrs == nil {
+		mmSimulate.defaultExpectation.paramPtrs = &AccessServiceMockSimulateParamPtrs{}
+	}
+	mmSimulate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSimulate
+}
+
+// ExpectChangesParam2 sets up expected param changes for AccessService.Simulate
+func (mmSimulate *mAccessServiceMockSimulate) ExpectChangesParam2(changes []*model.PolicyChange) *mAccessServiceMockSimulate {
+	if mmSimulate.mock.funcSimulate != nil {
+		mmSimulate.mock.t.Fatalf("AccessServiceMock.Simulate mock is already set by Set")
+	}
+
+	if mmSimulate.defaultExpectation == nil {
+		mmSimulate.defaultExpectation = &AccessServiceMockSimulateExpectation{}
+	}
+
+	if mmSimulate.defaultExpectation.params != nil {
+		mmSimulate.mock.t.Fatalf("AccessServiceMock.Simulate mock is already set by Expect")
+	}
+
+	if mmSimulate.defaultExpectation.paramPtrs == nil {
+		mmSimulate.defaultExpectation.paramPtrs = &AccessServiceMockSimulateParamPtrs{}
+	}
+	mmSimulate.defaultExpectation.paramPtrs.changes = &changes
+
+	return mmSimulate
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.Simulate
+func (mmSimulate *mAccessServiceMockSimulate) Inspect(f func(ctx context.Context, changes []*model.PolicyChange)) *mAccessServiceMockSimulate {
+	if mmSimulate.mock.inspectFuncSimulate != nil {
+		mmSimulate.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.Simulate")
+	}
+
+	mmSimulate.mock.inspectFuncSimulate = f
+
+	return mmSimulate
+}
+
+// Return sets up results that will be returned by AccessService.Simulate
+func (mmSimulate *mAccessServiceMockSimulate) Return(spa1 []*model.SimulationResult, err error) *AccessServiceMock {
+	if mmSimulate.mock.funcSimulate != nil {
+		mmSimulate.mock.t.Fatalf("AccessServiceMock.Simulate mock is already set by Set")
+	}
+
+	if mmSimulate.defaultExpectation == nil {
+		mmSimulate.defaultExpectation = &AccessServiceMockSimulateExpectation{mock: mmSimulate.mock}
+	}
+	mmSimulate.defaultExpectation.results = &AccessServiceMockSimulateResults{spa1, err}
+	return mmSimulate.mock
+}
+
+// Set uses given function f to mock the AccessService.Simulate method
+func (mmSimulate *mAccessServiceMockSimulate) Set(f func(ctx context.Context, changes []*model.PolicyChange) (spa1 []*model.SimulationResult, err error)) *AccessServiceMock {
+	if mmSimulate.defaultExpectation != nil {
+		mmSimulate.mock.t.Fatalf("Default expectation is already set for the AccessService.Simulate method")
+	}
+
+	if len(mmSimulate.expectations) > 0 {
+		mmSimulate.mock.t.Fatalf("Some expectations are already set for the AccessService.Simulate method")
+	}
+
+	mmSimulate.mock.funcSimulate = f
+	return mmSimulate.mock
+}
+
+// When sets expectation for the AccessService.Simulate which will trigger the result defined by the following
+// Then helper
+func (mmSimulate *mAccessServiceMockSimulate) When(ctx context.Context, changes []*model.PolicyChange) *AccessServiceMockSimulateExpectation {
+	if mmSimulate.mock.funcSimulate != nil {
+		mmSimulate.mock.t.Fatalf("AccessServiceMock.Simulate mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockSimulateExpectation{
+		mock:   mmSimulate.mock,
+		params: &AccessServiceMockSimulateParams{ctx, changes},
+	}
+	mmSimulate.expectations = append(mmSimulate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.Simulate return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockSimulateExpectation) Then(spa1 []*model.SimulationResult, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockSimulateResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.Simulate should be invoked
+func (mmSimulate *mAccessServiceMockSimulate) Times(n uint64) *mAccessServiceMockSimulate {
+	if n == 0 {
+		mmSimulate.mock.t.Fatalf("Times of AccessServiceMock.Simulate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSimulate.expectedInvocations, n)
+	return mmSimulate
+}
+
+func (mmSimulate *mAccessServiceMockSimulate) invocationsDone() bool {
+	if len(mmSimulate.expectations) == 0 && mmSimulate.defaultExpectation == nil && mmSimulate.mock.funcSimulate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSimulate.mock.afterSimulateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSimulate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Simulate implements service.AccessService
+func (mmSimulate *AccessServiceMock) Simulate(ctx context.Context, changes []*model.PolicyChange) (spa1 []*model.SimulationResult, err error) {
+	mm_atomic.AddUint64(&mmSimulate.beforeSimulateCounter, 1)
+	defer mm_atomic.AddUint64(&mmSimulate.afterSimulateCounter, 1)
+
+	if mmSimulate.inspectFuncSimulate != nil {
+		mmSimulate.inspectFuncSimulate(ctx, changes)
+	}
+
+	mm_params := AccessServiceMockSimulateParams{ctx, changes}
+
+	// Record call args
+	mmSimulate.SimulateMock.mutex.Lock()
+	mmSimulate.SimulateMock.callArgs = append(mmSimulate.SimulateMock.callArgs, &mm_params)
+	mmSimulate.SimulateMock.mutex.Unlock()
+
+	for _, e := range mmSimulate.SimulateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmSimulate.SimulateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSimulate.SimulateMock.defaultExpectation.Counter, 1)
+		mm_want := mmSimulate.SimulateMock.defaultExpectation.params
+		mm_want_ptrs := mmSimulate.SimulateMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockSimulateParams{ctx, changes}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSimulate.t.Errorf("AccessServiceMock.Simulate got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.changes != nil && !minimock.Equal(*mm_want_ptrs.changes, mm_got.changes) {
+				mmSimulate.t.Errorf("AccessServiceMock.Simulate got unexpected parameter changes, want: %#v, got: %#v%s\n", *mm_want_ptrs.changes, mm_got.changes, minimock.Diff(*mm_want_ptrs.changes, mm_got.changes))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSimulate.t.Errorf("AccessServiceMock.Simulate got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSimulate.SimulateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSimulate.t.Fatal("No results are set for the AccessServiceMock.Simulate")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmSimulate.funcSimulate != nil {
+		return mmSimulate.funcSimulate(ctx, changes)
+	}
+	mmSimulate.t.Fatalf("Unexpected call to AccessServiceMock.Simulate. %v %v", ctx, changes)
+	return
+}
+
+// SimulateAfterCounter returns a count of finished AccessServiceMock.Simulate invocations
+func (mmSimulate *AccessServiceMock) SimulateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSimulate.afterSimulateCounter)
+}
+
+// SimulateBeforeCounter returns a count of AccessServiceMock.Simulate invocations
+func (mmSimulate *AccessServiceMock) SimulateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSimulate.beforeSimulateCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.Simulate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSimulate *mAccessServiceMockSimulate) Calls() []*AccessServiceMockSimulateParams {
+	mmSimulate.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockSimulateParams, len(mmSimulate.callArgs))
+	copy(argCopy, mmSimulate.callArgs)
+
+	mmSimulate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSimulateDone returns true if the count of the Simulate invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockSimulateDone() bool {
+	if m.SimulateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SimulateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SimulateMock.invocationsDone()
+}
+
+// MinimockSimulateInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockSimulateInspect() {
+	for _, e := range m.SimulateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.Simulate with params: %#v", *e.params)
+		}
+	}
+
+	afterSimulateCounter := mm_atomic.LoadUint64(&m.afterSimulateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SimulateMock.defaultExpectation != nil && afterSimulateCounter < 1 {
+		if m.SimulateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.Simulate")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.Simulate with params: %#v", *m.SimulateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSimulate != nil && afterSimulateCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.Simulate")
+	}
+
+	if !m.SimulateMock.invocationsDone() && afterSimulateCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.Simulate but found %d calls",
+			mm_atomic.LoadUint64(&m.SimulateMock.expectedInvocations), afterSimulateCounter)
+	}
+}
+
+type mAccessServiceMockUpdateRole struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockUpdateRoleExpectation
+	expectations       []*AccessServiceMockUpdateRoleExpectation
+
+	callArgs []*AccessServiceMockUpdateRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockUpdateRoleExpectation specifies expectation struct of the AccessService.UpdateRole
+type AccessServiceMockUpdateRoleExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockUpdateRoleParams
+	paramPtrs *AccessServiceMockUpdateRoleParamPtrs
+	results   *AccessServiceMockUpdateRoleResults
+	Counter   uint64
+}
+
+// AccessServiceMockUpdateRoleParams contains parameters of the AccessService.UpdateRole
+type AccessServiceMockUpdateRoleParams struct {
+	ctx     context.Context
+	updates *model.RoleUpdate
+}
+
+// AccessServiceMockUpdateRoleParamPtrs contains pointers to parameters of the AccessService.UpdateRole
+type AccessServiceMockUpdateRoleParamPtrs struct {
+	ctx     *context.Context
+	updates **model.RoleUpdate
+}
+
+// AccessServiceMockUpdateRoleResults contains results of the AccessService.UpdateRole
+type AccessServiceMockUpdateRoleResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateRole *mAccessServiceMockUpdateRole) Optional() *mAccessServiceMockUpdateRole {
+	mmUpdateRole.optional = true
+	return mmUpdateRole
+}
+
+// Expect sets up expected params for AccessService.UpdateRole
+func (mmUpdateRole *mAccessServiceMockUpdateRole) Expect(ctx context.Context, updates *model.RoleUpdate) *mAccessServiceMockUpdateRole {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("AccessServiceMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &AccessServiceMockUpdateRoleExpectation{}
+	}
+
+	if mmUpdateRole.defaultExpectation.paramPtrs != nil {
+		mmUpdateRole.mock.t.Fatalf("AccessServiceMock.UpdateRole mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateRole.defaultExpectation.params = &AccessServiceMockUpdateRoleParams{ctx, updates}
+	for _, e := range mmUpdateRole.expectations {
+		if minimock.Equal(e.params, mmUpdateRole.defaultExpectation.params) {
+			mmUpdateRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateRole.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.UpdateRole
+func (mmUpdateRole *mAccessServiceMockUpdateRole) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockUpdateRole {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("AccessServiceMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &AccessServiceMockUpdateRoleExpectation{}
+	}
+
+	if mmUpdateRole.defaultExpectation.params != nil {
+		mmUpdateRole.mock.t.Fatalf("AccessServiceMock.UpdateRole mock is already set by Expect")
+	}
+
+	if mmUpdateRole.defaultExpectation.paramPtrs == nil {
+		mmUpdateRole.defaultExpectation.paramPtrs = &AccessServiceMockUpdateRoleParamPtrs{}
+	}
+	mmUpdateRole.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdateRole
+}
+
+// ExpectUpdatesParam2 sets up expected param updates for AccessService.UpdateRole
+func (mmUpdateRole *mAccessServiceMockUpdateRole) ExpectUpdatesParam2(updates *model.RoleUpdate) *mAccessServiceMockUpdateRole {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("AccessServiceMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &AccessServiceMockUpdateRoleExpectation{}
+	}
+
+	if mmUpdateRole.defaultExpectation.params != nil {
+		mmUpdateRole.mock.t.Fatalf("AccessServiceMock.UpdateRole mock is already set by Expect")
+	}
+
+	if mmUpdateRole.defaultExpectation.paramPtrs == nil {
+		mmUpdateRole.defaultExpectation.paramPtrs = &AccessServiceMockUpdateRoleParamPtrs{}
+	}
+	mmUpdateRole.defaultExpectation.paramPtrs.updates = &updates
+
+	return mmUpdateRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.UpdateRole
+func (mmUpdateRole *mAccessServiceMockUpdateRole) Inspect(f func(ctx context.Context, updates *model.RoleUpdate)) *mAccessServiceMockUpdateRole {
+	if mmUpdateRole.mock.inspectFuncUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.UpdateRole")
+	}
+
+	mmUpdateRole.mock.inspectFuncUpdateRole = f
+
+	return mmUpdateRole
+}
+
+// Return sets up results that will be returned by AccessService.UpdateRole
+func (mmUpdateRole *mAccessServiceMockUpdateRole) Return(err error) *AccessServiceMock {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("AccessServiceMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &AccessServiceMockUpdateRoleExpectation{mock: mmUpdateRole.mock}
+	}
+	mmUpdateRole.defaultExpectation.results = &AccessServiceMockUpdateRoleResults{err}
+	return mmUpdateRole.mock
+}
+
+// Set uses given function f to mock the AccessService.UpdateRole method
+func (mmUpdateRole *mAccessServiceMockUpdateRole) Set(f func(ctx context.Context, updates *model.RoleUpdate) (err error)) *AccessServiceMock {
+	if mmUpdateRole.defaultExpectation != nil {
+		mmUpdateRole.mock.t.Fatalf("Default expectation is already set for the AccessService.UpdateRole method")
+	}
+
+	if len(mmUpdateRole.expectations) > 0 {
+		mmUpdateRole.mock.t.Fatalf("Some expectations are already set for the AccessService.UpdateRole method")
+	}
+
+	mmUpdateRole.mock.funcUpdateRole = f
+	return mmUpdateRole.mock
+}
+
+// When sets expectation for the AccessService.UpdateRole which will trigger the result defined by the following
+// Then helper
+func (mmUpdateRole *mAccessServiceMockUpdateRole) When(ctx context.Context, updates *model.RoleUpdate) *AccessServiceMockUpdateRoleExpectation {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("AccessServiceMock.UpdateRole mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockUpdateRoleExpectation{
+		mock:   mmUpdateRole.mock,
+		params: &AccessServiceMockUpdateRoleParams{ctx, updates},
+	}
+	mmUpdateRole.expectations = append(mmUpdateRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.UpdateRole return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockUpdateRoleExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockUpdateRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.UpdateRole should be invoked
+func (mmUpdateRole *mAccessServiceMockUpdateRole) Times(n uint64) *mAccessServiceMockUpdateRole {
+	if n == 0 {
+		mmUpdateRole.mock.t.Fatalf("Times of AccessServiceMock.UpdateRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateRole.expectedInvocations, n)
+	return mmUpdateRole
+}
+
+func (mmUpdateRole *mAccessServiceMockUpdateRole) invocationsDone() bool {
+	if len(mmUpdateRole.expectations) == 0 && mmUpdateRole.defaultExpectation == nil && mmUpdateRole.mock.funcUpdateRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateRole.mock.afterUpdateRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateRole implements service.AccessService
+func (mmUpdateRole *AccessServiceMock) UpdateRole(ctx context.Context, updates *model.RoleUpdate) (err error) {
+	mm_atomic.AddUint64(&mmUpdateRole.beforeUpdateRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateRole.afterUpdateRoleCounter, 1)
+
+	if mmUpdateRole.inspectFuncUpdateRole != nil {
+		mmUpdateRole.inspectFuncUpdateRole(ctx, updates)
+	}
+
+	mm_params := AccessServiceMockUpdateRoleParams{ctx, updates}
+
+	// Record call args
+	mmUpdateRole.UpdateRoleMock.mutex.Lock()
+	mmUpdateRole.UpdateRoleMock.callArgs = append(mmUpdateRole.UpdateRoleMock.callArgs, &mm_params)
+	mmUpdateRole.UpdateRoleMock.mutex.Unlock()
+
+	for _, e := range mmUpdateRole.UpdateRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateRole.UpdateRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateRole.UpdateRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateRole.UpdateRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateRole.UpdateRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockUpdateRoleParams{ctx, updates}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateRole.t.Errorf("AccessServiceMock.UpdateRole got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.updates != nil && !minimock.Equal(*mm_want_ptrs.updates, mm_got.updates) {
+				mmUpdateRole.t.Errorf("AccessServiceMock.UpdateRole got unexpected parameter updates, want: %#v, got: %#v%s\n", *mm_want_ptrs.updates, mm_got.updates, minimock.Diff(*mm_want_ptrs.updates, mm_got.updates))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateRole.t.Errorf("AccessServiceMock.UpdateRole got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateRole.UpdateRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateRole.t.Fatal("No results are set for the AccessServiceMock.UpdateRole")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateRole.funcUpdateRole != nil {
+		return mmUpdateRole.funcUpdateRole(ctx, updates)
+	}
+	mmUpdateRole.t.Fatalf("Unexpected call to AccessServiceMock.UpdateRole. %v %v", ctx, updates)
+	return
+}
+
+// UpdateRoleAfterCounter returns a count of finished AccessServiceMock.UpdateRole invocations
+func (mmUpdateRole *AccessServiceMock) UpdateRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRole.afterUpdateRoleCounter)
+}
+
+// UpdateRoleBeforeCounter returns a count of AccessServiceMock.UpdateRole invocations
+func (mmUpdateRole *AccessServiceMock) UpdateRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRole.beforeUpdateRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.UpdateRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateRole *mAccessServiceMockUpdateRole) Calls() []*AccessServiceMockUpdateRoleParams {
+	mmUpdateRole.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockUpdateRoleParams, len(mmUpdateRole.callArgs))
+	copy(argCopy, mmUpdateRole.callArgs)
+
+	mmUpdateRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateRoleDone returns true if the count of the UpdateRole invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockUpdateRoleDone() bool {
+	if m.UpdateRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateRoleMock.invocationsDone()
+}
+
+// MinimockUpdateRoleInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockUpdateRoleInspect() {
+	for _, e := range m.UpdateRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.UpdateRole with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdateRoleCounter := mm_atomic.LoadUint64(&m.afterUpdateRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateRoleMock.defaultExpectation != nil && afterUpdateRoleCounter < 1 {
+		if m.UpdateRoleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.UpdateRole")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.UpdateRole with params: %#v", *m.UpdateRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateRole != nil && afterUpdateRoleCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.UpdateRole")
+	}
+
+	if !m.UpdateRoleMock.invocationsDone() && afterUpdateRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.UpdateRole but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateRoleMock.expectedInvocations), afterUpdateRoleCounter)
+	}
+}
+
+type mAccessServiceMockValidatePolicy struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockValidatePolicyExpectation
+	expectations       []*AccessServiceMockValidatePolicyExpectation
+
+	callArgs []*AccessServiceMockValidatePolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockValidatePolicyExpectation specifies expectation struct of the AccessService.ValidatePolicy
+type AccessServiceMockValidatePolicyExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockValidatePolicyParams
+	paramPtrs *AccessServiceMockValidatePolicyParamPtrs
+	results   *AccessServiceMockValidatePolicyResults
+	Counter   uint64
+}
+
+// AccessServiceMockValidatePolicyParams contains parameters of the AccessService.ValidatePolicy
+type AccessServiceMockValidatePolicyParams struct {
+	ctx       context.Context
+	condition string
+}
+
+// AccessServiceMockValidatePolicyParamPtrs contains pointers to parameters of the AccessService.ValidatePolicy
+type AccessServiceMockValidatePolicyParamPtrs struct {
+	ctx       *context.Context
+	condition *string
+}
+
+// AccessServiceMockValidatePolicyResults contains results of the AccessService.ValidatePolicy
+type AccessServiceMockValidatePolicyResults struct {
+	sa1 []string
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidatePolicy *mAccessServiceMockValidatePolicy) Optional() *mAccessServiceMockValidatePolicy {
+	mmValidatePolicy.optional = true
+	return mmValidatePolicy
+}
+
+// Expect sets up expected params for AccessService.ValidatePolicy
+func (mmValidatePolicy *mAccessServiceMockValidatePolicy) Expect(ctx context.Context, condition string) *mAccessServiceMockValidatePolicy {
+	if mmValidatePolicy.mock.funcValidatePolicy != nil {
+		mmValidatePolicy.mock.t.Fatalf("AccessServiceMock.ValidatePolicy mock is already set by Set")
+	}
+
+	if mmValidatePolicy.defaultExpectation == nil {
+		mmValidatePolicy.defaultExpectation = &AccessServiceMockValidatePolicyExpectation{}
+	}
+
+	if mmValidatePolicy.defaultExpectation.paramPtrs != nil {
+		mmValidatePolicy.mock.t.Fatalf("AccessServiceMock.ValidatePolicy mock is already set by ExpectParams functions")
+	}
+
+	mmValidatePolicy.defaultExpectation.params = &AccessServiceMockValidatePolicyParams{ctx, condition}
+	for _, e := range mmValidatePolicy.expectations {
+		if minimock.Equal(e.params, mmValidatePolicy.defaultExpectation.params) {
+			mmValidatePolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidatePolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmValidatePolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.ValidatePolicy
+func (mmValidatePolicy *mAccessServiceMockValidatePolicy) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockValidatePolicy {
+	if mmValidatePolicy.mock.funcValidatePolicy != nil {
+		mmValidatePolicy.mock.t.Fatalf("AccessServiceMock.ValidatePolicy mock is already set by Set")
+	}
+
+	if mmValidatePolicy.defaultExpectation == nil {
+		mmValidatePolicy.defaultExpectation = &AccessServiceMockValidatePolicyExpectation{}
+	}
+
+	if mmValidatePolicy.defaultExpectation.params != nil {
+		mmValidatePolicy.mock.t.Fatalf("AccessServiceMock.ValidatePolicy mock is already set by Expect")
+	}
+
+	if mmValidatePolicy.defaultExpectation.paramPtrs == nil {
+		mmValidatePolicy.defaultExpectation.paramPtrs = &AccessServiceMockValidatePolicyParamPtrs{}
+	}
+	mmValidatePolicy.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmValidatePolicy
+}
+
+// ExpectConditionParam2 sets up expected param condition for AccessService.ValidatePolicy
+func (mmValidatePolicy *mAccessServiceMockValidatePolicy) ExpectConditionParam2(condition string) *mAccessServiceMockValidatePolicy {
+	if mmValidatePolicy.mock.funcValidatePolicy != nil {
+		mmValidatePolicy.mock.t.Fatalf("AccessServiceMock.ValidatePolicy mock is already set by Set")
+	}
+
+	if mmValidatePolicy.defaultExpectation == nil {
+		mmValidatePolicy.defaultExpectation = &AccessServiceMockValidatePolicyExpectation{}
+	}
+
+	if mmValidatePolicy.defaultExpectation.params != nil {
+		mmValidatePolicy.mock.t.Fatalf("AccessServiceMock.ValidatePolicy mock is already set by Expect")
+	}
+
+	if mmValidatePolicy.defaultExpectation.paramPtrs == nil {
+		mmValidatePolicy.defaultExpectation.paramPtrs = &AccessServiceMockValidatePolicyParamPtrs{}
+	}
+	mmValidatePolicy.defaultExpectation.paramPtrs.condition = &condition
+
+	return mmValidatePolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.ValidatePolicy
+func (mmValidatePolicy *mAccessServiceMockValidatePolicy) Inspect(f func(ctx context.Context, condition string)) *mAccessServiceMockValidatePolicy {
+	if mmValidatePolicy.mock.inspectFuncValidatePolicy != nil {
+		mmValidatePolicy.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.ValidatePolicy")
+	}
+
+	mmValidatePolicy.mock.inspectFuncValidatePolicy = f
+
+	return mmValidatePolicy
+}
+
+// Return sets up results that will be returned by AccessService.ValidatePolicy
+func (mmValidatePolicy *mAccessServiceMockValidatePolicy) Return(sa1 []string, err error) *AccessServiceMock {
+	if mmValidatePolicy.mock.funcValidatePolicy != nil {
+		mmValidatePolicy.mock.t.Fatalf("AccessServiceMock.ValidatePolicy mock is already set by Set")
+	}
+
+	if mmValidatePolicy.defaultExpectation == nil {
+		mmValidatePolicy.defaultExpectation = &AccessServiceMockValidatePolicyExpectation{mock: mmValidatePolicy.mock}
+	}
+	mmValidatePolicy.defaultExpectation.results = &AccessServiceMockValidatePolicyResults{sa1, err}
+	return mmValidatePolicy.mock
+}
+
+// Set uses given function f to mock the AccessService.ValidatePolicy method
+func (mmValidatePolicy *mAccessServiceMockValidatePolicy) Set(f func(ctx context.Context, condition string) (sa1 []string, err error)) *AccessServiceMock {
+	if mmValidatePolicy.defaultExpectation != nil {
+		mmValidatePolicy.mock.t.Fatalf("Default expectation is already set for the AccessService.ValidatePolicy method")
+	}
+
+	if len(mmValidatePolicy.expectations) > 0 {
+		mmValidatePolicy.mock.t.Fatalf("Some expectations are already set for the AccessService.ValidatePolicy method")
+	}
+
+	mmValidatePolicy.mock.funcValidatePolicy = f
+	return mmValidatePolicy.mock
+}
+
+// When sets expectation for the AccessService.ValidatePolicy which will trigger the result defined by the following
+// Then helper
+func (mmValidatePolicy *mAccessServiceMockValidatePolicy) When(ctx context.Context, condition string) *AccessServiceMockValidatePolicyExpectation {
+	if mmValidatePolicy.mock.funcValidatePolicy != nil {
+		mmValidatePolicy.mock.t.Fatalf("AccessServiceMock.ValidatePolicy mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockValidatePolicyExpectation{
+		mock:   mmValidatePolicy.mock,
+		params: &AccessServiceMockValidatePolicyParams{ctx, condition},
+	}
+	mmValidatePolicy.expectations = append(mmValidatePolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.ValidatePolicy return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockValidatePolicyExpectation) Then(sa1 []string, err error) *AccessServiceMock {
+	e.results = &AccessServiceMockValidatePolicyResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.ValidatePolicy should be invoked
+func (mmValidatePolicy *mAccessServiceMockValidatePolicy) Times(n uint64) *mAccessServiceMockValidatePolicy {
+	if n == 0 {
+		mmValidatePolicy.mock.t.Fatalf("Times of AccessServiceMock.ValidatePolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidatePolicy.expectedInvocations, n)
+	return mmValidatePolicy
+}
+
+func (mmValidatePolicy *mAccessServiceMockValidatePolicy) invocationsDone() bool {
+	if len(mmValidatePolicy.expectations) == 0 && mmValidatePolicy.defaultExpectation == nil && mmValidatePolicy.mock.funcValidatePolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidatePolicy.mock.afterValidatePolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidatePolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidatePolicy implements service.AccessService
+func (mmValidatePolicy *AccessServiceMock) ValidatePolicy(ctx context.Context, condition string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmValidatePolicy.beforeValidatePolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidatePolicy.afterValidatePolicyCounter, 1)
+
+	if mmValidatePolicy.inspectFuncValidatePolicy != nil {
+		mmValidatePolicy.inspectFuncValidatePolicy(ctx, condition)
+	}
+
+	mm_params := AccessServiceMockValidatePolicyParams{ctx, condition}
+
+	// Record call args
+	mmValidatePolicy.ValidatePolicyMock.mutex.Lock()
+	mmValidatePolicy.ValidatePolicyMock.callArgs = append(mmValidatePolicy.ValidatePolicyMock.callArgs, &mm_params)
+	mmValidatePolicy.ValidatePolicyMock.mutex.Unlock()
+
+	for _, e := range mmValidatePolicy.ValidatePolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmValidatePolicy.ValidatePolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidatePolicy.ValidatePolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidatePolicy.ValidatePolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmValidatePolicy.ValidatePolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockValidatePolicyParams{ctx, condition}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmValidatePolicy.t.Errorf("AccessServiceMock.ValidatePolicy got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.condition != nil && !minimock.Equal(*mm_want_ptrs.condition, mm_got.condition) {
+				mmValidatePolicy.t.Errorf("AccessServiceMock.ValidatePolicy got unexpected parameter condition, want: %#v, got: %#v%s\n", *mm_want_ptrs.condition, mm_got.condition, minimock.Diff(*mm_want_ptrs.condition, mm_got.condition))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidatePolicy.t.Errorf("AccessServiceMock.ValidatePolicy got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidatePolicy.ValidatePolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidatePolicy.t.Fatal("No results are set for the AccessServiceMock.ValidatePolicy")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmValidatePolicy.funcValidatePolicy != nil {
+		return mmValidatePolicy.funcValidatePolicy(ctx, condition)
+	}
+	mmValidatePolicy.t.Fatalf("Unexpected call to AccessServiceMock.ValidatePolicy. %v %v", ctx, condition)
+	return
+}
+
+// ValidatePolicyAfterCounter returns a count of finished AccessServiceMock.ValidatePolicy invocations
+func (mmValidatePolicy *AccessServiceMock) ValidatePolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidatePolicy.afterValidatePolicyCounter)
+}
+
+// ValidatePolicyBeforeCounter returns a count of AccessServiceMock.ValidatePolicy invocations
+func (mmValidatePolicy *AccessServiceMock) ValidatePolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidatePolicy.beforeValidatePolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.ValidatePolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidatePolicy *mAccessServiceMockValidatePolicy) Calls() []*AccessServiceMockValidatePolicyParams {
+	mmValidatePolicy.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockValidatePolicyParams, len(mmValidatePolicy.callArgs))
+	copy(argCopy, mmValidatePolicy.callArgs)
+
+	mmValidatePolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidatePolicyDone returns true if the count of the ValidatePolicy invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockValidatePolicyDone() bool {
+	if m.ValidatePolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidatePolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidatePolicyMock.invocationsDone()
+}
+
+// MinimockValidatePolicyInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockValidatePolicyInspect() {
+	for _, e := range m.ValidatePolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.ValidatePolicy with params: %#v", *e.params)
+		}
+	}
+
+	afterValidatePolicyCounter := mm_atomic.LoadUint64(&m.afterValidatePolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidatePolicyMock.defaultExpectation != nil && afterValidatePolicyCounter < 1 {
+		if m.ValidatePolicyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.ValidatePolicy")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.ValidatePolicy with params: %#v", *m.ValidatePolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidatePolicy != nil && afterValidatePolicyCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.ValidatePolicy")
+	}
+
+	if !m.ValidatePolicyMock.invocationsDone() && afterValidatePolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.ValidatePolicy but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidatePolicyMock.expectedInvocations), afterValidatePolicyCounter)
+	}
+}
+
+type mAccessServiceMockWriteRelation struct {
+	optional           bool
+	mock               *AccessServiceMock
+	defaultExpectation *AccessServiceMockWriteRelationExpectation
+	expectations       []*AccessServiceMockWriteRelationExpectation
+
+	callArgs []*AccessServiceMockWriteRelationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessServiceMockWriteRelationExpectation specifies expectation struct of the AccessService.WriteRelation
+type AccessServiceMockWriteRelationExpectation struct {
+	mock      *AccessServiceMock
+	params    *AccessServiceMockWriteRelationParams
+	paramPtrs *AccessServiceMockWriteRelationParamPtrs
+	results   *AccessServiceMockWriteRelationResults
+	Counter   uint64
+}
+
+// AccessServiceMockWriteRelationParams contains parameters of the AccessService.WriteRelation
+type AccessServiceMockWriteRelationParams struct {
+	ctx   context.Context
+	tuple *model.RelationTuple
+}
+
+// AccessServiceMockWriteRelationParamPtrs contains pointers to parameters of the AccessService.WriteRelation
+type AccessServiceMockWriteRelationParamPtrs struct {
+	ctx   *context.Context
+	tuple **model.RelationTuple
+}
+
+// AccessServiceMockWriteRelationResults contains results of the AccessService.WriteRelation
+type AccessServiceMockWriteRelationResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWriteRelation *mAccessServiceMockWriteRelation) Optional() *mAccessServiceMockWriteRelation {
+	mmWriteRelation.optional = true
+	return mmWriteRelation
+}
+
+// Expect sets up expected params for AccessService.WriteRelation
+func (mmWriteRelation *mAccessServiceMockWriteRelation) Expect(ctx context.Context, tuple *model.RelationTuple) *mAccessServiceMockWriteRelation {
+	if mmWriteRelation.mock.funcWriteRelation != nil {
+		mmWriteRelation.mock.t.Fatalf("AccessServiceMock.WriteRelation mock is already set by Set")
+	}
+
+	if mmWriteRelation.defaultExpectation == nil {
+		mmWriteRelation.defaultExpectation = &AccessServiceMockWriteRelationExpectation{}
+	}
+
+	if mmWriteRelation.defaultExpectation.paramPtrs != nil {
+		mmWriteRelation.mock.t.Fatalf("AccessServiceMock.WriteRelation mock is already set by ExpectParams functions")
+	}
+
+	mmWriteRelation.defaultExpectation.params = &AccessServiceMockWriteRelationParams{ctx, tuple}
+	for _, e := range mmWriteRelation.expectations {
+		if minimock.Equal(e.params, mmWriteRelation.defaultExpectation.params) {
+			mmWriteRelation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWriteRelation.defaultExpectation.params)
+		}
+	}
+
+	return mmWriteRelation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessService.WriteRelation
+func (mmWriteRelation *mAccessServiceMockWriteRelation) ExpectCtxParam1(ctx context.Context) *mAccessServiceMockWriteRelation {
+	if mmWriteRelation.mock.funcWriteRelation != nil {
+		mmWriteRelation.mock.t.Fatalf("AccessServiceMock.WriteRelation mock is already set by Set")
+	}
+
+	if mmWriteRelation.defaultExpectation == nil {
+		mmWriteRelation.defaultExpectation = &AccessServiceMockWriteRelationExpectation{}
+	}
+
+	if mmWriteRelation.defaultExpectation.params != nil {
+		mmWriteRelation.mock.t.Fatalf("AccessServiceMock.WriteRelation mock is already set by Expect")
+	}
+
+	if mmWriteRelation.defaultExpectation.paramPtrs == nil {
+		mmWriteRelation.defaultExpectation.paramPtrs = &AccessServiceMockWriteRelationParamPtrs{}
+	}
+	mmWriteRelation.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmWriteRelation
+}
+
+// ExpectTupleParam2 sets up expected param tuple for AccessService.WriteRelation
+func (mmWriteRelation *mAccessServiceMockWriteRelation) ExpectTupleParam2(tuple *model.RelationTuple) *mAccessServiceMockWriteRelation {
+	if mmWriteRelation.mock.funcWriteRelation != nil {
+		mmWriteRelation.mock.t.Fatalf("AccessServiceMock.WriteRelation mock is already set by Set")
+	}
+
+	if mmWriteRelation.defaultExpectation == nil {
+		mmWriteRelation.defaultExpectation = &AccessServiceMockWriteRelationExpectation{}
+	}
+
+	if mmWriteRelation.defaultExpectation.params != nil {
+		mmWriteRelation.mock.t.Fatalf("AccessServiceMock.WriteRelation mock is already set by Expect")
+	}
+
+	if mmWriteRelation.defaultExpectation.paramPtrs == nil {
+		mmWriteRelation.defaultExpectation.paramPtrs = &AccessServiceMockWriteRelationParamPtrs{}
+	}
+	mmWriteRelation.defaultExpectation.paramPtrs.tuple = &tuple
+
+	return mmWriteRelation
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessService.WriteRelation
+func (mmWriteRelation *mAccessServiceMockWriteRelation) Inspect(f func(ctx context.Context, tuple *model.RelationTuple)) *mAccessServiceMockWriteRelation {
+	if mmWriteRelation.mock.inspectFuncWriteRelation != nil {
+		mmWriteRelation.mock.t.Fatalf("Inspect function is already set for AccessServiceMock.WriteRelation")
+	}
+
+	mmWriteRelation.mock.inspectFuncWriteRelation = f
+
+	return mmWriteRelation
+}
+
+// Return sets up results that will be returned by AccessService.WriteRelation
+func (mmWriteRelation *mAccessServiceMockWriteRelation) Return(err error) *AccessServiceMock {
+	if mmWriteRelation.mock.funcWriteRelation != nil {
+		mmWriteRelation.mock.t.Fatalf("AccessServiceMock.WriteRelation mock is already set by Set")
+	}
+
+	if mmWriteRelation.defaultExpectation == nil {
+		mmWriteRelation.defaultExpectation = &AccessServiceMockWriteRelationExpectation{mock: mmWriteRelation.mock}
+	}
+	mmWriteRelation.defaultExpectation.results = &AccessServiceMockWriteRelationResults{err}
+	return mmWriteRelation.mock
+}
+
+// Set uses given function f to mock the AccessService.WriteRelation method
+func (mmWriteRelation *mAccessServiceMockWriteRelation) Set(f func(ctx context.Context, tuple *model.RelationTuple) (err error)) *AccessServiceMock {
+	if mmWriteRelation.defaultExpectation != nil {
+		mmWriteRelation.mock.t.Fatalf("Default expectation is already set for the AccessService.WriteRelation method")
+	}
+
+	if len(mmWriteRelation.expectations) > 0 {
+		mmWriteRelation.mock.t.Fatalf("Some expectations are already set for the AccessService.WriteRelation method")
+	}
+
+	mmWriteRelation.mock.funcWriteRelation = f
+	return mmWriteRelation.mock
+}
+
+// When sets expectation for the AccessService.WriteRelation which will trigger the result defined by the following
+// Then helper
+func (mmWriteRelation *mAccessServiceMockWriteRelation) When(ctx context.Context, tuple *model.RelationTuple) *AccessServiceMockWriteRelationExpectation {
+	if mmWriteRelation.mock.funcWriteRelation != nil {
+		mmWriteRelation.mock.t.Fatalf("AccessServiceMock.WriteRelation mock is already set by Set")
+	}
+
+	expectation := &AccessServiceMockWriteRelationExpectation{
+		mock:   mmWriteRelation.mock,
+		params: &AccessServiceMockWriteRelationParams{ctx, tuple},
+	}
+	mmWriteRelation.expectations = append(mmWriteRelation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessService.WriteRelation return parameters for the expectation previously defined by the When method
+func (e *AccessServiceMockWriteRelationExpectation) Then(err error) *AccessServiceMock {
+	e.results = &AccessServiceMockWriteRelationResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessService.WriteRelation should be invoked
+func (mmWriteRelation *mAccessServiceMockWriteRelation) Times(n uint64) *mAccessServiceMockWriteRelation {
+	if n == 0 {
+		mmWriteRelation.mock.t.Fatalf("Times of AccessServiceMock.WriteRelation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWriteRelation.expectedInvocations, n)
+	return mmWriteRelation
+}
+
+func (mmWriteRelation *mAccessServiceMockWriteRelation) invocationsDone() bool {
+	if len(mmWriteRelation.expectations) == 0 && mmWriteRelation.defaultExpectation == nil && mmWriteRelation.mock.funcWriteRelation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWriteRelation.mock.afterWriteRelationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWriteRelation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// WriteRelation implements service.AccessService
+func (mmWriteRelation *AccessServiceMock) WriteRelation(ctx context.Context, tuple *model.RelationTuple) (err error) {
+	mm_atomic.AddUint64(&mmWriteRelation.beforeWriteRelationCounter, 1)
+	defer mm_atomic.AddUint64(&mmWriteRelation.afterWriteRelationCounter, 1)
+
+	if mmWriteRelation.inspectFuncWriteRelation != nil {
+		mmWriteRelation.inspectFuncWriteRelation(ctx, tuple)
+	}
+
+	mm_params := AccessServiceMockWriteRelationParams{ctx, tuple}
+
+	// Record call args
+	mmWriteRelation.WriteRelationMock.mutex.Lock()
+	mmWriteRelation.WriteRelationMock.callArgs = append(mmWriteRelation.WriteRelationMock.callArgs, &mm_params)
+	mmWriteRelation.WriteRelationMock.mutex.Unlock()
+
+	for _, e := range mmWriteRelation.WriteRelationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmWriteRelation.WriteRelationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWriteRelation.WriteRelationMock.defaultExpectation.Counter, 1)
+		mm_want := mmWriteRelation.WriteRelationMock.defaultExpectation.params
+		mm_want_ptrs := mmWriteRelation.WriteRelationMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceMockWriteRelationParams{ctx, tuple}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWriteRelation.t.Errorf("AccessServiceMock.WriteRelation got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tuple != nil && !minimock.Equal(*mm_want_ptrs.tuple, mm_got.tuple) {
+				mmWriteRelation.t.Errorf("AccessServiceMock.WriteRelation got unexpected parameter tuple, want: %#v, got: %#v%s\n", *mm_want_ptrs.tuple, mm_got.tuple, minimock.Diff(*mm_want_ptrs.tuple, mm_got.tuple))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWriteRelation.t.Errorf("AccessServiceMock.WriteRelation got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWriteRelation.WriteRelationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWriteRelation.t.Fatal("No results are set for the AccessServiceMock.WriteRelation")
+		}
+		return (*mm_results).err
+	}
+	if mmWriteRelation.funcWriteRelation != nil {
+		return mmWriteRelation.funcWriteRelation(ctx, tuple)
+	}
+	mmWriteRelation.t.Fatalf("Unexpected call to AccessServiceMock.WriteRelation. %v %v", ctx, tuple)
+	return
+}
+
+// WriteRelationAfterCounter returns a count of finished AccessServiceMock.WriteRelation invocations
+func (mmWriteRelation *AccessServiceMock) WriteRelationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWriteRelation.afterWriteRelationCounter)
+}
+
+// WriteRelationBeforeCounter returns a count of AccessServiceMock.WriteRelation invocations
+func (mmWriteRelation *AccessServiceMock) WriteRelationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWriteRelation.beforeWriteRelationCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceMock.WriteRelation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWriteRelation *mAccessServiceMockWriteRelation) Calls() []*AccessServiceMockWriteRelationParams {
+	mmWriteRelation.mutex.RLock()
+
+	argCopy := make([]*AccessServiceMockWriteRelationParams, len(mmWriteRelation.callArgs))
+	copy(argCopy, mmWriteRelation.callArgs)
+
+	mmWriteRelation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWriteRelationDone returns true if the count of the WriteRelation invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceMock) MinimockWriteRelationDone() bool {
+	if m.WriteRelationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WriteRelationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WriteRelationMock.invocationsDone()
+}
+
+// MinimockWriteRelationInspect logs each unmet expectation
+func (m *AccessServiceMock) MinimockWriteRelationInspect() {
+	for _, e := range m.WriteRelationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceMock.WriteRelation with params: %#v", *e.params)
+		}
+	}
+
+	afterWriteRelationCounter := mm_atomic.LoadUint64(&m.afterWriteRelationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WriteRelationMock.defaultExpectation != nil && afterWriteRelationCounter < 1 {
+		if m.WriteRelationMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessServiceMock.WriteRelation")
+		} else {
+			m.t.Errorf("Expected call to AccessServiceMock.WriteRelation with params: %#v", *m.WriteRelationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWriteRelation != nil && afterWriteRelationCounter < 1 {
+		m.t.Error("Expected call to AccessServiceMock.WriteRelation")
+	}
+
+	if !m.WriteRelationMock.invocationsDone() && afterWriteRelationCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceMock.WriteRelation but found %d calls",
+			mm_atomic.LoadUint64(&m.WriteRelationMock.expectedInvocations), afterWriteRelationCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AccessServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddGroupMemberInspect()
+
+			m.MinimockApplyPolicyInspect()
+
+			m.MinimockApproveElevationInspect()
+
+			m.MinimockAssignGroupRoleInspect()
+
+			m.MinimockAssignUserRoleInspect()
+
+			m.MinimockBatchCheckInspect()
+
+			m.MinimockCheckInspect()
+
+			m.MinimockCheckRelationInspect()
+
+			m.MinimockCheckResourceInspect()
+
+			m.MinimockCreateGroupInspect()
+
+			m.MinimockCreatePermissionInspect()
+
+			m.MinimockCreateRoleInspect()
+
+			m.MinimockDeleteGroupInspect()
+
+			m.MinimockDeletePermissionInspect()
+
+			m.MinimockDeleteRelationInspect()
+
+			m.MinimockDeleteRoleInspect()
+
+			m.MinimockExplainInspect()
+
+			m.MinimockExportPolicyInspect()
+
+			m.MinimockGetRoleInspect()
+
+			m.MinimockGetUserRolesInspect()
+
+			m.MinimockListDecisionsInspect()
+
+			m.MinimockListGroupsInspect()
+
+			m.MinimockListPermissionsInspect()
+
+			m.MinimockListRelationsInspect()
+
+			m.MinimockListRolesInspect()
+
+			m.MinimockRemoveGroupMemberInspect()
+
+			m.MinimockRequestElevationInspect()
+
+			m.MinimockRevokeGroupRoleInspect()
+
+			m.MinimockRevokeUserRoleInspect()
+
+			m.MinimockSimulateInspect()
+
+			m.MinimockUpdateRoleInspect()
+
+			m.MinimockValidatePolicyInspect()
+
+			m.MinimockWriteRelationInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AccessServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AccessServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddGroupMemberDone() &&
+		m.MinimockApplyPolicyDone() &&
+		m.MinimockApproveElevationDone() &&
+		m.MinimockAssignGroupRoleDone() &&
+		m.MinimockAssignUserRoleDone() &&
+		m.MinimockBatchCheckDone() &&
+		m.MinimockCheckDone() &&
+		m.MinimockCheckRelationDone() &&
+		m.MinimockCheckResourceDone() &&
+		m.MinimockCreateGroupDone() &&
+		m.MinimockCreatePermissionDone() &&
+		m.MinimockCreateRoleDone() &&
+		m.MinimockDeleteGroupDone() &&
+		m.MinimockDeletePermissionDone() &&
+		m.MinimockDeleteRelationDone() &&
+		m.MinimockDeleteRoleDone() &&
+		m.MinimockExplainDone() &&
+		m.MinimockExportPolicyDone() &&
+		m.MinimockGetRoleDone() &&
+		m.MinimockGetUserRolesDone() &&
+		m.MinimockListDecisionsDone() &&
+		m.MinimockListGroupsDone() &&
+		m.MinimockListPermissionsDone() &&
+		m.MinimockListRelationsDone() &&
+		m.MinimockListRolesDone() &&
+		m.MinimockRemoveGroupMemberDone() &&
+		m.MinimockRequestElevationDone() &&
+		m.MinimockRevokeGroupRoleDone() &&
+		m.MinimockRevokeUserRoleDone() &&
+		m.MinimockSimulateDone() &&
+		m.MinimockUpdateRoleDone() &&
+		m.MinimockValidatePolicyDone() &&
+		m.MinimockWriteRelationDone()
+}
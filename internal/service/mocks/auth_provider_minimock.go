@@ -0,0 +1,613 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/auth/internal/service.AuthProvider -o auth_provider_minimock.go -n AuthProviderMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// AuthProviderMock implements service.AuthProvider
+type AuthProviderMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAuthenticate          func(ctx context.Context, username string, password string) (up1 *model.User, err error)
+	inspectFuncAuthenticate   func(ctx context.Context, username string, password string)
+	afterAuthenticateCounter  uint64
+	beforeAuthenticateCounter uint64
+	AuthenticateMock          mAuthProviderMockAuthenticate
+
+	funcName          func() (s1 string)
+	inspectFuncName   func()
+	afterNameCounter  uint64
+	beforeNameCounter uint64
+	NameMock          mAuthProviderMockName
+}
+
+// NewAuthProviderMock returns a mock for service.AuthProvider
+func NewAuthProviderMock(t minimock.Tester) *AuthProviderMock {
+	m := &AuthProviderMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AuthenticateMock = mAuthProviderMockAuthenticate{mock: m}
+	m.AuthenticateMock.callArgs = []*AuthProviderMockAuthenticateParams{}
+
+	m.NameMock = mAuthProviderMockName{mock: m}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuthProviderMockAuthenticate struct {
+	optional           bool
+	mock               *AuthProviderMock
+	defaultExpectation *AuthProviderMockAuthenticateExpectation
+	expectations       []*AuthProviderMockAuthenticateExpectation
+
+	callArgs []*AuthProviderMockAuthenticateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuthProviderMockAuthenticateExpectation specifies expectation struct of the AuthProvider.Authenticate
+type AuthProviderMockAuthenticateExpectation struct {
+	mock      *AuthProviderMock
+	params    *AuthProviderMockAuthenticateParams
+	paramPtrs *AuthProviderMockAuthenticateParamPtrs
+	results   *AuthProviderMockAuthenticateResults
+	Counter   uint64
+}
+
+// AuthProviderMockAuthenticateParams contains parameters of the AuthProvider.Authenticate
+type AuthProviderMockAuthenticateParams struct {
+	ctx      context.Context
+	username string
+	password string
+}
+
+// AuthProviderMockAuthenticateParamPtrs contains pointers to parameters of the AuthProvider.Authenticate
+type AuthProviderMockAuthenticateParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	password *string
+}
+
+// AuthProviderMockAuthenticateResults contains results of the AuthProvider.Authenticate
+type AuthProviderMockAuthenticateResults struct {
+	up1 *model.User
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAuthenticate *mAuthProviderMockAuthenticate) Optional() *mAuthProviderMockAuthenticate {
+	mmAuthenticate.optional = true
+	return mmAuthenticate
+}
+
+// Expect sets up expected params for AuthProvider.Authenticate
+func (mmAuthenticate *mAuthProviderMockAuthenticate) Expect(ctx context.Context, username string, password string) *mAuthProviderMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthProviderMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &AuthProviderMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthProviderMock.Authenticate mock is already set by ExpectParams functions")
+	}
+
+	mmAuthenticate.defaultExpectation.params = &AuthProviderMockAuthenticateParams{ctx, username, password}
+	for _, e := range mmAuthenticate.expectations {
+		if minimock.Equal(e.params, mmAuthenticate.defaultExpectation.params) {
+			mmAuthenticate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAuthenticate.defaultExpectation.params)
+		}
+	}
+
+	return mmAuthenticate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthProvider.Authenticate
+func (mmAuthenticate *mAuthProviderMockAuthenticate) ExpectCtxParam1(ctx context.Context) *mAuthProviderMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthProviderMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &AuthProviderMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthProviderMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &AuthProviderMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAuthenticate
+}
+
+// ExpectUsernameParam2 sets up expected param username for AuthProvider.Authenticate
+func (mmAuthenticate *mAuthProviderMockAuthenticate) ExpectUsernameParam2(username string) *mAuthProviderMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthProviderMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &AuthProviderMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthProviderMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &AuthProviderMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.username = &username
+
+	return mmAuthenticate
+}
+
+// ExpectPasswordParam3 sets up expected param password for AuthProvider.Authenticate
+func (mmAuthenticate *mAuthProviderMockAuthenticate) ExpectPasswordParam3(password string) *mAuthProviderMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthProviderMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &AuthProviderMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthProviderMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &AuthProviderMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.password = &password
+
+	return mmAuthenticate
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthProvider.Authenticate
+func (mmAuthenticate *mAuthProviderMockAuthenticate) Inspect(f func(ctx context.Context, username string, password string)) *mAuthProviderMockAuthenticate {
+	if mmAuthenticate.mock.inspectFuncAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("Inspect function is already set for AuthProviderMock.Authenticate")
+	}
+
+	mmAuthenticate.mock.inspectFuncAuthenticate = f
+
+	return mmAuthenticate
+}
+
+// Return sets up results that will be returned by AuthProvider.Authenticate
+func (mmAuthenticate *mAuthProviderMockAuthenticate) Return(up1 *model.User, err error) *AuthProviderMock {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthProviderMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &AuthProviderMockAuthenticateExpectation{mock: mmAuthenticate.mock}
+	}
+	mmAuthenticate.defaultExpectation.results = &AuthProviderMockAuthenticateResults{up1, err}
+	return mmAuthenticate.mock
+}
+
+// Set uses given function f to mock the AuthProvider.Authenticate method
+func (mmAuthenticate *mAuthProviderMockAuthenticate) Set(f func(ctx context.Context, username string, password string) (up1 *model.User, err error)) *AuthProviderMock {
+	if mmAuthenticate.defaultExpectation != nil {
+		mmAuthenticate.mock.t.Fatalf("Default expectation is already set for the AuthProvider.Authenticate method")
+	}
+
+	if len(mmAuthenticate.expectations) > 0 {
+		mmAuthenticate.mock.t.Fatalf("Some expectations are already set for the AuthProvider.Authenticate method")
+	}
+
+	mmAuthenticate.mock.funcAuthenticate = f
+	return mmAuthenticate.mock
+}
+
+// When sets expectation for the AuthProvider.Authenticate which will trigger the result defined by the following
+// Then helper
+func (mmAuthenticate *mAuthProviderMockAuthenticate) When(ctx context.Context, username string, password string) *AuthProviderMockAuthenticateExpectation {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("AuthProviderMock.Authenticate mock is already set by Set")
+	}
+
+	expectation := &AuthProviderMockAuthenticateExpectation{
+		mock:   mmAuthenticate.mock,
+		params: &AuthProviderMockAuthenticateParams{ctx, username, password},
+	}
+	mmAuthenticate.expectations = append(mmAuthenticate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthProvider.Authenticate return parameters for the expectation previously defined by the When method
+func (e *AuthProviderMockAuthenticateExpectation) Then(up1 *model.User, err error) *AuthProviderMock {
+	e.results = &AuthProviderMockAuthenticateResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthProvider.Authenticate should be invoked
+func (mmAuthenticate *mAuthProviderMockAuthenticate) Times(n uint64) *mAuthProviderMockAuthenticate {
+	if n == 0 {
+		mmAuthenticate.mock.t.Fatalf("Times of AuthProviderMock.Authenticate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAuthenticate.expectedInvocations, n)
+	return mmAuthenticate
+}
+
+func (mmAuthenticate *mAuthProviderMockAuthenticate) invocationsDone() bool {
+	if len(mmAuthenticate.expectations) == 0 && mmAuthenticate.defaultExpectation == nil && mmAuthenticate.mock.funcAuthenticate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAuthenticate.mock.afterAuthenticateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAuthenticate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Authenticate implements service.AuthProvider
+func (mmAuthenticate *AuthProviderMock) Authenticate(ctx context.Context, username string, password string) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmAuthenticate.beforeAuthenticateCounter, 1)
+	defer mm_atomic.AddUint64(&mmAuthenticate.afterAuthenticateCounter, 1)
+
+	if mmAuthenticate.inspectFuncAuthenticate != nil {
+		mmAuthenticate.inspectFuncAuthenticate(ctx, username, password)
+	}
+
+	mm_params := AuthProviderMockAuthenticateParams{ctx, username, password}
+
+	// Record call args
+	mmAuthenticate.AuthenticateMock.mutex.Lock()
+	mmAuthenticate.AuthenticateMock.callArgs = append(mmAuthenticate.AuthenticateMock.callArgs, &mm_params)
+	mmAuthenticate.AuthenticateMock.mutex.Unlock()
+
+	for _, e := range mmAuthenticate.AuthenticateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmAuthenticate.AuthenticateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAuthenticate.AuthenticateMock.defaultExpectation.Counter, 1)
+		mm_want := mmAuthenticate.AuthenticateMock.defaultExpectation.params
+		mm_want_ptrs := mmAuthenticate.AuthenticateMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthProviderMockAuthenticateParams{ctx, username, password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAuthenticate.t.Errorf("AuthProviderMock.Authenticate got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmAuthenticate.t.Errorf("AuthProviderMock.Authenticate got unexpected parameter username, want: %#v, got: %#v%s\n", *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmAuthenticate.t.Errorf("AuthProviderMock.Authenticate got unexpected parameter password, want: %#v, got: %#v%s\n", *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAuthenticate.t.Errorf("AuthProviderMock.Authenticate got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAuthenticate.AuthenticateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAuthenticate.t.Fatal("No results are set for the AuthProviderMock.Authenticate")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmAuthenticate.funcAuthenticate != nil {
+		return mmAuthenticate.funcAuthenticate(ctx, username, password)
+	}
+	mmAuthenticate.t.Fatalf("Unexpected call to AuthProviderMock.Authenticate. %v %v %v", ctx, username, password)
+	return
+}
+
+// AuthenticateAfterCounter returns a count of finished AuthProviderMock.Authenticate invocations
+func (mmAuthenticate *AuthProviderMock) AuthenticateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticate.afterAuthenticateCounter)
+}
+
+// AuthenticateBeforeCounter returns a count of AuthProviderMock.Authenticate invocations
+func (mmAuthenticate *AuthProviderMock) AuthenticateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticate.beforeAuthenticateCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthProviderMock.Authenticate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAuthenticate *mAuthProviderMockAuthenticate) Calls() []*AuthProviderMockAuthenticateParams {
+	mmAuthenticate.mutex.RLock()
+
+	argCopy := make([]*AuthProviderMockAuthenticateParams, len(mmAuthenticate.callArgs))
+	copy(argCopy, mmAuthenticate.callArgs)
+
+	mmAuthenticate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAuthenticateDone returns true if the count of the Authenticate invocations corresponds
+// the number of defined expectations
+func (m *AuthProviderMock) MinimockAuthenticateDone() bool {
+	if m.AuthenticateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AuthenticateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AuthenticateMock.invocationsDone()
+}
+
+// MinimockAuthenticateInspect logs each unmet expectation
+func (m *AuthProviderMock) MinimockAuthenticateInspect() {
+	for _, e := range m.AuthenticateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthProviderMock.Authenticate with params: %#v", *e.params)
+		}
+	}
+
+	afterAuthenticateCounter := mm_atomic.LoadUint64(&m.afterAuthenticateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AuthenticateMock.defaultExpectation != nil && afterAuthenticateCounter < 1 {
+		if m.AuthenticateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuthProviderMock.Authenticate")
+		} else {
+			m.t.Errorf("Expected call to AuthProviderMock.Authenticate with params: %#v", *m.AuthenticateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAuthenticate != nil && afterAuthenticateCounter < 1 {
+		m.t.Error("Expected call to AuthProviderMock.Authenticate")
+	}
+
+	if !m.AuthenticateMock.invocationsDone() && afterAuthenticateCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthProviderMock.Authenticate but found %d calls",
+			mm_atomic.LoadUint64(&m.AuthenticateMock.expectedInvocations), afterAuthenticateCounter)
+	}
+}
+
+type mAuthProviderMockName struct {
+	optional           bool
+	mock               *AuthProviderMock
+	defaultExpectation *AuthProviderMockNameExpectation
+	expectations       []*AuthProviderMockNameExpectation
+
+	expectedInvocations uint64
+}
+
+// AuthProviderMockNameExpectation specifies expectation struct of the AuthProvider.Name
+type AuthProviderMockNameExpectation struct {
+	mock *AuthProviderMock
+
+	results *AuthProviderMockNameResults
+	Counter uint64
+}
+
+// AuthProviderMockNameResults contains results of the AuthProvider.Name
+type AuthProviderMockNameResults struct {
+	s1 string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmName *mAuthProviderMockName) Optional() *mAuthProviderMockName {
+	mmName.optional = true
+	return mmName
+}
+
+// Expect sets up expected params for AuthProvider.Name
+func (mmName *mAuthProviderMockName) Expect() *mAuthProviderMockName {
+	if mmName.mock.funcName != nil {
+		mmName.mock.t.Fatalf("AuthProviderMock.Name mock is already set by Set")
+	}
+
+	if mmName.defaultExpectation == nil {
+		mmName.defaultExpectation = &AuthProviderMockNameExpectation{}
+	}
+
+	return mmName
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthProvider.Name
+func (mmName *mAuthProviderMockName) Inspect(f func()) *mAuthProviderMockName {
+	if mmName.mock.inspectFuncName != nil {
+		mmName.mock.t.Fatalf("Inspect function is already set for AuthProviderMock.Name")
+	}
+
+	mmName.mock.inspectFuncName = f
+
+	return mmName
+}
+
+// Return sets up results that will be returned by AuthProvider.Name
+func (mmName *mAuthProviderMockName) Return(s1 string) *AuthProviderMock {
+	if mmName.mock.funcName != nil {
+		mmName.mock.t.Fatalf("AuthProviderMock.Name mock is already set by Set")
+	}
+
+	if mmName.defaultExpectation == nil {
+		mmName.defaultExpectation = &AuthProviderMockNameExpectation{mock: mmName.mock}
+	}
+	mmName.defaultExpectation.results = &AuthProviderMockNameResults{s1}
+	return mmName.mock
+}
+
+// Set uses given function f to mock the AuthProvider.Name method
+func (mmName *mAuthProviderMockName) Set(f func() (s1 string)) *AuthProviderMock {
+	if mmName.defaultExpectation != nil {
+		mmName.mock.t.Fatalf("Default expectation is already set for the AuthProvider.Name method")
+	}
+
+	if len(mmName.expectations) > 0 {
+		mmName.mock.t.Fatalf("Some expectations are already set for the AuthProvider.Name method")
+	}
+
+	mmName.mock.funcName = f
+	return mmName.mock
+}
+
+// Times sets number of times AuthProvider.Name should be invoked
+func (mmName *mAuthProviderMockName) Times(n uint64) *mAuthProviderMockName {
+	if n == 0 {
+		mmName.mock.t.Fatalf("Times of AuthProviderMock.Name mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmName.expectedInvocations, n)
+	return mmName
+}
+
+func (mmName *mAuthProviderMockName) invocationsDone() bool {
+	if len(mmName.expectations) == 0 && mmName.defaultExpectation == nil && mmName.mock.funcName == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmName.mock.afterNameCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmName.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Name implements service.AuthProvider
+func (mmName *AuthProviderMock) Name() (s1 string) {
+	mm_atomic.AddUint64(&mmName.beforeNameCounter, 1)
+	defer mm_atomic.AddUint64(&mmName.afterNameCounter, 1)
+
+	if mmName.inspectFuncName != nil {
+		mmName.inspectFuncName()
+	}
+
+	if mmName.NameMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmName.NameMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmName.NameMock.defaultExpectation.results
+		if mm_results == nil {
+			mmName.t.Fatal("No results are set for the AuthProviderMock.Name")
+		}
+		return (*mm_results).s1
+	}
+	if mmName.funcName != nil {
+		return mmName.funcName()
+	}
+	mmName.t.Fatalf("Unexpected call to AuthProviderMock.Name.")
+	return
+}
+
+// NameAfterCounter returns a count of finished AuthProviderMock.Name invocations
+func (mmName *AuthProviderMock) NameAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmName.afterNameCounter)
+}
+
+// NameBeforeCounter returns a count of AuthProviderMock.Name invocations
+func (mmName *AuthProviderMock) NameBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmName.beforeNameCounter)
+}
+
+// MinimockNameDone returns true if the count of the Name invocations corresponds
+// the number of defined expectations
+func (m *AuthProviderMock) MinimockNameDone() bool {
+	if m.NameMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.NameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.NameMock.invocationsDone()
+}
+
+// MinimockNameInspect logs each unmet expectation
+func (m *AuthProviderMock) MinimockNameInspect() {
+	for _, e := range m.NameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to AuthProviderMock.Name")
+		}
+	}
+
+	afterNameCounter := mm_atomic.LoadUint64(&m.afterNameCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.NameMock.defaultExpectation != nil && afterNameCounter < 1 {
+		m.t.Error("Expected call to AuthProviderMock.Name")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcName != nil && afterNameCounter < 1 {
+		m.t.Error("Expected call to AuthProviderMock.Name")
+	}
+
+	if !m.NameMock.invocationsDone() && afterNameCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthProviderMock.Name but found %d calls",
+			mm_atomic.LoadUint64(&m.NameMock.expectedInvocations), afterNameCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuthProviderMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAuthenticateInspect()
+
+			m.MinimockNameInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuthProviderMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuthProviderMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAuthenticateDone() &&
+		m.MinimockNameDone()
+}
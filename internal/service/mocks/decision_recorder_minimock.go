@@ -0,0 +1,670 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/auth/internal/service.DecisionRecorder -o decision_recorder_minimock.go -n DecisionRecorderMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// DecisionRecorderMock implements service.DecisionRecorder
+type DecisionRecorderMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcRecord          func(ctx context.Context, event *model.DecisionEvent)
+	inspectFuncRecord   func(ctx context.Context, event *model.DecisionEvent)
+	afterRecordCounter  uint64
+	beforeRecordCounter uint64
+	RecordMock          mDecisionRecorderMockRecord
+
+	funcRunRecorder          func(ctx context.Context) (err error)
+	inspectFuncRunRecorder   func(ctx context.Context)
+	afterRunRecorderCounter  uint64
+	beforeRunRecorderCounter uint64
+	RunRecorderMock          mDecisionRecorderMockRunRecorder
+}
+
+// NewDecisionRecorderMock returns a mock for service.DecisionRecorder
+func NewDecisionRecorderMock(t minimock.Tester) *DecisionRecorderMock {
+	m := &DecisionRecorderMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.RecordMock = mDecisionRecorderMockRecord{mock: m}
+	m.RecordMock.callArgs = []*DecisionRecorderMockRecordParams{}
+
+	m.RunRecorderMock = mDecisionRecorderMockRunRecorder{mock: m}
+	m.RunRecorderMock.callArgs = []*DecisionRecorderMockRunRecorderParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mDecisionRecorderMockRecord struct {
+	optional           bool
+	mock               *DecisionRecorderMock
+	defaultExpectation *DecisionRecorderMockRecordExpectation
+	expectations       []*DecisionRecorderMockRecordExpectation
+
+	callArgs []*DecisionRecorderMockRecordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// DecisionRecorderMockRecordExpectation specifies expectation struct of the DecisionRecorder.Record
+type DecisionRecorderMockRecordExpectation struct {
+	mock      *DecisionRecorderMock
+	params    *DecisionRecorderMockRecordParams
+	paramPtrs *DecisionRecorderMockRecordParamPtrs
+
+	Counter uint64
+}
+
+// DecisionRecorderMockRecordParams contains parameters of the DecisionRecorder.Record
+type DecisionRecorderMockRecordParams struct {
+	ctx   context.Context
+	event *model.DecisionEvent
+}
+
+// DecisionRecorderMockRecordParamPtrs contains pointers to parameters of the DecisionRecorder.Record
+type DecisionRecorderMockRecordParamPtrs struct {
+	ctx   *context.Context
+	event **model.DecisionEvent
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecord *mDecisionRecorderMockRecord) Optional() *mDecisionRecorderMockRecord {
+	mmRecord.optional = true
+	return mmRecord
+}
+
+// Expect sets up expected params for DecisionRecorder.Record
+func (mmRecord *mDecisionRecorderMockRecord) Expect(ctx context.Context, event *model.DecisionEvent) *mDecisionRecorderMockRecord {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("DecisionRecorderMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &DecisionRecorderMockRecordExpectation{}
+	}
+
+	if mmRecord.defaultExpectation.paramPtrs != nil {
+		mmRecord.mock.t.Fatalf("DecisionRecorderMock.Record mock is already set by ExpectParams functions")
+	}
+
+	mmRecord.defaultExpectation.params = &DecisionRecorderMockRecordParams{ctx, event}
+	for _, e := range mmRecord.expectations {
+		if minimock.Equal(e.params, mmRecord.defaultExpectation.params) {
+			mmRecord.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecord.defaultExpectation.params)
+		}
+	}
+
+	return mmRecord
+}
+
+// ExpectCtxParam1 sets up expected param ctx for DecisionRecorder.Record
+func (mmRecord *mDecisionRecorderMockRecord) ExpectCtxParam1(ctx context.Context) *mDecisionRecorderMockRecord {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("DecisionRecorderMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &DecisionRecorderMockRecordExpectation{}
+	}
+
+	if mmRecord.defaultExpectation.params != nil {
+		mmRecord.mock.t.Fatalf("DecisionRecorderMock.Record mock is already set by Expect")
+	}
+
+	if mmRecord.defaultExpectation.paramPtrs == nil {
+		mmRecord.defaultExpectation.paramPtrs = &DecisionRecorderMockRecordParamPtrs{}
+	}
+	mmRecord.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRecord
+}
+
+// ExpectEventParam2 sets up expected param event for DecisionRecorder.Record
+func (mmRecord *mDecisionRecorderMockRecord) ExpectEventParam2(event *model.DecisionEvent) *mDecisionRecorderMockRecord {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("DecisionRecorderMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &DecisionRecorderMockRecordExpectation{}
+	}
+
+	if mmRecord.defaultExpectation.params != nil {
+		mmRecord.mock.t.Fatalf("DecisionRecorderMock.Record mock is already set by Expect")
+	}
+
+	if mmRecord.defaultExpectation.paramPtrs == nil {
+		mmRecord.defaultExpectation.paramPtrs = &DecisionRecorderMockRecordParamPtrs{}
+	}
+	mmRecord.defaultExpectation.paramPtrs.event = &event
+
+	return mmRecord
+}
+
+// Inspect accepts an inspector function that has same arguments as the DecisionRecorder.Record
+func (mmRecord *mDecisionRecorderMockRecord) Inspect(f func(ctx context.Context, event *model.DecisionEvent)) *mDecisionRecorderMockRecord {
+	if mmRecord.mock.inspectFuncRecord != nil {
+		mmRecord.mock.t.Fatalf("Inspect function is already set for DecisionRecorderMock.Record")
+	}
+
+	mmRecord.mock.inspectFuncRecord = f
+
+	return mmRecord
+}
+
+// Return sets up results that will be returned by DecisionRecorder.Record
+func (mmRecord *mDecisionRecorderMockRecord) Return() *DecisionRecorderMock {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("DecisionRecorderMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &DecisionRecorderMockRecordExpectation{mock: mmRecord.mock}
+	}
+
+	return mmRecord.mock
+}
+
+// Set uses given function f to mock the DecisionRecorder.Record method
+func (mmRecord *mDecisionRecorderMockRecord) Set(f func(ctx context.Context, event *model.DecisionEvent)) *DecisionRecorderMock {
+	if mmRecord.defaultExpectation != nil {
+		mmRecord.mock.t.Fatalf("Default expectation is already set for the DecisionRecorder.Record method")
+	}
+
+	if len(mmRecord.expectations) > 0 {
+		mmRecord.mock.t.Fatalf("Some expectations are already set for the DecisionRecorder.Record method")
+	}
+
+	mmRecord.mock.funcRecord = f
+	return mmRecord.mock
+}
+
+// Times sets number of times DecisionRecorder.Record should be invoked
+func (mmRecord *mDecisionRecorderMockRecord) Times(n uint64) *mDecisionRecorderMockRecord {
+	if n == 0 {
+		mmRecord.mock.t.Fatalf("Times of DecisionRecorderMock.Record mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecord.expectedInvocations, n)
+	return mmRecord
+}
+
+func (mmRecord *mDecisionRecorderMockRecord) invocationsDone() bool {
+	if len(mmRecord.expectations) == 0 && mmRecord.defaultExpectation == nil && mmRecord.mock.funcRecord == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecord.mock.afterRecordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecord.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Record implements service.DecisionRecorder
+func (mmRecord *DecisionRecorderMock) Record(ctx context.Context, event *model.DecisionEvent) {
+	mm_atomic.AddUint64(&mmRecord.beforeRecordCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecord.afterRecordCounter, 1)
+
+	if mmRecord.inspectFuncRecord != nil {
+		mmRecord.inspectFuncRecord(ctx, event)
+	}
+
+	mm_params := DecisionRecorderMockRecordParams{ctx, event}
+
+	// Record call args
+	mmRecord.RecordMock.mutex.Lock()
+	mmRecord.RecordMock.callArgs = append(mmRecord.RecordMock.callArgs, &mm_params)
+	mmRecord.RecordMock.mutex.Unlock()
+
+	for _, e := range mmRecord.RecordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmRecord.RecordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecord.RecordMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecord.RecordMock.defaultExpectation.params
+		mm_want_ptrs := mmRecord.RecordMock.defaultExpectation.paramPtrs
+
+		mm_got := DecisionRecorderMockRecordParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecord.t.Errorf("DecisionRecorderMock.Record got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmRecord.t.Errorf("DecisionRecorderMock.Record got unexpected parameter event, want: %#v, got: %#v%s\n", *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecord.t.Errorf("DecisionRecorderMock.Record got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmRecord.funcRecord != nil {
+		mmRecord.funcRecord(ctx, event)
+		return
+	}
+	mmRecord.t.Fatalf("Unexpected call to DecisionRecorderMock.Record. %v %v", ctx, event)
+
+}
+
+// RecordAfterCounter returns a count of finished DecisionRecorderMock.Record invocations
+func (mmRecord *DecisionRecorderMock) RecordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecord.afterRecordCounter)
+}
+
+// RecordBeforeCounter returns a count of DecisionRecorderMock.Record invocations
+func (mmRecord *DecisionRecorderMock) RecordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecord.beforeRecordCounter)
+}
+
+// Calls returns a list of arguments used in each call to DecisionRecorderMock.Record.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecord *mDecisionRecorderMockRecord) Calls() []*DecisionRecorderMockRecordParams {
+	mmRecord.mutex.RLock()
+
+	argCopy := make([]*DecisionRecorderMockRecordParams, len(mmRecord.callArgs))
+	copy(argCopy, mmRecord.callArgs)
+
+	mmRecord.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordDone returns true if the count of the Record invocations corresponds
+// the number of defined expectations
+func (m *DecisionRecorderMock) MinimockRecordDone() bool {
+	if m.RecordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordMock.invocationsDone()
+}
+
+// MinimockRecordInspect logs each unmet expectation
+func (m *DecisionRecorderMock) MinimockRecordInspect() {
+	for _, e := range m.RecordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DecisionRecorderMock.Record with params: %#v", *e.params)
+		}
+	}
+
+	afterRecordCounter := mm_atomic.LoadUint64(&m.afterRecordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordMock.defaultExpectation != nil && afterRecordCounter < 1 {
+		if m.RecordMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DecisionRecorderMock.Record")
+		} else {
+			m.t.Errorf("Expected call to DecisionRecorderMock.Record with params: %#v", *m.RecordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecord != nil && afterRecordCounter < 1 {
+		m.t.Error("Expected call to DecisionRecorderMock.Record")
+	}
+
+	if !m.RecordMock.invocationsDone() && afterRecordCounter > 0 {
+		m.t.Errorf("Expected %d calls to DecisionRecorderMock.Record but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordMock.expectedInvocations), afterRecordCounter)
+	}
+}
+
+type mDecisionRecorderMockRunRecorder struct {
+	optional           bool
+	mock               *DecisionRecorderMock
+	defaultExpectation *DecisionRecorderMockRunRecorderExpectation
+	expectations       []*DecisionRecorderMockRunRecorderExpectation
+
+	callArgs []*DecisionRecorderMockRunRecorderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// DecisionRecorderMockRunRecorderExpectation specifies expectation struct of the DecisionRecorder.RunRecorder
+type DecisionRecorderMockRunRecorderExpectation struct {
+	mock      *DecisionRecorderMock
+	params    *DecisionRecorderMockRunRecorderParams
+	paramPtrs *DecisionRecorderMockRunRecorderParamPtrs
+	results   *DecisionRecorderMockRunRecorderResults
+	Counter   uint64
+}
+
+// DecisionRecorderMockRunRecorderParams contains parameters of the DecisionRecorder.RunRecorder
+type DecisionRecorderMockRunRecorderParams struct {
+	ctx context.Context
+}
+
+// DecisionRecorderMockRunRecorderParamPtrs contains pointers to parameters of the DecisionRecorder.RunRecorder
+type DecisionRecorderMockRunRecorderParamPtrs struct {
+	ctx *context.Context
+}
+
+// DecisionRecorderMockRunRecorderResults contains results of the DecisionRecorder.RunRecorder
+type DecisionRecorderMockRunRecorderResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRunRecorder *mDecisionRecorderMockRunRecorder) Optional() *mDecisionRecorderMockRunRecorder {
+	mmRunRecorder.optional = true
+	return mmRunRecorder
+}
+
+// Expect sets up expected params for DecisionRecorder.RunRecorder
+func (mmRunRecorder *mDecisionRecorderMockRunRecorder) Expect(ctx context.Context) *mDecisionRecorderMockRunRecorder {
+	if mmRunRecorder.mock.funcRunRecorder != nil {
+		mmRunRecorder.mock.t.Fatalf("DecisionRecorderMock.RunRecorder mock is already set by Set")
+	}
+
+	if mmRunRecorder.defaultExpectation == nil {
+		mmRunRecorder.defaultExpectation = &DecisionRecorderMockRunRecorderExpectation{}
+	}
+
+	if mmRunRecorder.defaultExpectation.paramPtrs != nil {
+		mmRunRecorder.mock.t.Fatalf("DecisionRecorderMock.RunRecorder mock is already set by ExpectParams functions")
+	}
+
+	mmRunRecorder.defaultExpectation.params = &DecisionRecorderMockRunRecorderParams{ctx}
+	for _, e := range mmRunRecorder.expectations {
+		if minimock.Equal(e.params, mmRunRecorder.defaultExpectation.params) {
+			mmRunRecorder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRunRecorder.defaultExpectation.params)
+		}
+	}
+
+	return mmRunRecorder
+}
+
+// ExpectCtxParam1 sets up expected param ctx for DecisionRecorder.RunRecorder
+func (mmRunRecorder *mDecisionRecorderMockRunRecorder) ExpectCtxParam1(ctx context.Context) *mDecisionRecorderMockRunRecorder {
+	if mmRunRecorder.mock.funcRunRecorder != nil {
+		mmRunRecorder.mock.t.Fatalf("DecisionRecorderMock.RunRecorder mock is already set by Set")
+	}
+
+	if mmRunRecorder.defaultExpectation == nil {
+		mmRunRecorder.defaultExpectation = &DecisionRecorderMockRunRecorderExpectation{}
+	}
+
+	if mmRunRecorder.defaultExpectation.params != nil {
+		mmRunRecorder.mock.t.Fatalf("DecisionRecorderMock.RunRecorder mock is already set by Expect")
+	}
+
+	if mmRunRecorder.defaultExpectation.paramPtrs == nil {
+		mmRunRecorder.defaultExpectation.paramPtrs = &DecisionRecorderMockRunRecorderParamPtrs{}
+	}
+	mmRunRecorder.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRunRecorder
+}
+
+// Inspect accepts an inspector function that has same arguments as the DecisionRecorder.RunRecorder
+func (mmRunRecorder *mDecisionRecorderMockRunRecorder) Inspect(f func(ctx context.Context)) *mDecisionRecorderMockRunRecorder {
+	if mmRunRecorder.mock.inspectFuncRunRecorder != nil {
+		mmRunRecorder.mock.t.Fatalf("Inspect function is already set for DecisionRecorderMock.RunRecorder")
+	}
+
+	mmRunRecorder.mock.inspectFuncRunRecorder = f
+
+	return mmRunRecorder
+}
+
+// Return sets up results that will be returned by DecisionRecorder.RunRecorder
+func (mmRunRecorder *mDecisionRecorderMockRunRecorder) Return(err error) *DecisionRecorderMock {
+	if mmRunRecorder.mock.funcRunRecorder != nil {
+		mmRunRecorder.mock.t.Fatalf("DecisionRecorderMock.RunRecorder mock is already set by Set")
+	}
+
+	if mmRunRecorder.defaultExpectation == nil {
+		mmRunRecorder.defaultExpectation = &DecisionRecorderMockRunRecorderExpectation{mock: mmRunRecorder.mock}
+	}
+	mmRunRecorder.defaultExpectation.results = &DecisionRecorderMockRunRecorderResults{err}
+	return mmRunRecorder.mock
+}
+
+// Set uses given function f to mock the DecisionRecorder.RunRecorder method
+func (mmRunRecorder *mDecisionRecorderMockRunRecorder) Set(f func(ctx context.Context) (err error)) *DecisionRecorderMock {
+	if mmRunRecorder.defaultExpectation != nil {
+		mmRunRecorder.mock.t.Fatalf("Default expectation is already set for the DecisionRecorder.RunRecorder method")
+	}
+
+	if len(mmRunRecorder.expectations) > 0 {
+		mmRunRecorder.mock.t.Fatalf("Some expectations are already set for the DecisionRecorder.RunRecorder method")
+	}
+
+	mmRunRecorder.mock.funcRunRecorder = f
+	return mmRunRecorder.mock
+}
+
+// When sets expectation for the DecisionRecorder.RunRecorder which will trigger the result defined by the following
+// Then helper
+func (mmRunRecorder *mDecisionRecorderMockRunRecorder) When(ctx context.Context) *DecisionRecorderMockRunRecorderExpectation {
+	if mmRunRecorder.mock.funcRunRecorder != nil {
+		mmRunRecorder.mock.t.Fatalf("DecisionRecorderMock.RunRecorder mock is already set by Set")
+	}
+
+	expectation := &DecisionRecorderMockRunRecorderExpectation{
+		mock:   mmRunRecorder.mock,
+		params: &DecisionRecorderMockRunRecorderParams{ctx},
+	}
+	mmRunRecorder.expectations = append(mmRunRecorder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DecisionRecorder.RunRecorder return parameters for the expectation previously defined by the When method
+func (e *DecisionRecorderMockRunRecorderExpectation) Then(err error) *DecisionRecorderMock {
+	e.results = &DecisionRecorderMockRunRecorderResults{err}
+	return e.mock
+}
+
+// Times sets number of times DecisionRecorder.RunRecorder should be invoked
+func (mmRunRecorder *mDecisionRecorderMockRunRecorder) Times(n uint64) *mDecisionRecorderMockRunRecorder {
+	if n == 0 {
+		mmRunRecorder.mock.t.Fatalf("Times of DecisionRecorderMock.RunRecorder mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRunRecorder.expectedInvocations, n)
+	return mmRunRecorder
+}
+
+func (mmRunRecorder *mDecisionRecorderMockRunRecorder) invocationsDone() bool {
+	if len(mmRunRecorder.expectations) == 0 && mmRunRecorder.defaultExpectation == nil && mmRunRecorder.mock.funcRunRecorder == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRunRecorder.mock.afterRunRecorderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRunRecorder.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RunRecorder implements service.DecisionRecorder
+func (mmRunRecorder *DecisionRecorderMock) RunRecorder(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmRunRecorder.beforeRunRecorderCounter, 1)
+	defer mm_atomic.AddUint64(&mmRunRecorder.afterRunRecorderCounter, 1)
+
+	if mmRunRecorder.inspectFuncRunRecorder != nil {
+		mmRunRecorder.inspectFuncRunRecorder(ctx)
+	}
+
+	mm_params := DecisionRecorderMockRunRecorderParams{ctx}
+
+	// Record call args
+	mmRunRecorder.RunRecorderMock.mutex.Lock()
+	mmRunRecorder.RunRecorderMock.callArgs = append(mmRunRecorder.RunRecorderMock.callArgs, &mm_params)
+	mmRunRecorder.RunRecorderMock.mutex.Unlock()
+
+	for _, e := range mmRunRecorder.RunRecorderMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRunRecorder.RunRecorderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRunRecorder.RunRecorderMock.defaultExpectation.Counter, 1)
+		mm_want := mmRunRecorder.RunRecorderMock.defaultExpectation.params
+		mm_want_ptrs := mmRunRecorder.RunRecorderMock.defaultExpectation.paramPtrs
+
+		mm_got := DecisionRecorderMockRunRecorderParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRunRecorder.t.Errorf("DecisionRecorderMock.RunRecorder got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRunRecorder.t.Errorf("DecisionRecorderMock.RunRecorder got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRunRecorder.RunRecorderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRunRecorder.t.Fatal("No results are set for the DecisionRecorderMock.RunRecorder")
+		}
+		return (*mm_results).err
+	}
+	if mmRunRecorder.funcRunRecorder != nil {
+		return mmRunRecorder.funcRunRecorder(ctx)
+	}
+	mmRunRecorder.t.Fatalf("Unexpected call to DecisionRecorderMock.RunRecorder. %v", ctx)
+	return
+}
+
+// RunRecorderAfterCounter returns a count of finished DecisionRecorderMock.RunRecorder invocations
+func (mmRunRecorder *DecisionRecorderMock) RunRecorderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRunRecorder.afterRunRecorderCounter)
+}
+
+// RunRecorderBeforeCounter returns a count of DecisionRecorderMock.RunRecorder invocations
+func (mmRunRecorder *DecisionRecorderMock) RunRecorderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRunRecorder.beforeRunRecorderCounter)
+}
+
+// Calls returns a list of arguments used in each call to DecisionRecorderMock.RunRecorder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRunRecorder *mDecisionRecorderMockRunRecorder) Calls() []*DecisionRecorderMockRunRecorderParams {
+	mmRunRecorder.mutex.RLock()
+
+	argCopy := make([]*DecisionRecorderMockRunRecorderParams, len(mmRunRecorder.callArgs))
+	copy(argCopy, mmRunRecorder.callArgs)
+
+	mmRunRecorder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRunRecorderDone returns true if the count of the RunRecorder invocations corresponds
+// the number of defined expectations
+func (m *DecisionRecorderMock) MinimockRunRecorderDone() bool {
+	if m.RunRecorderMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RunRecorderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RunRecorderMock.invocationsDone()
+}
+
+// MinimockRunRecorderInspect logs each unmet expectation
+func (m *DecisionRecorderMock) MinimockRunRecorderInspect() {
+	for _, e := range m.RunRecorderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DecisionRecorderMock.RunRecorder with params: %#v", *e.params)
+		}
+	}
+
+	afterRunRecorderCounter := mm_atomic.LoadUint64(&m.afterRunRecorderCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RunRecorderMock.defaultExpectation != nil && afterRunRecorderCounter < 1 {
+		if m.RunRecorderMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DecisionRecorderMock.RunRecorder")
+		} else {
+			m.t.Errorf("Expected call to DecisionRecorderMock.RunRecorder with params: %#v", *m.RunRecorderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRunRecorder != nil && afterRunRecorderCounter < 1 {
+		m.t.Error("Expected call to DecisionRecorderMock.RunRecorder")
+	}
+
+	if !m.RunRecorderMock.invocationsDone() && afterRunRecorderCounter > 0 {
+		m.t.Errorf("Expected %d calls to DecisionRecorderMock.RunRecorder but found %d calls",
+			mm_atomic.LoadUint64(&m.RunRecorderMock.expectedInvocations), afterRunRecorderCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *DecisionRecorderMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockRecordInspect()
+
+			m.MinimockRunRecorderInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *DecisionRecorderMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *DecisionRecorderMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockRecordDone() &&
+		m.MinimockRunRecorderDone()
+}
@@ -11,6 +11,7 @@ import (
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
 	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
 )
 
@@ -31,6 +32,12 @@ type UserServiceMock struct {
 	beforeCreateCounter uint64
 	CreateMock          mUserServiceMockCreate
 
+	funcCreateServiceAccount          func(ctx context.Context, account *model.User) (i1 int64, s1 string, err error)
+	inspectFuncCreateServiceAccount   func(ctx context.Context, account *model.User)
+	afterCreateServiceAccountCounter  uint64
+	beforeCreateServiceAccountCounter uint64
+	CreateServiceAccountMock          mUserServiceMockCreateServiceAccount
+
 	funcDelete          func(ctx context.Context, id int64) (err error)
 	inspectFuncDelete   func(ctx context.Context, id int64)
 	afterDeleteCounter  uint64
@@ -43,8 +50,8 @@ type UserServiceMock struct {
 	beforeGetCounter uint64
 	GetMock          mUserServiceMockGet
 
-	funcList          func(ctx context.Context, limit int64, offset int64) (upa1 []*model.User, err error)
-	inspectFuncList   func(ctx context.Context, limit int64, offset int64)
+	funcList          func(ctx context.Context, filter filter.UserListFilter) (up1 *model.UserPage, err error)
+	inspectFuncList   func(ctx context.Context, filter filter.UserListFilter)
 	afterListCounter  uint64
 	beforeListCounter uint64
 	ListMock          mUserServiceMockList
@@ -70,6 +77,9 @@ func NewUserServiceMock(t minimock.Tester) *UserServiceMock {
 	m.CreateMock = mUserServiceMockCreate{mock: m}
 	m.CreateMock.callArgs = []*UserServiceMockCreateParams{}
 
+	m.CreateServiceAccountMock = mUserServiceMockCreateServiceAccount{mock: m}
+	m.CreateServiceAccountMock.callArgs = []*UserServiceMockCreateServiceAccountParams{}
+
 	m.DeleteMock = mUserServiceMockDelete{mock: m}
 	m.DeleteMock.callArgs = []*UserServiceMockDeleteParams{}
 
@@ -728,6 +738,328 @@ func (m *UserServiceMock) MinimockCreateInspect() {
 	}
 }
 
+type mUserServiceMockCreateServiceAccount struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockCreateServiceAccountExpectation
+	expectations       []*UserServiceMockCreateServiceAccountExpectation
+
+	callArgs []*UserServiceMockCreateServiceAccountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserServiceMockCreateServiceAccountExpectation specifies expectation struct of the UserService.CreateServiceAccount
+type UserServiceMockCreateServiceAccountExpectation struct {
+	mock      *UserServiceMock
+	params    *UserServiceMockCreateServiceAccountParams
+	paramPtrs *UserServiceMockCreateServiceAccountParamPtrs
+	results   *UserServiceMockCreateServiceAccountResults
+	Counter   uint64
+}
+
+// UserServiceMockCreateServiceAccountParams contains parameters of the UserService.CreateServiceAccount
+type UserServiceMockCreateServiceAccountParams struct {
+	ctx     context.Context
+	account *model.User
+}
+
+// UserServiceMockCreateServiceAccountParamPtrs contains pointers to parameters of the UserService.CreateServiceAccount
+type UserServiceMockCreateServiceAccountParamPtrs struct {
+	ctx     *context.Context
+	account **model.User
+}
+
+// UserServiceMockCreateServiceAccountResults contains results of the UserService.CreateServiceAccount
+type UserServiceMockCreateServiceAccountResults struct {
+	i1  int64
+	s1  string
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateServiceAccount *mUserServiceMockCreateServiceAccount) Optional() *mUserServiceMockCreateServiceAccount {
+	mmCreateServiceAccount.optional = true
+	return mmCreateServiceAccount
+}
+
+// Expect sets up expected params for UserService.CreateServiceAccount
+func (mmCreateServiceAccount *mUserServiceMockCreateServiceAccount) Expect(ctx context.Context, account *model.User) *mUserServiceMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("UserServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &UserServiceMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("UserServiceMock.CreateServiceAccount mock is already set by ExpectParams functions")
+	}
+
+	mmCreateServiceAccount.defaultExpectation.params = &UserServiceMockCreateServiceAccountParams{ctx, account}
+	for _, e := range mmCreateServiceAccount.expectations {
+		if minimock.Equal(e.params, mmCreateServiceAccount.defaultExpectation.params) {
+			mmCreateServiceAccount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateServiceAccount.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateServiceAccount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.CreateServiceAccount
+func (mmCreateServiceAccount *mUserServiceMockCreateServiceAccount) ExpectCtxParam1(ctx context.Context) *mUserServiceMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("UserServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &UserServiceMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.params != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("UserServiceMock.CreateServiceAccount mock is already set by Expect")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmCreateServiceAccount.defaultExpectation.paramPtrs = &UserServiceMockCreateServiceAccountParamPtrs{}
+	}
+	mmCreateServiceAccount.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateServiceAccount
+}
+
+// ExpectAccountParam2 sets up expected param account for UserService.CreateServiceAccount
+func (mmCreateServiceAccount *mUserServiceMockCreateServiceAccount) ExpectAccountParam2(account *model.User) *mUserServiceMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("UserServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &UserServiceMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.params != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("UserServiceMock.CreateServiceAccount mock is already set by Expect")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmCreateServiceAccount.defaultExpectation.paramPtrs = &UserServiceMockCreateServiceAccountParamPtrs{}
+	}
+	mmCreateServiceAccount.defaultExpectation.paramPtrs.account = &account
+
+	return mmCreateServiceAccount
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.CreateServiceAccount
+func (mmCreateServiceAccount *mUserServiceMockCreateServiceAccount) Inspect(f func(ctx context.Context, account *model.User)) *mUserServiceMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.inspectFuncCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("Inspect function is already set for UserServiceMock.CreateServiceAccount")
+	}
+
+	mmCreateServiceAccount.mock.inspectFuncCreateServiceAccount = f
+
+	return mmCreateServiceAccount
+}
+
+// Return sets up results that will be returned by UserService.CreateServiceAccount
+func (mmCreateServiceAccount *mUserServiceMockCreateServiceAccount) Return(i1 int64, s1 string, err error) *UserServiceMock {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("UserServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &UserServiceMockCreateServiceAccountExpectation{mock: mmCreateServiceAccount.mock}
+	}
+	mmCreateServiceAccount.defaultExpectation.results = &UserServiceMockCreateServiceAccountResults{i1, s1, err}
+	return mmCreateServiceAccount.mock
+}
+
+// Set uses given function f to mock the UserService.CreateServiceAccount method
+func (mmCreateServiceAccount *mUserServiceMockCreateServiceAccount) Set(f func(ctx context.Context, account *model.User) (i1 int64, s1 string, err error)) *UserServiceMock {
+	if mmCreateServiceAccount.defaultExpectation != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("Default expectation is already set for the UserService.CreateServiceAccount method")
+	}
+
+	if len(mmCreateServiceAccount.expectations) > 0 {
+		mmCreateServiceAccount.mock.t.Fatalf("Some expectations are already set for the UserService.CreateServiceAccount method")
+	}
+
+	mmCreateServiceAccount.mock.funcCreateServiceAccount = f
+	return mmCreateServiceAccount.mock
+}
+
+// When sets expectation for the UserService.CreateServiceAccount which will trigger the result defined by the following
+// Then helper
+func (mmCreateServiceAccount *mUserServiceMockCreateServiceAccount) When(ctx context.Context, account *model.User) *UserServiceMockCreateServiceAccountExpectation {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("UserServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockCreateServiceAccountExpectation{
+		mock:   mmCreateServiceAccount.mock,
+		params: &UserServiceMockCreateServiceAccountParams{ctx, account},
+	}
+	mmCreateServiceAccount.expectations = append(mmCreateServiceAccount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.CreateServiceAccount return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockCreateServiceAccountExpectation) Then(i1 int64, s1 string, err error) *UserServiceMock {
+	e.results = &UserServiceMockCreateServiceAccountResults{i1, s1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.CreateServiceAccount should be invoked
+func (mmCreateServiceAccount *mUserServiceMockCreateServiceAccount) Times(n uint64) *mUserServiceMockCreateServiceAccount {
+	if n == 0 {
+		mmCreateServiceAccount.mock.t.Fatalf("Times of UserServiceMock.CreateServiceAccount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateServiceAccount.expectedInvocations, n)
+	return mmCreateServiceAccount
+}
+
+func (mmCreateServiceAccount *mUserServiceMockCreateServiceAccount) invocationsDone() bool {
+	if len(mmCreateServiceAccount.expectations) == 0 && mmCreateServiceAccount.defaultExpectation == nil && mmCreateServiceAccount.mock.funcCreateServiceAccount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateServiceAccount.mock.afterCreateServiceAccountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateServiceAccount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateServiceAccount implements service.UserService
+func (mmCreateServiceAccount *UserServiceMock) CreateServiceAccount(ctx context.Context, account *model.User) (i1 int64, s1 string, err error) {
+	mm_atomic.AddUint64(&mmCreateServiceAccount.beforeCreateServiceAccountCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateServiceAccount.afterCreateServiceAccountCounter, 1)
+
+	if mmCreateServiceAccount.inspectFuncCreateServiceAccount != nil {
+		mmCreateServiceAccount.inspectFuncCreateServiceAccount(ctx, account)
+	}
+
+	mm_params := UserServiceMockCreateServiceAccountParams{ctx, account}
+
+	// Record call args
+	mmCreateServiceAccount.CreateServiceAccountMock.mutex.Lock()
+	mmCreateServiceAccount.CreateServiceAccountMock.callArgs = append(mmCreateServiceAccount.CreateServiceAccountMock.callArgs, &mm_params)
+	mmCreateServiceAccount.CreateServiceAccountMock.mutex.Unlock()
+
+	for _, e := range mmCreateServiceAccount.CreateServiceAccountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.s1, e.results.err
+		}
+	}
+
+	if mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockCreateServiceAccountParams{ctx, account}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateServiceAccount.t.Errorf("UserServiceMock.CreateServiceAccount got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.account != nil && !minimock.Equal(*mm_want_ptrs.account, mm_got.account) {
+				mmCreateServiceAccount.t.Errorf("UserServiceMock.CreateServiceAccount got unexpected parameter account, want: %#v, got: %#v%s\n", *mm_want_ptrs.account, mm_got.account, minimock.Diff(*mm_want_ptrs.account, mm_got.account))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateServiceAccount.t.Errorf("UserServiceMock.CreateServiceAccount got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateServiceAccount.t.Fatal("No results are set for the UserServiceMock.CreateServiceAccount")
+		}
+		return (*mm_results).i1, (*mm_results).s1, (*mm_results).err
+	}
+	if mmCreateServiceAccount.funcCreateServiceAccount != nil {
+		return mmCreateServiceAccount.funcCreateServiceAccount(ctx, account)
+	}
+	mmCreateServiceAccount.t.Fatalf("Unexpected call to UserServiceMock.CreateServiceAccount. %v %v", ctx, account)
+	return
+}
+
+// CreateServiceAccountAfterCounter returns a count of finished UserServiceMock.CreateServiceAccount invocations
+func (mmCreateServiceAccount *UserServiceMock) CreateServiceAccountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateServiceAccount.afterCreateServiceAccountCounter)
+}
+
+// CreateServiceAccountBeforeCounter returns a count of UserServiceMock.CreateServiceAccount invocations
+func (mmCreateServiceAccount *UserServiceMock) CreateServiceAccountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateServiceAccount.beforeCreateServiceAccountCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.CreateServiceAccount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateServiceAccount *mUserServiceMockCreateServiceAccount) Calls() []*UserServiceMockCreateServiceAccountParams {
+	mmCreateServiceAccount.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockCreateServiceAccountParams, len(mmCreateServiceAccount.callArgs))
+	copy(argCopy, mmCreateServiceAccount.callArgs)
+
+	mmCreateServiceAccount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateServiceAccountDone returns true if the count of the CreateServiceAccount invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockCreateServiceAccountDone() bool {
+	if m.CreateServiceAccountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateServiceAccountMock.invocationsDone()
+}
+
+// MinimockCreateServiceAccountInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockCreateServiceAccountInspect() {
+	for _, e := range m.CreateServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.CreateServiceAccount with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateServiceAccountCounter := mm_atomic.LoadUint64(&m.afterCreateServiceAccountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateServiceAccountMock.defaultExpectation != nil && afterCreateServiceAccountCounter < 1 {
+		if m.CreateServiceAccountMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.CreateServiceAccount")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.CreateServiceAccount with params: %#v", *m.CreateServiceAccountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateServiceAccount != nil && afterCreateServiceAccountCounter < 1 {
+		m.t.Error("Expected call to UserServiceMock.CreateServiceAccount")
+	}
+
+	if !m.CreateServiceAccountMock.invocationsDone() && afterCreateServiceAccountCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.CreateServiceAccount but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateServiceAccountMock.expectedInvocations), afterCreateServiceAccountCounter)
+	}
+}
+
 type mUserServiceMockDelete struct {
 	optional           bool
 	mock               *UserServiceMock
@@ -1393,21 +1725,19 @@ type UserServiceMockListExpectation struct {
 // UserServiceMockListParams contains parameters of the UserService.List
 type UserServiceMockListParams struct {
 	ctx    context.Context
-	limit  int64
-	offset int64
+	filter filter.UserListFilter
 }
 
 // UserServiceMockListParamPtrs contains pointers to parameters of the UserService.List
 type UserServiceMockListParamPtrs struct {
 	ctx    *context.Context
-	limit  *int64
-	offset *int64
+	filter *filter.UserListFilter
 }
 
 // UserServiceMockListResults contains results of the UserService.List
 type UserServiceMockListResults struct {
-	upa1 []*model.User
-	err  error
+	up1 *model.UserPage
+	err error
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1421,7 +1751,7 @@ func (mmList *mUserServiceMockList) Optional() *mUserServiceMockList {
 }
 
 // Expect sets up expected params for UserService.List
-func (mmList *mUserServiceMockList) Expect(ctx context.Context, limit int64, offset int64) *mUserServiceMockList {
+func (mmList *mUserServiceMockList) Expect(ctx context.Context, filter filter.UserListFilter) *mUserServiceMockList {
 	if mmList.mock.funcList != nil {
 		mmList.mock.t.Fatalf("UserServiceMock.List mock is already set by Set")
 	}
@@ -1434,7 +1764,7 @@ func (mmList *mUserServiceMockList) Expect(ctx context.Context, limit int64, off
 		mmList.mock.t.Fatalf("UserServiceMock.List mock is already set by ExpectParams functions")
 	}
 
-	mmList.defaultExpectation.params = &UserServiceMockListParams{ctx, limit, offset}
+	mmList.defaultExpectation.params = &UserServiceMockListParams{ctx, filter}
 	for _, e := range mmList.expectations {
 		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
 			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
@@ -1466,8 +1796,8 @@ func (mmList *mUserServiceMockList) ExpectCtxParam1(ctx context.Context) *mUserS
 	return mmList
 }
 
-// ExpectLimitParam2 sets up expected param limit for UserService.List
-func (mmList *mUserServiceMockList) ExpectLimitParam2(limit int64) *mUserServiceMockList {
+// ExpectFilterParam2 sets up expected param filter for UserService.List
+func (mmList *mUserServiceMockList) ExpectFilterParam2(filter filter.UserListFilter) *mUserServiceMockList {
 	if mmList.mock.funcList != nil {
 		mmList.mock.t.Fatalf("UserServiceMock.List mock is already set by Set")
 	}
@@ -1483,35 +1813,13 @@ func (mmList *mUserServiceMockList) ExpectLimitParam2(limit int64) *mUserService
 	if mmList.defaultExpectation.paramPtrs == nil {
 		mmList.defaultExpectation.paramPtrs = &UserServiceMockListParamPtrs{}
 	}
-	mmList.defaultExpectation.paramPtrs.limit = &limit
-
-	return mmList
-}
-
-// ExpectOffsetParam3 sets up expected param offset for UserService.List
-func (mmList *mUserServiceMockList) ExpectOffsetParam3(offset int64) *mUserServiceMockList {
-	if mmList.mock.funcList != nil {
-		mmList.mock.t.Fatalf("UserServiceMock.List mock is already set by Set")
-	}
-
-	if mmList.defaultExpectation == nil {
-		mmList.defaultExpectation = &UserServiceMockListExpectation{}
-	}
-
-	if mmList.defaultExpectation.params != nil {
-		mmList.mock.t.Fatalf("UserServiceMock.List mock is already set by Expect")
-	}
-
-	if mmList.defaultExpectation.paramPtrs == nil {
-		mmList.defaultExpectation.paramPtrs = &UserServiceMockListParamPtrs{}
-	}
-	mmList.defaultExpectation.paramPtrs.offset = &offset
+	mmList.defaultExpectation.paramPtrs.filter = &filter
 
 	return mmList
 }
 
 // Inspect accepts an inspector function that has same arguments as the UserService.List
-func (mmList *mUserServiceMockList) Inspect(f func(ctx context.Context, limit int64, offset int64)) *mUserServiceMockList {
+func (mmList *mUserServiceMockList) Inspect(f func(ctx context.Context, filter filter.UserListFilter)) *mUserServiceMockList {
 	if mmList.mock.inspectFuncList != nil {
 		mmList.mock.t.Fatalf("Inspect function is already set for UserServiceMock.List")
 	}
@@ -1522,7 +1830,7 @@ func (mmList *mUserServiceMockList) Inspect(f func(ctx context.Context, limit in
 }
 
 // Return sets up results that will be returned by UserService.List
-func (mmList *mUserServiceMockList) Return(upa1 []*model.User, err error) *UserServiceMock {
+func (mmList *mUserServiceMockList) Return(up1 *model.UserPage, err error) *UserServiceMock {
 	if mmList.mock.funcList != nil {
 		mmList.mock.t.Fatalf("UserServiceMock.List mock is already set by Set")
 	}
@@ -1530,12 +1838,12 @@ func (mmList *mUserServiceMockList) Return(upa1 []*model.User, err error) *UserS
 	if mmList.defaultExpectation == nil {
 		mmList.defaultExpectation = &UserServiceMockListExpectation{mock: mmList.mock}
 	}
-	mmList.defaultExpectation.results = &UserServiceMockListResults{upa1, err}
+	mmList.defaultExpectation.results = &UserServiceMockListResults{up1, err}
 	return mmList.mock
 }
 
 // Set uses given function f to mock the UserService.List method
-func (mmList *mUserServiceMockList) Set(f func(ctx context.Context, limit int64, offset int64) (upa1 []*model.User, err error)) *UserServiceMock {
+func (mmList *mUserServiceMockList) Set(f func(ctx context.Context, filter filter.UserListFilter) (up1 *model.UserPage, err error)) *UserServiceMock {
 	if mmList.defaultExpectation != nil {
 		mmList.mock.t.Fatalf("Default expectation is already set for the UserService.List method")
 	}
@@ -1550,22 +1858,22 @@ func (mmList *mUserServiceMockList) Set(f func(ctx context.Context, limit int64,
 
 // When sets expectation for the UserService.List which will trigger the result defined by the following
 // Then helper
-func (mmList *mUserServiceMockList) When(ctx context.Context, limit int64, offset int64) *UserServiceMockListExpectation {
+func (mmList *mUserServiceMockList) When(ctx context.Context, filter filter.UserListFilter) *UserServiceMockListExpectation {
 	if mmList.mock.funcList != nil {
 		mmList.mock.t.Fatalf("UserServiceMock.List mock is already set by Set")
 	}
 
 	expectation := &UserServiceMockListExpectation{
 		mock:   mmList.mock,
-		params: &UserServiceMockListParams{ctx, limit, offset},
+		params: &UserServiceMockListParams{ctx, filter},
 	}
 	mmList.expectations = append(mmList.expectations, expectation)
 	return expectation
 }
 
 // Then sets up UserService.List return parameters for the expectation previously defined by the When method
-func (e *UserServiceMockListExpectation) Then(upa1 []*model.User, err error) *UserServiceMock {
-	e.results = &UserServiceMockListResults{upa1, err}
+func (e *UserServiceMockListExpectation) Then(up1 *model.UserPage, err error) *UserServiceMock {
+	e.results = &UserServiceMockListResults{up1, err}
 	return e.mock
 }
 
@@ -1590,15 +1898,15 @@ func (mmList *mUserServiceMockList) invocationsDone() bool {
 }
 
 // List implements service.UserService
-func (mmList *UserServiceMock) List(ctx context.Context, limit int64, offset int64) (upa1 []*model.User, err error) {
+func (mmList *UserServiceMock) List(ctx context.Context, filter filter.UserListFilter) (up1 *model.UserPage, err error) {
 	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
 	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
 
 	if mmList.inspectFuncList != nil {
-		mmList.inspectFuncList(ctx, limit, offset)
+		mmList.inspectFuncList(ctx, filter)
 	}
 
-	mm_params := UserServiceMockListParams{ctx, limit, offset}
+	mm_params := UserServiceMockListParams{ctx, filter}
 
 	// Record call args
 	mmList.ListMock.mutex.Lock()
@@ -1608,7 +1916,7 @@ func (mmList *UserServiceMock) List(ctx context.Context, limit int64, offset int
 	for _, e := range mmList.ListMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.upa1, e.results.err
+			return e.results.up1, e.results.err
 		}
 	}
 
@@ -1617,7 +1925,7 @@ func (mmList *UserServiceMock) List(ctx context.Context, limit int64, offset int
 		mm_want := mmList.ListMock.defaultExpectation.params
 		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
 
-		mm_got := UserServiceMockListParams{ctx, limit, offset}
+		mm_got := UserServiceMockListParams{ctx, filter}
 
 		if mm_want_ptrs != nil {
 
@@ -1625,12 +1933,8 @@ func (mmList *UserServiceMock) List(ctx context.Context, limit int64, offset int
 				mmList.t.Errorf("UserServiceMock.List got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
-				mmList.t.Errorf("UserServiceMock.List got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
-			}
-
-			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
-				mmList.t.Errorf("UserServiceMock.List got unexpected parameter offset, want: %#v, got: %#v%s\n", *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmList.t.Errorf("UserServiceMock.List got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
@@ -1641,12 +1945,12 @@ func (mmList *UserServiceMock) List(ctx context.Context, limit int64, offset int
 		if mm_results == nil {
 			mmList.t.Fatal("No results are set for the UserServiceMock.List")
 		}
-		return (*mm_results).upa1, (*mm_results).err
+		return (*mm_results).up1, (*mm_results).err
 	}
 	if mmList.funcList != nil {
-		return mmList.funcList(ctx, limit, offset)
+		return mmList.funcList(ctx, filter)
 	}
-	mmList.t.Fatalf("Unexpected call to UserServiceMock.List. %v %v %v", ctx, limit, offset)
+	mmList.t.Fatalf("Unexpected call to UserServiceMock.List. %v %v", ctx, filter)
 	return
 }
 
@@ -2046,6 +2350,8 @@ func (m *UserServiceMock) MinimockFinish() {
 
 			m.MinimockCreateInspect()
 
+			m.MinimockCreateServiceAccountInspect()
+
 			m.MinimockDeleteInspect()
 
 			m.MinimockGetInspect()
@@ -2078,6 +2384,7 @@ func (m *UserServiceMock) minimockDone() bool {
 	return done &&
 		m.MinimockCheckUsersExistDone() &&
 		m.MinimockCreateDone() &&
+		m.MinimockCreateServiceAccountDone() &&
 		m.MinimockDeleteDone() &&
 		m.MinimockGetDone() &&
 		m.MinimockListDone() &&
@@ -2,7 +2,11 @@ package service
 
 import (
 	"context"
+	"time"
 
+	decisionFilter "github.com/mikhailsoldatkin/auth/internal/repository/decision/filter"
+	permissionFilter "github.com/mikhailsoldatkin/auth/internal/repository/permission/filter"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
 	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
 )
 
@@ -12,8 +16,9 @@ type UserService interface {
 	Get(ctx context.Context, id int64) (*model.User, error)
 	Delete(ctx context.Context, id int64) error
 	Update(ctx context.Context, updates *model.User) error
-	List(ctx context.Context, limit, offset int64) ([]*model.User, error)
+	List(ctx context.Context, filter filter.UserListFilter) (*model.UserPage, error)
 	CheckUsersExist(ctx context.Context, ids []int64) error
+	CreateServiceAccount(ctx context.Context, account *model.User) (int64, string, error)
 }
 
 // ConsumerService defines the interface for running a Kafka consumer.
@@ -21,14 +26,77 @@ type ConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
 
+// SweeperService defines the interface for periodic removal of expired role grants.
+type SweeperService interface {
+	RunSweeper(ctx context.Context) error
+	Sweep(ctx context.Context) (int, error)
+}
+
+// DecisionRecorder defines the interface for the asynchronous access decision log.
+type DecisionRecorder interface {
+	Record(ctx context.Context, event *model.DecisionEvent)
+	RunRecorder(ctx context.Context) error
+}
+
 // AuthService provides methods for user authentication and token management.
 type AuthService interface {
 	Login(ctx context.Context, username, password string) (string, error)
 	GetRefreshToken(ctx context.Context, oldRefreshToken string) (string, error)
 	GetAccessToken(ctx context.Context, refreshToken string) (string, error)
+	CreateAPIToken(ctx context.Context, name string, expiresAt time.Time, scopes []string) (string, *model.APIToken, error)
+	ListAPITokens(ctx context.Context) ([]*model.APIToken, error)
+	RevokeAPIToken(ctx context.Context, id int64) error
+	ClientCredentials(ctx context.Context, clientID, clientSecret string) (string, error)
+	Impersonate(ctx context.Context, userID int64, reason string) (string, time.Time, error)
+	StopImpersonation(ctx context.Context) error
+	Reauthenticate(ctx context.Context, password string) (string, time.Time, error)
+}
+
+// AuthProvider verifies user credentials against an identity source such as the users table or LDAP.
+type AuthProvider interface {
+	Name() string
+	Authenticate(ctx context.Context, username, password string) (*model.User, error)
+}
+
+// FederationService provides federated login via an upstream OpenID Connect identity provider.
+type FederationService interface {
+	AuthCodeURL(ctx context.Context) (string, string, error)
+	Callback(ctx context.Context, code, state string) (string, error)
 }
 
-// AccessService provides methods for checking access permissions for various endpoints.
+// AccessService provides methods for checking and managing access permissions, roles and user groups.
 type AccessService interface {
-	Check(ctx context.Context, endpoint string) error
+	Check(ctx context.Context, endpoint string) (*model.AccessGrant, error)
+	CheckResource(ctx context.Context, endpoint, resource string) (*model.AccessGrant, error)
+	BatchCheck(ctx context.Context, requests []*model.AccessRequest) ([]*model.AccessDecision, error)
+	CreatePermission(ctx context.Context, permission *model.Permission) (int64, error)
+	ListPermissions(ctx context.Context, filter permissionFilter.PermissionFilter) ([]*model.Permission, error)
+	DeletePermission(ctx context.Context, id int64) error
+	CreateRole(ctx context.Context, role *model.Role) error
+	GetRole(ctx context.Context, name string) (*model.Role, error)
+	ListRoles(ctx context.Context) ([]*model.Role, error)
+	UpdateRole(ctx context.Context, updates *model.RoleUpdate) error
+	DeleteRole(ctx context.Context, name string) error
+	AssignUserRole(ctx context.Context, grant *model.RoleGrant) error
+	RevokeUserRole(ctx context.Context, userID int64, role string) error
+	GetUserRoles(ctx context.Context, userID int64) ([]string, error)
+	CreateGroup(ctx context.Context, group *model.Group) (int64, error)
+	ListGroups(ctx context.Context) ([]*model.Group, error)
+	DeleteGroup(ctx context.Context, id int64) error
+	AddGroupMember(ctx context.Context, groupID, userID int64) error
+	RemoveGroupMember(ctx context.Context, groupID, userID int64) error
+	AssignGroupRole(ctx context.Context, groupID int64, role string) error
+	RevokeGroupRole(ctx context.Context, groupID int64, role string) error
+	WriteRelation(ctx context.Context, tuple *model.RelationTuple) error
+	DeleteRelation(ctx context.Context, tuple *model.RelationTuple) error
+	ListRelations(ctx context.Context, object, relation, subject string) ([]*model.RelationTuple, error)
+	CheckRelation(ctx context.Context, object, relation string, userID int64) (bool, error)
+	ValidatePolicy(ctx context.Context, condition string) ([]string, error)
+	RequestElevation(ctx context.Context, request *model.ElevationRequest) (int64, error)
+	ApproveElevation(ctx context.Context, id int64) (*model.RoleGrant, error)
+	ApplyPolicy(ctx context.Context, policy *model.Policy, dryRun bool) (*model.PolicyPlan, error)
+	ExportPolicy(ctx context.Context) (*model.Policy, error)
+	ListDecisions(ctx context.Context, filter decisionFilter.DecisionFilter) ([]*model.DecisionEvent, error)
+	Simulate(ctx context.Context, changes []*model.PolicyChange) ([]*model.SimulationResult, error)
+	Explain(ctx context.Context, userID int64, endpoint string) (*model.Explanation, error)
 }
@@ -0,0 +1,94 @@
+package sweeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/logger"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	"github.com/mikhailsoldatkin/auth/internal/service"
+)
+
+var _ service.SweeperService = (*sweeperService)(nil)
+
+// sweeperService implements the SweeperService interface removing expired temporary role grants.
+type sweeperService struct {
+	roleRepo  repository.RoleRepository
+	logRepo   repository.LogRepository
+	txManager db.TxManager
+	config    config.RoleGrants
+}
+
+// NewSweeperService creates a new instance of the role grant sweeper.
+func NewSweeperService(
+	roleRepo repository.RoleRepository,
+	logRepo repository.LogRepository,
+	txManager db.TxManager,
+	config config.RoleGrants,
+) service.SweeperService {
+	return &sweeperService{
+		roleRepo:  roleRepo,
+		logRepo:   logRepo,
+		txManager: txManager,
+		config:    config,
+	}
+}
+
+// RunSweeper removes expired role grants every configured interval until the context is cancelled.
+// Failed sweeps are logged and retried on the next tick.
+func (s *sweeperService) RunSweeper(ctx context.Context) error {
+	logger.Info("role grant sweeper is running")
+
+	ticker := time.NewTicker(time.Duration(s.config.SweepIntervalSec) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			revoked, err := s.Sweep(ctx)
+			if err != nil {
+				logger.Errorf("failed to sweep expired role grants: %v", err)
+				continue
+			}
+			if revoked > 0 {
+				logger.Infof("revoked %d expired role grants", revoked)
+			}
+		}
+	}
+}
+
+// Sweep removes the role grants expired by now and records each revocation in the users log
+// within the same transaction. It returns the number of revoked grants.
+func (s *sweeperService) Sweep(ctx context.Context) (int, error) {
+	var revoked int
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		grants, errTx := s.roleRepo.DeleteExpired(ctx, time.Now())
+		if errTx != nil {
+			return errTx
+		}
+
+		for _, grant := range grants {
+			errTx = s.logRepo.Log(ctx, grant.UserID, fmt.Sprintf(
+				"role %s of user %d revoked: grant expired at %s",
+				grant.Role, grant.UserID, grant.ValidUntil.Format(time.RFC3339),
+			))
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		revoked = len(grants)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return revoked, nil
+}
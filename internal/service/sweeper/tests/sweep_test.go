@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/service/sweeper"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+type noOpTxManager struct{}
+
+func (noOpTxManager) ReadCommitted(ctx context.Context, f db.Handler) error {
+	return f(ctx)
+}
+
+func TestSweep(t *testing.T) {
+	t.Parallel()
+	type roleRepoMockFunc func(mc *minimock.Controller) repository.RoleRepository
+	type logRepoMockFunc func(mc *minimock.Controller) repository.LogRepository
+
+	var (
+		ctx       = context.Background()
+		mc        = minimock.NewController(t)
+		expiredAt = time.Date(2024, 11, 12, 10, 0, 0, 0, time.UTC)
+		repoErr   = errors.New("connection refused")
+
+		expiredGrants = func(grants ...*model.RoleGrant) roleRepoMockFunc {
+			return func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.DeleteExpiredMock.Set(func(_ context.Context, now time.Time) ([]*model.RoleGrant, error) {
+					require.WithinDuration(t, time.Now(), now, time.Minute)
+					return grants, nil
+				})
+				return mock
+			}
+		}
+	)
+
+	tests := []struct {
+		name         string
+		revoked      int
+		err          error
+		roleRepoMock roleRepoMockFunc
+		logRepoMock  logRepoMockFunc
+	}{
+		{
+			name:    "each revocation is logged",
+			revoked: 2,
+			roleRepoMock: expiredGrants(
+				&model.RoleGrant{UserID: 7, Role: "ADMIN", ValidUntil: expiredAt},
+				&model.RoleGrant{UserID: 9, Role: "AUDITOR", ValidUntil: expiredAt},
+			),
+			logRepoMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repoMocks.NewLogRepositoryMock(mc)
+				mock.LogMock.When(minimock.AnyContext, 7, "role ADMIN of user 7 revoked: grant expired at 2024-11-12T10:00:00Z").Then(nil)
+				mock.LogMock.When(minimock.AnyContext, 9, "role AUDITOR of user 9 revoked: grant expired at 2024-11-12T10:00:00Z").Then(nil)
+				return mock
+			},
+		},
+		{
+			name:         "nothing expired",
+			roleRepoMock: expiredGrants(),
+			logRepoMock: func(mc *minimock.Controller) repository.LogRepository {
+				return repoMocks.NewLogRepositoryMock(mc)
+			},
+		},
+		{
+			name: "delete failure",
+			err:  repoErr,
+			roleRepoMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.DeleteExpiredMock.Return(nil, repoErr)
+				return mock
+			},
+			logRepoMock: func(mc *minimock.Controller) repository.LogRepository {
+				return repoMocks.NewLogRepositoryMock(mc)
+			},
+		},
+		{
+			name:         "log failure fails the sweep",
+			err:          repoErr,
+			roleRepoMock: expiredGrants(&model.RoleGrant{UserID: 7, Role: "ADMIN", ValidUntil: expiredAt}),
+			logRepoMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repoMocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Return(repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := sweeper.NewSweeperService(tt.roleRepoMock(mc), tt.logRepoMock(mc), noOpTxManager{}, config.RoleGrants{})
+
+			revoked, err := service.Sweep(ctx)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.revoked, revoked)
+		})
+	}
+}
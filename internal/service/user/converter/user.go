@@ -3,6 +3,7 @@ package converter
 import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
 	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
 	pb "github.com/mikhailsoldatkin/auth/pkg/user_v1"
 )
@@ -10,12 +11,15 @@ import (
 // FromServiceToProtobuf converter from service User model to protobuf User model.
 func FromServiceToProtobuf(user *model.User) *pb.User {
 	return &pb.User{
-		Id:        user.ID,
-		Username:  user.Username,
-		Email:     user.Email,
-		Role:      pb.Role(pb.Role_value[user.Role]),
-		CreatedAt: timestamppb.New(user.CreatedAt),
-		UpdatedAt: timestamppb.New(user.UpdatedAt),
+		Id:            user.ID,
+		Username:      user.Username,
+		Email:         user.Email,
+		Role:          pb.Role(pb.Role_value[user.Role]),
+		RoleName:      user.Role,
+		PrincipalType: pb.PrincipalType(pb.PrincipalType_value[user.PrincipalType]),
+		OwnerId:       user.OwnerID,
+		CreatedAt:     timestamppb.New(user.CreatedAt),
+		UpdatedAt:     timestamppb.New(user.UpdatedAt),
 	}
 }
 
@@ -33,7 +37,7 @@ func FromProtobufToServiceCreate(req *pb.CreateRequest) *model.User {
 	return &model.User{
 		Username: req.GetUsername(),
 		Email:    req.GetEmail(),
-		Role:     req.GetRole().String(),
+		Role:     roleName(req.GetRoleName(), req.GetRole()),
 		Password: req.GetPassword(),
 	}
 }
@@ -44,6 +48,59 @@ func FromProtobufToServiceUpdate(req *pb.UpdateRequest) *model.User {
 		ID:       req.Id,
 		Username: req.GetUsername().GetValue(),
 		Email:    req.GetEmail().GetValue(),
-		Role:     req.GetRole().String(),
+		Role:     roleName(req.GetRoleName(), req.GetRole()),
 	}
 }
+
+// FromProtobufToServiceCreateServiceAccount converter from protobuf CreateServiceAccount request to service User model.
+func FromProtobufToServiceCreateServiceAccount(req *pb.CreateServiceAccountRequest) *model.User {
+	return &model.User{
+		Username:      req.GetUsername(),
+		Email:         req.GetEmail(),
+		Role:          roleName(req.GetRoleName(), req.GetRole()),
+		PrincipalType: model.PrincipalService,
+		OwnerID:       req.GetOwnerId(),
+	}
+}
+
+// FromProtobufToServiceListFilter converter from protobuf List request to users list filter.
+func FromProtobufToServiceListFilter(req *pb.ListRequest) filter.UserListFilter {
+	f := filter.UserListFilter{
+		Limit:      req.GetLimit(),
+		Offset:     req.GetOffset(),
+		Username:   req.GetUsername(),
+		Email:      req.GetEmail(),
+		Substring:  req.GetMatch() == pb.MatchMode_MATCH_SUBSTRING,
+		Role:       req.GetRole(),
+		SortBy:     sortFields[req.GetSortBy()],
+		Descending: req.GetDirection() == pb.SortDirection_DESC,
+		PageToken:  req.GetPageToken(),
+	}
+	if req.GetPrincipalType() != pb.PrincipalType_PRINCIPAL_UNKNOWN {
+		f.PrincipalType = req.GetPrincipalType().String()
+	}
+	if req.GetCreatedFrom() != nil {
+		f.CreatedFrom = req.GetCreatedFrom().AsTime()
+	}
+	if req.GetCreatedTo() != nil {
+		f.CreatedTo = req.GetCreatedTo().AsTime()
+	}
+
+	return f
+}
+
+// sortFields maps protobuf sort fields to the sort fields of the list filter.
+var sortFields = map[pb.SortField]string{
+	pb.SortField_SORT_BY_ID:         filter.SortByID,
+	pb.SortField_SORT_BY_USERNAME:   filter.SortByUsername,
+	pb.SortField_SORT_BY_EMAIL:      filter.SortByEmail,
+	pb.SortField_SORT_BY_CREATED_AT: filter.SortByCreatedAt,
+}
+
+// roleName returns the role referenced by name, falling back to the built-in role enum for older clients.
+func roleName(name string, role pb.Role) string {
+	if name != "" {
+		return name
+	}
+	return role.String()
+}
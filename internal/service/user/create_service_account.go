@@ -0,0 +1,39 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+// CreateServiceAccount creates a service account owned by a human user.
+// It returns the account ID and the generated client secret, which is shown only once.
+func (s *userService) CreateServiceAccount(ctx context.Context, account *model.User) (int64, string, error) {
+	owner, err := s.pgRepository.Get(ctx, filter.UserFilter{ID: &account.OwnerID})
+	if err != nil {
+		return 0, "", err
+	}
+
+	if owner.IsService() {
+		return 0, "", customerrors.NewErrInvalidArgument("service account owner must be a human user")
+	}
+
+	secret, err := utils.GenerateClientSecret()
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to generate client secret: %w", err)
+	}
+
+	account.PrincipalType = model.PrincipalService
+	account.Password = secret
+
+	id, err := s.Create(ctx, account)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return id, secret, nil
+}
@@ -3,15 +3,54 @@ package user
 import (
 	"context"
 
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
 	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
 )
 
-// List retrieves a list of users from the system based on the provided limit and offset.
-func (s *userService) List(ctx context.Context, limit, offset int64) ([]*model.User, error) {
-	users, err := s.pgRepository.List(ctx, limit, offset)
+const defaultPageSize = 10
+
+// List retrieves a page of users matching the provided filter together with the total number of matching users.
+// Pages are continued either by offset or by the page token of the previous page, the token keeps its position
+// when users are created or deleted in between so that pages have neither duplicates nor gaps.
+func (s *userService) List(ctx context.Context, f filter.UserListFilter) (*model.UserPage, error) {
+	if f.PageToken != "" {
+		if f.Offset != 0 {
+			return nil, customerrors.NewErrInvalidArgument("offset cannot be combined with page token")
+		}
+
+		after, err := s.decodePageToken(f)
+		if err != nil {
+			return nil, err
+		}
+		f.After = after
+	}
+
+	limit := f.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+
+	// one more user than requested tells whether there is a next page
+	f.Limit = limit + 1
+	users, err := s.pgRepository.List(ctx, f)
 	if err != nil {
 		return nil, err
 	}
 
-	return users, nil
+	total, err := s.pgRepository.Count(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.UserPage{Users: users, TotalCount: total}
+	if int64(len(users)) > limit {
+		page.Users = users[:limit]
+		page.NextPageToken, err = s.encodePageToken(f, page.Users[limit-1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
 }
@@ -0,0 +1,25 @@
+package model
+
+// AccessGrant represents access granted to the authenticated principal by the matched permission.
+type AccessGrant struct {
+	Claims     *UserClaims `json:"claims"`
+	Permission *Permission `json:"permission"`
+}
+
+// AccessRequest represents an endpoint the principal asks access to, optionally narrowed to a resource.
+// Conditions see the resource as request.resource, it is also returned with the decision to correlate results.
+type AccessRequest struct {
+	Endpoint string `json:"endpoint"`
+	Resource string `json:"resource"`
+}
+
+// AccessDecision represents the outcome of the access check of a single request.
+// A positive MaxAuthAgeMin means access is granted after authenticating within that many minutes,
+// Reason explains why access was denied.
+type AccessDecision struct {
+	Endpoint      string `json:"endpoint"`
+	Resource      string `json:"resource"`
+	Allowed       bool   `json:"allowed"`
+	MaxAuthAgeMin int    `json:"max_auth_age_min"`
+	Reason        string `json:"reason"`
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+)
+
+// APIToken represents a business logic personal access token model.
+// Only the hash of the token secret is kept, the plain value is returned to the owner once on creation.
+type APIToken struct {
+	ID         int64      `json:"id"`
+	UserID     int64      `json:"user_id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Hash       string     `json:"-"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// IsActive reports whether the token is neither revoked nor expired at the given moment.
+func (t *APIToken) IsActive(now time.Time) bool {
+	return t.RevokedAt == nil && now.Before(t.ExpiresAt)
+}
@@ -1,10 +1,77 @@
 package model
 
-import "github.com/dgrijalva/jwt-go"
+import (
+	"slices"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// AuthMethodPassword is the amr value for authentication with a password.
+const AuthMethodPassword = "pwd"
 
 // UserClaims ...
 type UserClaims struct {
 	jwt.StandardClaims
-	Username string `json:"username"`
-	Role     string `json:"role"`
+	UserID     int64            `json:"uid,omitempty"`
+	Username   string           `json:"username"`
+	Role       string           `json:"role"`
+	Roles      []string         `json:"roles,omitempty"`
+	RolesUntil map[string]int64 `json:"roles_until,omitempty"`
+	Act        *ActorClaims     `json:"act,omitempty"`
+	AuthTime   int64            `json:"auth_time,omitempty"`
+	AMR        []string         `json:"amr,omitempty"`
+	Scopes     []string         `json:"scopes,omitempty"`
+}
+
+// ActorClaims identifies the admin acting on behalf of the token subject during impersonation.
+type ActorClaims struct {
+	Username string `json:"sub"`
+}
+
+// HasRole reports whether the role is the primary role or one of the effective roles of the token subject.
+func (c *UserClaims) HasRole(role string) bool {
+	return c.Role == role || slices.Contains(c.Roles, role)
+}
+
+// HasScope reports whether the credentials the claims were built from allow access to the endpoint.
+// Only personal access tokens are restricted by scopes, claims without scopes are not restricted.
+func (c *UserClaims) HasScope(endpoint string) bool {
+	return len(c.Scopes) == 0 || slices.Contains(c.Scopes, endpoint)
+}
+
+// EffectiveRoles returns the primary role followed by the other effective roles of the token subject.
+func (c *UserClaims) EffectiveRoles() []string {
+	if slices.Contains(c.Roles, c.Role) {
+		return c.Roles
+	}
+	return append([]string{c.Role}, c.Roles...)
+}
+
+// ExpireRoles drops the roles whose grants expired by now according to RolesUntil (unix times of the roles
+// granted only for a limited time), tokens issued during a temporary grant lose the role on expiry.
+func (c *UserClaims) ExpireRoles(now time.Time) {
+	if len(c.RolesUntil) == 0 {
+		return
+	}
+
+	c.Roles = slices.DeleteFunc(c.Roles, func(role string) bool {
+		until, ok := c.RolesUntil[role]
+		return ok && role != c.Role && now.Unix() >= until
+	})
+}
+
+// IsImpersonated reports whether the token was issued to an admin impersonating the user.
+func (c *UserClaims) IsImpersonated() bool {
+	return c.Act != nil
+}
+
+// AuthenticatedWithin reports whether the subject authenticated interactively no earlier than maxAge before now.
+// Tokens without auth_time (API tokens, client credentials, impersonation) are never considered fresh.
+func (c *UserClaims) AuthenticatedWithin(maxAge time.Duration, now time.Time) bool {
+	if c.AuthTime == 0 {
+		return false
+	}
+
+	return now.Sub(time.Unix(c.AuthTime, 0)) <= maxAge
 }
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// Outcomes of access decisions.
+const (
+	DecisionAllow           = "ALLOW"
+	DecisionDeny            = "DENY"
+	DecisionStepUp          = "STEP_UP"
+	DecisionUnauthenticated = "UNAUTHENTICATED"
+)
+
+// DecisionEvent represents a recorded access decision. MatchedRule describes the permission
+// the decision was made by, it is empty when no permission matched or the principal wasn't authenticated.
+type DecisionEvent struct {
+	ID           int64         `json:"id"`
+	UserID       int64         `json:"user_id"`
+	Username     string        `json:"username"`
+	Actor        string        `json:"actor"`
+	Endpoint     string        `json:"endpoint"`
+	Decision     string        `json:"decision"`
+	PermissionID int64         `json:"permission_id"`
+	MatchedRule  string        `json:"matched_rule"`
+	Reason       string        `json:"reason"`
+	Latency      time.Duration `json:"latency"`
+	RequestID    string        `json:"request_id"`
+	CreatedAt    time.Time     `json:"created_at"`
+}
+
+// IsAllow reports whether access was granted by the decision.
+func (e *DecisionEvent) IsAllow() bool {
+	return e.Decision == DecisionAllow
+}
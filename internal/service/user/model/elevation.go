@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// Statuses of elevation requests.
+const (
+	ElevationPending  = "PENDING"
+	ElevationApproved = "APPROVED"
+)
+
+// ElevationRequest represents a request of a user for a temporary role, the role is granted
+// for Duration from the moment another user with access to approvals approves the request.
+type ElevationRequest struct {
+	ID         int64         `json:"id"`
+	UserID     int64         `json:"user_id"`
+	Role       string        `json:"role"`
+	Reason     string        `json:"reason"`
+	Duration   time.Duration `json:"duration"`
+	Status     string        `json:"status"`
+	ApprovedBy int64         `json:"approved_by"`
+	CreatedAt  time.Time     `json:"created_at"`
+	ApprovedAt time.Time     `json:"approved_at"`
+}
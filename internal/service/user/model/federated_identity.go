@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// FederatedIdentity links a subject of an upstream identity provider to a local user.
+type FederatedIdentity struct {
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	UserID    int64     `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// Group represents a business logic model of a user group, members get all roles assigned to the group.
+type Group struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+}
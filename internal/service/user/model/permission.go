@@ -0,0 +1,72 @@
+package model
+
+// Effects of endpoint permissions.
+const (
+	EffectAllow = "allow"
+	EffectDeny  = "deny"
+)
+
+// Permission represents a business logic model of an endpoint permission granted to a role.
+type Permission struct {
+	ID            int64  `json:"id"`
+	Endpoint      string `json:"endpoint"`
+	Role          string `json:"role"`
+	MaxAuthAgeMin int    `json:"max_auth_age_min"`
+	Effect        string `json:"effect"`
+	Condition     string `json:"condition,omitempty"`
+}
+
+// RequiresFreshAuth reports whether the permission requires a recent authentication.
+func (p *Permission) RequiresFreshAuth() bool {
+	return p.MaxAuthAgeMin > 0
+}
+
+// HasCondition reports whether the permission applies only when its condition holds.
+func (p *Permission) HasCondition() bool {
+	return p.Condition != ""
+}
+
+// IsDeny reports whether the permission forbids access to the endpoint.
+func (p *Permission) IsDeny() bool {
+	return p.Effect == EffectDeny
+}
+
+// Actions of policy changes.
+const (
+	PolicyCreate = "create"
+	PolicyUpdate = "update"
+	PolicyDelete = "delete"
+)
+
+// PolicyChange describes a change of the permissions table planned by applying a policy document.
+// Permission is the declared permission (the deleted one for deletes), Previous is the stored one for updates.
+type PolicyChange struct {
+	Action     string      `json:"action"`
+	Permission *Permission `json:"permission"`
+	Previous   *Permission `json:"previous,omitempty"`
+}
+
+// Policy is the declared state of roles and endpoint permissions kept in a policy document.
+type Policy struct {
+	Roles       []*Role       `json:"roles"`
+	Permissions []*Permission `json:"permissions"`
+}
+
+// RoleChange describes a change of the roles table planned by applying a policy document.
+// Role is the declared role, Previous is the stored one for updates.
+type RoleChange struct {
+	Action   string `json:"action"`
+	Role     *Role  `json:"role"`
+	Previous *Role  `json:"previous,omitempty"`
+}
+
+// PolicyPlan holds the changes of roles and permissions planned by applying a policy document.
+type PolicyPlan struct {
+	Roles       []*RoleChange   `json:"roles"`
+	Permissions []*PolicyChange `json:"permissions"`
+}
+
+// IsEmpty reports whether the plan has no changes.
+func (p *PolicyPlan) IsEmpty() bool {
+	return len(p.Roles) == 0 && len(p.Permissions) == 0
+}
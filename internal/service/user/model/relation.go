@@ -0,0 +1,68 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Relations with built-in rewrite rules, an owner is an editor and an editor is a viewer.
+// Members of group:<id> objects are the members of the user group in addition to the member tuples.
+const (
+	RelationOwner  = "owner"
+	RelationEditor = "editor"
+	RelationViewer = "viewer"
+	RelationMember = "member"
+)
+
+// Object types with a built-in meaning.
+const (
+	ObjectTypeUser  = "user"
+	ObjectTypeGroup = "group"
+)
+
+// Operations of relation tuple change events.
+const (
+	RelationOperationWrite  = "write"
+	RelationOperationDelete = "delete"
+)
+
+// RelationTuple represents a business logic model of a relationship, the subject has the relation to the object.
+// Objects are written as type:id, e.g. chat:7. The subject is either a user, e.g. user:42,
+// or a set of subjects having a relation to another object, e.g. group:3#member.
+type RelationTuple struct {
+	Object    string    `json:"object"`
+	Relation  string    `json:"relation"`
+	Subject   string    `json:"subject"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RelationEvent represents a change of a relation tuple published to Kafka.
+type RelationEvent struct {
+	Operation  string         `json:"operation"`
+	Tuple      *RelationTuple `json:"tuple"`
+	Actor      string         `json:"actor"`
+	OccurredAt time.Time      `json:"occurred_at"`
+}
+
+// SubjectSet splits a subject set such as group:3#member into the object and the relation.
+// It reports false for subjects naming a single user.
+func (t *RelationTuple) SubjectSet() (object, relation string, ok bool) {
+	return strings.Cut(t.Subject, "#")
+}
+
+// UserObject returns the object identifying the user in relation tuples, e.g. user:42.
+func UserObject(userID int64) string {
+	return ObjectTypeUser + ":" + strconv.FormatInt(userID, 10)
+}
+
+// GroupID returns the ID of the user group the object identifies, e.g. 3 for group:3.
+func GroupID(object string) (int64, bool) {
+	id, ok := strings.CutPrefix(object, ObjectTypeGroup+":")
+	if !ok {
+		return 0, false
+	}
+
+	groupID, err := strconv.ParseInt(id, 10, 64)
+	return groupID, err == nil
+}
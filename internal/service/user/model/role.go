@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// Role represents a business logic model of a role, a role inherits all permissions of its parent.
+type Role struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Parent      string    `json:"parent"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// RoleUpdate holds changes of a role, nil fields are left unchanged and an empty Parent detaches the role.
+type RoleUpdate struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	Parent      *string `json:"parent"`
+}
+
+// RoleGrant represents a role assigned to a user in addition to the primary role.
+// The role is effective from ValidFrom, a zero ValidUntil grants it permanently.
+type RoleGrant struct {
+	UserID     int64     `json:"user_id"`
+	Role       string    `json:"role"`
+	ValidFrom  time.Time `json:"valid_from"`
+	ValidUntil time.Time `json:"valid_until"`
+}
+
+// IsTemporary reports whether the role is granted for a limited time.
+func (g *RoleGrant) IsTemporary() bool {
+	return !g.ValidUntil.IsZero()
+}
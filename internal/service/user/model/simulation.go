@@ -0,0 +1,40 @@
+package model
+
+// RoleSet represents the number of users holding exactly the same set of roles.
+type RoleSet struct {
+	Roles []string `json:"roles"`
+	Users int64    `json:"users"`
+}
+
+// SimulationResult describes how a proposed change of permissions affects access to an endpoint:
+// roles gaining and losing access and the number of users whose access changes.
+type SimulationResult struct {
+	Endpoint     string   `json:"endpoint"`
+	GainedRoles  []string `json:"gained_roles"`
+	LostRoles    []string `json:"lost_roles"`
+	UsersGaining int64    `json:"users_gaining"`
+	UsersLosing  int64    `json:"users_losing"`
+}
+
+// PermissionEvaluation describes a permission matching the endpoint in an explained decision.
+// ConditionResult is empty for unconditional permissions and otherwise true, false or the evaluation error.
+// A permission is applicable when the user holds its role and its condition holds.
+type PermissionEvaluation struct {
+	Permission      *Permission `json:"permission"`
+	RoleHeld        bool        `json:"role_held"`
+	ConditionResult string      `json:"condition_result,omitempty"`
+	Applicable      bool        `json:"applicable"`
+}
+
+// Explanation traces the access decision of a user for an endpoint: the effective roles of the user,
+// all permissions matching the endpoint and the most specific applicable one the decision was made by.
+type Explanation struct {
+	UserID      int64                   `json:"user_id"`
+	Username    string                  `json:"username"`
+	Endpoint    string                  `json:"endpoint"`
+	Roles       []string                `json:"roles"`
+	Evaluations []*PermissionEvaluation `json:"evaluations"`
+	Matched     *Permission             `json:"matched"`
+	Decision    string                  `json:"decision"`
+	Reason      string                  `json:"reason"`
+}
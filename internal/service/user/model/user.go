@@ -1,16 +1,69 @@
 package model
 
 import (
+	"slices"
 	"time"
 )
 
+// Principal types of users.
+const (
+	PrincipalHuman   = "HUMAN"
+	PrincipalService = "SERVICE"
+)
+
+// Authentication providers verifying user credentials.
+const (
+	AuthProviderLocal = "local"
+	AuthProviderLDAP  = "ldap"
+	AuthProviderOIDC  = "oidc"
+)
+
 // User represents a business logic user model.
+// RolesUntil holds expiry times of the roles granted only for a limited time.
 type User struct {
-	ID        int64     `json:"id"`
-	Username  string    `json:"username"`
-	Email     string    `json:"email"`
-	Role      string    `json:"role"`
-	Password  string    `json:"password"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID            int64                `json:"id"`
+	Username      string               `json:"username"`
+	Email         string               `json:"email"`
+	Role          string               `json:"role"`
+	Roles         []string             `json:"roles"`
+	RolesUntil    map[string]time.Time `json:"roles_until"`
+	Password      string               `json:"password"`
+	PrincipalType string               `json:"principal_type"`
+	OwnerID       int64                `json:"owner_id"`
+	AuthProvider  string               `json:"auth_provider"`
+	CreatedAt     time.Time            `json:"created_at"`
+	UpdatedAt     time.Time            `json:"updated_at"`
+}
+
+// UserPage is a page of listed users. TotalCount is the number of users matching the filters on all pages,
+// NextPageToken continues the list after the page and is empty on the last page.
+type UserPage struct {
+	Users         []*User
+	TotalCount    int64
+	NextPageToken string
+}
+
+// IsService reports whether the user is a service account.
+func (u *User) IsService() bool {
+	return u.PrincipalType == PrincipalService
+}
+
+// EffectiveRoles returns the primary role followed by the additional roles without duplicates.
+func (u *User) EffectiveRoles() []string {
+	roles := make([]string, 0, len(u.Roles)+1)
+	seen := make(map[string]struct{}, len(u.Roles)+1)
+	for _, role := range append([]string{u.Role}, u.Roles...) {
+		if _, ok := seen[role]; ok || role == "" {
+			continue
+		}
+		seen[role] = struct{}{}
+		roles = append(roles, role)
+	}
+
+	return roles
+}
+
+// HasRole reports whether the role is one of the user's effective roles.
+func (u *User) HasRole(role string) bool {
+	return slices.Contains(u.EffectiveRoles(), role)
 }
@@ -0,0 +1,89 @@
+package user
+
+import (
+	"encoding/json"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+// pageToken is the signed payload of list page tokens: the filters and sorting of the list
+// and the sort keys of the last user of the page.
+type pageToken struct {
+	Query  pageQuery         `json:"q"`
+	Cursor filter.UserCursor `json:"c"`
+}
+
+// pageQuery holds the list filters a page token is valid for, times are kept as Unix nanoseconds
+// so that equal filters compare equal regardless of location.
+type pageQuery struct {
+	PrincipalType string `json:"pt,omitempty"`
+	Username      string `json:"u,omitempty"`
+	Email         string `json:"e,omitempty"`
+	Substring     bool   `json:"ss,omitempty"`
+	Role          string `json:"r,omitempty"`
+	CreatedFrom   int64  `json:"cf,omitempty"`
+	CreatedTo     int64  `json:"ct,omitempty"`
+	SortBy        string `json:"s,omitempty"`
+	Descending    bool   `json:"d,omitempty"`
+}
+
+func newPageQuery(f filter.UserListFilter) pageQuery {
+	q := pageQuery{
+		PrincipalType: f.PrincipalType,
+		Username:      f.Username,
+		Email:         f.Email,
+		Substring:     f.Substring,
+		Role:          f.Role,
+		SortBy:        f.SortBy,
+		Descending:    f.Descending,
+	}
+	if !f.CreatedFrom.IsZero() {
+		q.CreatedFrom = f.CreatedFrom.UnixNano()
+	}
+	if !f.CreatedTo.IsZero() {
+		q.CreatedTo = f.CreatedTo.UnixNano()
+	}
+
+	return q
+}
+
+// encodePageToken returns the signed token of the page following the user.
+func (s *userService) encodePageToken(f filter.UserListFilter, last *model.User) (string, error) {
+	payload, err := json.Marshal(pageToken{
+		Query: newPageQuery(f),
+		Cursor: filter.UserCursor{
+			ID:        last.ID,
+			Username:  last.Username,
+			Email:     last.Email,
+			CreatedAt: last.CreatedAt,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return utils.SignPageToken(s.pageTokenKey, payload), nil
+}
+
+// decodePageToken verifies the page token of the filter and returns the cursor it continues from.
+// The token is only valid with the filters and sorting of the list it was issued for.
+func (s *userService) decodePageToken(f filter.UserListFilter) (*filter.UserCursor, error) {
+	payload, err := utils.VerifyPageToken(f.PageToken, s.pageTokenKey)
+	if err != nil {
+		return nil, customerrors.NewErrInvalidArgument("invalid page token")
+	}
+
+	var token pageToken
+	if err = json.Unmarshal(payload, &token); err != nil {
+		return nil, customerrors.NewErrInvalidArgument("invalid page token")
+	}
+
+	if token.Query != newPageQuery(f) {
+		return nil, customerrors.NewErrInvalidArgument("page token does not match the list filters")
+	}
+
+	return &token.Cursor, nil
+}
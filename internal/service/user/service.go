@@ -5,6 +5,7 @@ import (
 
 	"github.com/mikhailsoldatkin/platform_common/pkg/db"
 
+	"github.com/mikhailsoldatkin/auth/internal/config"
 	"github.com/mikhailsoldatkin/auth/internal/repository"
 	"github.com/mikhailsoldatkin/auth/internal/service"
 )
@@ -16,6 +17,7 @@ type userService struct {
 	redisRepository repository.UserRepository
 	logRepository   repository.LogRepository
 	txManager       db.TxManager
+	pageTokenKey    []byte
 }
 
 // NewUserService creates a new instance of the user service.
@@ -24,12 +26,14 @@ func NewUserService(
 	redisRepository repository.UserRepository,
 	logRepository repository.LogRepository,
 	txManager db.TxManager,
+	paginationConfig config.Pagination,
 ) service.UserService {
 	return &userService{
 		pgRepository:    pgRepository,
 		redisRepository: redisRepository,
 		logRepository:   logRepository,
 		txManager:       txManager,
+		pageTokenKey:    []byte(paginationConfig.PageTokenSecretKey),
 	}
 }
 
@@ -59,6 +63,8 @@ func NewMockUserService(deps ...any) service.UserService {
 		case repository.UserRepository:
 			srv.pgRepository = s
 			srv.redisRepository = s
+		case config.Pagination:
+			srv.pageTokenKey = []byte(s.PageTokenSecretKey)
 		}
 	}
 
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	"github.com/mikhailsoldatkin/auth/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+func TestCreateServiceAccount(t *testing.T) {
+	t.Parallel()
+	type userRepoMockFunc func(mc *minimock.Controller) repository.UserRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id      = gofakeit.Int64()
+		ownerID = gofakeit.Int64()
+		name    = gofakeit.Username()
+		email   = gofakeit.Email()
+
+		human   = &model.User{ID: ownerID, PrincipalType: model.PrincipalHuman}
+		service = &model.User{ID: ownerID, PrincipalType: model.PrincipalService}
+		wantErr = fmt.Errorf("repository error")
+	)
+
+	tests := []struct {
+		name         string
+		want         int64
+		err          error
+		userRepoMock userRepoMockFunc
+	}{
+		{
+			name: "success case",
+			want: id,
+			err:  nil,
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, filter.UserFilter{ID: &ownerID}).Return(human, nil)
+				mock.CreateMock.Set(func(_ context.Context, account *model.User) (int64, error) {
+					require.Equal(t, model.PrincipalService, account.PrincipalType)
+					require.Equal(t, ownerID, account.OwnerID)
+					require.NotEmpty(t, account.Password)
+					return id, nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "owner is a service account",
+			want: 0,
+			err:  customerrors.NewErrInvalidArgument("service account owner must be a human user"),
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, filter.UserFilter{ID: &ownerID}).Return(service, nil)
+				return mock
+			},
+		},
+		{
+			name: "error case",
+			want: 0,
+			err:  wantErr,
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, filter.UserFilter{ID: &ownerID}).Return(nil, wantErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userRepoMock := tt.userRepoMock(mc)
+			service := user.NewMockUserService(userRepoMock)
+
+			account := &model.User{Username: name, Email: email, Role: "USER", OwnerID: ownerID}
+			resp, secret, err := service.CreateServiceAccount(ctx, account)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, resp)
+			if tt.err == nil {
+				require.NotEmpty(t, secret)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/mikhailsoldatkin/auth/internal/repository"
 	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
 	"github.com/mikhailsoldatkin/auth/internal/service/user"
 	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
 )
@@ -22,16 +23,16 @@ func TestList(t *testing.T) {
 
 	type args struct {
 		ctx    context.Context
-		limit  int64
-		offset int64
+		filter filter.UserListFilter
 	}
 
 	var (
 		ctx = context.Background()
 		mc  = minimock.NewController(t)
 
-		limit  = 2
-		offset = 0
+		listFilter = filter.UserListFilter{Limit: 2, Offset: 0}
+		// the repository is asked for one more user to find out whether there is a next page
+		repoFilter = filter.UserListFilter{Limit: 3, Offset: 0}
 
 		id1    = gofakeit.Int64()
 		name1  = gofakeit.Name()
@@ -63,13 +64,14 @@ func TestList(t *testing.T) {
 				UpdatedAt: now2,
 			},
 		}
-		wantErr = fmt.Errorf("repository error")
+		wantPage = &model.UserPage{Users: wantResp, TotalCount: int64(len(wantResp))}
+		wantErr  = fmt.Errorf("repository error")
 	)
 
 	tests := []struct {
 		name         string
 		args         args
-		want         []*model.User
+		want         *model.UserPage
 		err          error
 		userRepoMock userRepoMockFunc
 	}{
@@ -77,14 +79,14 @@ func TestList(t *testing.T) {
 			name: "success case",
 			args: args{
 				ctx:    ctx,
-				limit:  int64(limit),
-				offset: int64(offset),
+				filter: listFilter,
 			},
-			want: wantResp,
+			want: wantPage,
 			err:  nil,
 			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
-				mock.ListMock.Expect(ctx, int64(limit), int64(offset)).Return(wantResp, nil)
+				mock.ListMock.Expect(ctx, repoFilter).Return(wantResp, nil)
+				mock.CountMock.Expect(ctx, repoFilter).Return(wantPage.TotalCount, nil)
 				return mock
 			},
 		},
@@ -92,14 +94,28 @@ func TestList(t *testing.T) {
 			name: "error case",
 			args: args{
 				ctx:    ctx,
-				limit:  int64(limit),
-				offset: int64(offset),
+				filter: listFilter,
 			},
 			want: nil,
 			err:  wantErr,
 			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
-				mock.ListMock.Expect(ctx, int64(limit), int64(offset)).Return(nil, wantErr)
+				mock.ListMock.Expect(ctx, repoFilter).Return(nil, wantErr)
+				return mock
+			},
+		},
+		{
+			name: "count error case",
+			args: args{
+				ctx:    ctx,
+				filter: listFilter,
+			},
+			want: nil,
+			err:  wantErr,
+			userRepoMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.ListMock.Expect(ctx, repoFilter).Return(wantResp, nil)
+				mock.CountMock.Expect(ctx, repoFilter).Return(0, wantErr)
 				return mock
 			},
 		},
@@ -112,7 +128,7 @@ func TestList(t *testing.T) {
 			userRepoMock := tt.userRepoMock(mc)
 			service := user.NewMockUserService(userRepoMock)
 
-			resp, repoErr := service.List(tt.args.ctx, tt.args.limit, tt.args.offset)
+			resp, repoErr := service.List(tt.args.ctx, tt.args.filter)
 			require.Equal(t, tt.err, repoErr)
 			require.Equal(t, tt.want, resp)
 		})
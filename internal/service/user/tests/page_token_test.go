@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikhailsoldatkin/auth/internal/config"
+	"github.com/mikhailsoldatkin/auth/internal/customerrors"
+	repoMocks "github.com/mikhailsoldatkin/auth/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/auth/internal/repository/user/pg/filter"
+	"github.com/mikhailsoldatkin/auth/internal/service/user"
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+func users(n int) []*model.User {
+	list := make([]*model.User, n)
+	for i := range list {
+		list[i] = &model.User{
+			ID:        int64(i + 1),
+			Username:  gofakeit.Username(),
+			Email:     gofakeit.Email(),
+			CreatedAt: time.Now().UTC().Round(0),
+		}
+	}
+	return list
+}
+
+func TestListPageTokenContinues(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		mc      = minimock.NewController(t)
+		first   = users(3)
+		f       = filter.UserListFilter{Limit: 2, Role: "USER", SortBy: filter.SortByCreatedAt, Descending: true}
+		repo    = repoMocks.NewUserRepositoryMock(mc)
+		service = user.NewMockUserService(repo, config.Pagination{PageTokenSecretKey: "secret"})
+	)
+	last := first[1]
+	repo.CountMock.Return(5, nil)
+	repo.ListMock.Set(func(_ context.Context, got filter.UserListFilter) ([]*model.User, error) {
+		if got.After == nil {
+			return first, nil
+		}
+
+		// the next page continues after the last user of the previous one with the same filters
+		require.Equal(t, &filter.UserCursor{
+			ID:        last.ID,
+			Username:  last.Username,
+			Email:     last.Email,
+			CreatedAt: last.CreatedAt,
+		}, got.After)
+		require.Equal(t, "USER", got.Role)
+		require.Equal(t, int64(3), got.Limit)
+		return first[2:], nil
+	})
+
+	page, err := service.List(ctx, f)
+	require.NoError(t, err)
+	require.Equal(t, first[:2], page.Users)
+	require.NotEmpty(t, page.NextPageToken)
+
+	f.PageToken = page.NextPageToken
+	page, err = service.List(ctx, f)
+	require.NoError(t, err)
+	require.Equal(t, first[2:], page.Users)
+	require.Empty(t, page.NextPageToken)
+}
+
+func TestListPageTokenRejected(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		mc      = minimock.NewController(t)
+		repo    = repoMocks.NewUserRepositoryMock(mc)
+		service = user.NewMockUserService(repo, config.Pagination{PageTokenSecretKey: "secret"})
+		other   = user.NewMockUserService(repo, config.Pagination{PageTokenSecretKey: "other secret"})
+		f       = filter.UserListFilter{Limit: 2, SortBy: filter.SortByUsername}
+	)
+	repo.ListMock.Return(users(3), nil)
+	repo.CountMock.Return(5, nil)
+
+	page, err := service.List(ctx, f)
+	require.NoError(t, err)
+	require.Len(t, page.Users, 2)
+	require.Equal(t, int64(5), page.TotalCount)
+	require.NotEmpty(t, page.NextPageToken)
+
+	token := page.NextPageToken
+	tampered := []byte(token)
+	tampered[0] ^= 1
+
+	tests := []struct {
+		name   string
+		list   func() error
+		reason string
+	}{
+		{
+			name: "tampered token",
+			list: func() error {
+				_, err := service.List(ctx, filter.UserListFilter{Limit: 2, SortBy: filter.SortByUsername, PageToken: string(tampered)})
+				return err
+			},
+			reason: "invalid page token",
+		},
+		{
+			name: "token signed with another key",
+			list: func() error {
+				_, err := other.List(ctx, filter.UserListFilter{Limit: 2, SortBy: filter.SortByUsername, PageToken: token})
+				return err
+			},
+			reason: "invalid page token",
+		},
+		{
+			name: "other filters",
+			list: func() error {
+				_, err := service.List(ctx, filter.UserListFilter{Limit: 2, SortBy: filter.SortByEmail, PageToken: token})
+				return err
+			},
+			reason: "page token does not match the list filters",
+		},
+		{
+			name: "offset with token",
+			list: func() error {
+				_, err := service.List(ctx, filter.UserListFilter{Limit: 2, Offset: 2, SortBy: filter.SortByUsername, PageToken: token})
+				return err
+			},
+			reason: "offset cannot be combined with page token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var invalidArgument *customerrors.ErrInvalidArgument
+			err := tt.list()
+			require.True(t, errors.As(err, &invalidArgument))
+			require.Equal(t, tt.reason, invalidArgument.Reason)
+		})
+	}
+}
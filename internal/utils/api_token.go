@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	// APITokenPrefix marks personal access tokens so they can be told apart from JWTs.
+	APITokenPrefix = "pat_"
+
+	apiTokenIDLength     = 8
+	apiTokenSecretLength = 32
+)
+
+// IsAPIToken reports whether the bearer token is a personal access token.
+func IsAPIToken(token string) bool {
+	return strings.HasPrefix(token, APITokenPrefix)
+}
+
+// GenerateAPIToken generates a new personal access token of the form pat_<id>_<secret>.
+// It returns the plain token, its public prefix used for lookups and the hash to be stored.
+func GenerateAPIToken() (token, prefix, hash string, err error) {
+	id, err := randomHex(apiTokenIDLength)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	secret, err := randomHex(apiTokenSecretLength)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	prefix = APITokenPrefix + id
+	token = prefix + "_" + secret
+
+	return token, prefix, HashAPIToken(token), nil
+}
+
+// GenerateClientSecret generates a random client secret for a service account.
+func GenerateClientSecret() (string, error) {
+	return randomHex(apiTokenSecretLength)
+}
+
+// ParseAPITokenPrefix extracts the public prefix from the plain personal access token.
+func ParseAPITokenPrefix(token string) (string, bool) {
+	if !IsAPIToken(token) {
+		return "", false
+	}
+
+	prefix, _, ok := strings.Cut(token[len(APITokenPrefix):], "_")
+	if !ok || len(prefix) != apiTokenIDLength*2 {
+		return "", false
+	}
+
+	return APITokenPrefix + prefix, true
+}
+
+// HashAPIToken returns the hex encoded SHA-256 hash of the plain token.
+// Tokens carry enough entropy so a fast hash is sufficient, unlike passwords.
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyAPIToken compares the plain token with the stored hash in constant time.
+func VerifyAPIToken(hash, token string) bool {
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(HashAPIToken(token))) == 1
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	headerAuth      = "authorization"
+	headerRequestID = "x-request-id"
+	prefixAuth      = "Bearer "
+)
+
+// ExtractBearerToken returns the bearer token from the authorization header of incoming gRPC metadata.
+func ExtractBearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("metadata is not provided")
+	}
+
+	authHeader, ok := md[headerAuth]
+	if !ok || len(authHeader) == 0 {
+		return "", fmt.Errorf("authorization header is not provided")
+	}
+
+	if !strings.HasPrefix(authHeader[0], prefixAuth) {
+		return "", fmt.Errorf("invalid authorization header format")
+	}
+
+	return strings.TrimPrefix(authHeader[0], prefixAuth), nil
+}
+
+// HasAuthorizationHeader reports whether incoming gRPC metadata carries an authorization header.
+func HasAuthorizationHeader(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	return len(md[headerAuth]) > 0
+}
+
+// RequestID returns the ID of the request from the x-request-id header of incoming gRPC metadata,
+// it is empty when the caller or the proxy in front of it didn't set one.
+func RequestID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(headerRequestID)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
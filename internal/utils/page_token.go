@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SignPageToken encodes the payload into an opaque page token of the form <payload>.<signature>.
+func SignPageToken(secretKey, payload []byte) string {
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+
+	return encoded + "." + signPageToken(secretKey, encoded)
+}
+
+// VerifyPageToken verifies the signature of the page token and returns its payload.
+func VerifyPageToken(token string, secretKey []byte) ([]byte, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, errors.New("malformed page token")
+	}
+
+	if !hmac.Equal([]byte(signPageToken(secretKey, encoded)), []byte(signature)) {
+		return nil, errors.New("invalid page token signature")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.New("malformed page token")
+	}
+
+	return payload, nil
+}
+
+// signPageToken signs with a key derived from secretKey, so the key can be shared with access tokens.
+func signPageToken(secretKey []byte, payload string) string {
+	mac := hmac.New(sha256.New, append([]byte("page:"), secretKey...))
+	mac.Write([]byte(payload))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
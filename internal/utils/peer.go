@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"context"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// PeerCertificateIdentities returns identities of the verified client certificate of the gRPC peer:
+// URI SANs, DNS SANs and the subject common name, in this order.
+// Nothing is returned when the peer didn't present a certificate verified against the client CA bundle.
+func PeerCertificateIdentities(ctx context.Context) []string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+
+	cert := tlsInfo.State.VerifiedChains[0][0]
+
+	identities := make([]string, 0, len(cert.URIs)+len(cert.DNSNames)+1)
+	for _, uri := range cert.URIs {
+		identities = append(identities, uri.String())
+	}
+	identities = append(identities, cert.DNSNames...)
+	if cert.Subject.CommonName != "" {
+		identities = append(identities, cert.Subject.CommonName)
+	}
+
+	return identities
+}
@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+)
+
+// MatchEndpoint reports whether the endpoint matches the permission pattern,
+// * in the pattern matches any sequence of characters except /, other characters match themselves.
+func MatchEndpoint(pattern, endpoint string) bool {
+	literals := strings.Split(pattern, "*")
+	if len(literals) == 1 {
+		return pattern == endpoint
+	}
+
+	rest, ok := strings.CutPrefix(endpoint, literals[0])
+	return ok && matchWildcards(rest, literals[1:])
+}
+
+// matchWildcards reports whether s consists of a sequence of characters except / followed by literals[0],
+// then another such sequence followed by literals[1] and so on.
+func matchWildcards(s string, literals []string) bool {
+	if len(literals) == 0 {
+		return s == ""
+	}
+
+	for i := 0; i <= len(s); i++ {
+		if i > 0 && s[i-1] == '/' {
+			return false
+		}
+		rest, ok := strings.CutPrefix(s[i:], literals[0])
+		if ok && matchWildcards(rest, literals[1:]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MatchPermission returns the most specific permission of the endpoint among permissions of the roles held,
+// or nil if none matches. Exact endpoints win over patterns, then more literals, then fewer wildcards, then deny.
+func MatchPermission(permissions []*model.Permission, endpoint string, hasRole func(role string) bool) *model.Permission {
+	var best *model.Permission
+	for _, permission := range permissions {
+		if !hasRole(permission.Role) || !MatchEndpoint(permission.Endpoint, endpoint) {
+			continue
+		}
+
+		if best == nil {
+			best = permission
+			continue
+		}
+
+		cmp := compareSpecificity(permission.Endpoint, best.Endpoint)
+		if cmp > 0 || cmp == 0 && permission.IsDeny() && !best.IsDeny() {
+			best = permission
+		}
+	}
+
+	return best
+}
+
+// compareSpecificity returns a positive number if pattern a is more specific than b,
+// a negative one if it is less specific and zero if both are equally specific.
+func compareSpecificity(a, b string) int {
+	wildcardsA, wildcardsB := strings.Count(a, "*"), strings.Count(b, "*")
+
+	if (wildcardsA == 0) != (wildcardsB == 0) {
+		if wildcardsA == 0 {
+			return 1
+		}
+		return -1
+	}
+
+	literalsA, literalsB := len(a)-wildcardsA, len(b)-wildcardsB
+	if literalsA != literalsB {
+		return literalsA - literalsB
+	}
+
+	return wildcardsB - wildcardsA
+}
@@ -0,0 +1,18 @@
+package utils
+
+import (
+	pbUser "github.com/mikhailsoldatkin/auth/pkg/user_v1"
+)
+
+// MapRole returns the most privileged role mapped from the external groups or the default role.
+func MapRole(groups []string, mapping map[string]string, defaultRole string) string {
+	role := defaultRole
+	for _, group := range groups {
+		mapped, ok := mapping[group]
+		if ok && pbUser.Role_value[mapped] > pbUser.Role_value[role] {
+			role = mapped
+		}
+	}
+
+	return role
+}
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const stateNonceLength = 16
+
+// GenerateState generates a signed state for the federated login redirect of the form <nonce>.<expiry>.<signature>.
+// The nonce is bound to the upstream ID token so that the callback can't be replayed with another login.
+func GenerateState(secretKey []byte, ttl time.Duration) (state, nonce string, err error) {
+	nonce, err = randomHex(stateNonceLength)
+	if err != nil {
+		return "", "", err
+	}
+
+	payload := nonce + "." + strconv.FormatInt(time.Now().Add(ttl).Unix(), 10)
+
+	return payload + "." + signState(secretKey, payload), nonce, nil
+}
+
+// VerifyState verifies the signature and expiry of the state and returns its nonce.
+func VerifyState(state string, secretKey []byte) (string, error) {
+	i := strings.LastIndex(state, ".")
+	if i < 0 {
+		return "", errors.New("malformed state")
+	}
+
+	payload, signature := state[:i], state[i+1:]
+	if !hmac.Equal([]byte(signState(secretKey, payload)), []byte(signature)) {
+		return "", errors.New("invalid state signature")
+	}
+
+	nonce, expiry, ok := strings.Cut(payload, ".")
+	if !ok {
+		return "", errors.New("malformed state")
+	}
+
+	expiresAt, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return "", errors.New("state expired")
+	}
+
+	return nonce, nil
+}
+
+func signState(secretKey []byte, payload string) string {
+	mac := hmac.New(sha256.New, append([]byte("state:"), secretKey...))
+	mac.Write([]byte(payload))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
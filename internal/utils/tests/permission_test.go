@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikhailsoldatkin/auth/internal/service/user/model"
+	"github.com/mikhailsoldatkin/auth/internal/utils"
+)
+
+func TestMatchEndpoint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		pattern  string
+		endpoint string
+		want     bool
+	}{
+		{name: "exact endpoint", pattern: "/chat_v1.ChatV1/Create", endpoint: "/chat_v1.ChatV1/Create", want: true},
+		{name: "other endpoint", pattern: "/chat_v1.ChatV1/Create", endpoint: "/chat_v1.ChatV1/Delete", want: false},
+		{name: "all methods of service", pattern: "/chat_v1.ChatV1/*", endpoint: "/chat_v1.ChatV1/Delete", want: true},
+		{name: "method of other service", pattern: "/chat_v1.ChatV1/*", endpoint: "/user_v1.UserV1/Delete", want: false},
+		{name: "package prefix", pattern: "/admin.*/*", endpoint: "/admin.AdminV1/Reset", want: true},
+		{name: "package prefix mismatch", pattern: "/admin.*/*", endpoint: "/administration.AdminV1/Reset", want: false},
+		{name: "wildcard doesn't cross slash", pattern: "/*", endpoint: "/chat_v1.ChatV1/Create", want: false},
+		{name: "method suffix", pattern: "/*/Get*", endpoint: "/user_v1.UserV1/GetRole", want: true},
+		{name: "trailing wildcard matches nothing", pattern: "/*/Get*", endpoint: "/user_v1.UserV1/Get", want: true},
+		{name: "sub-endpoint", pattern: "/user_v1.UserV1/*/Role", endpoint: "/user_v1.UserV1/Update/Role", want: true},
+		{name: "wildcard doesn't match sub-endpoint", pattern: "/user_v1.UserV1/*", endpoint: "/user_v1.UserV1/Update/Role", want: false},
+		{name: "question mark is literal", pattern: "/chat_v1.ChatV1/Get?*", endpoint: "/chat_v1.ChatV1/GetX", want: false},
+		{name: "bracket is literal", pattern: "/chat_v1.ChatV1/[*", endpoint: "/chat_v1.ChatV1/[Get", want: true},
+		{name: "backslash is literal", pattern: `/chat_v1.ChatV1/\*`, endpoint: "/chat_v1.ChatV1/*", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, utils.MatchEndpoint(tt.pattern, tt.endpoint))
+		})
+	}
+}
+
+func TestMatchPermission(t *testing.T) {
+	t.Parallel()
+
+	var (
+		endpoint = "/chat_v1.ChatV1/Delete"
+
+		allow = func(pattern, role string) *model.Permission {
+			return &model.Permission{Endpoint: pattern, Role: role, Effect: model.EffectAllow}
+		}
+		deny = func(pattern, role string) *model.Permission {
+			return &model.Permission{Endpoint: pattern, Role: role, Effect: model.EffectDeny}
+		}
+		roles = func(roles ...string) func(string) bool {
+			return func(role string) bool {
+				for _, r := range roles {
+					if r == role {
+						return true
+					}
+				}
+				return false
+			}
+		}
+	)
+
+	tests := []struct {
+		name        string
+		permissions []*model.Permission
+		hasRole     func(string) bool
+		wantIndex   int
+	}{
+		{
+			name:        "no permissions",
+			permissions: nil,
+			hasRole:     roles("USER"),
+			wantIndex:   -1,
+		},
+		{
+			name:        "permission of other role",
+			permissions: []*model.Permission{allow(endpoint, "ADMIN")},
+			hasRole:     roles("USER"),
+			wantIndex:   -1,
+		},
+		{
+			name:        "pattern not matching",
+			permissions: []*model.Permission{allow("/user_v1.UserV1/*", "USER")},
+			hasRole:     roles("USER"),
+			wantIndex:   -1,
+		},
+		{
+			name:        "exact endpoint wins over pattern",
+			permissions: []*model.Permission{deny("/chat_v1.ChatV1/*", "USER"), allow(endpoint, "USER")},
+			hasRole:     roles("USER"),
+			wantIndex:   1,
+		},
+		{
+			name:        "exact deny wins over pattern allow",
+			permissions: []*model.Permission{allow("/chat_v1.ChatV1/*", "USER"), deny(endpoint, "USER")},
+			hasRole:     roles("USER"),
+			wantIndex:   1,
+		},
+		{
+			name:        "longer literal prefix wins",
+			permissions: []*model.Permission{allow("/chat_v1.*/*", "USER"), deny("/chat_v1.ChatV1/*", "USER")},
+			hasRole:     roles("USER"),
+			wantIndex:   1,
+		},
+		{
+			name:        "fewer wildcards win",
+			permissions: []*model.Permission{deny("/chat_v1.Chat*/De*", "USER"), allow("/chat_v1.ChatV1/*", "USER")},
+			hasRole:     roles("USER"),
+			wantIndex:   1,
+		},
+		{
+			name:        "deny wins among equally specific",
+			permissions: []*model.Permission{allow(endpoint, "USER"), deny(endpoint, "BANNED")},
+			hasRole:     roles("USER", "BANNED"),
+			wantIndex:   1,
+		},
+		{
+			name:        "first wins among equally specific allows",
+			permissions: []*model.Permission{allow(endpoint, "USER"), allow(endpoint, "ADMIN")},
+			hasRole:     roles("USER", "ADMIN"),
+			wantIndex:   0,
+		},
+		{
+			name:        "more specific allow of other role wins over deny",
+			permissions: []*model.Permission{deny("/chat_v1.ChatV1/*", "USER"), allow(endpoint, "MODERATOR")},
+			hasRole:     roles("USER", "MODERATOR"),
+			wantIndex:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := utils.MatchPermission(tt.permissions, endpoint, tt.hasRole)
+			if tt.wantIndex < 0 {
+				require.Nil(t, got)
+				return
+			}
+			require.Same(t, tt.permissions[tt.wantIndex], got)
+		})
+	}
+}
@@ -13,8 +13,11 @@ import (
 func GenerateToken(user model.User, secretKey []byte, duration time.Duration) (string, error) {
 	claims := model.UserClaims{
 		StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(duration).Unix()},
+		UserID:         user.ID,
 		Username:       user.Username,
 		Role:           user.Role,
+		Roles:          user.EffectiveRoles(),
+		RolesUntil:     rolesUntil(user),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -22,6 +25,63 @@ func GenerateToken(user model.User, secretKey []byte, duration time.Duration) (s
 	return token.SignedString(secretKey)
 }
 
+// GenerateAuthenticatedToken generates a signed JWT token for the provided user
+// with auth_time and amr claims describing when and how the user authenticated.
+func GenerateAuthenticatedToken(
+	user model.User,
+	authTime time.Time,
+	amr []string,
+	secretKey []byte,
+	duration time.Duration,
+) (string, error) {
+	claims := model.UserClaims{
+		StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(duration).Unix()},
+		UserID:         user.ID,
+		Username:       user.Username,
+		Role:           user.Role,
+		Roles:          user.EffectiveRoles(),
+		RolesUntil:     rolesUntil(user),
+		AuthTime:       authTime.Unix(),
+		AMR:            amr,
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	return token.SignedString(secretKey)
+}
+
+// GenerateImpersonationToken generates a signed JWT token for the provided user
+// with an act claim naming the admin who impersonates the user.
+func GenerateImpersonationToken(user model.User, actor string, secretKey []byte, expiresAt time.Time) (string, error) {
+	claims := model.UserClaims{
+		StandardClaims: jwt.StandardClaims{ExpiresAt: expiresAt.Unix()},
+		UserID:         user.ID,
+		Username:       user.Username,
+		Role:           user.Role,
+		Roles:          user.EffectiveRoles(),
+		RolesUntil:     rolesUntil(user),
+		Act:            &model.ActorClaims{Username: actor},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	return token.SignedString(secretKey)
+}
+
+// rolesUntil returns unix expiry times of the user's temporary roles.
+func rolesUntil(user model.User) map[string]int64 {
+	if len(user.RolesUntil) == 0 {
+		return nil
+	}
+
+	until := make(map[string]int64, len(user.RolesUntil))
+	for role, validUntil := range user.RolesUntil {
+		until[role] = validUntil.Unix()
+	}
+
+	return until
+}
+
 // VerifyToken verifies a JWT token string and returns the user claims if the token is valid.
 func VerifyToken(tokenStr string, secretKey []byte) (*model.UserClaims, error) {
 	keyFunc := func(token *jwt.Token) (interface{}, error) {
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE api_tokens
+(
+    id           BIGSERIAL PRIMARY KEY,
+    user_id      BIGINT                   NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name         TEXT                     NOT NULL,
+    prefix       TEXT UNIQUE              NOT NULL,
+    token_hash   TEXT                     NOT NULL,
+    scopes       TEXT[]                   NOT NULL DEFAULT '{}',
+    expires_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at   TIMESTAMP WITH TIME ZONE,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX api_tokens_user_id_idx ON api_tokens (user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS api_tokens;
@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE users
+    ADD COLUMN principal_type TEXT   NOT NULL DEFAULT 'HUMAN',
+    ADD COLUMN owner_id       BIGINT REFERENCES users (id) ON DELETE CASCADE,
+    ADD CONSTRAINT users_principal_type_check CHECK (principal_type IN ('HUMAN', 'SERVICE')),
+    ADD CONSTRAINT users_owner_check CHECK (
+        (principal_type = 'HUMAN' AND owner_id IS NULL) OR
+        (principal_type = 'SERVICE' AND owner_id IS NOT NULL)
+    );
+
+CREATE INDEX users_principal_type_idx ON users (principal_type);
+
+-- +goose Down
+DROP INDEX IF EXISTS users_principal_type_idx;
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS users_owner_check,
+    DROP CONSTRAINT IF EXISTS users_principal_type_check,
+    DROP COLUMN IF EXISTS owner_id,
+    DROP COLUMN IF EXISTS principal_type;
@@ -0,0 +1,7 @@
+-- +goose Up
+INSERT INTO permissions (endpoint, role)
+VALUES ('/auth_v1.AuthV1/Impersonate', 'ADMIN');
+
+-- +goose Down
+DELETE FROM permissions
+WHERE endpoint = '/auth_v1.AuthV1/Impersonate';
@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE permissions
+    ADD COLUMN max_auth_age_min INTEGER CHECK (max_auth_age_min > 0);
+
+-- deleting users and changing roles require a fresh authentication, role changes made with UserV1.Update
+-- are authorized against the /user_v1.UserV1/Update/Role endpoint in addition to the Update one
+INSERT INTO permissions (endpoint, role, max_auth_age_min)
+VALUES ('/user_v1.UserV1/Delete', 'ADMIN', 5),
+       ('/user_v1.UserV1/Update/Role', 'ADMIN', 5)
+ON CONFLICT (endpoint, role) DO NOTHING;
+
+-- +goose Down
+-- rows existing before the migration had no max_auth_age_min, only the inserted ones are deleted
+DELETE FROM permissions
+WHERE (endpoint, role) IN (('/user_v1.UserV1/Delete', 'ADMIN'),
+                           ('/user_v1.UserV1/Update/Role', 'ADMIN'))
+  AND max_auth_age_min = 5;
+
+ALTER TABLE permissions
+    DROP COLUMN IF EXISTS max_auth_age_min;
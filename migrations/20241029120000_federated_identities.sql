@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE federated_identities
+(
+    provider   TEXT                     NOT NULL,
+    subject    TEXT                     NOT NULL,
+    user_id    BIGINT                   NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, subject)
+);
+
+CREATE INDEX federated_identities_user_id_idx ON federated_identities (user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS federated_identities;
@@ -0,0 +1,12 @@
+-- +goose Up
+INSERT INTO permissions (endpoint, role)
+VALUES ('/access_v1.AccessV1/CreatePermission', 'ADMIN'),
+       ('/access_v1.AccessV1/ListPermissions', 'ADMIN'),
+       ('/access_v1.AccessV1/DeletePermission', 'ADMIN')
+ON CONFLICT (endpoint, role) DO NOTHING;
+
+-- +goose Down
+DELETE FROM permissions
+WHERE endpoint IN ('/access_v1.AccessV1/CreatePermission',
+                   '/access_v1.AccessV1/ListPermissions',
+                   '/access_v1.AccessV1/DeletePermission');
@@ -0,0 +1,53 @@
+-- +goose Up
+CREATE TABLE roles
+(
+    name        TEXT PRIMARY KEY,
+    description TEXT                     NOT NULL DEFAULT '',
+    parent      TEXT REFERENCES roles (name) ON DELETE RESTRICT,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    CHECK (parent <> name)
+);
+
+-- built-in roles of the user_v1.Role enum
+INSERT INTO roles (name, description)
+VALUES ('UNKNOWN', 'Role of users without any privileges'),
+       ('USER', 'Regular user'),
+       ('ADMIN', 'Administrator');
+
+-- roles already referenced by users and permissions
+INSERT INTO roles (name)
+SELECT role FROM users
+UNION
+SELECT role FROM permissions
+ON CONFLICT (name) DO NOTHING;
+
+ALTER TABLE users
+    ADD CONSTRAINT users_role_fkey FOREIGN KEY (role) REFERENCES roles (name) ON DELETE RESTRICT;
+
+ALTER TABLE permissions
+    ADD CONSTRAINT permissions_role_fkey FOREIGN KEY (role) REFERENCES roles (name) ON DELETE CASCADE;
+
+INSERT INTO permissions (endpoint, role)
+VALUES ('/access_v1.AccessV1/CreateRole', 'ADMIN'),
+       ('/access_v1.AccessV1/GetRole', 'ADMIN'),
+       ('/access_v1.AccessV1/ListRoles', 'ADMIN'),
+       ('/access_v1.AccessV1/UpdateRole', 'ADMIN'),
+       ('/access_v1.AccessV1/DeleteRole', 'ADMIN')
+ON CONFLICT (endpoint, role) DO NOTHING;
+
+-- +goose Down
+DELETE FROM permissions
+WHERE endpoint IN ('/access_v1.AccessV1/CreateRole',
+                   '/access_v1.AccessV1/GetRole',
+                   '/access_v1.AccessV1/ListRoles',
+                   '/access_v1.AccessV1/UpdateRole',
+                   '/access_v1.AccessV1/DeleteRole');
+
+ALTER TABLE permissions
+    DROP CONSTRAINT IF EXISTS permissions_role_fkey;
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS users_role_fkey;
+
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,62 @@
+-- +goose Up
+CREATE TABLE user_roles
+(
+    user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role    TEXT   NOT NULL REFERENCES roles (name) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, role)
+);
+
+CREATE TABLE groups
+(
+    id          BIGSERIAL PRIMARY KEY,
+    name        TEXT UNIQUE              NOT NULL,
+    description TEXT                     NOT NULL DEFAULT '',
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE TABLE group_members
+(
+    group_id BIGINT NOT NULL REFERENCES groups (id) ON DELETE CASCADE,
+    user_id  BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX group_members_user_id_idx ON group_members (user_id);
+
+CREATE TABLE group_roles
+(
+    group_id BIGINT NOT NULL REFERENCES groups (id) ON DELETE CASCADE,
+    role     TEXT   NOT NULL REFERENCES roles (name) ON DELETE CASCADE,
+    PRIMARY KEY (group_id, role)
+);
+
+INSERT INTO permissions (endpoint, role)
+VALUES ('/access_v1.AccessV1/AssignUserRole', 'ADMIN'),
+       ('/access_v1.AccessV1/RevokeUserRole', 'ADMIN'),
+       ('/access_v1.AccessV1/GetUserRoles', 'ADMIN'),
+       ('/access_v1.AccessV1/CreateGroup', 'ADMIN'),
+       ('/access_v1.AccessV1/ListGroups', 'ADMIN'),
+       ('/access_v1.AccessV1/DeleteGroup', 'ADMIN'),
+       ('/access_v1.AccessV1/AddGroupMember', 'ADMIN'),
+       ('/access_v1.AccessV1/RemoveGroupMember', 'ADMIN'),
+       ('/access_v1.AccessV1/AssignGroupRole', 'ADMIN'),
+       ('/access_v1.AccessV1/RevokeGroupRole', 'ADMIN')
+ON CONFLICT (endpoint, role) DO NOTHING;
+
+-- +goose Down
+DELETE FROM permissions
+WHERE endpoint IN ('/access_v1.AccessV1/AssignUserRole',
+                   '/access_v1.AccessV1/RevokeUserRole',
+                   '/access_v1.AccessV1/GetUserRoles',
+                   '/access_v1.AccessV1/CreateGroup',
+                   '/access_v1.AccessV1/ListGroups',
+                   '/access_v1.AccessV1/DeleteGroup',
+                   '/access_v1.AccessV1/AddGroupMember',
+                   '/access_v1.AccessV1/RemoveGroupMember',
+                   '/access_v1.AccessV1/AssignGroupRole',
+                   '/access_v1.AccessV1/RevokeGroupRole');
+
+DROP TABLE IF EXISTS group_roles;
+DROP TABLE IF EXISTS group_members;
+DROP TABLE IF EXISTS groups;
+DROP TABLE IF EXISTS user_roles;
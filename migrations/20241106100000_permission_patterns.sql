@@ -0,0 +1,12 @@
+-- +goose Up
+-- endpoints may be patterns like /chat_v1.ChatV1/* where * matches any characters except /
+ALTER TABLE permissions
+    ADD COLUMN effect TEXT NOT NULL DEFAULT 'allow' CHECK (effect IN ('allow', 'deny'));
+
+-- +goose Down
+DELETE FROM permissions
+WHERE effect = 'deny'
+   OR strpos(endpoint, '*') > 0;
+
+ALTER TABLE permissions
+    DROP COLUMN IF EXISTS effect;
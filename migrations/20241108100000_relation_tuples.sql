@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE relation_tuples
+(
+    object     TEXT                     NOT NULL,
+    relation   TEXT                     NOT NULL,
+    subject    TEXT                     NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (object, relation, subject)
+);
+
+CREATE INDEX relation_tuples_subject_idx ON relation_tuples (subject);
+
+INSERT INTO permissions (endpoint, role)
+VALUES ('/access_v1.AccessV1/WriteRelation', 'ADMIN'),
+       ('/access_v1.AccessV1/DeleteRelation', 'ADMIN'),
+       ('/access_v1.AccessV1/ListRelations', 'ADMIN'),
+       ('/access_v1.AccessV1/CheckRelation', 'ADMIN')
+ON CONFLICT (endpoint, role) DO NOTHING;
+
+-- +goose Down
+DELETE FROM permissions
+WHERE endpoint IN ('/access_v1.AccessV1/WriteRelation',
+                   '/access_v1.AccessV1/DeleteRelation',
+                   '/access_v1.AccessV1/ListRelations',
+                   '/access_v1.AccessV1/CheckRelation');
+
+DROP TABLE IF EXISTS relation_tuples;
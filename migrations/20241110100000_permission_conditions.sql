@@ -0,0 +1,16 @@
+-- +goose Up
+-- a permission with a CEL condition applies only to requests for which the condition holds
+ALTER TABLE permissions
+    ADD COLUMN condition TEXT NOT NULL DEFAULT '';
+
+INSERT INTO permissions (endpoint, role)
+VALUES ('/access_v1.AccessV1/ValidatePolicy', 'ADMIN')
+ON CONFLICT (endpoint, role) DO NOTHING;
+
+-- +goose Down
+DELETE FROM permissions
+WHERE endpoint = '/access_v1.AccessV1/ValidatePolicy'
+   OR condition <> '';
+
+ALTER TABLE permissions
+    DROP COLUMN IF EXISTS condition;
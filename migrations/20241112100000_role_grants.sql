@@ -0,0 +1,39 @@
+-- +goose Up
+-- a role assigned with valid_until is granted for a limited time and is removed by the sweeper once it expires
+ALTER TABLE user_roles
+    ADD COLUMN valid_from  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    ADD COLUMN valid_until TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX user_roles_valid_until_idx ON user_roles (valid_until) WHERE valid_until IS NOT NULL;
+
+CREATE TABLE elevation_requests
+(
+    id           BIGSERIAL PRIMARY KEY,
+    user_id      BIGINT                   NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role         TEXT                     NOT NULL REFERENCES roles (name) ON DELETE CASCADE,
+    reason       TEXT                     NOT NULL,
+    duration_min INT                      NOT NULL CHECK (duration_min > 0),
+    status       TEXT                     NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'APPROVED')),
+    approved_by  BIGINT REFERENCES users (id) ON DELETE SET NULL,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    approved_at  TIMESTAMP WITH TIME ZONE
+);
+
+INSERT INTO permissions (endpoint, role)
+VALUES ('/access_v1.AccessV1/RequestElevation', 'USER'),
+       ('/access_v1.AccessV1/RequestElevation', 'ADMIN'),
+       ('/access_v1.AccessV1/ApproveElevation', 'ADMIN')
+ON CONFLICT (endpoint, role) DO NOTHING;
+
+-- +goose Down
+DELETE FROM permissions
+WHERE endpoint IN ('/access_v1.AccessV1/RequestElevation',
+                   '/access_v1.AccessV1/ApproveElevation');
+
+DROP TABLE IF EXISTS elevation_requests;
+
+DROP INDEX IF EXISTS user_roles_valid_until_idx;
+
+ALTER TABLE user_roles
+    DROP COLUMN IF EXISTS valid_until,
+    DROP COLUMN IF EXISTS valid_from;
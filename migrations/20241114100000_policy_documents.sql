@@ -0,0 +1,10 @@
+-- +goose Up
+INSERT INTO permissions (endpoint, role)
+VALUES ('/access_v1.AccessV1/ApplyPolicy', 'ADMIN'),
+       ('/access_v1.AccessV1/ExportPolicy', 'ADMIN')
+ON CONFLICT (endpoint, role) DO NOTHING;
+
+-- +goose Down
+DELETE FROM permissions
+WHERE endpoint IN ('/access_v1.AccessV1/ApplyPolicy',
+                   '/access_v1.AccessV1/ExportPolicy');
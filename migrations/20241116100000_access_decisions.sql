@@ -0,0 +1,31 @@
+-- +goose Up
+-- decisions are kept after the principal is deleted, so user_id and permission_id are not foreign keys
+CREATE TABLE access_decisions
+(
+    id            BIGSERIAL PRIMARY KEY,
+    user_id       BIGINT,
+    username      TEXT                     NOT NULL DEFAULT '',
+    actor         TEXT                     NOT NULL DEFAULT '',
+    endpoint      TEXT                     NOT NULL,
+    decision      TEXT                     NOT NULL CHECK (decision IN ('ALLOW', 'DENY', 'STEP_UP', 'UNAUTHENTICATED')),
+    permission_id BIGINT,
+    matched_rule  TEXT                     NOT NULL DEFAULT '',
+    reason        TEXT                     NOT NULL DEFAULT '',
+    latency_us    BIGINT                   NOT NULL,
+    request_id    TEXT                     NOT NULL DEFAULT '',
+    created_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX access_decisions_created_at_idx ON access_decisions (created_at);
+CREATE INDEX access_decisions_user_id_created_at_idx ON access_decisions (user_id, created_at);
+CREATE INDEX access_decisions_endpoint_created_at_idx ON access_decisions (endpoint, created_at);
+
+INSERT INTO permissions (endpoint, role)
+VALUES ('/access_v1.AccessV1/ListDecisions', 'ADMIN')
+ON CONFLICT (endpoint, role) DO NOTHING;
+
+-- +goose Down
+DELETE FROM permissions
+WHERE endpoint = '/access_v1.AccessV1/ListDecisions';
+
+DROP TABLE IF EXISTS access_decisions;
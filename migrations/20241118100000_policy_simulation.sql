@@ -0,0 +1,10 @@
+-- +goose Up
+INSERT INTO permissions (endpoint, role)
+VALUES ('/access_v1.AccessV1/Simulate', 'ADMIN'),
+       ('/access_v1.AccessV1/Explain', 'ADMIN')
+ON CONFLICT (endpoint, role) DO NOTHING;
+
+-- +goose Down
+DELETE FROM permissions
+WHERE endpoint IN ('/access_v1.AccessV1/Simulate',
+                   '/access_v1.AccessV1/Explain');
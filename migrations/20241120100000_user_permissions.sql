@@ -0,0 +1,29 @@
+-- +goose Up
+-- UserV1 calls are authorized by the auth interceptor, users read and update only their own accounts
+-- unless they are admins. Sign-ups with the USER role are public, creating users with other roles is
+-- checked against the Create permissions.
+INSERT INTO permissions (endpoint, role)
+VALUES ('/user_v1.UserV1/Create', 'ADMIN'),
+       ('/user_v1.UserV1/Get', 'USER'),
+       ('/user_v1.UserV1/Get', 'ADMIN'),
+       ('/user_v1.UserV1/List', 'ADMIN'),
+       ('/user_v1.UserV1/Update', 'USER'),
+       ('/user_v1.UserV1/Update', 'ADMIN'),
+       ('/user_v1.UserV1/CheckUsersExist', 'USER'),
+       ('/user_v1.UserV1/CheckUsersExist', 'ADMIN'),
+       ('/user_v1.UserV1/CreateServiceAccount', 'USER'),
+       ('/user_v1.UserV1/CreateServiceAccount', 'ADMIN')
+ON CONFLICT (endpoint, role) DO NOTHING;
+
+-- +goose Down
+DELETE FROM permissions
+WHERE (endpoint, role) IN (('/user_v1.UserV1/Create', 'ADMIN'),
+                           ('/user_v1.UserV1/Get', 'USER'),
+                           ('/user_v1.UserV1/Get', 'ADMIN'),
+                           ('/user_v1.UserV1/List', 'ADMIN'),
+                           ('/user_v1.UserV1/Update', 'USER'),
+                           ('/user_v1.UserV1/Update', 'ADMIN'),
+                           ('/user_v1.UserV1/CheckUsersExist', 'USER'),
+                           ('/user_v1.UserV1/CheckUsersExist', 'ADMIN'),
+                           ('/user_v1.UserV1/CreateServiceAccount', 'USER'),
+                           ('/user_v1.UserV1/CreateServiceAccount', 'ADMIN'));
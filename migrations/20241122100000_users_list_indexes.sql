@@ -0,0 +1,15 @@
+-- +goose Up
+-- Username and email filters use ILIKE with prefix or substring patterns, trigram indexes serve both.
+-- Sorting by username or email is served by their unique indexes, created_at is paired with id which breaks ties.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX users_username_trgm_idx ON users USING GIN (username gin_trgm_ops);
+CREATE INDEX users_email_trgm_idx ON users USING GIN (email gin_trgm_ops);
+CREATE INDEX users_created_at_id_idx ON users (created_at, id);
+CREATE INDEX users_role_idx ON users (role);
+
+-- +goose Down
+DROP INDEX IF EXISTS users_role_idx;
+DROP INDEX IF EXISTS users_created_at_id_idx;
+DROP INDEX IF EXISTS users_email_trgm_idx;
+DROP INDEX IF EXISTS users_username_trgm_idx;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- users check their own relations, checking relations of other users is granted separately
+-- by the /access_v1.AccessV1/CheckRelation/AnyUser permission, e.g. to roles of resource services
+INSERT INTO permissions (endpoint, role)
+VALUES ('/access_v1.AccessV1/CheckRelation', 'USER'),
+       ('/access_v1.AccessV1/CheckRelation/AnyUser', 'ADMIN')
+ON CONFLICT (endpoint, role) DO NOTHING;
+
+-- +goose Down
+DELETE FROM permissions
+WHERE (endpoint, role) IN (('/access_v1.AccessV1/CheckRelation', 'USER'),
+                           ('/access_v1.AccessV1/CheckRelation/AnyUser', 'ADMIN'));
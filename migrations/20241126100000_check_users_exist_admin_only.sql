@@ -0,0 +1,10 @@
+-- +goose Up
+-- only admins check that users exist, users could enumerate user IDs otherwise
+DELETE FROM permissions
+WHERE endpoint = '/user_v1.UserV1/CheckUsersExist'
+  AND role = 'USER';
+
+-- +goose Down
+INSERT INTO permissions (endpoint, role)
+VALUES ('/user_v1.UserV1/CheckUsersExist', 'USER')
+ON CONFLICT (endpoint, role) DO NOTHING;
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,9 +20,16 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	AuthV1_Login_FullMethodName           = "/auth_v1.AuthV1/Login"
-	AuthV1_GetRefreshToken_FullMethodName = "/auth_v1.AuthV1/GetRefreshToken"
-	AuthV1_GetAccessToken_FullMethodName  = "/auth_v1.AuthV1/GetAccessToken"
+	AuthV1_Login_FullMethodName             = "/auth_v1.AuthV1/Login"
+	AuthV1_GetRefreshToken_FullMethodName   = "/auth_v1.AuthV1/GetRefreshToken"
+	AuthV1_GetAccessToken_FullMethodName    = "/auth_v1.AuthV1/GetAccessToken"
+	AuthV1_CreateAPIToken_FullMethodName    = "/auth_v1.AuthV1/CreateAPIToken"
+	AuthV1_ListAPITokens_FullMethodName     = "/auth_v1.AuthV1/ListAPITokens"
+	AuthV1_RevokeAPIToken_FullMethodName    = "/auth_v1.AuthV1/RevokeAPIToken"
+	AuthV1_ClientCredentials_FullMethodName = "/auth_v1.AuthV1/ClientCredentials"
+	AuthV1_Impersonate_FullMethodName       = "/auth_v1.AuthV1/Impersonate"
+	AuthV1_StopImpersonation_FullMethodName = "/auth_v1.AuthV1/StopImpersonation"
+	AuthV1_Reauthenticate_FullMethodName    = "/auth_v1.AuthV1/Reauthenticate"
 )
 
 // AuthV1Client is the client API for AuthV1 service.
@@ -31,6 +39,13 @@ type AuthV1Client interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	GetRefreshToken(ctx context.Context, in *GetRefreshTokenRequest, opts ...grpc.CallOption) (*GetRefreshTokenResponse, error)
 	GetAccessToken(ctx context.Context, in *GetAccessTokenRequest, opts ...grpc.CallOption) (*GetAccessTokenResponse, error)
+	CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenResponse, error)
+	ListAPITokens(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAPITokensResponse, error)
+	RevokeAPIToken(ctx context.Context, in *RevokeAPITokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ClientCredentials(ctx context.Context, in *ClientCredentialsRequest, opts ...grpc.CallOption) (*ClientCredentialsResponse, error)
+	Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*ImpersonateResponse, error)
+	StopImpersonation(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Reauthenticate(ctx context.Context, in *ReauthenticateRequest, opts ...grpc.CallOption) (*ReauthenticateResponse, error)
 }
 
 type authV1Client struct {
@@ -71,6 +86,76 @@ func (c *authV1Client) GetAccessToken(ctx context.Context, in *GetAccessTokenReq
 	return out, nil
 }
 
+func (c *authV1Client) CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPITokenResponse)
+	err := c.cc.Invoke(ctx, AuthV1_CreateAPIToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authV1Client) ListAPITokens(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAPITokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPITokensResponse)
+	err := c.cc.Invoke(ctx, AuthV1_ListAPITokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authV1Client) RevokeAPIToken(ctx context.Context, in *RevokeAPITokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthV1_RevokeAPIToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authV1Client) ClientCredentials(ctx context.Context, in *ClientCredentialsRequest, opts ...grpc.CallOption) (*ClientCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClientCredentialsResponse)
+	err := c.cc.Invoke(ctx, AuthV1_ClientCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authV1Client) Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*ImpersonateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonateResponse)
+	err := c.cc.Invoke(ctx, AuthV1_Impersonate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authV1Client) StopImpersonation(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthV1_StopImpersonation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authV1Client) Reauthenticate(ctx context.Context, in *ReauthenticateRequest, opts ...grpc.CallOption) (*ReauthenticateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReauthenticateResponse)
+	err := c.cc.Invoke(ctx, AuthV1_Reauthenticate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthV1Server is the server API for AuthV1 service.
 // All implementations must embed UnimplementedAuthV1Server
 // for forward compatibility
@@ -78,6 +163,13 @@ type AuthV1Server interface {
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	GetRefreshToken(context.Context, *GetRefreshTokenRequest) (*GetRefreshTokenResponse, error)
 	GetAccessToken(context.Context, *GetAccessTokenRequest) (*GetAccessTokenResponse, error)
+	CreateAPIToken(context.Context, *CreateAPITokenRequest) (*CreateAPITokenResponse, error)
+	ListAPITokens(context.Context, *emptypb.Empty) (*ListAPITokensResponse, error)
+	RevokeAPIToken(context.Context, *RevokeAPITokenRequest) (*emptypb.Empty, error)
+	ClientCredentials(context.Context, *ClientCredentialsRequest) (*ClientCredentialsResponse, error)
+	Impersonate(context.Context, *ImpersonateRequest) (*ImpersonateResponse, error)
+	StopImpersonation(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	Reauthenticate(context.Context, *ReauthenticateRequest) (*ReauthenticateResponse, error)
 	mustEmbedUnimplementedAuthV1Server()
 }
 
@@ -94,6 +186,27 @@ func (UnimplementedAuthV1Server) GetRefreshToken(context.Context, *GetRefreshTok
 func (UnimplementedAuthV1Server) GetAccessToken(context.Context, *GetAccessTokenRequest) (*GetAccessTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccessToken not implemented")
 }
+func (UnimplementedAuthV1Server) CreateAPIToken(context.Context, *CreateAPITokenRequest) (*CreateAPITokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIToken not implemented")
+}
+func (UnimplementedAuthV1Server) ListAPITokens(context.Context, *emptypb.Empty) (*ListAPITokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPITokens not implemented")
+}
+func (UnimplementedAuthV1Server) RevokeAPIToken(context.Context, *RevokeAPITokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIToken not implemented")
+}
+func (UnimplementedAuthV1Server) ClientCredentials(context.Context, *ClientCredentialsRequest) (*ClientCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientCredentials not implemented")
+}
+func (UnimplementedAuthV1Server) Impersonate(context.Context, *ImpersonateRequest) (*ImpersonateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Impersonate not implemented")
+}
+func (UnimplementedAuthV1Server) StopImpersonation(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopImpersonation not implemented")
+}
+func (UnimplementedAuthV1Server) Reauthenticate(context.Context, *ReauthenticateRequest) (*ReauthenticateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reauthenticate not implemented")
+}
 func (UnimplementedAuthV1Server) mustEmbedUnimplementedAuthV1Server() {}
 
 // UnsafeAuthV1Server may be embedded to opt out of forward compatibility for this service.
@@ -161,6 +274,132 @@ func _AuthV1_GetAccessToken_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthV1_CreateAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthV1Server).CreateAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthV1_CreateAPIToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthV1Server).CreateAPIToken(ctx, req.(*CreateAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthV1_ListAPITokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthV1Server).ListAPITokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthV1_ListAPITokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthV1Server).ListAPITokens(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthV1_RevokeAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthV1Server).RevokeAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthV1_RevokeAPIToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthV1Server).RevokeAPIToken(ctx, req.(*RevokeAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthV1_ClientCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthV1Server).ClientCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthV1_ClientCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthV1Server).ClientCredentials(ctx, req.(*ClientCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthV1_Impersonate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthV1Server).Impersonate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthV1_Impersonate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthV1Server).Impersonate(ctx, req.(*ImpersonateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthV1_StopImpersonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthV1Server).StopImpersonation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthV1_StopImpersonation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthV1Server).StopImpersonation(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthV1_Reauthenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReauthenticateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthV1Server).Reauthenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthV1_Reauthenticate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthV1Server).Reauthenticate(ctx, req.(*ReauthenticateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthV1_ServiceDesc is the grpc.ServiceDesc for AuthV1 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -180,6 +419,34 @@ var AuthV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccessToken",
 			Handler:    _AuthV1_GetAccessToken_Handler,
 		},
+		{
+			MethodName: "CreateAPIToken",
+			Handler:    _AuthV1_CreateAPIToken_Handler,
+		},
+		{
+			MethodName: "ListAPITokens",
+			Handler:    _AuthV1_ListAPITokens_Handler,
+		},
+		{
+			MethodName: "RevokeAPIToken",
+			Handler:    _AuthV1_RevokeAPIToken_Handler,
+		},
+		{
+			MethodName: "ClientCredentials",
+			Handler:    _AuthV1_ClientCredentials_Handler,
+		},
+		{
+			MethodName: "Impersonate",
+			Handler:    _AuthV1_Impersonate_Handler,
+		},
+		{
+			MethodName: "StopImpersonation",
+			Handler:    _AuthV1_StopImpersonation_Handler,
+		},
+		{
+			MethodName: "Reauthenticate",
+			Handler:    _AuthV1_Reauthenticate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
@@ -150,6 +150,12 @@ func (m *User) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for PrincipalType
+
+	// no validation rules for OwnerId
+
+	// no validation rules for RoleName
+
 	if len(errors) > 0 {
 		return UserMultiError(errors)
 	}
@@ -355,6 +361,17 @@ func (m *CreateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetRoleName()) > 64 {
+		err := CreateRequestValidationError{
+			field:  "RoleName",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreateRequestMultiError(errors)
 	}
@@ -898,6 +915,17 @@ func (m *UpdateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetRoleName()) > 64 {
+		err := UpdateRequestValidationError{
+			field:  "RoleName",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return UpdateRequestMultiError(errors)
 	}
@@ -1181,6 +1209,152 @@ func (m *ListRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := PrincipalType_name[int32(m.GetPrincipalType())]; !ok {
+		err := ListRequestValidationError{
+			field:  "PrincipalType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsername()) > 25 {
+		err := ListRequestValidationError{
+			field:  "Username",
+			reason: "value length must be at most 25 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEmail()) > 254 {
+		err := ListRequestValidationError{
+			field:  "Email",
+			reason: "value length must be at most 254 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := MatchMode_name[int32(m.GetMatch())]; !ok {
+		err := ListRequestValidationError{
+			field:  "Match",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) > 64 {
+		err := ListRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListRequestValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListRequestValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListRequestValidationError{
+				field:  "CreatedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListRequestValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListRequestValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListRequestValidationError{
+				field:  "CreatedTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := SortField_name[int32(m.GetSortBy())]; !ok {
+		err := ListRequestValidationError{
+			field:  "SortBy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := SortDirection_name[int32(m.GetDirection())]; !ok {
+		err := ListRequestValidationError{
+			field:  "Direction",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 2048 {
+		err := ListRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ListRequestMultiError(errors)
 	}
@@ -1314,6 +1488,10 @@ func (m *ListResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for TotalCount
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ListResponseMultiError(errors)
 	}
@@ -1492,3 +1670,319 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CheckUsersExistRequestValidationError{}
+
+// Validate checks the field values on CreateServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateServiceAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateServiceAccountRequestMultiError, or nil if none found.
+func (m *CreateServiceAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateServiceAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 1 || l > 25 {
+		err := CreateServiceAccountRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 1 and 25 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = CreateServiceAccountRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Role_name[int32(m.GetRole())]; !ok {
+		err := CreateServiceAccountRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOwnerId() <= 0 {
+		err := CreateServiceAccountRequestValidationError{
+			field:  "OwnerId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRoleName()) > 64 {
+		err := CreateServiceAccountRequestValidationError{
+			field:  "RoleName",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateServiceAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateServiceAccountRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *CreateServiceAccountRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// CreateServiceAccountRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateServiceAccountRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateServiceAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateServiceAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateServiceAccountRequestMultiError) AllErrors() []error { return m }
+
+// CreateServiceAccountRequestValidationError is the validation error returned
+// by CreateServiceAccountRequest.Validate if the designated constraints
+// aren't met.
+type CreateServiceAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateServiceAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateServiceAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateServiceAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateServiceAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateServiceAccountRequestValidationError) ErrorName() string {
+	return "CreateServiceAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateServiceAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateServiceAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateServiceAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateServiceAccountRequestValidationError{}
+
+// Validate checks the field values on CreateServiceAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateServiceAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateServiceAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateServiceAccountResponseMultiError, or nil if none found.
+func (m *CreateServiceAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateServiceAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ClientSecret
+
+	if len(errors) > 0 {
+		return CreateServiceAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateServiceAccountResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateServiceAccountResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateServiceAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateServiceAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateServiceAccountResponseMultiError) AllErrors() []error { return m }
+
+// CreateServiceAccountResponseValidationError is the validation error returned
+// by CreateServiceAccountResponse.Validate if the designated constraints
+// aren't met.
+type CreateServiceAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateServiceAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateServiceAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateServiceAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateServiceAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateServiceAccountResponseValidationError) ErrorName() string {
+	return "CreateServiceAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateServiceAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateServiceAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateServiceAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateServiceAccountResponseValidationError{}
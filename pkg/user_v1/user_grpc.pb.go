@@ -20,12 +20,13 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	UserV1_Create_FullMethodName          = "/user_v1.UserV1/Create"
-	UserV1_Get_FullMethodName             = "/user_v1.UserV1/Get"
-	UserV1_List_FullMethodName            = "/user_v1.UserV1/List"
-	UserV1_Update_FullMethodName          = "/user_v1.UserV1/Update"
-	UserV1_Delete_FullMethodName          = "/user_v1.UserV1/Delete"
-	UserV1_CheckUsersExist_FullMethodName = "/user_v1.UserV1/CheckUsersExist"
+	UserV1_Create_FullMethodName               = "/user_v1.UserV1/Create"
+	UserV1_Get_FullMethodName                  = "/user_v1.UserV1/Get"
+	UserV1_List_FullMethodName                 = "/user_v1.UserV1/List"
+	UserV1_Update_FullMethodName               = "/user_v1.UserV1/Update"
+	UserV1_Delete_FullMethodName               = "/user_v1.UserV1/Delete"
+	UserV1_CheckUsersExist_FullMethodName      = "/user_v1.UserV1/CheckUsersExist"
+	UserV1_CreateServiceAccount_FullMethodName = "/user_v1.UserV1/CreateServiceAccount"
 )
 
 // UserV1Client is the client API for UserV1 service.
@@ -38,6 +39,7 @@ type UserV1Client interface {
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CheckUsersExist(ctx context.Context, in *CheckUsersExistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error)
 }
 
 type userV1Client struct {
@@ -108,6 +110,16 @@ func (c *userV1Client) CheckUsersExist(ctx context.Context, in *CheckUsersExistR
 	return out, nil
 }
 
+func (c *userV1Client) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateServiceAccountResponse)
+	err := c.cc.Invoke(ctx, UserV1_CreateServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserV1Server is the server API for UserV1 service.
 // All implementations must embed UnimplementedUserV1Server
 // for forward compatibility
@@ -118,6 +130,7 @@ type UserV1Server interface {
 	Update(context.Context, *UpdateRequest) (*emptypb.Empty, error)
 	Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error)
 	CheckUsersExist(context.Context, *CheckUsersExistRequest) (*emptypb.Empty, error)
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error)
 	mustEmbedUnimplementedUserV1Server()
 }
 
@@ -143,6 +156,9 @@ func (UnimplementedUserV1Server) Delete(context.Context, *DeleteRequest) (*empty
 func (UnimplementedUserV1Server) CheckUsersExist(context.Context, *CheckUsersExistRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckUsersExist not implemented")
 }
+func (UnimplementedUserV1Server) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
 func (UnimplementedUserV1Server) mustEmbedUnimplementedUserV1Server() {}
 
 // UnsafeUserV1Server may be embedded to opt out of forward compatibility for this service.
@@ -264,6 +280,24 @@ func _UserV1_CheckUsersExist_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserV1_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserV1Server).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserV1_CreateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserV1Server).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserV1_ServiceDesc is the grpc.ServiceDesc for UserV1 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -295,6 +329,10 @@ var UserV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckUsersExist",
 			Handler:    _UserV1_CheckUsersExist_Handler,
 		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _UserV1_CreateServiceAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",